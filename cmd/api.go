@@ -39,6 +39,7 @@ Access the API documentation at http://localhost:8080/swagger/index.html`,
 
 		port, _ := cmd.Flags().GetInt("port")
 		frontendPath, _ := cmd.Flags().GetString("frontend")
+		maxBuilds, _ := cmd.Flags().GetInt("max-builds")
 
 		// Auto-detect frontend path if not provided
 		if frontendPath == "" {
@@ -68,6 +69,16 @@ Access the API documentation at http://localhost:8080/swagger/index.html`,
 		}
 		defer services.Close()
 
+		// Limit the number of builds running at the same time
+		services.BuildQueue.SetMaxWorkers(maxBuilds)
+
+		// Builds that were still active when the server stopped cannot be resumed
+		if count, err := services.Project.MarkInterruptedBuilds(context.Background()); err != nil {
+			slog.Warn("Could not mark interrupted builds", "error", err)
+		} else if count > 0 {
+			slog.Info("Marked interrupted builds", "count", count)
+		}
+
 		// Create API server with embedded frontend (fallback to external if provided)
 		server := api.NewServer(services, api.ServerOptions{
 			FrontendPath: frontendPath, // Used as fallback if embedded fails
@@ -125,4 +136,5 @@ func init() {
 	rootCmd.AddCommand(apiCmd)
 	apiCmd.Flags().IntP("port", "p", 8080, "Port to run the API server on")
 	apiCmd.Flags().StringP("frontend", "f", "", "Path to frontend dist directory (optional)")
+	apiCmd.Flags().Int("max-builds", core.DefaultMaxConcurrentBuilds, "Maximum number of project builds running at the same time")
 }
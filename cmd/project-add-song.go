@@ -9,9 +9,8 @@ import (
 	"log/slog"
 	"strconv"
 
-	"github.com/bwl21/zupfmanager/internal/database"
-	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
 	"github.com/bwl21/zupfmanager/internal/ent/song"
+	"github.com/bwl21/zupfmanager/pkg/core"
 	"github.com/spf13/cobra"
 )
 
@@ -25,10 +24,17 @@ var projectAddSongCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cmd.SilenceUsage = true
 
-		client, err := database.New()
+		// Songs are added through the core service, which numbers them
+		services, err := core.NewServices()
 		if err != nil {
 			return err
 		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+		client := services.DB()
 
 		// Parse project ID
 		projectID, err := strconv.Atoi(args[0])
@@ -89,13 +95,13 @@ var projectAddSongCmd = &cobra.Command{
 		comment, _ := cmd.Flags().GetString("comment")
 
 		// Add song to project
-		projectSong, err := client.ProjectSong.Create().
-			SetProjectID(projectID).
-			SetSongID(songID).
-			SetPriority(priority).
-			SetDifficulty(projectsong.Difficulty(difficulty)).
-			SetComment(comment).
-			Save(context.Background())
+		projectSong, err := services.Project.AddSongToProject(context.Background(), core.AddSongToProjectRequest{
+			ProjectID:  projectID,
+			SongID:     songID,
+			Priority:   &priority,
+			Difficulty: &difficulty,
+			Comment:    &comment,
+		})
 		if err != nil {
 			return err
 		}
@@ -111,7 +117,8 @@ var projectAddSongCmd = &cobra.Command{
 			"song_id", songID,
 			"song_filename", songResult.Filename,
 			"priority", projectSong.Priority,
-			"difficulty", projectSong.Difficulty)
+			"difficulty", projectSong.Difficulty,
+			"number", projectSong.Number)
 
 		return nil
 	},
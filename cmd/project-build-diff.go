@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// projectBuildDiffCmd compares two builds of a project
+var projectBuildDiffCmd = &cobra.Command{
+	Use:   "build-diff <project-id> <from-build-id> [to-build-id]",
+	Short: "Show what changed between two builds of a project",
+	Long: `Show the files that were added, removed or changed by content between two
+finished builds of a project and the songs that were added, removed,
+renumbered or whose config or ABC file changed. Changed print files show the
+pages they take in the merged PDF of their folder, so that only those pages
+need to be reprinted. Without a second build ID the latest finished build is
+compared.
+
+With --pdf the print files the newer build added or changed are merged into a
+single PDF for reprinting, --folder restricts them to druckdateien folders.`,
+	Args: cobra.RangeArgs(2, 3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		projectID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid project ID: %w", err)
+		}
+		toBuildID := ""
+		if len(args) == 3 {
+			toBuildID = args[2]
+		}
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		ctx := context.Background()
+		diff, err := services.Project.CompareBuilds(ctx, projectID, args[1], toBuildID)
+		if err != nil {
+			return fmt.Errorf("failed to compare builds: %w", err)
+		}
+
+		if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+			if err := printJSON(diff); err != nil {
+				return err
+			}
+		} else if err := printBuildDiff(diff); err != nil {
+			return err
+		}
+
+		pdfFile, _ := cmd.Flags().GetString("pdf")
+		if pdfFile == "" {
+			return nil
+		}
+		folders, _ := cmd.Flags().GetStringSlice("folder")
+		count, err := services.Project.WriteChangedPagesPDF(ctx, diff, folders, pdfFile)
+		if err != nil {
+			return fmt.Errorf("failed to write changed pages: %w", err)
+		}
+		if count == 0 {
+			fmt.Fprintln(os.Stderr, "No print files changed, no PDF written")
+			return nil
+		}
+		fmt.Fprintf(os.Stderr, "Changed pages of %d print files written to %s\n", count, pdfFile)
+		return nil
+	},
+}
+
+func init() {
+	projectCmd.AddCommand(projectBuildDiffCmd)
+
+	projectBuildDiffCmd.Flags().String("pdf", "", "Merge the print files the newer build added or changed into this PDF")
+	projectBuildDiffCmd.Flags().StringSlice("folder", nil, "The druckdateien folders to take the print files for --pdf from, e.g. klein (default: all)")
+	projectBuildDiffCmd.Flags().BoolP("json", "j", false, "Output the diff in JSON format")
+}
+
+// printBuildDiff prints the changed songs and files of a build diff
+func printBuildDiff(diff *core.BuildDiff) error {
+	fmt.Printf("Changes from build %s (%s) to %s (%s)\n", shortBuildID(diff.From.BuildID), diff.From.StartedAt, shortBuildID(diff.To.BuildID), diff.To.StartedAt)
+	fmt.Printf("Files: %d added, %d removed, %d changed, %d unchanged\n", diff.FilesAdded, diff.FilesRemoved, diff.FilesChanged, diff.FilesUnchanged)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	if len(diff.Songs) > 0 {
+		fmt.Fprintf(w, "\nSongs (%d):\n", len(diff.Songs))
+		for _, song := range diff.Songs {
+			number := fmt.Sprintf("%02d", song.ToIndex)
+			switch {
+			case song.ToIndex == 0:
+				number = fmt.Sprintf("%02d", song.FromIndex)
+			case song.FromIndex != 0 && song.FromIndex != song.ToIndex:
+				number = fmt.Sprintf("%02d -> %02d", song.FromIndex, song.ToIndex)
+			}
+			fmt.Fprintf(w, "   %s\t%s\t%s\t%s\n", number, song.Title, song.Edition, strings.Join(song.Changes, ", "))
+		}
+	}
+
+	if len(diff.Files) > 0 {
+		fmt.Fprintf(w, "\nFiles (%d):\n", len(diff.Files))
+		for _, file := range diff.Files {
+			pages := ""
+			switch {
+			case file.PagesChanged():
+				pages = fmt.Sprintf("%d -> %d pages", file.FromPages, file.ToPages)
+			case file.ToPages > 0:
+				pages = fmt.Sprintf("%d pages", file.ToPages)
+			}
+			merged := ""
+			if file.MergedFile != "" {
+				merged = fmt.Sprintf("%s p. %d-%d", file.MergedFile, file.FirstPage, file.LastPage)
+			}
+			fmt.Fprintf(w, "   %s\t%s\t%s\t%s\n", file.Change, file.Path, pages, merged)
+		}
+	}
+	return w.Flush()
+}
+
+// shortBuildID returns the first part of a build ID
+func shortBuildID(buildID string) string {
+	if len(buildID) > 8 {
+		return buildID[:8]
+	}
+	return buildID
+}
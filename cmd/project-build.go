@@ -3,24 +3,38 @@ package cmd
 import (
 	"context"
 	_ "embed"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"syscall"
 
-	"github.com/bwl21/zupfmanager/internal/database"
-	"github.com/bwl21/zupfmanager/internal/ent"
-	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
 	"github.com/bwl21/zupfmanager/pkg/core"
 	"github.com/spf13/cobra"
 )
 
 
 
+// cliPriorityThreshold is the priority threshold of builds from the command
+// line that neither set one nor use a profile that does
+const cliPriorityThreshold = 1
+
 var (
 	projectBuildOutputDir         string
 	projectBuildAbcFileDir        string
 	projectBuildPriorityThreshold int
 	projectSampleId               string
+	projectBuildIncremental       bool
+	projectBuildDryRun            bool
+	projectBuildSongOrder         string
+	projectBuildSince             string
+	projectBuildProfile           string
+	projectBuildFinal             bool
 )
 
 var projectBuildCmd = &cobra.Command{
@@ -34,63 +48,6 @@ var projectBuildCmd = &cobra.Command{
 			return fmt.Errorf("invalid project ID: %w", err)
 		}
 
-		client, err := database.New()
-		if err != nil {
-			return err
-		}
-		defer func() {
-			if err := client.Close(); err != nil {
-				slog.Warn("failed to close database client", "error", err)
-			}
-		}()
-
-		// First, get the project to ensure it exists
-		project, err := client.Project.Get(context.Background(), projectID)
-		if err != nil {
-			return fmt.Errorf("failed to find project with ID %d: %w", projectID, err)
-		}
-
-		// Then query the project songs separately with the priority filter
-		projectSongs, err := client.ProjectSong.Query().
-			Where(
-				projectsong.And(
-					projectsong.ProjectID(projectID),
-					projectsong.PriorityLTE(projectBuildPriorityThreshold),
-				),
-			).
-			WithSong().
-			WithProject().
-			Order(ent.Asc("priority")).
-			All(context.Background())
-		if err != nil {
-			return fmt.Errorf("failed to query project songs: %w", err)
-		}
-
-		// Attach the songs to the project for compatibility with the rest of the code
-		project.Edges.ProjectSongs = projectSongs
-
-		if projectBuildOutputDir == "" {
-			projectBuildOutputDir = project.ShortName
-		}
-
-		if projectBuildAbcFileDir == "" {
-			// Priority order: abc_file_dir_preference > abc_file_dir (from config) > last import directory
-			if project.AbcFileDirPreference != "" {
-				projectBuildAbcFileDir = project.AbcFileDirPreference
-			} else if abcFileDir, ok := project.Config["abc_file_dir"].(string); ok && abcFileDir != "" {
-				projectBuildAbcFileDir = abcFileDir
-			} else {
-				// Try to use the most recent import directory as default
-				lastImportDir, err := core.GetLastImportDir()
-				if err == nil && lastImportDir != "" {
-					projectBuildAbcFileDir = lastImportDir
-				} else {
-					// Provide a default value or handle the error appropriately
-					projectBuildAbcFileDir = ""
-				}
-			}
-		}
-
 		// Use core service for build logic
 		services, err := core.NewServices()
 		if err != nil {
@@ -102,24 +59,194 @@ var projectBuildCmd = &cobra.Command{
 			}
 		}()
 
+		// Parameters left out come from the build profile or the project defaults
 		buildReq := core.BuildProjectRequest{
 			ProjectID:         projectID,
 			OutputDir:         projectBuildOutputDir,
 			AbcFileDir:        projectBuildAbcFileDir,
 			PriorityThreshold: projectBuildPriorityThreshold,
 			SampleID:          projectSampleId,
+			Incremental:       projectBuildIncremental,
+			DryRun:            projectBuildDryRun,
+			SongOrder:         projectBuildSongOrder,
+			SupplementSince:   projectBuildSince,
+			Profile:           projectBuildProfile,
+			Final:             projectBuildFinal,
+
+			FallbackPriorityThreshold: cliPriorityThreshold,
+		}
+
+		// Ctrl-C or SIGTERM cancel the build, running zupfnoter and Chrome processes are killed
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		result, err := services.Project.RunProjectBuild(ctx, buildReq)
+
+		// Check if json output is requested, the report is printed for failed builds as well
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		if jsonOutput && result != nil {
+			jsonData, jsonErr := json.MarshalIndent(result, "", "  ")
+			if jsonErr != nil {
+				return jsonErr
+			}
+			fmt.Println(string(jsonData))
 		}
 
-		return services.Project.ExecuteProjectBuild(context.Background(), buildReq)
+		if ctx.Err() != nil {
+			return errors.New("build cancelled")
+		}
+		if err != nil {
+			return err
+		}
+		if jsonOutput {
+			return nil
+		}
+		if result.Plan != nil {
+			printBuildPlan(result.Plan)
+			return nil
+		}
+
+		fmt.Printf("Build %s %s\n", result.BuildID, result.Status)
+		if result.Profile != "" {
+			fmt.Printf("Profile: %s\n", result.Profile)
+		}
+		if result.Manifest != nil {
+			fmt.Printf("Files: %d written, see %s\n", result.Manifest.FileCount, filepath.Join(result.OutputDir, result.BuildDir, core.ManifestFilename))
+			fmt.Printf("Latest: %s\n", filepath.Join(result.OutputDir, core.LatestBuildLink))
+		}
+		if result.Report != nil {
+			fmt.Printf("Songs: %d built, %d failed, %d skipped\n", result.Report.Succeeded, result.Report.Failed, result.Report.Skipped)
+			if projectBuildIncremental {
+				fmt.Printf("Cache: %d of %d songs unchanged\n", result.Report.Cached, result.Report.Succeeded)
+			}
+			for _, song := range result.Report.FailedSongs() {
+				edition := ""
+				if song.Edition != "" {
+					edition = fmt.Sprintf(" [%s]", song.Edition)
+				}
+				fmt.Printf("\n%02d %s (%s)%s\n  %s\n", song.Index, song.Title, song.Filename, edition, song.Error)
+				if song.Stderr != "" {
+					fmt.Printf("  %s\n", strings.ReplaceAll(strings.TrimSpace(song.Stderr), "\n", "\n  "))
+				}
+			}
+		}
+		return nil
 	},
 }
 
 func init() {
 	projectCmd.AddCommand(projectBuildCmd)
 
-	projectBuildCmd.Flags().StringVarP(&projectBuildOutputDir, "output-dir", "o", "", "The directory to output the build results")
-	projectBuildCmd.Flags().StringVarP(&projectBuildAbcFileDir, "abc-file-dir", "a", "", "The directory to find the ABC files")
-	projectBuildCmd.Flags().IntVarP(&projectBuildPriorityThreshold, "priority-threshold", "p", 1, "The maximum priority of songs to include in the build")
+	projectBuildCmd.Flags().StringVarP(&projectBuildOutputDir, "output-dir", "o", "", "The directory to output the build results (default: build profile, otherwise the short name of the project)")
+	projectBuildCmd.Flags().StringVarP(&projectBuildAbcFileDir, "abc-file-dir", "a", "", "The directory to find the ABC files (default: build profile, otherwise the ABC file directory of the project)")
+	projectBuildCmd.Flags().IntVarP(&projectBuildPriorityThreshold, "priority-threshold", "p", 0, "The maximum priority of songs to include in the build (default: build profile, otherwise 1)")
 	projectBuildCmd.Flags().StringVarP(&projectSampleId, "sampleId", "s", projectSampleId, "A string to indentify the sample stage. Will be injected to the project config")
+	projectBuildCmd.Flags().BoolVarP(&projectBuildIncremental, "incremental", "i", false, "Reuse the PDFs of songs whose ABC file and config did not change since the last build")
+	projectBuildCmd.Flags().BoolVar(&projectBuildDryRun, "dry-run", false, "Show the songs, configs, PDFs and merged files of the build without running it")
+	projectBuildCmd.Flags().StringVar(&projectBuildSongOrder, "order", "", "The order of the songs: "+strings.Join(core.SongOrders, ", ")+" (default: songOrder of the project config, otherwise title)")
+	projectBuildCmd.Flags().StringVar(&projectBuildSince, "since", "", "Build a supplement (Nachtrag) with the songs added or changed since a build ID or date (YYYY-MM-DD)")
+	projectBuildCmd.Flags().StringVar(&projectBuildProfile, "profile", "", "The build profile to take the parameters and config overlay from (default: the default profile of the project)")
+	projectBuildCmd.Flags().BoolVar(&projectBuildFinal, "final", false, "Build the final print files, only watermarks marked final are stamped")
+	projectBuildCmd.Flags().BoolP("json", "j", false, "Output the build result with the per-song report in JSON format")
+
+}
+
+// printBuildPlan prints what a build would do
+func printBuildPlan(plan *core.BuildPlan) {
+	fmt.Printf("Build plan for %s (priority <= %d)\n", plan.ShortName, plan.PriorityThreshold)
+	if plan.Profile != "" {
+		fmt.Printf("Profile:    %s\n", plan.Profile)
+	}
+	fmt.Printf("ABC files:  %s\n", plan.AbcFileDir)
+	fmt.Printf("Output:     %s\n", plan.OutputDir)
+	fmt.Printf("Zupfnoter:  %s\n", plan.ZupfnoterVersion)
+	fmt.Printf("Song order: %s\n", plan.SongOrder)
+	if plan.SupplementSince != "" {
+		fmt.Printf("Supplement: songs added or changed since %s\n", plan.SupplementSince)
+	}
+	if plan.Final {
+		fmt.Printf("Final:      only watermarks marked final\n")
+	}
+
+	if len(plan.Editions) == 0 {
+		printPlannedEdition(core.PlannedEdition{Songs: plan.Songs, TOC: plan.TOC, MergedFiles: plan.MergedFiles, Watermarks: plan.Watermarks})
+	}
+	for _, edition := range plan.Editions {
+		fmt.Printf("\n=== Edition %s (paths below %s/ of the build) ===\n", edition.Name, edition.Name)
+		printPlannedEdition(edition)
+	}
+
+	if len(plan.Warnings) > 0 {
+		fmt.Printf("\nWarnings:\n")
+		for _, warning := range plan.Warnings {
+			fmt.Printf("   %s\n", warning)
+		}
+	}
+}
+
+// printPlannedEdition prints the songs, table of contents and merged files of
+// a build plan or of one of its editions
+func printPlannedEdition(plan core.PlannedEdition) {
+	fmt.Printf("\nSongs (%d):\n", len(plan.Songs))
+	for _, song := range plan.Songs {
+		exists := ""
+		if !song.ABCExists {
+			exists = " (missing)"
+		}
+		fmt.Printf("\n%02d %s [priority %d]\n", song.Index, song.Title, song.Priority)
+		fmt.Printf("   abc:    %s%s\n", song.ABCPath, exists)
+		if song.Config != nil {
+			config, _ := json.Marshal(song.Config)
+			fmt.Printf("   config: %s\n", config)
+		}
+		printPlannedPDFs(song.PDFs)
+		for _, warning := range song.Warnings {
+			fmt.Printf("   warning: %s\n", warning)
+		}
+	}
+
+	fmt.Printf("\nTable of contents:\n")
+	fmt.Printf("   template:      %s\n", plan.TOC.Template)
+	fmt.Printf("   html template: %s\n", plan.TOC.HTMLTemplate)
+	printPlannedPDFs(plan.TOC.PDFs)
+	for _, warning := range plan.TOC.Warnings {
+		fmt.Printf("   warning: %s\n", warning)
+	}
+
+	fmt.Printf("\nMerged files:\n")
+	for _, merge := range plan.MergedFiles {
+		fmt.Printf("   %s (%d files)\n", merge.Path, len(merge.Files))
+		for _, file := range merge.Files {
+			fmt.Printf("      %s\n", file)
+		}
+	}
+
+	if len(plan.Watermarks) > 0 {
+		fmt.Printf("\nWatermarks:\n")
+		for _, watermark := range plan.Watermarks {
+			mark := watermark.Text
+			if watermark.Image != "" {
+				mark = watermark.Image
+			}
+			folders := "all folders"
+			if len(watermark.Folders) > 0 {
+				folders = strings.Join(watermark.Folders, ", ")
+			}
+			fmt.Printf("   %s: %q on %s\n", watermark.Name, mark, folders)
+		}
+	}
+}
 
+// printPlannedPDFs prints the expected PDFs of a song and where they go
+func printPlannedPDFs(pdfs []core.PlannedPDF) {
+	for _, pdf := range pdfs {
+		target := pdf.Target
+		if target == "" {
+			target = "not distributed"
+		}
+		fmt.Printf("   pdf:    %s -> %s\n", pdf.Path, target)
+		if pdf.Reference != "" {
+			fmt.Printf("           %s\n", pdf.Reference)
+		}
+	}
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strconv"
+	"time"
 
 	"github.com/bwl21/zupfmanager/internal/database"
 	"github.com/bwl21/zupfmanager/internal/ent/project"
@@ -86,7 +87,8 @@ var projectEditSongCmd = &cobra.Command{
 		}
 
 		// Initialize the update
-		update := client.ProjectSong.UpdateOne(projectSong)
+		// Supplement builds pick up changed songs
+		update := client.ProjectSong.UpdateOne(projectSong).SetUpdatedAt(time.Now())
 
 		// Update priority if changed
 		if priorityFlag {
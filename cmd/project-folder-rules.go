@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// projectFolderRulesCmd shows where the folder rules of a project route the PDFs of a build
+var projectFolderRulesCmd = &cobra.Command{
+	Use:   "folder-rules <project-id> [build-id]",
+	Short: "Check the folder rules of a project against the PDFs of a build",
+	Long: `Show the ordered folder rules of a project and, for every PDF a finished
+build wrote to pdf/, the rule it hits and the druckdateien folders it would be
+copied into with the current rules. Without a build ID the latest finished
+build is checked.
+
+The rules are configured in the project config as folderRules, e.g.
+
+  "folderRouting": "first",
+  "folderRules": [
+    {"match": "*_entwurf*", "exclude": true},
+    {"match": "*_-A*_a3.pdf", "folders": ["klein", "archiv"]},
+    {"regex": "_-[BX]\\d+_a3\\.pdf$", "folder": "gross"}
+  ]
+
+With folderRouting "first" the first matching rule decides, with "all" a file
+is copied into the folders of every rule it matches. Projects without
+folderRules use their folderPatterns, most specific pattern first.`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		projectID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid project ID: %w", err)
+		}
+		buildID := ""
+		if len(args) == 2 {
+			buildID = args[1]
+		}
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		check, err := services.Project.CheckFolderRules(context.Background(), projectID, buildID)
+		if err != nil {
+			return fmt.Errorf("failed to check folder rules: %w", err)
+		}
+
+		if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+			return printJSON(check)
+		}
+		return printFolderRuleCheck(check)
+	},
+}
+
+func init() {
+	projectCmd.AddCommand(projectFolderRulesCmd)
+
+	projectFolderRulesCmd.Flags().BoolP("json", "j", false, "Output the check in JSON format")
+}
+
+// printFolderRuleCheck prints the folder rules and the files routed by them
+func printFolderRuleCheck(check *core.FolderRuleCheck) error {
+	fmt.Printf("Folder rules checked against build %s (%s)\n", shortBuildID(check.Build.BuildID), check.Build.StartedAt)
+	fmt.Printf("Files: %d, %d without folder, %d excluded\n", len(check.Files), check.Unmatched, check.Excluded)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	if check.Routing != nil {
+		printFolderRouting(w, "", check.Routing)
+	}
+	for _, edition := range check.Editions {
+		printFolderRouting(w, edition.Name, edition.Routing)
+	}
+
+	if len(check.Files) > 0 {
+		fmt.Fprintf(w, "\nFiles (%d):\n", len(check.Files))
+		for _, file := range check.Files {
+			rules := make([]string, len(file.Rules))
+			for i, rule := range file.Rules {
+				rules[i] = fmt.Sprintf("#%d", rule+1)
+			}
+			folders := strings.Join(file.Folders, ", ")
+			switch {
+			case file.Excluded:
+				folders = "excluded"
+			case len(file.Folders) == 0:
+				folders = "NO FOLDER"
+			case len(file.Rules) == 0:
+				rules = []string{"html"}
+			}
+			moved := ""
+			if !file.Excluded && len(file.Folders) > 0 && file.BuildFolder != "" && file.BuildFolder != file.Folders[0] {
+				moved = fmt.Sprintf("(was %s)", file.BuildFolder)
+			}
+			fmt.Fprintf(w, "   %s\t%s\t%s\t%s\n", file.Path, strings.Join(rules, ", "), folders, moved)
+		}
+	}
+	return w.Flush()
+}
+
+// printFolderRouting prints the numbered rules of a project or edition
+func printFolderRouting(w *tabwriter.Writer, edition string, routing *core.FolderRouting) {
+	title := "Rules"
+	if edition != "" {
+		title = fmt.Sprintf("Rules of edition %s", edition)
+	}
+	fmt.Fprintf(w, "\n%s (%s match):\n", title, routing.Mode)
+	for i, rule := range routing.Rules {
+		pattern := rule.Match
+		if rule.Regex != "" {
+			pattern = "regex " + rule.Regex
+		}
+		target := strings.Join(rule.Folders, ", ")
+		if rule.Exclude {
+			target = "exclude"
+		}
+		fmt.Fprintf(w, "   #%d\t%s\t%s\n", i+1, pattern, target)
+	}
+}
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// projectProfileCmd shows and changes the build profiles of a project
+var projectProfileCmd = &cobra.Command{
+	Use:   "profile <project-id> [name]",
+	Short: "Show or change the build profiles of a project",
+	Long: `Show or change the named build profiles of a project, e.g. "Probedruck",
+"Teilnehmer" or "Referent". A profile holds the output directory, ABC file
+directory, priority threshold and sample ID of a build and a config overlay
+that is merged over the project config. "project build --profile <name>" uses
+a profile, builds without --profile use the default profile.
+
+Without a name the profiles are listed. With a name and settings the profile
+is created or its settings are changed, settings not given stay as they are.`,
+	Aliases: []string{"profiles"},
+	Args:    cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		projectID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid project ID: %w", err)
+		}
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		ctx := context.Background()
+		profiles, err := services.Project.ListBuildProfiles(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to list build profiles of project %d: %w", projectID, err)
+		}
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		if len(args) == 1 {
+			if jsonOutput {
+				return printJSON(profiles)
+			}
+			return printBuildProfiles(profiles)
+		}
+
+		name := args[1]
+		var profile *core.BuildProfile
+		for _, candidate := range profiles {
+			if candidate.Name == name {
+				profile = candidate
+			}
+		}
+
+		if deleteProfile, _ := cmd.Flags().GetBool("delete"); deleteProfile {
+			if profile == nil {
+				return fmt.Errorf("build profile %q does not exist", name)
+			}
+			if err := services.Project.DeleteBuildProfile(ctx, projectID, profile.ID); err != nil {
+				return fmt.Errorf("failed to delete build profile: %w", err)
+			}
+			fmt.Printf("Build profile %q deleted\n", name)
+			return nil
+		}
+
+		req := core.BuildProfileRequest{ProjectID: projectID, Name: name}
+		if profile != nil {
+			req = core.BuildProfileRequest{
+				ProjectID:         projectID,
+				Name:              profile.Name,
+				OutputDir:         profile.OutputDir,
+				AbcFileDir:        profile.AbcFileDir,
+				PriorityThreshold: profile.PriorityThreshold,
+				SampleID:          profile.SampleID,
+				Config:            profile.Config,
+				IsDefault:         profile.IsDefault,
+			}
+		}
+
+		changed := false
+		flags := cmd.Flags()
+		if flags.Changed("output-dir") {
+			req.OutputDir, _ = flags.GetString("output-dir")
+			changed = true
+		}
+		if flags.Changed("abc-file-dir") {
+			req.AbcFileDir, _ = flags.GetString("abc-file-dir")
+			changed = true
+		}
+		if flags.Changed("priority-threshold") {
+			req.PriorityThreshold, _ = flags.GetInt("priority-threshold")
+			changed = true
+		}
+		if flags.Changed("sampleId") {
+			req.SampleID, _ = flags.GetString("sampleId")
+			changed = true
+		}
+		if flags.Changed("config") {
+			configFile, _ := flags.GetString("config")
+			req.Config = nil
+			if configFile != "" {
+				req.Config, err = services.Config.LoadFromFile(configFile)
+				if err != nil {
+					return err
+				}
+			}
+			changed = true
+		}
+		if flags.Changed("default") {
+			req.IsDefault, _ = flags.GetBool("default")
+			changed = true
+		}
+
+		switch {
+		case profile == nil:
+			profile, err = services.Project.CreateBuildProfile(ctx, req)
+		case changed:
+			profile, err = services.Project.UpdateBuildProfile(ctx, profile.ID, req)
+		}
+		var validationErr core.ValidationErrors
+		if errors.As(err, &validationErr) {
+			return validationErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to save build profile: %w", err)
+		}
+
+		return printJSON(profile)
+	},
+}
+
+func init() {
+	projectCmd.AddCommand(projectProfileCmd)
+
+	projectProfileCmd.Flags().StringP("output-dir", "o", "", "The directory to output the build results")
+	projectProfileCmd.Flags().StringP("abc-file-dir", "a", "", "The directory to find the ABC files")
+	projectProfileCmd.Flags().IntP("priority-threshold", "p", 0, "The maximum priority of songs to include in the build, 0 leaves it to the project default")
+	projectProfileCmd.Flags().StringP("sampleId", "s", "", "A string to identify the sample stage")
+	projectProfileCmd.Flags().String("config", "", "A JSON file with the config overlay of the profile, empty removes the overlay")
+	projectProfileCmd.Flags().Bool("default", false, "Use the profile for builds without --profile")
+	projectProfileCmd.Flags().Bool("delete", false, "Delete the profile")
+	projectProfileCmd.Flags().BoolP("json", "j", false, "Output the profiles in JSON format")
+}
+
+// printBuildProfiles prints the build profiles of a project as table
+func printBuildProfiles(profiles []*core.BuildProfile) error {
+	if len(profiles) == 0 {
+		fmt.Println("No build profiles")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(w, "NAME\tDEFAULT\tOUTPUT\tABC FILES\tPRIORITY\tSAMPLE\tCONFIG")
+	fmt.Fprintln(w, "----\t-------\t------\t---------\t--------\t------\t------")
+	for _, profile := range profiles {
+		isDefault, priority := "", ""
+		if profile.IsDefault {
+			isDefault = "yes"
+		}
+		if profile.PriorityThreshold > 0 {
+			priority = strconv.Itoa(profile.PriorityThreshold)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d keys\n", profile.Name, isDefault, profile.OutputDir, profile.AbcFileDir, priority, profile.SampleID, len(profile.Config))
+	}
+	return w.Flush()
+}
+
+// printJSON prints a value as indented JSON
+func printJSON(value any) error {
+	jsonData, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(jsonData))
+	return nil
+}
@@ -9,9 +9,8 @@ import (
 	"log/slog"
 	"strconv"
 
-	"github.com/bwl21/zupfmanager/internal/database"
-	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
 	"github.com/bwl21/zupfmanager/internal/ent/song"
+	"github.com/bwl21/zupfmanager/pkg/core"
 	"github.com/spf13/cobra"
 )
 
@@ -25,10 +24,17 @@ var projectRemoveSongCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cmd.SilenceUsage = true
 
-		client, err := database.New()
+		// Songs are removed through the core service, which releases their number
+		services, err := core.NewServices()
 		if err != nil {
 			return err
 		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+		client := services.DB()
 
 		// Parse project ID
 		projectID, err := strconv.Atoi(args[0])
@@ -83,19 +89,11 @@ var projectRemoveSongCmd = &cobra.Command{
 			songExists = true
 		}
 
-		// Verify song is associated with project
-		projectSong, err := client.ProjectSong.Query().
-			Where(
-				projectsong.ProjectID(projectID),
-				projectsong.SongID(songID),
-			).
-			Only(context.Background())
-		if err != nil {
+		// Delete the project-song relationship
+		err = services.Project.RemoveSongFromProject(context.Background(), projectID, songID)
+		if err == core.ErrProjectSongNotFound {
 			return fmt.Errorf("song ID %d is not associated with project ID %d", songID, projectID)
 		}
-
-		// Delete the project-song relationship
-		err = client.ProjectSong.DeleteOne(projectSong).Exec(context.Background())
 		if err != nil {
 			return err
 		}
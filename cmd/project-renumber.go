@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// projectRenumberCmd numbers the songs of a project from 1
+var projectRenumberCmd = &cobra.Command{
+	Use:   "renumber <project-id>",
+	Short: "Number the songs of a project from 1",
+	Long: `Number all songs of a project from 1 in the song order of the project or
+the one given with --order. Song numbers otherwise stay fixed once assigned,
+so only renumber before the sheets of the project are handed out. Reserved
+numbers of removed songs are released.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		projectID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid project ID: %w", err)
+		}
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		order, _ := cmd.Flags().GetString("order")
+		projectSongs, err := services.Project.RenumberProjectSongs(context.Background(), projectID, order)
+		var validationErr core.ValidationErrors
+		if errors.As(err, &validationErr) {
+			return validationErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to renumber songs of project %d: %w", projectID, err)
+		}
+
+		return printProjectSongOrder(projectSongs)
+	},
+}
+
+func init() {
+	projectCmd.AddCommand(projectRenumberCmd)
+
+	projectRenumberCmd.Flags().String("order", "", "The order of the new numbers: "+strings.Join(core.SongOrders, ", ")+" (default: the song order of the project)")
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// projectReorderCmd sets the manual order of the songs in a project
+var projectReorderCmd = &cobra.Command{
+	Use:   "reorder <project-id> [song-id...]",
+	Short: "Show or set the manual order of the songs in a project",
+	Long: `Show or set the manual order of the songs in a project. The song IDs must
+list every song of the project exactly once. Builds use this order with
+"project build --order manual" or "songOrder": "manual" in the project config.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		projectID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid project ID: %w", err)
+		}
+
+		songIDs := make([]int, 0, len(args)-1)
+		for _, arg := range args[1:] {
+			songID, err := strconv.Atoi(arg)
+			if err != nil {
+				return fmt.Errorf("invalid song ID: %w", err)
+			}
+			songIDs = append(songIDs, songID)
+		}
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		var projectSongs []*core.ProjectSong
+		if len(songIDs) > 0 {
+			projectSongs, err = services.Project.ReorderProjectSongs(context.Background(), projectID, songIDs)
+		} else {
+			projectSongs, err = services.Project.ListProjectSongs(context.Background(), projectID)
+		}
+		var validationErr core.ValidationErrors
+		if errors.As(err, &validationErr) {
+			return validationErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to order songs of project %d: %w", projectID, err)
+		}
+
+		return printProjectSongOrder(projectSongs)
+	},
+}
+
+func init() {
+	projectCmd.AddCommand(projectReorderCmd)
+}
+
+// printProjectSongOrder prints the position and number of the songs of a project
+func printProjectSongOrder(projectSongs []*core.ProjectSong) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(w, "POS\tNR\tID\tTITLE")
+	fmt.Fprintln(w, "---\t--\t--\t-----")
+	for _, ps := range projectSongs {
+		title := ""
+		if ps.Song != nil {
+			title = ps.Song.Title
+		}
+		fmt.Fprintf(w, "%d\t%02d\t%d\t%s\n", ps.Position, ps.Number, ps.SongID, title)
+	}
+	return w.Flush()
+}
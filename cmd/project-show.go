@@ -15,6 +15,7 @@ import (
 	"github.com/bwl21/zupfmanager/internal/ent"
 	"github.com/bwl21/zupfmanager/internal/ent/project"
 	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
+	"github.com/bwl21/zupfmanager/pkg/core"
 	"github.com/spf13/cobra"
 )
 
@@ -76,21 +77,18 @@ var projectShowCmd = &cobra.Command{
 		fmt.Printf("Project: %s (ID: %d)\n", proj.Title, proj.ID)
 		fmt.Printf("Number of songs: %d\n", len(proj.Edges.ProjectSongs))
 
-		// Display folder patterns
-		fmt.Println("\nFolder Patterns:")
-		folderPatterns, hasFolderPatterns := proj.Config["folderPatterns"].(map[string]interface{})
-		if hasFolderPatterns && len(folderPatterns) > 0 {
-			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.TabIndent)
-			fmt.Fprintln(w, "PATTERN\tFOLDER")
-			fmt.Fprintln(w, "-------\t------")
-			for pattern, folder := range folderPatterns {
-				if folderStr, ok := folder.(string); ok {
-					fmt.Fprintf(w, "%s\t%s\n", pattern, folderStr)
-				}
-			}
-			w.Flush()
+		// Display the folder rules in the order they are applied
+		if routing, err := core.FolderRoutingFromConfig(proj.Config); err != nil {
+			fmt.Printf("\nFolder Rules: invalid: %v\n", err)
 		} else {
-			fmt.Println("  Using default folder patterns")
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			printFolderRouting(w, "", routing)
+			w.Flush()
+		}
+		if _, ok := proj.Config["folderRules"]; !ok {
+			if _, ok := proj.Config["folderPatterns"]; !ok {
+				fmt.Println("  Using default folder patterns")
+			}
 		}
 
 		if len(proj.Config) > 0 {
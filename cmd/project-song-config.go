@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	songConfigAbcFileDir        string
+	songConfigPriorityThreshold int
+	songConfigSampleId          string
+	songConfigSongOrder         string
+	songConfigProfile           string
+)
+
+// projectSongConfigCmd shows the zupfnoter config a build passes for a song
+var projectSongConfigCmd = &cobra.Command{
+	Use:   "song-config <project-id> <song-id>",
+	Short: "Show the resolved zupfnoter config of a song in a project",
+	Long: `Show the zupfnoter config a build passes for a song: the project config with
+its variables substituted, merged with the %%zupfnoter.config block of the
+ABC file. Every value is annotated with its origin: project, placeholder or
+abc_file. Use the same flags as for "project build" to get the same result.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		projectID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid project ID: %w", err)
+		}
+		songID, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid song ID: %w", err)
+		}
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		resolved, err := services.Project.ResolveSongConfig(context.Background(), core.ResolveSongConfigRequest{
+			ProjectID:         projectID,
+			SongID:            songID,
+			AbcFileDir:        songConfigAbcFileDir,
+			PriorityThreshold: songConfigPriorityThreshold,
+			SampleID:          songConfigSampleId,
+			SongOrder:         songConfigSongOrder,
+			Profile:           songConfigProfile,
+
+			FallbackPriorityThreshold: cliPriorityThreshold,
+		})
+		switch {
+		case errors.Is(err, core.ErrProjectNotFound):
+			return fmt.Errorf("project with ID %d not found", projectID)
+		case errors.Is(err, core.ErrProjectSongNotFound):
+			return fmt.Errorf("song ID %d is not associated with project ID %d", songID, projectID)
+		case errors.Is(err, core.ErrSongNotInBuild):
+			return fmt.Errorf("song ID %d has a priority above %d, raise --priority-threshold", songID, songConfigPriorityThreshold)
+		case err != nil:
+			return err
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		if jsonOutput {
+			jsonData, err := json.MarshalIndent(resolved, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(jsonData))
+			return nil
+		}
+
+		fmt.Printf("%02d %s\n", resolved.Index, resolved.Title)
+		if resolved.ABCExists {
+			fmt.Printf("ABC file: %s\n", resolved.ABCPath)
+		} else {
+			fmt.Printf("ABC file: %s (missing, showing the project config only)\n", resolved.ABCPath)
+		}
+
+		fmt.Println("\nVariables:")
+		names := make([]string, 0, len(resolved.Variables))
+		for name := range resolved.Variables {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Printf("  #{%s} = %q\n", name, resolved.Variables[name])
+		}
+
+		fmt.Println("\nConfig:")
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, path := range resolved.SortedConfigPaths() {
+			value, err := json.Marshal(resolved.ConfigValue(path))
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", path, value, resolved.Origins[path])
+		}
+		return w.Flush()
+	},
+}
+
+func init() {
+	projectCmd.AddCommand(projectSongConfigCmd)
+
+	projectSongConfigCmd.Flags().StringVarP(&songConfigAbcFileDir, "abc-file-dir", "a", "", "The directory to find the ABC files, defaults to the one of the project")
+	projectSongConfigCmd.Flags().IntVarP(&songConfigPriorityThreshold, "priority-threshold", "p", 0, "The maximum priority of songs in the build, determines the index of the song (default: build profile, otherwise 1)")
+	projectSongConfigCmd.Flags().StringVarP(&songConfigSampleId, "sampleId", "s", "", "The sample ID that replaces #{sampleId} in the project config")
+	projectSongConfigCmd.Flags().StringVar(&songConfigSongOrder, "order", "", "The order of the songs in the build, determines the index of the song")
+	projectSongConfigCmd.Flags().StringVar(&songConfigProfile, "profile", "", "The build profile whose parameters and config overlay the build uses")
+	projectSongConfigCmd.Flags().BoolP("json", "j", false, "Output the resolved config with the origins in JSON format")
+}
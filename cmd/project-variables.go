@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// projectVariablesCmd shows and changes the user-defined variables of a project
+var projectVariablesCmd = &cobra.Command{
+	Use:   "variables <project-id>",
+	Short: "Show or change the template variables of a project",
+	Long: `Show or change the user-defined variables of a project. The project config
+and the table of contents templates refer to variables as #{name}, with a
+default as #{name|default} and to the build date in a Go time layout as
+#{build_date:02.01.2006}.`,
+	Aliases: []string{"vars"},
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		projectID, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid project ID: %w", err)
+		}
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		project, err := services.Project.Get(context.Background(), projectID)
+		if err != nil {
+			return fmt.Errorf("failed to find project with ID %d: %w", projectID, err)
+		}
+
+		set, _ := cmd.Flags().GetStringArray("set")
+		unset, _ := cmd.Flags().GetStringArray("unset")
+		if len(set) > 0 || len(unset) > 0 {
+			variables := make(map[string]string)
+			for name, value := range project.Variables {
+				variables[name] = value
+			}
+			for _, name := range unset {
+				delete(variables, name)
+			}
+			for _, assignment := range set {
+				name, value, ok := strings.Cut(assignment, "=")
+				if !ok {
+					return fmt.Errorf("invalid variable %q, use name=value", assignment)
+				}
+				variables[name] = value
+			}
+
+			project, err = services.Project.SetProjectVariables(context.Background(), projectID, variables)
+			var validationErr core.ValidationErrors
+			if errors.As(err, &validationErr) {
+				return validationErr
+			}
+			if err != nil {
+				return fmt.Errorf("failed to update variables: %w", err)
+			}
+		}
+
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		if jsonOutput {
+			jsonData, err := json.MarshalIndent(project.Variables, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(jsonData))
+			return nil
+		}
+
+		names := make([]string, 0, len(project.Variables))
+		for name := range project.Variables {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fmt.Printf("Variables of %s (ID: %d):\n", project.Title, project.ID)
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, name := range names {
+			fmt.Fprintf(w, "  #{%s}\t%q\n", name, project.Variables[name])
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			fmt.Println("  none")
+		}
+
+		fmt.Printf("\nBuilt-in: %s\n", strings.Join(core.ProjectVariables, ", "))
+		fmt.Printf("Per song: %s\n", strings.Join(core.SongVariables, ", "))
+		return nil
+	},
+}
+
+func init() {
+	projectCmd.AddCommand(projectVariablesCmd)
+
+	projectVariablesCmd.Flags().StringArray("set", nil, "Set a variable as name=value, can be repeated")
+	projectVariablesCmd.Flags().StringArray("unset", nil, "Remove a variable, can be repeated")
+	projectVariablesCmd.Flags().BoolP("json", "j", false, "Output the variables in JSON format")
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// templateCopyCmd copies a shared template into a project
+var templateCopyCmd = &cobra.Command{
+	Use:   "copy <kind> <name> <project-id>",
+	Short: "Copy a shared template into a project",
+	Long: `Copy the shared template of a kind and name into the template directory of
+a project. The copy overrides the shared template for the project and can
+be changed without affecting other projects.`,
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		projectID, err := strconv.Atoi(args[2])
+		if err != nil {
+			return fmt.Errorf("invalid project ID: %w", err)
+		}
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		ctx := context.Background()
+		shared, err := services.Project.GetTemplate(ctx, core.TemplateRef{Kind: args[0], Source: core.TemplateSourceShared, Name: args[1]})
+		if err != nil {
+			return fmt.Errorf("failed to get shared template: %w", err)
+		}
+		template, err := services.Project.CopyTemplateToProject(ctx, shared.ID, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to copy template: %w", err)
+		}
+		fmt.Printf("Copied shared template %s to %s\n", shared.Name, template.Path)
+		return nil
+	},
+}
+
+func init() {
+	templateCmd.AddCommand(templateCopyCmd)
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// templateEditCmd edits a shared or project template in the editor
+var templateEditCmd = &cobra.Command{
+	Use:   "edit <kind>",
+	Short: "Edit a shared or project template in the editor",
+	Long: `Open a template of the kind "toc" or "toc-html" in $VISUAL or $EDITOR and
+store it when the editor exits with changes.
+
+With --name the shared template of that name is edited, a new one starts
+from the template of the server-wide order. With --project the template of
+the project is edited; a project without template of its own starts from the
+template it uses so far and gets its own copy.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		ref := templateRefFromFlags(cmd, args[0])
+		if ref.Name == "" && ref.ProjectID == 0 {
+			return fmt.Errorf("either --name or --project is required")
+		}
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		if ref.ProjectID != 0 {
+			ref.Source = core.TemplateSourceProject
+		}
+
+		ctx := context.Background()
+		template, err := services.Project.GetTemplate(ctx, ref)
+		exists := err == nil
+		if errors.Is(err, core.ErrTemplateNotFound) {
+			template, err = services.Project.GetTemplate(ctx, core.TemplateRef{Kind: ref.Kind, ProjectID: ref.ProjectID})
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get template: %w", err)
+		}
+
+		content, err := editTemplateContent(template)
+		if err != nil {
+			return err
+		}
+		if exists && content == template.Content {
+			fmt.Println("Template unchanged")
+			return nil
+		}
+
+		saved, err := saveTemplate(ctx, services, ref, "", content)
+		if err != nil {
+			return fmt.Errorf("failed to store template: %w", err)
+		}
+		fmt.Printf("Stored %s template %s\n", saved.Kind, saved.Origin())
+		return nil
+	},
+}
+
+func init() {
+	templateCmd.AddCommand(templateEditCmd)
+
+	templateEditCmd.Flags().StringP("name", "n", "", "Edit the shared template of this name")
+	templateEditCmd.Flags().IntP("project", "p", 0, "Edit the template of the project")
+	templateEditCmd.MarkFlagsMutuallyExclusive("name", "project")
+}
+
+// editTemplateContent opens the content of a template in the editor of the
+// user and returns the edited content
+func editTemplateContent(template *core.Template) (string, error) {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	// The extension lets editors pick the syntax
+	ext := ".abc"
+	if template.Kind == core.TemplateKindTOCHTML {
+		ext = ".html"
+	}
+	file, err := os.CreateTemp("", "zupfmanager-template-*"+ext)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.WriteString(template.Content); err != nil {
+		file.Close()
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+	file.Close()
+
+	editorCmd := exec.Command(editor, filepath.Clean(file.Name()))
+	editorCmd.Stdin, editorCmd.Stdout, editorCmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := editorCmd.Run(); err != nil {
+		return "", fmt.Errorf("editor %s failed: %w", editor, err)
+	}
+
+	content, err := os.ReadFile(file.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited template: %w", err)
+	}
+	return string(content), nil
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// templateListCmd lists the templates of all sources
+var templateListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the templates of all sources",
+	Long: `List the shared, user and built-in templates by kind. With --project the
+templates of the project are listed as well and the ones it uses are marked.`,
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		projectID, _ := cmd.Flags().GetInt("project")
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		templates, err := services.Project.ListTemplates(context.Background(), projectID)
+		if err != nil {
+			return fmt.Errorf("failed to list templates: %w", err)
+		}
+
+		if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+			return printJSON(templates)
+		}
+		return printTemplates(templates)
+	},
+}
+
+func init() {
+	templateCmd.AddCommand(templateListCmd)
+
+	templateListCmd.Flags().IntP("project", "p", 0, "Also list the templates of the project and mark the ones it uses")
+	templateListCmd.Flags().BoolP("json", "j", false, "Output the templates in JSON format")
+}
+
+// printTemplates prints templates as table
+func printTemplates(templates []*core.Template) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(w, "KIND\tSOURCE\tNAME\tUSED\tLOCATION\tDESCRIPTION")
+	fmt.Fprintln(w, "----\t------\t----\t----\t--------\t-----------")
+	for _, template := range templates {
+		used := ""
+		if template.Active {
+			used = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", template.Kind, template.Source, template.Name, used, template.Path, template.Description)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// templateOrderCmd shows or sets the order templates are looked up in
+var templateOrderCmd = &cobra.Command{
+	Use:   "order [source...]",
+	Short: "Show or set the order templates are looked up in",
+	Long: `Show the order the template sources are looked up in or set the
+server-wide order. The sources are project, shared, user and built-in; the
+built-in templates are used when no source in the order has a template.
+
+A project can set its own order in the project config, e.g.
+  "templateOrder": ["shared", "project"]
+
+Examples:
+  zupfmanager template order
+  zupfmanager template order --project 1
+  zupfmanager template order shared project user
+  zupfmanager template order --reset`,
+	Args: cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		projectID, _ := cmd.Flags().GetInt("project")
+		reset, _ := cmd.Flags().GetBool("reset")
+		if (len(args) > 0 || reset) && projectID != 0 {
+			return fmt.Errorf("the order of a project is set in its config as templateOrder")
+		}
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		ctx := context.Background()
+		if len(args) > 0 || reset {
+			if err := services.Project.SetTemplateOrder(ctx, args); err != nil {
+				return fmt.Errorf("failed to set template order: %w", err)
+			}
+		}
+
+		order, err := services.Project.GetTemplateOrder(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to get template order: %w", err)
+		}
+		if order[len(order)-1] != core.TemplateSourceBuiltIn {
+			order = append(order, core.TemplateSourceBuiltIn)
+		}
+		fmt.Println(strings.Join(order, " > "))
+		return nil
+	},
+}
+
+func init() {
+	templateCmd.AddCommand(templateOrderCmd)
+
+	templateOrderCmd.Flags().IntP("project", "p", 0, "Show the order of the project")
+	templateOrderCmd.Flags().Bool("reset", false, "Restore the default order")
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// templatePreviewCmd renders a template with the songs of a project
+var templatePreviewCmd = &cobra.Command{
+	Use:   "preview <kind>",
+	Short: "Render a template with the songs of a project",
+	Long: `Render a template of the kind "toc" or "toc-html" with the songs and
+variables of a project the way a build with the default parameters would and
+print the result. The ABC table of contents is not passed to zupfnoter.
+
+Without --source the template the project uses is rendered, --file renders
+a template file that is not stored yet.
+
+Examples:
+  zupfmanager template preview toc-html --project 1 > inhalt.html
+  zupfmanager template preview toc-html --project 1 --name zweispaltig
+  zupfmanager template preview toc --project 1 --file inhalt.abc`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		req := core.TemplatePreviewRequest{TemplateRef: templateRefFromFlags(cmd, args[0])}
+		if req.ProjectID == 0 {
+			return fmt.Errorf("--project is required")
+		}
+		if file, _ := cmd.Flags().GetString("file"); file != "" {
+			content, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read template file: %w", err)
+			}
+			req.Content = string(content)
+		}
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		preview, err := services.Project.PreviewTemplate(context.Background(), req)
+		if err != nil {
+			return fmt.Errorf("failed to preview template: %w", err)
+		}
+
+		if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+			return printJSON(preview)
+		}
+		fmt.Print(preview.Content)
+		return nil
+	},
+}
+
+func init() {
+	templateCmd.AddCommand(templatePreviewCmd)
+
+	addTemplateRefFlags(templatePreviewCmd)
+	templatePreviewCmd.Flags().StringP("file", "f", "", "Render this template file instead of the stored template")
+	templatePreviewCmd.Flags().BoolP("json", "j", false, "Output the preview in JSON format")
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// templateShowCmd prints the content of a template
+var templateShowCmd = &cobra.Command{
+	Use:   "show <kind>",
+	Short: "Show the content of a template",
+	Long: `Print the content of a template of the kind "toc" or "toc-html". Without
+--source the template a project uses is shown, without --project the one of
+the server-wide template order.
+
+Examples:
+  zupfmanager template show toc-html --project 1
+  zupfmanager template show toc --name gross
+  zupfmanager template show toc-html --source built-in`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		template, err := services.Project.GetTemplate(context.Background(), templateRefFromFlags(cmd, args[0]))
+		if err != nil {
+			return fmt.Errorf("failed to get template: %w", err)
+		}
+
+		if jsonOutput, _ := cmd.Flags().GetBool("json"); jsonOutput {
+			return printJSON(template)
+		}
+		slog.Info("showing template", "source", template.Source, "template", template.Origin())
+		fmt.Print(template.Content)
+		return nil
+	},
+}
+
+func init() {
+	templateCmd.AddCommand(templateShowCmd)
+
+	addTemplateRefFlags(templateShowCmd)
+	templateShowCmd.Flags().BoolP("json", "j", false, "Output the template in JSON format")
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// templateUploadCmd stores a template file as shared or project template
+var templateUploadCmd = &cobra.Command{
+	Use:   "upload <kind> <file>",
+	Short: "Store a template file as shared or project template",
+	Long: `Store a template file of the kind "toc" or "toc-html". With --name it
+becomes the shared template of that name, an existing one is replaced. With
+--project it is written to the template directory of the project, where it
+overrides the other templates.
+
+Examples:
+  zupfmanager template upload toc-html zweispaltig.html --name zweispaltig
+  zupfmanager template upload toc inhalt.abc --project 1`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		content, err := os.ReadFile(args[1])
+		if err != nil {
+			return fmt.Errorf("failed to read template file: %w", err)
+		}
+		ref := templateRefFromFlags(cmd, args[0])
+		if ref.Name == "" && ref.ProjectID == 0 {
+			return fmt.Errorf("either --name or --project is required")
+		}
+		description, _ := cmd.Flags().GetString("description")
+
+		services, err := core.NewServices()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := services.Close(); err != nil {
+				slog.Warn("failed to close services", "error", err)
+			}
+		}()
+
+		template, err := saveTemplate(context.Background(), services, ref, description, string(content))
+		if err != nil {
+			return fmt.Errorf("failed to store template: %w", err)
+		}
+		fmt.Printf("Stored %s template %s\n", template.Kind, template.Origin())
+		return nil
+	},
+}
+
+func init() {
+	templateCmd.AddCommand(templateUploadCmd)
+
+	templateUploadCmd.Flags().StringP("name", "n", "", "Store the file as the shared template of this name")
+	templateUploadCmd.Flags().IntP("project", "p", 0, "Store the file as template of the project")
+	templateUploadCmd.Flags().StringP("description", "d", "", "The description of the shared template")
+	templateUploadCmd.MarkFlagsMutuallyExclusive("name", "project")
+}
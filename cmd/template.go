@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/spf13/cobra"
+)
+
+// templateCmd represents the template command
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Manage the templates of the table of contents",
+	Long: `Manage the templates the table of contents is rendered with. The kinds
+are "toc" for the ABC template and "toc-html" for the HTML template.
+
+A project uses the first template found in the template order, by default:
+
+  project   <short>/tpl/999_inhaltsverzeichnis_template.abc or .html
+  shared    a named template in the database, selected in the project config
+            as "templates": {"toc-html": "<name>"}
+  user      the same file names in $ZUPFMANAGER_TEMPLATE_DIR or the
+            zupfmanager/templates directory of the user config directory
+
+and the built-in template if none of them has one.`,
+	Aliases: []string{"t", "templates"},
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	rootCmd.AddCommand(templateCmd)
+}
+
+// addTemplateRefFlags adds the flags that select a template
+func addTemplateRefFlags(cmd *cobra.Command) {
+	cmd.Flags().IntP("project", "p", 0, "The project whose template is selected")
+	cmd.Flags().String("source", "", "The source of the template: project, shared, user or built-in; empty selects the template the project uses")
+	cmd.Flags().StringP("name", "n", "", "The name of the shared template")
+}
+
+// templateRefFromFlags returns the template of a kind the flags select.
+// A name without source selects a shared template.
+func templateRefFromFlags(cmd *cobra.Command, kind string) core.TemplateRef {
+	ref := core.TemplateRef{Kind: kind}
+	ref.ProjectID, _ = cmd.Flags().GetInt("project")
+	ref.Source, _ = cmd.Flags().GetString("source")
+	ref.Name, _ = cmd.Flags().GetString("name")
+	if ref.Name != "" && ref.Source == "" {
+		ref.Source = core.TemplateSourceShared
+	}
+	return ref
+}
+
+// saveTemplate stores the content of a template where it was selected:
+// shared templates are updated, or created if they do not exist yet, other
+// templates are written to the project
+func saveTemplate(ctx context.Context, services *core.Services, ref core.TemplateRef, description, content string) (*core.Template, error) {
+	if ref.Source != core.TemplateSourceShared {
+		if ref.ProjectID == 0 {
+			return nil, fmt.Errorf("only shared and project templates can be saved, use --name or --project")
+		}
+		return services.Project.SetProjectTemplate(ctx, ref.ProjectID, ref.Kind, content)
+	}
+
+	req := core.TemplateRequest{Name: ref.Name, Kind: ref.Kind, Description: description, Content: content}
+	existing, err := services.Project.GetTemplate(ctx, core.TemplateRef{Kind: ref.Kind, Source: core.TemplateSourceShared, Name: ref.Name})
+	if errors.Is(err, core.ErrTemplateNotFound) {
+		return services.Project.CreateSharedTemplate(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if description == "" {
+		req.Description = existing.Description
+	}
+	return services.Project.UpdateSharedTemplate(ctx, existing.ID, req)
+}
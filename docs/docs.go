@@ -116,16 +116,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
+        "/api/v1/import/last-path": {
             "get": {
-                "description": "Check if the API server is running",
+                "description": "Get the last directory path used for importing ABC files",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "import"
                 ],
-                "summary": "Health check",
+                "summary": "Get last import path",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -135,128 +135,4775 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects": {
+            "get": {
+                "description": "Get a list of all projects",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "List all projects",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new project with title, short name and optional configuration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Create a new project",
+                "parameters": [
+                    {
+                        "description": "Create project request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateProjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/default-config": {
+            "get": {
+                "description": "Get the default project configuration from default-project-config.json",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get default project configuration",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}": {
+            "get": {
+                "description": "Get a specific project by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get project by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Update project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update project request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateProjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a project by ID",
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Delete project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/abc-file-dir": {
+            "put": {
+                "description": "Update the preferred ABC file directory for a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Update ABC file directory preference",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update ABC file dir request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateAbcFileDirRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/build": {
+            "post": {
+                "description": "Start building a project to generate ABC files, PDFs, and other outputs. With incremental set, songs whose ABC file, final zupfnoter config and zupfnoter version are unchanged reuse the PDFs of an earlier build. With dry_run set, nothing is built; the response carries the build plan with the songs in order, their resolved config, expected PDFs, folders, table of contents templates and merged files.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Build project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Build project request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/BuildProjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dry run with build plan",
+                        "schema": {
+                            "$ref": "#/definitions/BuildResultResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/BuildResultResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Supplement build without songs",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/build/defaults": {
+            "get": {
+                "description": "Get the values a build uses when the request leaves them out: those of the default build profile of the project, otherwise the project defaults (abc_file_dir from the project preference, its config or the last import)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get build defaults",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildDefaultsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/builds": {
+            "get": {
+                "description": "Get all build operations for a project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "List project builds",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove all build history for a project",
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Clear build history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/builds/compare": {
+            "get": {
+                "description": "List the files that were added, removed or changed by content between two finished builds, with their page counts and the pages of print files in the merged PDF of their folder, and the songs that were added, removed, renumbered or whose config or ABC file changed. Without to the latest finished build is compared. The output of the builds does not need to exist anymore.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Compare builds",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the older build",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the newer build, defaults to the latest finished build",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildDiffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/builds/{buildId}": {
+            "get": {
+                "description": "Get a build of a project including the per-song report with the zupfnoter output of each song and the manifest of all generated files",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get build",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildResultResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Cancel a queued or running project build. A running build is stopped and its partial output removed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Cancel build",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/builds/{buildId}/archive": {
+            "get": {
+                "description": "Download the druckdateien, pdf, abc and referenz folders of a finished build as ZIP archive. The folders parameter selects the folders to include, either comma separated or repeated; all of them are included by default. Every build keeps its own output directory; the last builds of the project are retained (5 by default, see buildRetention in the project configuration) and the output of a build removed by the retention answers with 410.",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Download build archive",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Folders to include (druckdateien, pdf, abc, referenz)",
+                        "name": "folders",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/builds/{buildId}/events": {
+            "get": {
+                "description": "Stream progress, per-song and result events of a build as Server-Sent Events. Each event carries its ID, a client that reconnects with the Last-Event-ID header (or the last_event_id query parameter) gets all events it missed first. The stream ends after the result event.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Stream build events",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last event received",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID of the last event received",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/builds/{buildId}/files/{path}": {
+            "get": {
+                "description": "Download a single file of a finished build, such as a merged PDF in druckdateien/\u003cshort\u003e_klein.pdf. The path is relative to the output directory and must lie in the druckdateien, pdf, abc or referenz folder.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Download build file",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File path relative to the output directory",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/builds/{buildId}/status": {
+            "get": {
+                "description": "Get the current status of a project build operation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get build status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/folder-rules/check": {
+            "get": {
+                "description": "Route the PDFs a finished build wrote to pdf/ with the current folder rules of the project and list the rules each file hits and the druckdateien folders it would be copied into. Without build the latest finished build is checked.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Check folder rules",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the build, defaults to the latest finished build",
+                        "name": "build",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/FolderRuleCheckResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/profiles": {
+            "get": {
+                "description": "Get the named build profiles of a project ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "List build profiles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildProfileListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a named set of build parameters with a config overlay. A new default profile replaces the previous one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Create build profile",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Build profile",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/BuildProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/BuildProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/profiles/{profileId}": {
+            "get": {
+                "description": "Get a build profile of a project by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get build profile",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Build profile ID",
+                        "name": "profileId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the settings of a build profile",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Update build profile",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Build profile ID",
+                        "name": "profileId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Build profile",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/BuildProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a build profile, builds made with it keep its name",
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Delete build profile",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Build profile ID",
+                        "name": "profileId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/songs": {
+            "get": {
+                "description": "Get all songs in a project with their relationships",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "List project songs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectSongsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/songs/order": {
+            "put": {
+                "description": "Store the manual order of the songs in a project. The list must contain every song of the project exactly once. Builds use this order with the song order \"manual\".",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Reorder project songs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Song IDs in the new order",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ReorderProjectSongsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectSongsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/songs/renumber": {
+            "post": {
+                "description": "Number all songs of a project from 1 in the given song order, the song order of the project by default. Reserved numbers of removed songs are released. Numbers otherwise stay fixed once assigned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Renumber project songs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Song order of the new numbers",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/RenumberProjectSongsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectSongsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/songs/{songId}": {
+            "put": {
+                "description": "Update difficulty and priority of a song in a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Update project-song relationship",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "songId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateProjectSongRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectSongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add an existing song to a project with optional difficulty and priority",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Add song to project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "songId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Add song request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/AddSongToProjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectSongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Song already in project",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a song from a project",
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Remove song from project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "songId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Song removed successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/songs/{songId}/resolved-config": {
+            "get": {
+                "description": "Get the zupfnoter config a build passes for a song: the project config with its variables substituted, merged with the config of the ABC file. Origins maps the JSON pointer of every value to \"project\", \"placeholder\" or \"abc_file\".",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get resolved song config",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "songId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Directory of the ABC files, defaults to the one of the project",
+                        "name": "abc_file_dir",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 4,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Priority threshold of the build, determines the index of the song",
+                        "name": "priority_threshold",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sample ID",
+                        "name": "sample_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "title",
+                            "manual",
+                            "priority",
+                            "difficulty",
+                            "genre"
+                        ],
+                        "type": "string",
+                        "description": "Song order of the build, determines the index of the song",
+                        "name": "song_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Build profile whose parameters and config overlay the build uses",
+                        "name": "profile",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ResolvedSongConfigResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Song is not part of a build with this priority threshold",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/templates/copy": {
+            "post": {
+                "description": "Copy a shared template into the template directory of a project, where it can be changed without affecting other projects",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Copy shared template into project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Shared template",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CopyTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/templates/{kind}": {
+            "put": {
+                "description": "Write a template into the template directory of a project, where it overrides shared and user templates in the default order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Set project template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "toc",
+                            "toc-html"
+                        ],
+                        "type": "string",
+                        "description": "Template kind",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template content",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ProjectTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/templates/{kind}/preview": {
+            "post": {
+                "description": "Render a template with the songs and variables of a project the way a build with the default parameters would. Content previews changes that are not stored yet. The ABC table of contents is not passed to zupfnoter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Preview template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "toc",
+                            "toc-html"
+                        ],
+                        "type": "string",
+                        "description": "Template kind",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template to preview",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/TemplatePreviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TemplatePreviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/projects/{id}/variables": {
+            "put": {
+                "description": "Replace the user-defined variables that project configs and table of contents templates refer to as #{name}. Names of built-in variables are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Update project variables",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update project variables request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateProjectVariablesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/songs": {
+            "get": {
+                "description": "Get a list of all songs in the database",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "List all songs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SongListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/songs/search": {
+            "get": {
+                "description": "Search for songs by query string. By default searches in both title and filename. Use specific parameters to limit search scope.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Search songs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Search only in title",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Search only in filename",
+                        "name": "filename",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Search only in genre",
+                        "name": "genre",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SongListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/songs/{id}": {
+            "get": {
+                "description": "Get a specific song by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get song by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a song from the database. The song must not be used in any projects.",
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Delete a song",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Song is used in projects",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/songs/{id}/generate-preview": {
+            "post": {
+                "description": "Generate preview PDFs for a specific song",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Generate preview PDFs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Preview generation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GeneratePreviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GeneratePreviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/songs/{id}/preview-pdf/{filename}": {
+            "get": {
+                "description": "Download or view a specific preview PDF file",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Get preview PDF",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "PDF filename",
+                        "name": "filename",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ABC file directory",
+                        "name": "abc_file_dir",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/songs/{id}/preview-pdfs": {
+            "get": {
+                "description": "Get a list of available preview PDFs for a song",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "List preview PDFs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PreviewPDFListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove all preview PDFs for a specific song",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "songs"
+                ],
+                "summary": "Cleanup preview PDFs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Song ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/templates": {
+            "get": {
+                "description": "Get the shared, user and built-in templates by kind without content. With a project its templates are listed as well and the ones it uses are marked active.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "List templates",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "project",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TemplateListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/templates/order": {
+            "get": {
+                "description": "Get the order the template sources are looked up in, of a project or server-wide. The built-in templates come last.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Get template order",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "project",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TemplateOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Set the order the template sources are looked up in for projects without templateOrder in their config. An empty order restores the default order project, shared, user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Set template order",
+                "parameters": [
+                    {
+                        "description": "Template order",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/TemplateOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TemplateOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/templates/shared": {
+            "post": {
+                "description": "Store a named template in the database. Projects select it in their config as \"templates\": {\"\u003ckind\u003e\": \"\u003cname\u003e\"}.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Create shared template",
+                "parameters": [
+                    {
+                        "description": "Shared template",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/TemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/TemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/templates/shared/{templateId}": {
+            "put": {
+                "description": "Replace name, description and content of a shared template, its kind stays as it is",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Update shared template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shared template ID",
+                        "name": "templateId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Shared template",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/TemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a shared template. Projects that still select it fail to build until they select another one.",
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Delete shared template",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shared template ID",
+                        "name": "templateId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/templates/{kind}": {
+            "get": {
+                "description": "Get a template of a kind. Without source the template the project uses is returned, without project the one of the server-wide order. Shared templates are selected by name.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "templates"
+                ],
+                "summary": "Get template",
+                "parameters": [
+                    {
+                        "enum": [
+                            "toc",
+                            "toc-html"
+                        ],
+                        "type": "string",
+                        "description": "Template kind",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "project",
+                            "shared",
+                            "user",
+                            "built-in"
+                        ],
+                        "type": "string",
+                        "description": "Template source",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the shared template",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "project",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/version": {
+            "get": {
+                "description": "Get detailed version information including git commit and working directory",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "version"
+                ],
+                "summary": "Version information",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Check if the API server is running",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/open-directory": {
+            "post": {
+                "description": "Open the working directory in the system's file explorer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "system"
+                ],
+                "summary": "Open directory",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "AddSongToProjectRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "example": "Great song for beginners"
+                },
+                "difficulty": {
+                    "type": "string",
+                    "enum": [
+                        "easy",
+                        "medium",
+                        "hard",
+                        "expert"
+                    ],
+                    "example": "medium"
+                },
+                "priority": {
+                    "type": "integer",
+                    "maximum": 4,
+                    "minimum": 1,
+                    "example": 1
+                }
+            }
+        },
+        "BuildDefaultsResponse": {
+            "type": "object",
+            "properties": {
+                "abc_file_dir": {
+                    "type": "string",
+                    "example": "/path/to/abc/files"
+                },
+                "output_dir": {
+                    "type": "string",
+                    "example": "my-project"
+                },
+                "priority_threshold": {
+                    "type": "integer",
+                    "maximum": 4,
+                    "minimum": 1,
+                    "example": 4
+                },
+                "profile": {
+                    "type": "string",
+                    "example": "Probedruck"
+                },
+                "sample_id": {
+                    "type": "string",
+                    "example": ""
+                }
+            }
+        },
+        "BuildDiffBuildResponse": {
+            "type": "object",
+            "properties": {
+                "build_dir": {
+                    "type": "string",
+                    "example": "builds/20250817-180000.000-550e8400-e29b-41d4-a716-446655440000"
+                },
+                "build_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "output_dir": {
+                    "type": "string",
+                    "example": "MBT-2025"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2025-08-17T18:00:00Z"
+                }
+            }
+        },
+        "BuildDiffResponse": {
+            "type": "object",
+            "properties": {
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/FileChangeResponse"
+                    }
+                },
+                "files_added": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "files_changed": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "files_removed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "files_unchanged": {
+                    "type": "integer",
+                    "example": 413
+                },
+                "from": {
+                    "$ref": "#/definitions/BuildDiffBuildResponse"
+                },
+                "project_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "songs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/SongChangeResponse"
+                    }
+                },
+                "to": {
+                    "$ref": "#/definitions/BuildDiffBuildResponse"
+                }
+            }
+        },
+        "BuildEventResponse": {
+            "type": "object",
+            "properties": {
+                "build_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "Zupfnoter failed for song.abc"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Built song 3/80: Amazing Grace"
+                },
+                "progress": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 40
+                },
+                "result": {
+                    "$ref": "#/definitions/BuildResultResponse"
+                },
+                "song": {
+                    "$ref": "#/definitions/BuildEventSong"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "running"
+                },
+                "time": {
+                    "type": "string",
+                    "example": "2025-08-17T18:01:00Z"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "status",
+                        "progress",
+                        "song_started",
+                        "song_completed",
+                        "song_failed",
+                        "result"
+                    ],
+                    "example": "song_completed"
+                }
+            }
+        },
+        "BuildEventSong": {
+            "type": "object",
+            "properties": {
+                "cached": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "edition": {
+                    "type": "string",
+                    "example": "teilnehmer"
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "amazing_grace.abc"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Amazing Grace"
+                }
+            }
+        },
+        "BuildListResponse": {
+            "type": "object",
+            "properties": {
+                "builds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/BuildResultResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "BuildManifestResponse": {
+            "type": "object",
+            "properties": {
+                "file_count": {
+                    "type": "integer",
+                    "example": 420
+                },
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ManifestFileResponse"
+                    }
+                },
+                "generated_at": {
+                    "type": "string",
+                    "example": "2025-08-17T18:05:00Z"
+                },
+                "total_size": {
+                    "type": "integer",
+                    "example": 73400320
+                }
+            }
+        },
+        "BuildPlanResponse": {
+            "type": "object",
+            "properties": {
+                "abc_file_dir": {
+                    "type": "string",
+                    "example": "/path/to/abc/files"
+                },
+                "editions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PlannedEditionResponse"
+                    }
+                },
+                "final": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "folder_routing": {
+                    "$ref": "#/definitions/FolderRoutingResponse"
+                },
+                "merged_files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PlannedMergeResponse"
+                    }
+                },
+                "output_dir": {
+                    "type": "string",
+                    "example": "MBT-2025"
+                },
+                "priority_threshold": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "profile": {
+                    "type": "string",
+                    "example": "Teilnehmer"
+                },
+                "project_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sample_id": {
+                    "type": "string",
+                    "example": "sample123"
+                },
+                "short_name": {
+                    "type": "string",
+                    "example": "MBT-2025"
+                },
+                "song_order": {
+                    "type": "string",
+                    "example": "title"
+                },
+                "songs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PlannedSongResponse"
+                    }
+                },
+                "supplement_since": {
+                    "type": "string",
+                    "example": "2025-06-01T00:00:00+02:00"
+                },
+                "toc": {
+                    "$ref": "#/definitions/PlannedTOCResponse"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "01 Amazing Grace: ABC file /path/to/abc/files/amazing_grace.abc not found"
+                    ]
+                },
+                "watermarks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PlannedWatermarkResponse"
+                    }
+                },
+                "zupfnoter_version": {
+                    "type": "string",
+                    "example": "V_1.15-1-g79f36737 (98c58af2)"
+                }
+            }
+        },
+        "BuildProfileListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "profiles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/BuildProfileResponse"
+                    }
+                }
+            }
+        },
+        "BuildProfileRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "abc_file_dir": {
+                    "type": "string",
+                    "example": "/path/to/abc/files"
+                },
+                "config": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "is_default": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Teilnehmer"
+                },
+                "output_dir": {
+                    "type": "string",
+                    "example": "MBT-2025/teilnehmer"
+                },
+                "priority_threshold": {
+                    "type": "integer",
+                    "maximum": 4,
+                    "minimum": 1,
+                    "example": 2
+                },
+                "sample_id": {
+                    "type": "string",
+                    "example": "final"
+                }
+            }
+        },
+        "BuildProfileResponse": {
+            "type": "object",
+            "properties": {
+                "abc_file_dir": {
+                    "type": "string",
+                    "example": "/path/to/abc/files"
+                },
+                "config": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "is_default": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Teilnehmer"
+                },
+                "output_dir": {
+                    "type": "string",
+                    "example": "MBT-2025/teilnehmer"
+                },
+                "priority_threshold": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "project_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sample_id": {
+                    "type": "string",
+                    "example": "final"
+                }
+            }
+        },
+        "BuildProjectRequest": {
+            "type": "object",
+            "properties": {
+                "abc_file_dir": {
+                    "type": "string",
+                    "example": "/path/to/abc/files"
+                },
+                "dry_run": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "final": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "incremental": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "output_dir": {
+                    "type": "string",
+                    "example": "my-project"
+                },
+                "priority_threshold": {
+                    "type": "integer",
+                    "maximum": 4,
+                    "minimum": 1,
+                    "example": 2
+                },
+                "profile": {
+                    "type": "string",
+                    "example": "Teilnehmer"
+                },
+                "sample_id": {
+                    "type": "string",
+                    "example": "sample123"
+                },
+                "song_order": {
+                    "type": "string",
+                    "enum": [
+                        "title",
+                        "manual",
+                        "priority",
+                        "difficulty",
+                        "genre"
+                    ],
+                    "example": "manual"
+                },
+                "supplement_since": {
+                    "type": "string",
+                    "example": "2025-06-01"
+                }
+            }
+        },
+        "BuildReportResponse": {
+            "type": "object",
+            "properties": {
+                "cached": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "skipped": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "songs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/SongReportResponse"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer",
+                    "example": 78
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "zupfnoter_version": {
+                    "type": "string",
+                    "example": "V_1.15-1-g79f36737 (98c58af2)"
+                }
+            }
+        },
+        "BuildResultResponse": {
+            "type": "object",
+            "properties": {
+                "build_dir": {
+                    "type": "string",
+                    "example": "builds/20250817-180000.000-550e8400-e29b-41d4-a716-446655440000"
+                },
+                "build_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "completed_at": {
+                    "type": "string",
+                    "example": "2025-08-17T18:05:00Z"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "Build failed: file not found"
+                },
+                "generated_files": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "my-project/druckdateien",
+                        "my-project/pdf"
+                    ]
+                },
+                "manifest": {
+                    "$ref": "#/definitions/BuildManifestResponse"
+                },
+                "output_dir": {
+                    "type": "string",
+                    "example": "my-project"
+                },
+                "plan": {
+                    "$ref": "#/definitions/BuildPlanResponse"
+                },
+                "profile": {
+                    "type": "string",
+                    "example": "Teilnehmer"
+                },
+                "project_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "report": {
+                    "$ref": "#/definitions/BuildReportResponse"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2025-08-17T18:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "queued",
+                        "running",
+                        "completed",
+                        "completed_with_errors",
+                        "failed",
+                        "cancelled",
+                        "interrupted",
+                        "dry_run"
+                    ],
+                    "example": "completed"
+                }
+            }
+        },
+        "BuildStatusResponse": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "type": "string",
+                    "example": "2025-08-17T18:05:00Z"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "Build failed: file not found"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Building songs..."
+                },
+                "progress": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 75
+                },
+                "queue_position": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2025-08-17T18:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "queued",
+                        "running",
+                        "completed",
+                        "completed_with_errors",
+                        "failed",
+                        "cancelled",
+                        "interrupted"
+                    ],
+                    "example": "running"
+                }
+            }
+        },
+        "CopyTemplateRequest": {
+            "type": "object",
+            "required": [
+                "template_id"
+            ],
+            "properties": {
+                "template_id": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "CreateProjectRequest": {
+            "type": "object",
+            "required": [
+                "short_name",
+                "title"
+            ],
+            "properties": {
+                "config": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "config_file": {
+                    "type": "string",
+                    "example": "/path/to/config.json"
+                },
+                "default_config": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "short_name": {
+                    "type": "string",
+                    "example": "my-project"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "My Music Project"
+                }
+            }
+        },
+        "ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "error": {
+                    "type": "string",
+                    "example": "validation failed"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Invalid input provided"
+                }
+            }
+        },
+        "FileChangeResponse": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "type": "string",
+                    "enum": [
+                        "added",
+                        "removed",
+                        "changed"
+                    ],
+                    "example": "changed"
+                },
+                "edition": {
+                    "type": "string",
+                    "example": "teilnehmer"
+                },
+                "first_page": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "folder": {
+                    "type": "string",
+                    "example": "klein"
+                },
+                "from_pages": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "from_sha256": {
+                    "type": "string"
+                },
+                "last_page": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "merged_file": {
+                    "type": "string",
+                    "example": "druckdateien/MBT-2025_klein.pdf"
+                },
+                "pages_changed": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "path": {
+                    "type": "string",
+                    "example": "druckdateien/klein/03_amazing_grace_-A1_a3.pdf"
+                },
+                "song": {
+                    "type": "string",
+                    "example": "Amazing Grace"
+                },
+                "song_index": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "to_pages": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "to_sha256": {
+                    "type": "string"
+                }
+            }
+        },
+        "FolderRoutingResponse": {
+            "type": "object",
+            "properties": {
+                "mode": {
+                    "type": "string",
+                    "example": "first"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/FolderRuleResponse"
+                    }
+                }
+            }
+        },
+        "FolderRuleCheckFileResponse": {
+            "type": "object",
+            "properties": {
+                "build_folder": {
+                    "type": "string",
+                    "example": "klein"
+                },
+                "edition": {
+                    "type": "string",
+                    "example": "teilnehmer"
+                },
+                "excluded": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "folders": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "path": {
+                    "type": "string",
+                    "example": "pdf/amazing_grace_-A1_a3.pdf"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "FolderRuleCheckResponse": {
+            "type": "object",
+            "properties": {
+                "build": {
+                    "$ref": "#/definitions/BuildDiffBuildResponse"
+                },
+                "editions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/FolderRuleEditionResponse"
+                    }
+                },
+                "excluded": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/FolderRuleCheckFileResponse"
+                    }
+                },
+                "project_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "routing": {
+                    "$ref": "#/definitions/FolderRoutingResponse"
+                },
+                "unmatched": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "FolderRuleEditionResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "teilnehmer"
+                },
+                "routing": {
+                    "$ref": "#/definitions/FolderRoutingResponse"
+                }
+            }
+        },
+        "FolderRuleResponse": {
+            "type": "object",
+            "properties": {
+                "exclude": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "folders": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "klein"
+                    ]
+                },
+                "match": {
+                    "type": "string",
+                    "example": "*_-A*_a3.pdf"
+                },
+                "regex": {
+                    "type": "string",
+                    "example": "_-[BX]\\d+_a3\\.pdf$"
+                }
+            }
+        },
+        "GeneratePreviewRequest": {
+            "type": "object",
+            "required": [
+                "abc_file_dir"
+            ],
+            "properties": {
+                "abc_file_dir": {
+                    "type": "string",
+                    "example": "/path/to/abc/files"
+                },
+                "config": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "GeneratePreviewResponse": {
+            "type": "object",
+            "properties": {
+                "pdf_files": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "song_-A1_a3.pdf",
+                        "song_-M1_a3.pdf"
+                    ]
+                },
+                "preview_dir": {
+                    "type": "string",
+                    "example": "/tmp/zupfmanager/previews/song-123"
+                }
+            }
+        },
+        "ImportDirectoryRequest": {
+            "type": "object",
+            "required": [
+                "directory_path"
+            ],
+            "properties": {
+                "directory_path": {
+                    "type": "string",
+                    "example": "/path/to/songs/"
+                }
+            }
+        },
+        "ImportFileRequest": {
+            "type": "object",
+            "required": [
+                "file_path"
+            ],
+            "properties": {
+                "file_path": {
+                    "type": "string",
+                    "example": "/path/to/song.abc"
+                }
+            }
+        },
+        "ImportResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ImportResult"
+                    }
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "summary": {
+                    "$ref": "#/definitions/ImportSummary"
+                }
+            }
+        },
+        "ImportResult": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "created"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "title",
+                        "genre"
+                    ]
+                },
+                "error": {
+                    "type": "string",
+                    "example": "file not found"
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "song.abc"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Amazing Grace"
+                }
+            }
+        },
+        "ImportSummary": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "errors": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "unchanged": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "ManifestFileResponse": {
+            "type": "object",
+            "properties": {
+                "edition": {
+                    "type": "string",
+                    "example": "teilnehmer"
+                },
+                "folder": {
+                    "type": "string",
+                    "example": "klein"
+                },
+                "pages": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "path": {
+                    "type": "string",
+                    "example": "druckdateien/klein/01_amazing_grace_-M_a4.pdf"
+                },
+                "sha256": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 48213
+                },
+                "song": {
+                    "type": "string",
+                    "example": "Amazing Grace"
+                },
+                "song_index": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "MessageResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Operation completed successfully"
+                }
+            }
+        },
+        "PlannedEditionResponse": {
+            "type": "object",
+            "properties": {
+                "folder_routing": {
+                    "$ref": "#/definitions/FolderRoutingResponse"
+                },
+                "merged_files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PlannedMergeResponse"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "teilnehmer"
+                },
+                "songs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PlannedSongResponse"
+                    }
+                },
+                "toc": {
+                    "$ref": "#/definitions/PlannedTOCResponse"
+                },
+                "watermarks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PlannedWatermarkResponse"
+                    }
+                }
+            }
+        },
+        "PlannedMergeResponse": {
+            "type": "object",
+            "properties": {
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "druckdateien/klein/01_amazing_grace_-A1_a3.pdf"
+                    ]
+                },
+                "folder": {
+                    "type": "string",
+                    "example": "klein"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "druckdateien/MBT-2025_klein.pdf"
+                }
+            }
+        },
+        "PlannedPDFResponse": {
+            "type": "object",
+            "properties": {
+                "excluded": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "extract": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "folder": {
+                    "type": "string",
+                    "example": "klein"
+                },
+                "folders": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "klein",
+                        "archiv"
+                    ]
+                },
+                "path": {
+                    "type": "string",
+                    "example": "pdf/amazing_grace_-A1_a3.pdf"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "referenz/Public Domain/amazing_grace_-A1_a3.pdf"
+                },
+                "target": {
+                    "type": "string",
+                    "example": "druckdateien/klein/01_amazing_grace_-A1_a3.pdf"
+                },
+                "targets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "druckdateien/klein/01_amazing_grace_-A1_a3.pdf",
+                        "druckdateien/archiv/01_amazing_grace_-A1_a3.pdf"
+                    ]
+                }
+            }
+        },
+        "PlannedSongResponse": {
+            "type": "object",
+            "properties": {
+                "abc_exists": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "abc_path": {
+                    "type": "string",
+                    "example": "/path/to/abc/files/amazing_grace.abc"
+                },
+                "config": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "copyright": {
+                    "type": "string",
+                    "example": "Public Domain"
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "amazing_grace.abc"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pdfs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PlannedPDFResponse"
+                    }
+                },
+                "priority": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "project_song_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "song_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Amazing Grace"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "extract 5 has no title",
+                        " zupfnoter skips it"
+                    ]
+                }
+            }
+        },
+        "PlannedTOCResponse": {
+            "type": "object",
+            "properties": {
+                "html_template": {
+                    "type": "string",
+                    "example": "built-in"
+                },
+                "page_numbers": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "pdfs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PlannedPDFResponse"
+                    }
+                },
+                "registers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Register",
+                        "Themenregister"
+                    ]
+                },
+                "template": {
+                    "type": "string",
+                    "example": "MBT-2025/tpl/999_inhaltsverzeichnis_template.abc"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "PlannedWatermarkResponse": {
+            "type": "object",
+            "properties": {
+                "folders": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "klein",
+                        "gross"
+                    ]
+                },
+                "image": {
+                    "type": "string",
+                    "example": "MBT-2025/tpl/logo.png"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "entwurf"
+                },
+                "text": {
+                    "type": "string",
+                    "example": "ENTWURF A"
+                }
+            }
+        },
+        "PreviewPDFListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "pdfs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PreviewPDFResponse"
+                    }
+                }
+            }
+        },
+        "PreviewPDFResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-08-20T18:20:00Z"
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "song_-A1_a3.pdf"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 12345
+                }
+            }
+        },
+        "ProjectListResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectResponse"
+                    }
+                }
+            }
+        },
+        "ProjectReference": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "short_name": {
+                    "type": "string",
+                    "example": "xmas"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Christmas Songs"
+                }
+            }
+        },
+        "ProjectResponse": {
+            "type": "object",
+            "properties": {
+                "abc_file_dir_preference": {
+                    "type": "string",
+                    "example": "/path/to/abc/files"
+                },
+                "config": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reserved_numbers": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        4,
+                        7
+                    ]
+                },
+                "short_name": {
+                    "type": "string",
+                    "example": "my-project"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "My Music Project"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "ProjectSongResponse": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "example": "Great song"
+                },
+                "difficulty": {
+                    "type": "string",
+                    "enum": [
+                        "easy",
+                        "medium",
+                        "hard",
+                        "expert"
+                    ],
+                    "example": "medium"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "number": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "priority": {
+                    "type": "integer",
+                    "maximum": 4,
+                    "minimum": 1,
+                    "example": 1
+                },
+                "project": {
+                    "$ref": "#/definitions/ProjectResponse"
+                },
+                "project_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "song": {
+                    "$ref": "#/definitions/SongResponse"
+                },
+                "song_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "ProjectSongsResponse": {
+            "type": "object",
+            "properties": {
+                "project_songs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectSongResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "ProjectTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                }
+            }
+        },
+        "RenumberProjectSongsRequest": {
+            "type": "object",
+            "properties": {
+                "song_order": {
+                    "type": "string",
+                    "enum": [
+                        "title",
+                        "manual",
+                        "priority",
+                        "difficulty",
+                        "genre"
+                    ],
+                    "example": "title"
+                }
+            }
+        },
+        "ReorderProjectSongsRequest": {
+            "type": "object",
+            "required": [
+                "song_ids"
+            ],
+            "properties": {
+                "song_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        3,
+                        1,
+                        2
+                    ]
+                }
+            }
+        },
+        "ResolvedSongConfigResponse": {
+            "type": "object",
+            "properties": {
+                "abc_exists": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "abc_path": {
+                    "type": "string",
+                    "example": "/path/to/abc/amazing_grace.abc"
+                },
+                "config": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "amazing_grace.abc"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "origins": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "project_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "project_song_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "song_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Amazing Grace"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "ErrorResponse": {
+        },
+        "SongChangeResponse": {
             "type": "object",
             "properties": {
-                "details": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "added",
+                            "removed",
+                            "renumbered",
+                            "config_changed",
+                            "abc_changed"
+                        ]
+                    },
+                    "example": [
+                        "renumbered",
+                        "abc_changed"
+                    ]
                 },
-                "error": {
+                "edition": {
                     "type": "string",
-                    "example": "validation failed"
+                    "example": "teilnehmer"
                 },
-                "message": {
+                "filename": {
                     "type": "string",
-                    "example": "Invalid input provided"
+                    "example": "amazing_grace.abc"
+                },
+                "from_index": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "project_song_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "song_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Amazing Grace"
+                },
+                "to_index": {
+                    "type": "integer",
+                    "example": 4
                 }
             }
         },
-        "ImportDirectoryRequest": {
+        "SongListResponse": {
             "type": "object",
-            "required": [
-                "directory_path"
-            ],
             "properties": {
-                "directory_path": {
-                    "type": "string",
-                    "example": "/path/to/songs/"
+                "count": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "songs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/SongResponse"
+                    }
                 }
             }
         },
-        "ImportFileRequest": {
+        "SongReportResponse": {
             "type": "object",
-            "required": [
-                "file_path"
-            ],
             "properties": {
-                "file_path": {
+                "abc_hash": {
                     "type": "string",
-                    "example": "/path/to/song.abc"
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "cache_key": {
+                    "type": "string",
+                    "example": "fd61a03af4f77d870fc21e05e7e80678095c92d808cfb3b5c279ee04c74aca13"
+                },
+                "cached": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "config_hash": {
+                    "type": "string",
+                    "example": "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 5400
+                },
+                "edition": {
+                    "type": "string",
+                    "example": "teilnehmer"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "Zupfnoter failed for amazing_grace.abc: exit status 1"
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "amazing_grace.abc"
+                },
+                "html_warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "failed to convert HTML to PDF for Amazing Grace"
+                    ]
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pdfs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "pdf/amazing_grace_-A_a3.pdf"
+                    ]
+                },
+                "project_song_id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "song_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2025-08-17T18:00:05Z"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "completed",
+                        "failed",
+                        "skipped"
+                    ],
+                    "example": "completed"
+                },
+                "stderr": {
+                    "type": "string"
+                },
+                "stdout": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Amazing Grace"
                 }
             }
         },
-        "ImportResponse": {
+        "SongResponse": {
             "type": "object",
             "properties": {
-                "results": {
+                "copyright": {
+                    "type": "string",
+                    "example": "Public Domain"
+                },
+                "filename": {
+                    "type": "string",
+                    "example": "amazing_grace.abc"
+                },
+                "genre": {
+                    "type": "string",
+                    "example": "Hymn"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "projects": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ImportResult"
+                        "$ref": "#/definitions/ProjectReference"
                     }
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "title": {
+                    "type": "string",
+                    "example": "Amazing Grace"
                 },
-                "summary": {
-                    "$ref": "#/definitions/ImportSummary"
+                "tocinfo": {
+                    "type": "string",
+                    "example": "John Newton"
                 }
             }
         },
-        "ImportResult": {
+        "TemplateListResponse": {
             "type": "object",
             "properties": {
-                "action": {
-                    "type": "string",
-                    "example": "created"
+                "count": {
+                    "type": "integer",
+                    "example": 5
                 },
-                "changes": {
+                "templates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TemplateResponse"
+                    }
+                }
+            }
+        },
+        "TemplateOrderRequest": {
+            "type": "object",
+            "properties": {
+                "order": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     },
                     "example": [
-                        "title",
-                        "genre"
+                        "shared",
+                        "project",
+                        "user"
+                    ]
+                }
+            }
+        },
+        "TemplateOrderResponse": {
+            "type": "object",
+            "properties": {
+                "order": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "project",
+                        "shared",
+                        "user"
                     ]
+                }
+            }
+        },
+        "TemplatePreviewRequest": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
                 },
-                "error": {
+                "name": {
                     "type": "string",
-                    "example": "file not found"
+                    "example": "zweispaltig"
                 },
-                "filename": {
+                "source": {
                     "type": "string",
-                    "example": "song.abc"
+                    "enum": [
+                        "project",
+                        "shared",
+                        "user",
+                        "built-in"
+                    ],
+                    "example": "shared"
+                }
+            }
+        },
+        "TemplatePreviewResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
                 },
-                "title": {
+                "content_type": {
                     "type": "string",
-                    "example": "Amazing Grace"
+                    "example": "text/html"
+                },
+                "template": {
+                    "$ref": "#/definitions/TemplateResponse"
                 }
             }
         },
-        "ImportSummary": {
+        "TemplateRequest": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "created": {
-                    "type": "integer",
-                    "example": 7
+                "content": {
+                    "type": "string"
                 },
-                "errors": {
-                    "type": "integer",
-                    "example": 0
+                "description": {
+                    "type": "string",
+                    "example": "Zwei Spalten nach Genre"
                 },
-                "total": {
-                    "type": "integer",
-                    "example": 10
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "toc",
+                        "toc-html"
+                    ],
+                    "example": "toc-html"
                 },
-                "unchanged": {
+                "name": {
+                    "type": "string",
+                    "example": "zweispaltig"
+                }
+            }
+        },
+        "TemplateResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "content": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Zwei Spalten nach Genre"
+                },
+                "id": {
                     "type": "integer",
-                    "example": 1
+                    "example": 3
                 },
-                "updated": {
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "toc",
+                        "toc-html"
+                    ],
+                    "example": "toc-html"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "zweispaltig"
+                },
+                "origin": {
+                    "type": "string",
+                    "example": "shared:zweispaltig"
+                },
+                "path": {
+                    "type": "string",
+                    "example": "MBT-2025/tpl/999_inhaltsverzeichnis_template.html"
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "project",
+                        "shared",
+                        "user",
+                        "built-in"
+                    ],
+                    "example": "shared"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2025-08-17T18:00:00Z"
+                }
+            }
+        },
+        "UpdateAbcFileDirRequest": {
+            "type": "object",
+            "required": [
+                "abc_file_dir"
+            ],
+            "properties": {
+                "abc_file_dir": {
+                    "type": "string",
+                    "example": "/path/to/abc/files"
+                }
+            }
+        },
+        "UpdateProjectRequest": {
+            "type": "object",
+            "required": [
+                "short_name",
+                "title"
+            ],
+            "properties": {
+                "config": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "config_file": {
+                    "type": "string",
+                    "example": "/path/to/config.json"
+                },
+                "default_config": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "short_name": {
+                    "type": "string",
+                    "example": "updated-project"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Updated Project"
+                }
+            }
+        },
+        "UpdateProjectSongRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "example": "Updated comment"
+                },
+                "difficulty": {
+                    "type": "string",
+                    "enum": [
+                        "easy",
+                        "medium",
+                        "hard",
+                        "expert"
+                    ],
+                    "example": "hard"
+                },
+                "priority": {
                     "type": "integer",
+                    "maximum": 4,
+                    "minimum": 1,
                     "example": 2
                 }
             }
+        },
+        "UpdateProjectVariablesRequest": {
+            "type": "object",
+            "properties": {
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -15,11 +15,28 @@ import type {
   UpdateProjectSongRequest,
   ProjectSongResponse,
   ProjectSongsResponse,
+  ResolvedSongConfigResponse,
+  ReorderProjectSongsRequest,
+  RenumberProjectSongsRequest,
+  SongOrder,
   BuildProjectRequest,
   BuildStatusResponse,
   BuildResultResponse,
   BuildListResponse,
   BuildDefaultsResponse,
+  BuildProfileRequest,
+  BuildProfileResponse,
+  BuildProfileListResponse,
+  BuildDiffResponse,
+  FolderRuleCheckResponse,
+  TemplateKind,
+  TemplateSource,
+  TemplateResponse,
+  TemplateListResponse,
+  TemplateRequest,
+  TemplatePreviewRequest,
+  TemplatePreviewResponse,
+  TemplateOrderResponse,
   GeneratePreviewRequest,
   GeneratePreviewResponse,
   PreviewPDFListResponse,
@@ -68,6 +85,9 @@ export const projectApi = {
   updateAbcFileDir: (id: number, abcFileDir: string): Promise<ProjectResponse> =>
     api.put(`/api/v1/projects/${id}/abc-file-dir`, { abc_file_dir: abcFileDir }).then((res) => res.data),
 
+  updateVariables: (id: number, variables: Record<string, string>): Promise<ProjectResponse> =>
+    api.put(`/api/v1/projects/${id}/variables`, { variables }).then((res) => res.data),
+
   getSongs: (id: number): Promise<ProjectSongsResponse> =>
     api.get(`/api/v1/projects/${id}/songs`).then((res) => res.data),
 
@@ -134,7 +154,20 @@ export const projectSongApi = {
     api.put(`/api/v1/projects/${projectId}/songs/${songId}`, data).then((res) => res.data),
 
   remove: (projectId: number, songId: number): Promise<void> =>
-    api.delete(`/api/v1/projects/${projectId}/songs/${songId}`)
+    api.delete(`/api/v1/projects/${projectId}/songs/${songId}`),
+
+  reorder: (projectId: number, songIds: number[]): Promise<ProjectSongsResponse> =>
+    api.put(`/api/v1/projects/${projectId}/songs/order`, { song_ids: songIds } as ReorderProjectSongsRequest).then((res) => res.data),
+
+  renumber: (projectId: number, data?: RenumberProjectSongsRequest): Promise<ProjectSongsResponse> =>
+    api.post(`/api/v1/projects/${projectId}/songs/renumber`, data || {}).then((res) => res.data),
+
+  resolvedConfig: (
+    projectId: number,
+    songId: number,
+    params?: { abc_file_dir?: string; priority_threshold?: number; sample_id?: string; song_order?: SongOrder; profile?: string }
+  ): Promise<ResolvedSongConfigResponse> =>
+    api.get(`/api/v1/projects/${projectId}/songs/${songId}/resolved-config`, { params }).then((res) => res.data)
 }
 
 // Project Build API
@@ -145,14 +178,89 @@ export const projectBuildApi = {
   getDefaults: (projectId: number): Promise<BuildDefaultsResponse> =>
     api.get(`/api/v1/projects/${projectId}/build/defaults`).then((res) => res.data),
 
+  listProfiles: (projectId: number): Promise<BuildProfileListResponse> =>
+    api.get(`/api/v1/projects/${projectId}/profiles`).then((res) => res.data),
+
+  getProfile: (projectId: number, profileId: number): Promise<BuildProfileResponse> =>
+    api.get(`/api/v1/projects/${projectId}/profiles/${profileId}`).then((res) => res.data),
+
+  createProfile: (projectId: number, data: BuildProfileRequest): Promise<BuildProfileResponse> =>
+    api.post(`/api/v1/projects/${projectId}/profiles`, data).then((res) => res.data),
+
+  updateProfile: (projectId: number, profileId: number, data: BuildProfileRequest): Promise<BuildProfileResponse> =>
+    api.put(`/api/v1/projects/${projectId}/profiles/${profileId}`, data).then((res) => res.data),
+
+  deleteProfile: (projectId: number, profileId: number): Promise<void> =>
+    api.delete(`/api/v1/projects/${projectId}/profiles/${profileId}`).then(() => undefined),
+
   getStatus: (projectId: number, buildId: string): Promise<BuildStatusResponse> =>
     api.get(`/api/v1/projects/${projectId}/builds/${buildId}/status`).then((res) => res.data),
 
+  cancel: (projectId: number, buildId: string): Promise<MessageResponse> =>
+    api.delete(`/api/v1/projects/${projectId}/builds/${buildId}`).then((res) => res.data),
+
+  // Server-Sent Events stream of a build, use with EventSource
+  eventsUrl: (projectId: number, buildId: string): string =>
+    `${import.meta.env.VITE_API_BASE_URL || ''}/api/v1/projects/${projectId}/builds/${buildId}/events`,
+
+  // ZIP archive of the output folders of a finished build, use as download link
+  archiveUrl: (projectId: number, buildId: string, folders?: string[]): string => {
+    const query = folders && folders.length > 0 ? `?folders=${folders.map(encodeURIComponent).join(',')}` : ''
+    return `${import.meta.env.VITE_API_BASE_URL || ''}/api/v1/projects/${projectId}/builds/${buildId}/archive${query}`
+  },
+
+  // Single file of a finished build, path is relative to the output directory
+  fileUrl: (projectId: number, buildId: string, path: string): string =>
+    `${import.meta.env.VITE_API_BASE_URL || ''}/api/v1/projects/${projectId}/builds/${buildId}/files/${path.split('/').map(encodeURIComponent).join('/')}`,
+
   listBuilds: (projectId: number): Promise<BuildListResponse> =>
     api.get(`/api/v1/projects/${projectId}/builds`).then((res) => res.data),
 
+  // Without to the latest finished build is compared
+  compareBuilds: (projectId: number, from: string, to?: string): Promise<BuildDiffResponse> =>
+    api.get(`/api/v1/projects/${projectId}/builds/compare`, { params: { from, to } }).then((res) => res.data),
+
+  // Without build the latest finished build is checked
+  checkFolderRules: (projectId: number, build?: string): Promise<FolderRuleCheckResponse> =>
+    api.get(`/api/v1/projects/${projectId}/folder-rules/check`, { params: { build } }).then((res) => res.data),
+
   clearHistory: (projectId: number): Promise<MessageResponse> =>
     api.delete(`/api/v1/projects/${projectId}/builds`).then((res) => res.data)
 }
 
+export const templateApi = {
+  // With a project its templates are listed and the ones it uses marked active
+  list: (projectId?: number): Promise<TemplateListResponse> =>
+    api.get('/api/v1/templates', { params: { project: projectId } }).then((res) => res.data),
+
+  // Without source the template the project uses, shared templates by name
+  get: (kind: TemplateKind, params?: { source?: TemplateSource; name?: string; project?: number }): Promise<TemplateResponse> =>
+    api.get(`/api/v1/templates/${kind}`, { params }).then((res) => res.data),
+
+  createShared: (data: TemplateRequest): Promise<TemplateResponse> =>
+    api.post('/api/v1/templates/shared', data).then((res) => res.data),
+
+  updateShared: (templateId: number, data: TemplateRequest): Promise<TemplateResponse> =>
+    api.put(`/api/v1/templates/shared/${templateId}`, data).then((res) => res.data),
+
+  deleteShared: (templateId: number): Promise<void> =>
+    api.delete(`/api/v1/templates/shared/${templateId}`).then(() => undefined),
+
+  setProjectTemplate: (projectId: number, kind: TemplateKind, content: string): Promise<TemplateResponse> =>
+    api.put(`/api/v1/projects/${projectId}/templates/${kind}`, { content }).then((res) => res.data),
+
+  copyToProject: (projectId: number, templateId: number): Promise<TemplateResponse> =>
+    api.post(`/api/v1/projects/${projectId}/templates/copy`, { template_id: templateId }).then((res) => res.data),
+
+  preview: (projectId: number, kind: TemplateKind, data: TemplatePreviewRequest = {}): Promise<TemplatePreviewResponse> =>
+    api.post(`/api/v1/projects/${projectId}/templates/${kind}/preview`, data).then((res) => res.data),
+
+  getOrder: (projectId?: number): Promise<TemplateOrderResponse> =>
+    api.get('/api/v1/templates/order', { params: { project: projectId } }).then((res) => res.data),
+
+  // An empty order restores the default order
+  setOrder: (order: TemplateSource[]): Promise<TemplateOrderResponse> =>
+    api.put('/api/v1/templates/order', { order }).then((res) => res.data)
+}
+
 export default api
@@ -51,6 +51,8 @@ export interface ProjectSongResponse {
   song_id: number
   difficulty: string // "easy" | "medium" | "hard" | "expert"
   priority: number // 1-4
+  position: number // manual order
+  number: number // stays fixed once assigned
   comment?: string
   song?: SongResponse
   project?: ProjectResponse
@@ -61,17 +63,48 @@ export interface ProjectSongsResponse {
   total: number
 }
 
+export interface ResolvedSongConfigResponse {
+  project_id: number
+  song_id: number
+  project_song_id: number
+  title: string
+  filename: string
+  index: number
+  abc_path: string
+  abc_exists: boolean
+  variables: Record<string, string>
+  config: Record<string, unknown>
+  origins: Record<string, string> // JSON pointer -> "project" | "placeholder" | "abc_file"
+}
+
 // Project Build Types
 export interface BuildProjectRequest {
   output_dir?: string
   abc_file_dir?: string
   priority_threshold?: number // 1-4
   sample_id?: string
+  incremental?: boolean
+  dry_run?: boolean
+  song_order?: SongOrder // defaults to songOrder of the project config, otherwise "title"
+  supplement_since?: string // build ID or date, builds only the songs added or changed since then
+  profile?: string // name of a build profile, defaults to the default profile of the project
+  final?: boolean // final builds get only the watermarks marked final
+}
+
+export type SongOrder = 'title' | 'manual' | 'priority' | 'difficulty' | 'genre'
+
+export interface ReorderProjectSongsRequest {
+  song_ids: number[]
+}
+
+export interface RenumberProjectSongsRequest {
+  song_order?: SongOrder // defaults to the song order of the project
 }
 
 export interface BuildStatusResponse {
-  status: string // "pending" | "running" | "completed" | "failed"
+  status: string // "queued" | "running" | "completed" | "completed_with_errors" | "failed" | "cancelled" | "interrupted"
   progress: number // 0-100
+  queue_position?: number // 1-based, only set while queued
   message?: string
   started_at?: string
   completed_at?: string
@@ -81,12 +114,247 @@ export interface BuildStatusResponse {
 export interface BuildResultResponse {
   build_id: string
   project_id: number
-  status: string // "pending" | "running" | "completed" | "failed"
+  status: string // "queued" | "running" | "completed" | "completed_with_errors" | "failed" | "cancelled" | "interrupted"
   output_dir: string
+  build_dir?: string // directory of the build below output_dir
+  profile?: string
   generated_files?: string[]
   started_at: string
   completed_at?: string
   error?: string
+  report?: BuildReportResponse
+  manifest?: BuildManifestResponse // only returned for a single build
+  plan?: BuildPlanResponse // only returned for a dry run
+}
+
+export interface PlannedPDFResponse {
+  path: string
+  extract?: string
+  folder?: string
+  target?: string // the first of targets
+  reference?: string
+  folders?: string[]
+  targets?: string[]
+  excluded?: boolean
+}
+
+export interface FolderRuleResponse {
+  match?: string
+  regex?: string
+  folders?: string[]
+  exclude?: boolean
+}
+
+export interface FolderRoutingResponse {
+  mode: 'first' | 'all'
+  rules: FolderRuleResponse[]
+}
+
+export interface PlannedSongResponse {
+  index: number
+  project_song_id: number
+  song_id: number
+  title: string
+  filename: string
+  priority: number
+  copyright?: string
+  abc_path: string
+  abc_exists: boolean
+  config?: Record<string, unknown>
+  pdfs: PlannedPDFResponse[]
+  warnings?: string[]
+}
+
+export interface PlannedTOCResponse {
+  template: string
+  html_template: string
+  page_numbers: boolean // the second pass numbers the pages of the songs
+  registers?: string[] // titles of the registers rendered with the same templates
+  pdfs: PlannedPDFResponse[]
+  warnings?: string[]
+}
+
+export interface PlannedMergeResponse {
+  path: string
+  folder: string
+  files: string[]
+}
+
+// Paths of an edition are relative to the directory of the edition
+export interface PlannedEditionResponse {
+  name: string
+  folder_routing: FolderRoutingResponse
+  songs: PlannedSongResponse[]
+  toc: PlannedTOCResponse
+  merged_files: PlannedMergeResponse[]
+  watermarks?: PlannedWatermarkResponse[]
+}
+
+// Watermarks without folders are stamped on all folders
+export interface PlannedWatermarkResponse {
+  name: string
+  text?: string
+  image?: string
+  folders?: string[]
+}
+
+export interface BuildPlanResponse {
+  project_id: number
+  short_name: string
+  output_dir: string
+  abc_file_dir: string
+  priority_threshold: number
+  sample_id?: string
+  song_order: SongOrder
+  supplement_since?: string
+  profile?: string
+  final?: boolean
+  zupfnoter_version: string
+  folder_routing: FolderRoutingResponse
+  songs: PlannedSongResponse[]
+  toc: PlannedTOCResponse
+  merged_files: PlannedMergeResponse[]
+  watermarks?: PlannedWatermarkResponse[]
+  editions?: PlannedEditionResponse[]
+  warnings?: string[]
+}
+
+export interface ManifestFileResponse {
+  path: string
+  size: number
+  sha256: string
+  pages?: number
+  song_index?: number
+  song?: string
+  folder?: string
+  edition?: string
+}
+
+export interface BuildManifestResponse {
+  generated_at: string
+  file_count: number
+  total_size: number
+  files: ManifestFileResponse[]
+}
+
+export interface SongReportResponse {
+  index: number
+  project_song_id: number
+  song_id: number
+  title: string
+  filename: string
+  edition?: string
+  status: string // "completed" | "failed" | "skipped"
+  started_at?: string
+  duration_ms: number
+  stdout?: string
+  stderr?: string
+  pdfs?: string[]
+  html_warnings?: string[]
+  error?: string
+  abc_hash?: string
+  config_hash?: string
+  cache_key?: string
+  cached?: boolean
+}
+
+export interface BuildReportResponse {
+  total: number
+  succeeded: number
+  failed: number
+  skipped: number
+  cached: number
+  zupfnoter_version?: string
+  songs: SongReportResponse[]
+}
+
+export interface BuildDiffBuild {
+  build_id: string
+  started_at: string
+  output_dir: string
+  build_dir?: string
+}
+
+export interface FileChangeResponse {
+  path: string
+  change: 'added' | 'removed' | 'changed'
+  edition?: string
+  folder?: string
+  song_index?: number
+  song?: string
+  from_sha256?: string
+  to_sha256?: string
+  from_pages?: number
+  to_pages?: number
+  pages_changed?: boolean
+  // Pages of a print file in the merged PDF of its folder in the newer build
+  merged_file?: string
+  first_page?: number
+  last_page?: number
+}
+
+export interface SongChangeResponse {
+  project_song_id: number
+  song_id: number
+  title: string
+  filename: string
+  edition?: string
+  from_index?: number
+  to_index?: number
+  changes: Array<'added' | 'removed' | 'renumbered' | 'config_changed' | 'abc_changed'>
+}
+
+export interface BuildDiffResponse {
+  project_id: number
+  from: BuildDiffBuild
+  to: BuildDiffBuild
+  files_added: number
+  files_removed: number
+  files_changed: number
+  files_unchanged: number
+  files: FileChangeResponse[]
+  songs: SongChangeResponse[]
+}
+
+// Rules of a file are indices into the rules of its edition
+export interface FolderRuleCheckFileResponse {
+  path: string
+  edition?: string
+  build_folder?: string
+  rules: number[]
+  folders: string[]
+  excluded?: boolean
+}
+
+export interface FolderRuleCheckResponse {
+  project_id: number
+  build: BuildDiffBuild
+  routing?: FolderRoutingResponse
+  editions?: Array<{ name: string; routing: FolderRoutingResponse }>
+  files: FolderRuleCheckFileResponse[]
+  unmatched: number
+  excluded: number
+}
+
+export interface BuildEventSong {
+  index: number
+  title: string
+  filename: string
+  edition?: string
+  cached?: boolean
+}
+
+export interface BuildEventResponse {
+  id: number
+  build_id: string
+  type: string // "status" | "progress" | "song_started" | "song_completed" | "song_failed" | "result"
+  time: string
+  status?: string
+  progress?: number
+  message?: string
+  song?: BuildEventSong
+  error?: string
+  result?: BuildResultResponse
 }
 
 export interface BuildListResponse {
@@ -95,12 +363,85 @@ export interface BuildListResponse {
 }
 
 export interface BuildDefaultsResponse {
+  profile?: string // the default build profile of the project, if any
   output_dir: string
   abc_file_dir: string
   priority_threshold: number
   sample_id: string
 }
 
+export interface BuildProfileRequest {
+  name: string
+  output_dir?: string
+  abc_file_dir?: string
+  priority_threshold?: number // 1-4
+  sample_id?: string
+  config?: Record<string, any> // merged over the project config
+  is_default?: boolean
+}
+
+export interface BuildProfileResponse {
+  id: number
+  project_id: number
+  name: string
+  output_dir?: string
+  abc_file_dir?: string
+  priority_threshold?: number
+  sample_id?: string
+  config?: Record<string, any>
+  is_default: boolean
+}
+
+export interface BuildProfileListResponse {
+  profiles: BuildProfileResponse[]
+  count: number
+}
+
+// Template Types
+export type TemplateKind = 'toc' | 'toc-html'
+export type TemplateSource = 'project' | 'shared' | 'user' | 'built-in'
+
+export interface TemplateResponse {
+  id?: number // only shared templates
+  kind: TemplateKind
+  source: TemplateSource
+  name?: string
+  description?: string
+  path?: string // templates stored in files
+  origin: string // e.g. "shared:zweispaltig" or "built-in"
+  content?: string // left out in listings
+  updated_at?: string
+  active?: boolean // the template the project uses
+}
+
+export interface TemplateListResponse {
+  templates: TemplateResponse[]
+  count: number
+}
+
+export interface TemplateRequest {
+  name: string
+  kind?: TemplateKind // kept as it is on updates
+  description?: string
+  content: string
+}
+
+export interface TemplatePreviewRequest {
+  source?: TemplateSource
+  name?: string
+  content?: string // previews changes that are not stored yet
+}
+
+export interface TemplatePreviewResponse {
+  template: TemplateResponse
+  content_type: string
+  content: string
+}
+
+export interface TemplateOrderResponse {
+  order: TemplateSource[]
+}
+
 // Import Types
 export interface ImportFileRequest {
   file_path: string
@@ -155,6 +496,8 @@ export interface ProjectResponse {
   short_name: string
   config?: Record<string, any>
   abc_file_dir_preference?: string
+  variables?: Record<string, string> // user-defined #{name} variables
+  reserved_numbers?: number[] // numbers of removed songs kept free
 }
 
 export interface ProjectListResponse {
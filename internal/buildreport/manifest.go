@@ -0,0 +1,30 @@
+package buildreport
+
+// Manifest lists the files written by a build
+type Manifest struct {
+	GeneratedAt string         `json:"generated_at"`
+	FileCount   int            `json:"file_count"`
+	TotalSize   int64          `json:"total_size"`
+	Files       []ManifestFile `json:"files"`
+}
+
+// ManifestFile describes a single file of the build output
+type ManifestFile struct {
+	Path      string `json:"path"` // relative to the output directory, slash separated
+	Size      int64  `json:"size"`
+	SHA256    string `json:"sha256"`
+	Pages     int    `json:"pages,omitempty"`
+	SongIndex int    `json:"song_index,omitempty"`
+	Song      string `json:"song,omitempty"`
+	Folder    string `json:"folder,omitempty"`  // druckdateien folder the file belongs to
+	Edition   string `json:"edition,omitempty"` // edition whose directory holds the file
+}
+
+// Paths returns the paths of all files in the manifest
+func (m *Manifest) Paths() []string {
+	paths := make([]string, len(m.Files))
+	for i, file := range m.Files {
+		paths[i] = file.Path
+	}
+	return paths
+}
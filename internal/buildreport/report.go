@@ -0,0 +1,99 @@
+// Package buildreport contains the structured results of a project build that
+// are stored with the build record.
+package buildreport
+
+import "strings"
+
+// Song build states
+const (
+	SongCompleted = "completed"
+	SongFailed    = "failed"
+	SongSkipped   = "skipped"
+)
+
+// Report is the outcome of a project build with one entry per project song
+type Report struct {
+	Total            int          `json:"total"`
+	Succeeded        int          `json:"succeeded"`
+	Failed           int          `json:"failed"`
+	Skipped          int          `json:"skipped"`
+	Cached           int          `json:"cached"`
+	ZupfnoterVersion string       `json:"zupfnoter_version,omitempty"`
+	Songs            []SongReport `json:"songs"`
+}
+
+// SongReport is the outcome of building a single project song
+type SongReport struct {
+	Index         int      `json:"index"`
+	ProjectSongID int      `json:"project_song_id"`
+	SongID        int      `json:"song_id"`
+	Title         string   `json:"title"`
+	Filename      string   `json:"filename"`
+	Edition       string   `json:"edition,omitempty"`
+	Status        string   `json:"status"`
+	StartedAt     string   `json:"started_at,omitempty"`
+	DurationMs    int64    `json:"duration_ms"`
+	Stdout        string   `json:"stdout,omitempty"`
+	Stderr        string   `json:"stderr,omitempty"`
+	PDFs          []string `json:"pdfs,omitempty"`
+	HTMLWarnings  []string `json:"html_warnings,omitempty"`
+	Error         string   `json:"error,omitempty"`
+	ABCHash       string   `json:"abc_hash,omitempty"`
+	ConfigHash    string   `json:"config_hash,omitempty"`
+	CacheKey      string   `json:"cache_key,omitempty"`
+	Cached        bool     `json:"cached,omitempty"`
+}
+
+// Count recalculates the totals from the song entries. Songs restored from the
+// cache of an incremental build count as succeeded and as cached.
+func (r *Report) Count() {
+	r.Total, r.Succeeded, r.Failed, r.Skipped, r.Cached = len(r.Songs), 0, 0, 0, 0
+	for _, song := range r.Songs {
+		switch song.Status {
+		case SongCompleted:
+			r.Succeeded++
+			if song.Cached {
+				r.Cached++
+			}
+		case SongFailed:
+			r.Failed++
+		default:
+			r.Skipped++
+		}
+	}
+}
+
+// FailedSongs returns the entries of all songs that failed to build
+func (r *Report) FailedSongs() []SongReport {
+	var failed []SongReport
+	for _, song := range r.Songs {
+		if song.Status == SongFailed {
+			failed = append(failed, song)
+		}
+	}
+	return failed
+}
+
+// Limits for the process output kept in a song report
+const (
+	maxOutputLineLength = 1000
+	maxOutputLength     = 32 << 10
+)
+
+// LimitOutput shortens process output for storage in a report. Overlong lines,
+// e.g. minified sources printed by node in a stack trace, are cut and only the
+// end of long output is kept, since that is where errors show up.
+func LimitOutput(output string) string {
+	lines := strings.Split(output, "\n")
+	for i, line := range lines {
+		if len(line) > maxOutputLineLength {
+			lines[i] = line[:maxOutputLineLength] + " [...]"
+		}
+	}
+	output = strings.Join(lines, "\n")
+
+	if len(output) > maxOutputLength {
+		output = "[...]\n" + output[len(output)-maxOutputLength:]
+	}
+	return strings.ToValidUTF8(output, "")
+}
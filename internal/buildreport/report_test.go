@@ -0,0 +1,48 @@
+package buildreport
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReport_Count(t *testing.T) {
+	report := &Report{Songs: []SongReport{
+		{Index: 1, Status: SongCompleted},
+		{Index: 2, Status: SongFailed},
+		{Index: 3, Status: SongSkipped},
+		{Index: 4, Status: SongCompleted, Cached: true},
+	}}
+	report.Count()
+
+	if report.Total != 4 || report.Succeeded != 2 || report.Failed != 1 || report.Skipped != 1 || report.Cached != 1 {
+		t.Errorf("unexpected totals: %+v", report)
+	}
+	if failed := report.FailedSongs(); len(failed) != 1 || failed[0].Index != 2 {
+		t.Errorf("expected song 2 to be the only failed song, got %+v", failed)
+	}
+}
+
+func TestLimitOutput(t *testing.T) {
+	if got := LimitOutput("short output\n"); got != "short output\n" {
+		t.Errorf("short output should be unchanged, got %q", got)
+	}
+
+	longLine := strings.Repeat("x", 5*maxOutputLineLength)
+	got := LimitOutput(longLine + "\nError: boom\n")
+	if len(got) > maxOutputLineLength+100 {
+		t.Errorf("overlong line was not cut, got %d bytes", len(got))
+	}
+	if !strings.Contains(got, "Error: boom") {
+		t.Errorf("error line was lost: %q", got)
+	}
+
+	var many strings.Builder
+	for i := 0; i < 2*maxOutputLength/10; i++ {
+		many.WriteString("line line\n")
+	}
+	many.WriteString("last line")
+	got = LimitOutput(many.String())
+	if !strings.HasPrefix(got, "[...]\n") || !strings.HasSuffix(got, "last line") {
+		t.Errorf("expected the end of long output to be kept")
+	}
+}
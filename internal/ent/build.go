@@ -0,0 +1,381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bwl21/zupfmanager/internal/buildreport"
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+	"github.com/bwl21/zupfmanager/internal/ent/project"
+)
+
+// Build is the model entity for the Build schema.
+type Build struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Public build identifier (UUID)
+	BuildID string `json:"build_id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int `json:"project_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status build.Status `json:"status,omitempty"`
+	// Process that runs the build, a server restart only interrupts server builds
+	Origin build.Origin `json:"origin,omitempty"`
+	// Progress holds the value of the "progress" field.
+	Progress int `json:"progress,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// OutputDir holds the value of the "output_dir" field.
+	OutputDir string `json:"output_dir,omitempty"`
+	// Directory of the build relative to the output directory
+	BuildDir string `json:"build_dir,omitempty"`
+	// AbcFileDir holds the value of the "abc_file_dir" field.
+	AbcFileDir string `json:"abc_file_dir,omitempty"`
+	// PriorityThreshold holds the value of the "priority_threshold" field.
+	PriorityThreshold int `json:"priority_threshold,omitempty"`
+	// SampleID holds the value of the "sample_id" field.
+	SampleID string `json:"sample_id,omitempty"`
+	// Songs with unchanged input were restored from the render cache
+	Incremental bool `json:"incremental,omitempty"`
+	// Name of the build profile the build was started with
+	Profile string `json:"profile,omitempty"`
+	// Order of the songs in the build, see core.SongOrders
+	SongOrder string `json:"song_order,omitempty"`
+	// Supplement builds contain only the songs added or changed since this time (RFC 3339)
+	SupplementSince string `json:"supplement_since,omitempty"`
+	// Final builds are not stamped with draft watermarks
+	Final bool `json:"final,omitempty"`
+	// GeneratedFiles holds the value of the "generated_files" field.
+	GeneratedFiles []string `json:"generated_files,omitempty"`
+	// Per-song results of the build
+	Report *buildreport.Report `json:"report,omitempty"`
+	// Files written by the build, also stored as manifest.json in the output directory
+	Manifest *buildreport.Manifest `json:"manifest,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BuildQuery when eager-loading is set.
+	Edges        BuildEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BuildEdges holds the relations/edges for other nodes in the graph.
+type BuildEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BuildEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Build) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case build.FieldGeneratedFiles, build.FieldReport, build.FieldManifest:
+			values[i] = new([]byte)
+		case build.FieldIncremental, build.FieldFinal:
+			values[i] = new(sql.NullBool)
+		case build.FieldID, build.FieldProjectID, build.FieldProgress, build.FieldPriorityThreshold:
+			values[i] = new(sql.NullInt64)
+		case build.FieldBuildID, build.FieldStatus, build.FieldOrigin, build.FieldMessage, build.FieldOutputDir, build.FieldBuildDir, build.FieldAbcFileDir, build.FieldSampleID, build.FieldProfile, build.FieldSongOrder, build.FieldSupplementSince, build.FieldError:
+			values[i] = new(sql.NullString)
+		case build.FieldStartedAt, build.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Build fields.
+func (b *Build) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case build.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			b.ID = int(value.Int64)
+		case build.FieldBuildID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field build_id", values[i])
+			} else if value.Valid {
+				b.BuildID = value.String
+			}
+		case build.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				b.ProjectID = int(value.Int64)
+			}
+		case build.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				b.Status = build.Status(value.String)
+			}
+		case build.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				b.Origin = build.Origin(value.String)
+			}
+		case build.FieldProgress:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field progress", values[i])
+			} else if value.Valid {
+				b.Progress = int(value.Int64)
+			}
+		case build.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				b.Message = value.String
+			}
+		case build.FieldOutputDir:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field output_dir", values[i])
+			} else if value.Valid {
+				b.OutputDir = value.String
+			}
+		case build.FieldBuildDir:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field build_dir", values[i])
+			} else if value.Valid {
+				b.BuildDir = value.String
+			}
+		case build.FieldAbcFileDir:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field abc_file_dir", values[i])
+			} else if value.Valid {
+				b.AbcFileDir = value.String
+			}
+		case build.FieldPriorityThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority_threshold", values[i])
+			} else if value.Valid {
+				b.PriorityThreshold = int(value.Int64)
+			}
+		case build.FieldSampleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sample_id", values[i])
+			} else if value.Valid {
+				b.SampleID = value.String
+			}
+		case build.FieldIncremental:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field incremental", values[i])
+			} else if value.Valid {
+				b.Incremental = value.Bool
+			}
+		case build.FieldProfile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field profile", values[i])
+			} else if value.Valid {
+				b.Profile = value.String
+			}
+		case build.FieldSongOrder:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field song_order", values[i])
+			} else if value.Valid {
+				b.SongOrder = value.String
+			}
+		case build.FieldSupplementSince:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field supplement_since", values[i])
+			} else if value.Valid {
+				b.SupplementSince = value.String
+			}
+		case build.FieldFinal:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field final", values[i])
+			} else if value.Valid {
+				b.Final = value.Bool
+			}
+		case build.FieldGeneratedFiles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field generated_files", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &b.GeneratedFiles); err != nil {
+					return fmt.Errorf("unmarshal field generated_files: %w", err)
+				}
+			}
+		case build.FieldReport:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field report", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &b.Report); err != nil {
+					return fmt.Errorf("unmarshal field report: %w", err)
+				}
+			}
+		case build.FieldManifest:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field manifest", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &b.Manifest); err != nil {
+					return fmt.Errorf("unmarshal field manifest: %w", err)
+				}
+			}
+		case build.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				b.Error = value.String
+			}
+		case build.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				b.StartedAt = value.Time
+			}
+		case build.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				b.CompletedAt = new(time.Time)
+				*b.CompletedAt = value.Time
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Build.
+// This includes values selected through modifiers, order, etc.
+func (b *Build) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the Build entity.
+func (b *Build) QueryProject() *ProjectQuery {
+	return NewBuildClient(b.config).QueryProject(b)
+}
+
+// Update returns a builder for updating this Build.
+// Note that you need to call Build.Unwrap() before calling this method if this Build
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Build) Update() *BuildUpdateOne {
+	return NewBuildClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Build entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Build) Unwrap() *Build {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Build is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Build) String() string {
+	var builder strings.Builder
+	builder.WriteString("Build(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("build_id=")
+	builder.WriteString(b.BuildID)
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", b.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", b.Status))
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(fmt.Sprintf("%v", b.Origin))
+	builder.WriteString(", ")
+	builder.WriteString("progress=")
+	builder.WriteString(fmt.Sprintf("%v", b.Progress))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(b.Message)
+	builder.WriteString(", ")
+	builder.WriteString("output_dir=")
+	builder.WriteString(b.OutputDir)
+	builder.WriteString(", ")
+	builder.WriteString("build_dir=")
+	builder.WriteString(b.BuildDir)
+	builder.WriteString(", ")
+	builder.WriteString("abc_file_dir=")
+	builder.WriteString(b.AbcFileDir)
+	builder.WriteString(", ")
+	builder.WriteString("priority_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", b.PriorityThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("sample_id=")
+	builder.WriteString(b.SampleID)
+	builder.WriteString(", ")
+	builder.WriteString("incremental=")
+	builder.WriteString(fmt.Sprintf("%v", b.Incremental))
+	builder.WriteString(", ")
+	builder.WriteString("profile=")
+	builder.WriteString(b.Profile)
+	builder.WriteString(", ")
+	builder.WriteString("song_order=")
+	builder.WriteString(b.SongOrder)
+	builder.WriteString(", ")
+	builder.WriteString("supplement_since=")
+	builder.WriteString(b.SupplementSince)
+	builder.WriteString(", ")
+	builder.WriteString("final=")
+	builder.WriteString(fmt.Sprintf("%v", b.Final))
+	builder.WriteString(", ")
+	builder.WriteString("generated_files=")
+	builder.WriteString(fmt.Sprintf("%v", b.GeneratedFiles))
+	builder.WriteString(", ")
+	builder.WriteString("report=")
+	builder.WriteString(fmt.Sprintf("%v", b.Report))
+	builder.WriteString(", ")
+	builder.WriteString("manifest=")
+	builder.WriteString(fmt.Sprintf("%v", b.Manifest))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(b.Error)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(b.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := b.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Builds is a parsable slice of Build.
+type Builds []*Build
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package build
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the build type in the database.
+	Label = "build"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBuildID holds the string denoting the build_id field in the database.
+	FieldBuildID = "build_id"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldProgress holds the string denoting the progress field in the database.
+	FieldProgress = "progress"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldOutputDir holds the string denoting the output_dir field in the database.
+	FieldOutputDir = "output_dir"
+	// FieldBuildDir holds the string denoting the build_dir field in the database.
+	FieldBuildDir = "build_dir"
+	// FieldAbcFileDir holds the string denoting the abc_file_dir field in the database.
+	FieldAbcFileDir = "abc_file_dir"
+	// FieldPriorityThreshold holds the string denoting the priority_threshold field in the database.
+	FieldPriorityThreshold = "priority_threshold"
+	// FieldSampleID holds the string denoting the sample_id field in the database.
+	FieldSampleID = "sample_id"
+	// FieldIncremental holds the string denoting the incremental field in the database.
+	FieldIncremental = "incremental"
+	// FieldProfile holds the string denoting the profile field in the database.
+	FieldProfile = "profile"
+	// FieldSongOrder holds the string denoting the song_order field in the database.
+	FieldSongOrder = "song_order"
+	// FieldSupplementSince holds the string denoting the supplement_since field in the database.
+	FieldSupplementSince = "supplement_since"
+	// FieldFinal holds the string denoting the final field in the database.
+	FieldFinal = "final"
+	// FieldGeneratedFiles holds the string denoting the generated_files field in the database.
+	FieldGeneratedFiles = "generated_files"
+	// FieldReport holds the string denoting the report field in the database.
+	FieldReport = "report"
+	// FieldManifest holds the string denoting the manifest field in the database.
+	FieldManifest = "manifest"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// EdgeProject holds the string denoting the project edge name in mutations.
+	EdgeProject = "project"
+	// Table holds the table name of the build in the database.
+	Table = "builds"
+	// ProjectTable is the table that holds the project relation/edge.
+	ProjectTable = "builds"
+	// ProjectInverseTable is the table name for the Project entity.
+	// It exists in this package in order to avoid circular dependency with the "project" package.
+	ProjectInverseTable = "projects"
+	// ProjectColumn is the table column denoting the project relation/edge.
+	ProjectColumn = "project_id"
+)
+
+// Columns holds all SQL columns for build fields.
+var Columns = []string{
+	FieldID,
+	FieldBuildID,
+	FieldProjectID,
+	FieldStatus,
+	FieldOrigin,
+	FieldProgress,
+	FieldMessage,
+	FieldOutputDir,
+	FieldBuildDir,
+	FieldAbcFileDir,
+	FieldPriorityThreshold,
+	FieldSampleID,
+	FieldIncremental,
+	FieldProfile,
+	FieldSongOrder,
+	FieldSupplementSince,
+	FieldFinal,
+	FieldGeneratedFiles,
+	FieldReport,
+	FieldManifest,
+	FieldError,
+	FieldStartedAt,
+	FieldCompletedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// BuildIDValidator is a validator for the "build_id" field. It is called by the builders before save.
+	BuildIDValidator func(string) error
+	// DefaultProgress holds the default value on creation for the "progress" field.
+	DefaultProgress int
+	// ProgressValidator is a validator for the "progress" field. It is called by the builders before save.
+	ProgressValidator func(int) error
+	// DefaultIncremental holds the default value on creation for the "incremental" field.
+	DefaultIncremental bool
+	// DefaultFinal holds the default value on creation for the "final" field.
+	DefaultFinal bool
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending             Status = "pending"
+	StatusQueued              Status = "queued"
+	StatusRunning             Status = "running"
+	StatusCompleted           Status = "completed"
+	StatusCompletedWithErrors Status = "completed_with_errors"
+	StatusFailed              Status = "failed"
+	StatusCancelled           Status = "cancelled"
+	StatusInterrupted         Status = "interrupted"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusQueued, StatusRunning, StatusCompleted, StatusCompletedWithErrors, StatusFailed, StatusCancelled, StatusInterrupted:
+		return nil
+	default:
+		return fmt.Errorf("build: invalid enum value for status field: %q", s)
+	}
+}
+
+// Origin defines the type for the "origin" enum field.
+type Origin string
+
+// OriginServer is the default value of the Origin enum.
+const DefaultOrigin = OriginServer
+
+// Origin values.
+const (
+	OriginServer Origin = "server"
+	OriginCli    Origin = "cli"
+)
+
+func (o Origin) String() string {
+	return string(o)
+}
+
+// OriginValidator is a validator for the "origin" field enum values. It is called by the builders before save.
+func OriginValidator(o Origin) error {
+	switch o {
+	case OriginServer, OriginCli:
+		return nil
+	default:
+		return fmt.Errorf("build: invalid enum value for origin field: %q", o)
+	}
+}
+
+// OrderOption defines the ordering options for the Build queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBuildID orders the results by the build_id field.
+func ByBuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildID, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByOrigin orders the results by the origin field.
+func ByOrigin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrigin, opts...).ToFunc()
+}
+
+// ByProgress orders the results by the progress field.
+func ByProgress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProgress, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
+
+// ByOutputDir orders the results by the output_dir field.
+func ByOutputDir(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputDir, opts...).ToFunc()
+}
+
+// ByBuildDir orders the results by the build_dir field.
+func ByBuildDir(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuildDir, opts...).ToFunc()
+}
+
+// ByAbcFileDir orders the results by the abc_file_dir field.
+func ByAbcFileDir(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAbcFileDir, opts...).ToFunc()
+}
+
+// ByPriorityThreshold orders the results by the priority_threshold field.
+func ByPriorityThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriorityThreshold, opts...).ToFunc()
+}
+
+// BySampleID orders the results by the sample_id field.
+func BySampleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSampleID, opts...).ToFunc()
+}
+
+// ByIncremental orders the results by the incremental field.
+func ByIncremental(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncremental, opts...).ToFunc()
+}
+
+// ByProfile orders the results by the profile field.
+func ByProfile(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProfile, opts...).ToFunc()
+}
+
+// BySongOrder orders the results by the song_order field.
+func BySongOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSongOrder, opts...).ToFunc()
+}
+
+// BySupplementSince orders the results by the supplement_since field.
+func BySupplementSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSupplementSince, opts...).ToFunc()
+}
+
+// ByFinal orders the results by the final field.
+func ByFinal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinal, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByProjectField orders the results by project field.
+func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProjectStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProjectInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+	)
+}
@@ -0,0 +1,1209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package build
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bwl21/zupfmanager/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldID, id))
+}
+
+// BuildID applies equality check predicate on the "build_id" field. It's identical to BuildIDEQ.
+func BuildID(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldBuildID, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldProjectID, v))
+}
+
+// Progress applies equality check predicate on the "progress" field. It's identical to ProgressEQ.
+func Progress(v int) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldProgress, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldMessage, v))
+}
+
+// OutputDir applies equality check predicate on the "output_dir" field. It's identical to OutputDirEQ.
+func OutputDir(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldOutputDir, v))
+}
+
+// BuildDir applies equality check predicate on the "build_dir" field. It's identical to BuildDirEQ.
+func BuildDir(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldBuildDir, v))
+}
+
+// AbcFileDir applies equality check predicate on the "abc_file_dir" field. It's identical to AbcFileDirEQ.
+func AbcFileDir(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldAbcFileDir, v))
+}
+
+// PriorityThreshold applies equality check predicate on the "priority_threshold" field. It's identical to PriorityThresholdEQ.
+func PriorityThreshold(v int) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldPriorityThreshold, v))
+}
+
+// SampleID applies equality check predicate on the "sample_id" field. It's identical to SampleIDEQ.
+func SampleID(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldSampleID, v))
+}
+
+// Incremental applies equality check predicate on the "incremental" field. It's identical to IncrementalEQ.
+func Incremental(v bool) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldIncremental, v))
+}
+
+// Profile applies equality check predicate on the "profile" field. It's identical to ProfileEQ.
+func Profile(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldProfile, v))
+}
+
+// SongOrder applies equality check predicate on the "song_order" field. It's identical to SongOrderEQ.
+func SongOrder(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldSongOrder, v))
+}
+
+// SupplementSince applies equality check predicate on the "supplement_since" field. It's identical to SupplementSinceEQ.
+func SupplementSince(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldSupplementSince, v))
+}
+
+// Final applies equality check predicate on the "final" field. It's identical to FinalEQ.
+func Final(v bool) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldFinal, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldError, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// BuildIDEQ applies the EQ predicate on the "build_id" field.
+func BuildIDEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldBuildID, v))
+}
+
+// BuildIDNEQ applies the NEQ predicate on the "build_id" field.
+func BuildIDNEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldBuildID, v))
+}
+
+// BuildIDIn applies the In predicate on the "build_id" field.
+func BuildIDIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldBuildID, vs...))
+}
+
+// BuildIDNotIn applies the NotIn predicate on the "build_id" field.
+func BuildIDNotIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldBuildID, vs...))
+}
+
+// BuildIDGT applies the GT predicate on the "build_id" field.
+func BuildIDGT(v string) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldBuildID, v))
+}
+
+// BuildIDGTE applies the GTE predicate on the "build_id" field.
+func BuildIDGTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldBuildID, v))
+}
+
+// BuildIDLT applies the LT predicate on the "build_id" field.
+func BuildIDLT(v string) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldBuildID, v))
+}
+
+// BuildIDLTE applies the LTE predicate on the "build_id" field.
+func BuildIDLTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldBuildID, v))
+}
+
+// BuildIDContains applies the Contains predicate on the "build_id" field.
+func BuildIDContains(v string) predicate.Build {
+	return predicate.Build(sql.FieldContains(FieldBuildID, v))
+}
+
+// BuildIDHasPrefix applies the HasPrefix predicate on the "build_id" field.
+func BuildIDHasPrefix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasPrefix(FieldBuildID, v))
+}
+
+// BuildIDHasSuffix applies the HasSuffix predicate on the "build_id" field.
+func BuildIDHasSuffix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasSuffix(FieldBuildID, v))
+}
+
+// BuildIDEqualFold applies the EqualFold predicate on the "build_id" field.
+func BuildIDEqualFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldEqualFold(FieldBuildID, v))
+}
+
+// BuildIDContainsFold applies the ContainsFold predicate on the "build_id" field.
+func BuildIDContainsFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldContainsFold(FieldBuildID, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// OriginEQ applies the EQ predicate on the "origin" field.
+func OriginEQ(v Origin) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldOrigin, v))
+}
+
+// OriginNEQ applies the NEQ predicate on the "origin" field.
+func OriginNEQ(v Origin) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldOrigin, v))
+}
+
+// OriginIn applies the In predicate on the "origin" field.
+func OriginIn(vs ...Origin) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldOrigin, vs...))
+}
+
+// OriginNotIn applies the NotIn predicate on the "origin" field.
+func OriginNotIn(vs ...Origin) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldOrigin, vs...))
+}
+
+// ProgressEQ applies the EQ predicate on the "progress" field.
+func ProgressEQ(v int) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldProgress, v))
+}
+
+// ProgressNEQ applies the NEQ predicate on the "progress" field.
+func ProgressNEQ(v int) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldProgress, v))
+}
+
+// ProgressIn applies the In predicate on the "progress" field.
+func ProgressIn(vs ...int) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldProgress, vs...))
+}
+
+// ProgressNotIn applies the NotIn predicate on the "progress" field.
+func ProgressNotIn(vs ...int) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldProgress, vs...))
+}
+
+// ProgressGT applies the GT predicate on the "progress" field.
+func ProgressGT(v int) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldProgress, v))
+}
+
+// ProgressGTE applies the GTE predicate on the "progress" field.
+func ProgressGTE(v int) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldProgress, v))
+}
+
+// ProgressLT applies the LT predicate on the "progress" field.
+func ProgressLT(v int) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldProgress, v))
+}
+
+// ProgressLTE applies the LTE predicate on the "progress" field.
+func ProgressLTE(v int) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldProgress, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.Build {
+	return predicate.Build(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// OutputDirEQ applies the EQ predicate on the "output_dir" field.
+func OutputDirEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldOutputDir, v))
+}
+
+// OutputDirNEQ applies the NEQ predicate on the "output_dir" field.
+func OutputDirNEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldOutputDir, v))
+}
+
+// OutputDirIn applies the In predicate on the "output_dir" field.
+func OutputDirIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldOutputDir, vs...))
+}
+
+// OutputDirNotIn applies the NotIn predicate on the "output_dir" field.
+func OutputDirNotIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldOutputDir, vs...))
+}
+
+// OutputDirGT applies the GT predicate on the "output_dir" field.
+func OutputDirGT(v string) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldOutputDir, v))
+}
+
+// OutputDirGTE applies the GTE predicate on the "output_dir" field.
+func OutputDirGTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldOutputDir, v))
+}
+
+// OutputDirLT applies the LT predicate on the "output_dir" field.
+func OutputDirLT(v string) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldOutputDir, v))
+}
+
+// OutputDirLTE applies the LTE predicate on the "output_dir" field.
+func OutputDirLTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldOutputDir, v))
+}
+
+// OutputDirContains applies the Contains predicate on the "output_dir" field.
+func OutputDirContains(v string) predicate.Build {
+	return predicate.Build(sql.FieldContains(FieldOutputDir, v))
+}
+
+// OutputDirHasPrefix applies the HasPrefix predicate on the "output_dir" field.
+func OutputDirHasPrefix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasPrefix(FieldOutputDir, v))
+}
+
+// OutputDirHasSuffix applies the HasSuffix predicate on the "output_dir" field.
+func OutputDirHasSuffix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasSuffix(FieldOutputDir, v))
+}
+
+// OutputDirIsNil applies the IsNil predicate on the "output_dir" field.
+func OutputDirIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldOutputDir))
+}
+
+// OutputDirNotNil applies the NotNil predicate on the "output_dir" field.
+func OutputDirNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldOutputDir))
+}
+
+// OutputDirEqualFold applies the EqualFold predicate on the "output_dir" field.
+func OutputDirEqualFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldEqualFold(FieldOutputDir, v))
+}
+
+// OutputDirContainsFold applies the ContainsFold predicate on the "output_dir" field.
+func OutputDirContainsFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldContainsFold(FieldOutputDir, v))
+}
+
+// BuildDirEQ applies the EQ predicate on the "build_dir" field.
+func BuildDirEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldBuildDir, v))
+}
+
+// BuildDirNEQ applies the NEQ predicate on the "build_dir" field.
+func BuildDirNEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldBuildDir, v))
+}
+
+// BuildDirIn applies the In predicate on the "build_dir" field.
+func BuildDirIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldBuildDir, vs...))
+}
+
+// BuildDirNotIn applies the NotIn predicate on the "build_dir" field.
+func BuildDirNotIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldBuildDir, vs...))
+}
+
+// BuildDirGT applies the GT predicate on the "build_dir" field.
+func BuildDirGT(v string) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldBuildDir, v))
+}
+
+// BuildDirGTE applies the GTE predicate on the "build_dir" field.
+func BuildDirGTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldBuildDir, v))
+}
+
+// BuildDirLT applies the LT predicate on the "build_dir" field.
+func BuildDirLT(v string) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldBuildDir, v))
+}
+
+// BuildDirLTE applies the LTE predicate on the "build_dir" field.
+func BuildDirLTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldBuildDir, v))
+}
+
+// BuildDirContains applies the Contains predicate on the "build_dir" field.
+func BuildDirContains(v string) predicate.Build {
+	return predicate.Build(sql.FieldContains(FieldBuildDir, v))
+}
+
+// BuildDirHasPrefix applies the HasPrefix predicate on the "build_dir" field.
+func BuildDirHasPrefix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasPrefix(FieldBuildDir, v))
+}
+
+// BuildDirHasSuffix applies the HasSuffix predicate on the "build_dir" field.
+func BuildDirHasSuffix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasSuffix(FieldBuildDir, v))
+}
+
+// BuildDirIsNil applies the IsNil predicate on the "build_dir" field.
+func BuildDirIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldBuildDir))
+}
+
+// BuildDirNotNil applies the NotNil predicate on the "build_dir" field.
+func BuildDirNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldBuildDir))
+}
+
+// BuildDirEqualFold applies the EqualFold predicate on the "build_dir" field.
+func BuildDirEqualFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldEqualFold(FieldBuildDir, v))
+}
+
+// BuildDirContainsFold applies the ContainsFold predicate on the "build_dir" field.
+func BuildDirContainsFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldContainsFold(FieldBuildDir, v))
+}
+
+// AbcFileDirEQ applies the EQ predicate on the "abc_file_dir" field.
+func AbcFileDirEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldAbcFileDir, v))
+}
+
+// AbcFileDirNEQ applies the NEQ predicate on the "abc_file_dir" field.
+func AbcFileDirNEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldAbcFileDir, v))
+}
+
+// AbcFileDirIn applies the In predicate on the "abc_file_dir" field.
+func AbcFileDirIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldAbcFileDir, vs...))
+}
+
+// AbcFileDirNotIn applies the NotIn predicate on the "abc_file_dir" field.
+func AbcFileDirNotIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldAbcFileDir, vs...))
+}
+
+// AbcFileDirGT applies the GT predicate on the "abc_file_dir" field.
+func AbcFileDirGT(v string) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldAbcFileDir, v))
+}
+
+// AbcFileDirGTE applies the GTE predicate on the "abc_file_dir" field.
+func AbcFileDirGTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldAbcFileDir, v))
+}
+
+// AbcFileDirLT applies the LT predicate on the "abc_file_dir" field.
+func AbcFileDirLT(v string) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldAbcFileDir, v))
+}
+
+// AbcFileDirLTE applies the LTE predicate on the "abc_file_dir" field.
+func AbcFileDirLTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldAbcFileDir, v))
+}
+
+// AbcFileDirContains applies the Contains predicate on the "abc_file_dir" field.
+func AbcFileDirContains(v string) predicate.Build {
+	return predicate.Build(sql.FieldContains(FieldAbcFileDir, v))
+}
+
+// AbcFileDirHasPrefix applies the HasPrefix predicate on the "abc_file_dir" field.
+func AbcFileDirHasPrefix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasPrefix(FieldAbcFileDir, v))
+}
+
+// AbcFileDirHasSuffix applies the HasSuffix predicate on the "abc_file_dir" field.
+func AbcFileDirHasSuffix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasSuffix(FieldAbcFileDir, v))
+}
+
+// AbcFileDirIsNil applies the IsNil predicate on the "abc_file_dir" field.
+func AbcFileDirIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldAbcFileDir))
+}
+
+// AbcFileDirNotNil applies the NotNil predicate on the "abc_file_dir" field.
+func AbcFileDirNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldAbcFileDir))
+}
+
+// AbcFileDirEqualFold applies the EqualFold predicate on the "abc_file_dir" field.
+func AbcFileDirEqualFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldEqualFold(FieldAbcFileDir, v))
+}
+
+// AbcFileDirContainsFold applies the ContainsFold predicate on the "abc_file_dir" field.
+func AbcFileDirContainsFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldContainsFold(FieldAbcFileDir, v))
+}
+
+// PriorityThresholdEQ applies the EQ predicate on the "priority_threshold" field.
+func PriorityThresholdEQ(v int) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldPriorityThreshold, v))
+}
+
+// PriorityThresholdNEQ applies the NEQ predicate on the "priority_threshold" field.
+func PriorityThresholdNEQ(v int) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldPriorityThreshold, v))
+}
+
+// PriorityThresholdIn applies the In predicate on the "priority_threshold" field.
+func PriorityThresholdIn(vs ...int) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldPriorityThreshold, vs...))
+}
+
+// PriorityThresholdNotIn applies the NotIn predicate on the "priority_threshold" field.
+func PriorityThresholdNotIn(vs ...int) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldPriorityThreshold, vs...))
+}
+
+// PriorityThresholdGT applies the GT predicate on the "priority_threshold" field.
+func PriorityThresholdGT(v int) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldPriorityThreshold, v))
+}
+
+// PriorityThresholdGTE applies the GTE predicate on the "priority_threshold" field.
+func PriorityThresholdGTE(v int) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldPriorityThreshold, v))
+}
+
+// PriorityThresholdLT applies the LT predicate on the "priority_threshold" field.
+func PriorityThresholdLT(v int) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldPriorityThreshold, v))
+}
+
+// PriorityThresholdLTE applies the LTE predicate on the "priority_threshold" field.
+func PriorityThresholdLTE(v int) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldPriorityThreshold, v))
+}
+
+// PriorityThresholdIsNil applies the IsNil predicate on the "priority_threshold" field.
+func PriorityThresholdIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldPriorityThreshold))
+}
+
+// PriorityThresholdNotNil applies the NotNil predicate on the "priority_threshold" field.
+func PriorityThresholdNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldPriorityThreshold))
+}
+
+// SampleIDEQ applies the EQ predicate on the "sample_id" field.
+func SampleIDEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldSampleID, v))
+}
+
+// SampleIDNEQ applies the NEQ predicate on the "sample_id" field.
+func SampleIDNEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldSampleID, v))
+}
+
+// SampleIDIn applies the In predicate on the "sample_id" field.
+func SampleIDIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldSampleID, vs...))
+}
+
+// SampleIDNotIn applies the NotIn predicate on the "sample_id" field.
+func SampleIDNotIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldSampleID, vs...))
+}
+
+// SampleIDGT applies the GT predicate on the "sample_id" field.
+func SampleIDGT(v string) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldSampleID, v))
+}
+
+// SampleIDGTE applies the GTE predicate on the "sample_id" field.
+func SampleIDGTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldSampleID, v))
+}
+
+// SampleIDLT applies the LT predicate on the "sample_id" field.
+func SampleIDLT(v string) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldSampleID, v))
+}
+
+// SampleIDLTE applies the LTE predicate on the "sample_id" field.
+func SampleIDLTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldSampleID, v))
+}
+
+// SampleIDContains applies the Contains predicate on the "sample_id" field.
+func SampleIDContains(v string) predicate.Build {
+	return predicate.Build(sql.FieldContains(FieldSampleID, v))
+}
+
+// SampleIDHasPrefix applies the HasPrefix predicate on the "sample_id" field.
+func SampleIDHasPrefix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasPrefix(FieldSampleID, v))
+}
+
+// SampleIDHasSuffix applies the HasSuffix predicate on the "sample_id" field.
+func SampleIDHasSuffix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasSuffix(FieldSampleID, v))
+}
+
+// SampleIDIsNil applies the IsNil predicate on the "sample_id" field.
+func SampleIDIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldSampleID))
+}
+
+// SampleIDNotNil applies the NotNil predicate on the "sample_id" field.
+func SampleIDNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldSampleID))
+}
+
+// SampleIDEqualFold applies the EqualFold predicate on the "sample_id" field.
+func SampleIDEqualFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldEqualFold(FieldSampleID, v))
+}
+
+// SampleIDContainsFold applies the ContainsFold predicate on the "sample_id" field.
+func SampleIDContainsFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldContainsFold(FieldSampleID, v))
+}
+
+// IncrementalEQ applies the EQ predicate on the "incremental" field.
+func IncrementalEQ(v bool) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldIncremental, v))
+}
+
+// IncrementalNEQ applies the NEQ predicate on the "incremental" field.
+func IncrementalNEQ(v bool) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldIncremental, v))
+}
+
+// ProfileEQ applies the EQ predicate on the "profile" field.
+func ProfileEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldProfile, v))
+}
+
+// ProfileNEQ applies the NEQ predicate on the "profile" field.
+func ProfileNEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldProfile, v))
+}
+
+// ProfileIn applies the In predicate on the "profile" field.
+func ProfileIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldProfile, vs...))
+}
+
+// ProfileNotIn applies the NotIn predicate on the "profile" field.
+func ProfileNotIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldProfile, vs...))
+}
+
+// ProfileGT applies the GT predicate on the "profile" field.
+func ProfileGT(v string) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldProfile, v))
+}
+
+// ProfileGTE applies the GTE predicate on the "profile" field.
+func ProfileGTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldProfile, v))
+}
+
+// ProfileLT applies the LT predicate on the "profile" field.
+func ProfileLT(v string) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldProfile, v))
+}
+
+// ProfileLTE applies the LTE predicate on the "profile" field.
+func ProfileLTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldProfile, v))
+}
+
+// ProfileContains applies the Contains predicate on the "profile" field.
+func ProfileContains(v string) predicate.Build {
+	return predicate.Build(sql.FieldContains(FieldProfile, v))
+}
+
+// ProfileHasPrefix applies the HasPrefix predicate on the "profile" field.
+func ProfileHasPrefix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasPrefix(FieldProfile, v))
+}
+
+// ProfileHasSuffix applies the HasSuffix predicate on the "profile" field.
+func ProfileHasSuffix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasSuffix(FieldProfile, v))
+}
+
+// ProfileIsNil applies the IsNil predicate on the "profile" field.
+func ProfileIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldProfile))
+}
+
+// ProfileNotNil applies the NotNil predicate on the "profile" field.
+func ProfileNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldProfile))
+}
+
+// ProfileEqualFold applies the EqualFold predicate on the "profile" field.
+func ProfileEqualFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldEqualFold(FieldProfile, v))
+}
+
+// ProfileContainsFold applies the ContainsFold predicate on the "profile" field.
+func ProfileContainsFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldContainsFold(FieldProfile, v))
+}
+
+// SongOrderEQ applies the EQ predicate on the "song_order" field.
+func SongOrderEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldSongOrder, v))
+}
+
+// SongOrderNEQ applies the NEQ predicate on the "song_order" field.
+func SongOrderNEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldSongOrder, v))
+}
+
+// SongOrderIn applies the In predicate on the "song_order" field.
+func SongOrderIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldSongOrder, vs...))
+}
+
+// SongOrderNotIn applies the NotIn predicate on the "song_order" field.
+func SongOrderNotIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldSongOrder, vs...))
+}
+
+// SongOrderGT applies the GT predicate on the "song_order" field.
+func SongOrderGT(v string) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldSongOrder, v))
+}
+
+// SongOrderGTE applies the GTE predicate on the "song_order" field.
+func SongOrderGTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldSongOrder, v))
+}
+
+// SongOrderLT applies the LT predicate on the "song_order" field.
+func SongOrderLT(v string) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldSongOrder, v))
+}
+
+// SongOrderLTE applies the LTE predicate on the "song_order" field.
+func SongOrderLTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldSongOrder, v))
+}
+
+// SongOrderContains applies the Contains predicate on the "song_order" field.
+func SongOrderContains(v string) predicate.Build {
+	return predicate.Build(sql.FieldContains(FieldSongOrder, v))
+}
+
+// SongOrderHasPrefix applies the HasPrefix predicate on the "song_order" field.
+func SongOrderHasPrefix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasPrefix(FieldSongOrder, v))
+}
+
+// SongOrderHasSuffix applies the HasSuffix predicate on the "song_order" field.
+func SongOrderHasSuffix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasSuffix(FieldSongOrder, v))
+}
+
+// SongOrderIsNil applies the IsNil predicate on the "song_order" field.
+func SongOrderIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldSongOrder))
+}
+
+// SongOrderNotNil applies the NotNil predicate on the "song_order" field.
+func SongOrderNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldSongOrder))
+}
+
+// SongOrderEqualFold applies the EqualFold predicate on the "song_order" field.
+func SongOrderEqualFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldEqualFold(FieldSongOrder, v))
+}
+
+// SongOrderContainsFold applies the ContainsFold predicate on the "song_order" field.
+func SongOrderContainsFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldContainsFold(FieldSongOrder, v))
+}
+
+// SupplementSinceEQ applies the EQ predicate on the "supplement_since" field.
+func SupplementSinceEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldSupplementSince, v))
+}
+
+// SupplementSinceNEQ applies the NEQ predicate on the "supplement_since" field.
+func SupplementSinceNEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldSupplementSince, v))
+}
+
+// SupplementSinceIn applies the In predicate on the "supplement_since" field.
+func SupplementSinceIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldSupplementSince, vs...))
+}
+
+// SupplementSinceNotIn applies the NotIn predicate on the "supplement_since" field.
+func SupplementSinceNotIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldSupplementSince, vs...))
+}
+
+// SupplementSinceGT applies the GT predicate on the "supplement_since" field.
+func SupplementSinceGT(v string) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldSupplementSince, v))
+}
+
+// SupplementSinceGTE applies the GTE predicate on the "supplement_since" field.
+func SupplementSinceGTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldSupplementSince, v))
+}
+
+// SupplementSinceLT applies the LT predicate on the "supplement_since" field.
+func SupplementSinceLT(v string) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldSupplementSince, v))
+}
+
+// SupplementSinceLTE applies the LTE predicate on the "supplement_since" field.
+func SupplementSinceLTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldSupplementSince, v))
+}
+
+// SupplementSinceContains applies the Contains predicate on the "supplement_since" field.
+func SupplementSinceContains(v string) predicate.Build {
+	return predicate.Build(sql.FieldContains(FieldSupplementSince, v))
+}
+
+// SupplementSinceHasPrefix applies the HasPrefix predicate on the "supplement_since" field.
+func SupplementSinceHasPrefix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasPrefix(FieldSupplementSince, v))
+}
+
+// SupplementSinceHasSuffix applies the HasSuffix predicate on the "supplement_since" field.
+func SupplementSinceHasSuffix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasSuffix(FieldSupplementSince, v))
+}
+
+// SupplementSinceIsNil applies the IsNil predicate on the "supplement_since" field.
+func SupplementSinceIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldSupplementSince))
+}
+
+// SupplementSinceNotNil applies the NotNil predicate on the "supplement_since" field.
+func SupplementSinceNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldSupplementSince))
+}
+
+// SupplementSinceEqualFold applies the EqualFold predicate on the "supplement_since" field.
+func SupplementSinceEqualFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldEqualFold(FieldSupplementSince, v))
+}
+
+// SupplementSinceContainsFold applies the ContainsFold predicate on the "supplement_since" field.
+func SupplementSinceContainsFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldContainsFold(FieldSupplementSince, v))
+}
+
+// FinalEQ applies the EQ predicate on the "final" field.
+func FinalEQ(v bool) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldFinal, v))
+}
+
+// FinalNEQ applies the NEQ predicate on the "final" field.
+func FinalNEQ(v bool) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldFinal, v))
+}
+
+// GeneratedFilesIsNil applies the IsNil predicate on the "generated_files" field.
+func GeneratedFilesIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldGeneratedFiles))
+}
+
+// GeneratedFilesNotNil applies the NotNil predicate on the "generated_files" field.
+func GeneratedFilesNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldGeneratedFiles))
+}
+
+// ReportIsNil applies the IsNil predicate on the "report" field.
+func ReportIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldReport))
+}
+
+// ReportNotNil applies the NotNil predicate on the "report" field.
+func ReportNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldReport))
+}
+
+// ManifestIsNil applies the IsNil predicate on the "manifest" field.
+func ManifestIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldManifest))
+}
+
+// ManifestNotNil applies the NotNil predicate on the "manifest" field.
+func ManifestNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldManifest))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.Build {
+	return predicate.Build(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.Build {
+	return predicate.Build(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.Build {
+	return predicate.Build(sql.FieldContainsFold(FieldError, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.Build {
+	return predicate.Build(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.Build {
+	return predicate.Build(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.Build {
+	return predicate.Build(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.Build {
+	return predicate.Build(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.Build {
+	return predicate.Build(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.Build {
+	return predicate.Build(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.Build {
+	return predicate.Build(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Build) predicate.Build {
+	return predicate.Build(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Build) predicate.Build {
+	return predicate.Build(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Build) predicate.Build {
+	return predicate.Build(sql.NotPredicates(p))
+}
@@ -0,0 +1,641 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bwl21/zupfmanager/internal/buildreport"
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+	"github.com/bwl21/zupfmanager/internal/ent/project"
+)
+
+// BuildCreate is the builder for creating a Build entity.
+type BuildCreate struct {
+	config
+	mutation *BuildMutation
+	hooks    []Hook
+}
+
+// SetBuildID sets the "build_id" field.
+func (bc *BuildCreate) SetBuildID(s string) *BuildCreate {
+	bc.mutation.SetBuildID(s)
+	return bc
+}
+
+// SetProjectID sets the "project_id" field.
+func (bc *BuildCreate) SetProjectID(i int) *BuildCreate {
+	bc.mutation.SetProjectID(i)
+	return bc
+}
+
+// SetStatus sets the "status" field.
+func (bc *BuildCreate) SetStatus(b build.Status) *BuildCreate {
+	bc.mutation.SetStatus(b)
+	return bc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableStatus(b *build.Status) *BuildCreate {
+	if b != nil {
+		bc.SetStatus(*b)
+	}
+	return bc
+}
+
+// SetOrigin sets the "origin" field.
+func (bc *BuildCreate) SetOrigin(b build.Origin) *BuildCreate {
+	bc.mutation.SetOrigin(b)
+	return bc
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableOrigin(b *build.Origin) *BuildCreate {
+	if b != nil {
+		bc.SetOrigin(*b)
+	}
+	return bc
+}
+
+// SetProgress sets the "progress" field.
+func (bc *BuildCreate) SetProgress(i int) *BuildCreate {
+	bc.mutation.SetProgress(i)
+	return bc
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableProgress(i *int) *BuildCreate {
+	if i != nil {
+		bc.SetProgress(*i)
+	}
+	return bc
+}
+
+// SetMessage sets the "message" field.
+func (bc *BuildCreate) SetMessage(s string) *BuildCreate {
+	bc.mutation.SetMessage(s)
+	return bc
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableMessage(s *string) *BuildCreate {
+	if s != nil {
+		bc.SetMessage(*s)
+	}
+	return bc
+}
+
+// SetOutputDir sets the "output_dir" field.
+func (bc *BuildCreate) SetOutputDir(s string) *BuildCreate {
+	bc.mutation.SetOutputDir(s)
+	return bc
+}
+
+// SetNillableOutputDir sets the "output_dir" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableOutputDir(s *string) *BuildCreate {
+	if s != nil {
+		bc.SetOutputDir(*s)
+	}
+	return bc
+}
+
+// SetBuildDir sets the "build_dir" field.
+func (bc *BuildCreate) SetBuildDir(s string) *BuildCreate {
+	bc.mutation.SetBuildDir(s)
+	return bc
+}
+
+// SetNillableBuildDir sets the "build_dir" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableBuildDir(s *string) *BuildCreate {
+	if s != nil {
+		bc.SetBuildDir(*s)
+	}
+	return bc
+}
+
+// SetAbcFileDir sets the "abc_file_dir" field.
+func (bc *BuildCreate) SetAbcFileDir(s string) *BuildCreate {
+	bc.mutation.SetAbcFileDir(s)
+	return bc
+}
+
+// SetNillableAbcFileDir sets the "abc_file_dir" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableAbcFileDir(s *string) *BuildCreate {
+	if s != nil {
+		bc.SetAbcFileDir(*s)
+	}
+	return bc
+}
+
+// SetPriorityThreshold sets the "priority_threshold" field.
+func (bc *BuildCreate) SetPriorityThreshold(i int) *BuildCreate {
+	bc.mutation.SetPriorityThreshold(i)
+	return bc
+}
+
+// SetNillablePriorityThreshold sets the "priority_threshold" field if the given value is not nil.
+func (bc *BuildCreate) SetNillablePriorityThreshold(i *int) *BuildCreate {
+	if i != nil {
+		bc.SetPriorityThreshold(*i)
+	}
+	return bc
+}
+
+// SetSampleID sets the "sample_id" field.
+func (bc *BuildCreate) SetSampleID(s string) *BuildCreate {
+	bc.mutation.SetSampleID(s)
+	return bc
+}
+
+// SetNillableSampleID sets the "sample_id" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableSampleID(s *string) *BuildCreate {
+	if s != nil {
+		bc.SetSampleID(*s)
+	}
+	return bc
+}
+
+// SetIncremental sets the "incremental" field.
+func (bc *BuildCreate) SetIncremental(b bool) *BuildCreate {
+	bc.mutation.SetIncremental(b)
+	return bc
+}
+
+// SetNillableIncremental sets the "incremental" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableIncremental(b *bool) *BuildCreate {
+	if b != nil {
+		bc.SetIncremental(*b)
+	}
+	return bc
+}
+
+// SetProfile sets the "profile" field.
+func (bc *BuildCreate) SetProfile(s string) *BuildCreate {
+	bc.mutation.SetProfile(s)
+	return bc
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableProfile(s *string) *BuildCreate {
+	if s != nil {
+		bc.SetProfile(*s)
+	}
+	return bc
+}
+
+// SetSongOrder sets the "song_order" field.
+func (bc *BuildCreate) SetSongOrder(s string) *BuildCreate {
+	bc.mutation.SetSongOrder(s)
+	return bc
+}
+
+// SetNillableSongOrder sets the "song_order" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableSongOrder(s *string) *BuildCreate {
+	if s != nil {
+		bc.SetSongOrder(*s)
+	}
+	return bc
+}
+
+// SetSupplementSince sets the "supplement_since" field.
+func (bc *BuildCreate) SetSupplementSince(s string) *BuildCreate {
+	bc.mutation.SetSupplementSince(s)
+	return bc
+}
+
+// SetNillableSupplementSince sets the "supplement_since" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableSupplementSince(s *string) *BuildCreate {
+	if s != nil {
+		bc.SetSupplementSince(*s)
+	}
+	return bc
+}
+
+// SetFinal sets the "final" field.
+func (bc *BuildCreate) SetFinal(b bool) *BuildCreate {
+	bc.mutation.SetFinal(b)
+	return bc
+}
+
+// SetNillableFinal sets the "final" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableFinal(b *bool) *BuildCreate {
+	if b != nil {
+		bc.SetFinal(*b)
+	}
+	return bc
+}
+
+// SetGeneratedFiles sets the "generated_files" field.
+func (bc *BuildCreate) SetGeneratedFiles(s []string) *BuildCreate {
+	bc.mutation.SetGeneratedFiles(s)
+	return bc
+}
+
+// SetReport sets the "report" field.
+func (bc *BuildCreate) SetReport(b *buildreport.Report) *BuildCreate {
+	bc.mutation.SetReport(b)
+	return bc
+}
+
+// SetManifest sets the "manifest" field.
+func (bc *BuildCreate) SetManifest(b *buildreport.Manifest) *BuildCreate {
+	bc.mutation.SetManifest(b)
+	return bc
+}
+
+// SetError sets the "error" field.
+func (bc *BuildCreate) SetError(s string) *BuildCreate {
+	bc.mutation.SetError(s)
+	return bc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableError(s *string) *BuildCreate {
+	if s != nil {
+		bc.SetError(*s)
+	}
+	return bc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (bc *BuildCreate) SetStartedAt(t time.Time) *BuildCreate {
+	bc.mutation.SetStartedAt(t)
+	return bc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableStartedAt(t *time.Time) *BuildCreate {
+	if t != nil {
+		bc.SetStartedAt(*t)
+	}
+	return bc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (bc *BuildCreate) SetCompletedAt(t time.Time) *BuildCreate {
+	bc.mutation.SetCompletedAt(t)
+	return bc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (bc *BuildCreate) SetNillableCompletedAt(t *time.Time) *BuildCreate {
+	if t != nil {
+		bc.SetCompletedAt(*t)
+	}
+	return bc
+}
+
+// SetID sets the "id" field.
+func (bc *BuildCreate) SetID(i int) *BuildCreate {
+	bc.mutation.SetID(i)
+	return bc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (bc *BuildCreate) SetProject(p *Project) *BuildCreate {
+	return bc.SetProjectID(p.ID)
+}
+
+// Mutation returns the BuildMutation object of the builder.
+func (bc *BuildCreate) Mutation() *BuildMutation {
+	return bc.mutation
+}
+
+// Save creates the Build in the database.
+func (bc *BuildCreate) Save(ctx context.Context) (*Build, error) {
+	bc.defaults()
+	return withHooks(ctx, bc.sqlSave, bc.mutation, bc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BuildCreate) SaveX(ctx context.Context) *Build {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BuildCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BuildCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BuildCreate) defaults() {
+	if _, ok := bc.mutation.Status(); !ok {
+		v := build.DefaultStatus
+		bc.mutation.SetStatus(v)
+	}
+	if _, ok := bc.mutation.Origin(); !ok {
+		v := build.DefaultOrigin
+		bc.mutation.SetOrigin(v)
+	}
+	if _, ok := bc.mutation.Progress(); !ok {
+		v := build.DefaultProgress
+		bc.mutation.SetProgress(v)
+	}
+	if _, ok := bc.mutation.Incremental(); !ok {
+		v := build.DefaultIncremental
+		bc.mutation.SetIncremental(v)
+	}
+	if _, ok := bc.mutation.Final(); !ok {
+		v := build.DefaultFinal
+		bc.mutation.SetFinal(v)
+	}
+	if _, ok := bc.mutation.StartedAt(); !ok {
+		v := build.DefaultStartedAt()
+		bc.mutation.SetStartedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BuildCreate) check() error {
+	if _, ok := bc.mutation.BuildID(); !ok {
+		return &ValidationError{Name: "build_id", err: errors.New(`ent: missing required field "Build.build_id"`)}
+	}
+	if v, ok := bc.mutation.BuildID(); ok {
+		if err := build.BuildIDValidator(v); err != nil {
+			return &ValidationError{Name: "build_id", err: fmt.Errorf(`ent: validator failed for field "Build.build_id": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "Build.project_id"`)}
+	}
+	if _, ok := bc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Build.status"`)}
+	}
+	if v, ok := bc.mutation.Status(); ok {
+		if err := build.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Build.status": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Origin(); !ok {
+		return &ValidationError{Name: "origin", err: errors.New(`ent: missing required field "Build.origin"`)}
+	}
+	if v, ok := bc.mutation.Origin(); ok {
+		if err := build.OriginValidator(v); err != nil {
+			return &ValidationError{Name: "origin", err: fmt.Errorf(`ent: validator failed for field "Build.origin": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Progress(); !ok {
+		return &ValidationError{Name: "progress", err: errors.New(`ent: missing required field "Build.progress"`)}
+	}
+	if v, ok := bc.mutation.Progress(); ok {
+		if err := build.ProgressValidator(v); err != nil {
+			return &ValidationError{Name: "progress", err: fmt.Errorf(`ent: validator failed for field "Build.progress": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Incremental(); !ok {
+		return &ValidationError{Name: "incremental", err: errors.New(`ent: missing required field "Build.incremental"`)}
+	}
+	if _, ok := bc.mutation.Final(); !ok {
+		return &ValidationError{Name: "final", err: errors.New(`ent: missing required field "Build.final"`)}
+	}
+	if _, ok := bc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "Build.started_at"`)}
+	}
+	if v, ok := bc.mutation.ID(); ok {
+		if err := build.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Build.id": %w`, err)}
+		}
+	}
+	if len(bc.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "Build.project"`)}
+	}
+	return nil
+}
+
+func (bc *BuildCreate) sqlSave(ctx context.Context) (*Build, error) {
+	if err := bc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	bc.mutation.id = &_node.ID
+	bc.mutation.done = true
+	return _node, nil
+}
+
+func (bc *BuildCreate) createSpec() (*Build, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Build{config: bc.config}
+		_spec = sqlgraph.NewCreateSpec(build.Table, sqlgraph.NewFieldSpec(build.FieldID, field.TypeInt))
+	)
+	if id, ok := bc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bc.mutation.BuildID(); ok {
+		_spec.SetField(build.FieldBuildID, field.TypeString, value)
+		_node.BuildID = value
+	}
+	if value, ok := bc.mutation.Status(); ok {
+		_spec.SetField(build.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := bc.mutation.Origin(); ok {
+		_spec.SetField(build.FieldOrigin, field.TypeEnum, value)
+		_node.Origin = value
+	}
+	if value, ok := bc.mutation.Progress(); ok {
+		_spec.SetField(build.FieldProgress, field.TypeInt, value)
+		_node.Progress = value
+	}
+	if value, ok := bc.mutation.Message(); ok {
+		_spec.SetField(build.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := bc.mutation.OutputDir(); ok {
+		_spec.SetField(build.FieldOutputDir, field.TypeString, value)
+		_node.OutputDir = value
+	}
+	if value, ok := bc.mutation.BuildDir(); ok {
+		_spec.SetField(build.FieldBuildDir, field.TypeString, value)
+		_node.BuildDir = value
+	}
+	if value, ok := bc.mutation.AbcFileDir(); ok {
+		_spec.SetField(build.FieldAbcFileDir, field.TypeString, value)
+		_node.AbcFileDir = value
+	}
+	if value, ok := bc.mutation.PriorityThreshold(); ok {
+		_spec.SetField(build.FieldPriorityThreshold, field.TypeInt, value)
+		_node.PriorityThreshold = value
+	}
+	if value, ok := bc.mutation.SampleID(); ok {
+		_spec.SetField(build.FieldSampleID, field.TypeString, value)
+		_node.SampleID = value
+	}
+	if value, ok := bc.mutation.Incremental(); ok {
+		_spec.SetField(build.FieldIncremental, field.TypeBool, value)
+		_node.Incremental = value
+	}
+	if value, ok := bc.mutation.Profile(); ok {
+		_spec.SetField(build.FieldProfile, field.TypeString, value)
+		_node.Profile = value
+	}
+	if value, ok := bc.mutation.SongOrder(); ok {
+		_spec.SetField(build.FieldSongOrder, field.TypeString, value)
+		_node.SongOrder = value
+	}
+	if value, ok := bc.mutation.SupplementSince(); ok {
+		_spec.SetField(build.FieldSupplementSince, field.TypeString, value)
+		_node.SupplementSince = value
+	}
+	if value, ok := bc.mutation.Final(); ok {
+		_spec.SetField(build.FieldFinal, field.TypeBool, value)
+		_node.Final = value
+	}
+	if value, ok := bc.mutation.GeneratedFiles(); ok {
+		_spec.SetField(build.FieldGeneratedFiles, field.TypeJSON, value)
+		_node.GeneratedFiles = value
+	}
+	if value, ok := bc.mutation.Report(); ok {
+		_spec.SetField(build.FieldReport, field.TypeJSON, value)
+		_node.Report = value
+	}
+	if value, ok := bc.mutation.Manifest(); ok {
+		_spec.SetField(build.FieldManifest, field.TypeJSON, value)
+		_node.Manifest = value
+	}
+	if value, ok := bc.mutation.Error(); ok {
+		_spec.SetField(build.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := bc.mutation.StartedAt(); ok {
+		_spec.SetField(build.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := bc.mutation.CompletedAt(); ok {
+		_spec.SetField(build.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if nodes := bc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   build.ProjectTable,
+			Columns: []string{build.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BuildCreateBulk is the builder for creating many Build entities in bulk.
+type BuildCreateBulk struct {
+	config
+	err      error
+	builders []*BuildCreate
+}
+
+// Save creates the Build entities in the database.
+func (bcb *BuildCreateBulk) Save(ctx context.Context) ([]*Build, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Build, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BuildMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BuildCreateBulk) SaveX(ctx context.Context) []*Build {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BuildCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BuildCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
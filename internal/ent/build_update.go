@@ -0,0 +1,1267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/bwl21/zupfmanager/internal/buildreport"
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+	"github.com/bwl21/zupfmanager/internal/ent/predicate"
+	"github.com/bwl21/zupfmanager/internal/ent/project"
+)
+
+// BuildUpdate is the builder for updating Build entities.
+type BuildUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BuildMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BuildUpdate builder.
+func (bu *BuildUpdate) Where(ps ...predicate.Build) *BuildUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetProjectID sets the "project_id" field.
+func (bu *BuildUpdate) SetProjectID(i int) *BuildUpdate {
+	bu.mutation.SetProjectID(i)
+	return bu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableProjectID(i *int) *BuildUpdate {
+	if i != nil {
+		bu.SetProjectID(*i)
+	}
+	return bu
+}
+
+// SetStatus sets the "status" field.
+func (bu *BuildUpdate) SetStatus(b build.Status) *BuildUpdate {
+	bu.mutation.SetStatus(b)
+	return bu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableStatus(b *build.Status) *BuildUpdate {
+	if b != nil {
+		bu.SetStatus(*b)
+	}
+	return bu
+}
+
+// SetOrigin sets the "origin" field.
+func (bu *BuildUpdate) SetOrigin(b build.Origin) *BuildUpdate {
+	bu.mutation.SetOrigin(b)
+	return bu
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableOrigin(b *build.Origin) *BuildUpdate {
+	if b != nil {
+		bu.SetOrigin(*b)
+	}
+	return bu
+}
+
+// SetProgress sets the "progress" field.
+func (bu *BuildUpdate) SetProgress(i int) *BuildUpdate {
+	bu.mutation.ResetProgress()
+	bu.mutation.SetProgress(i)
+	return bu
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableProgress(i *int) *BuildUpdate {
+	if i != nil {
+		bu.SetProgress(*i)
+	}
+	return bu
+}
+
+// AddProgress adds i to the "progress" field.
+func (bu *BuildUpdate) AddProgress(i int) *BuildUpdate {
+	bu.mutation.AddProgress(i)
+	return bu
+}
+
+// SetMessage sets the "message" field.
+func (bu *BuildUpdate) SetMessage(s string) *BuildUpdate {
+	bu.mutation.SetMessage(s)
+	return bu
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableMessage(s *string) *BuildUpdate {
+	if s != nil {
+		bu.SetMessage(*s)
+	}
+	return bu
+}
+
+// ClearMessage clears the value of the "message" field.
+func (bu *BuildUpdate) ClearMessage() *BuildUpdate {
+	bu.mutation.ClearMessage()
+	return bu
+}
+
+// SetOutputDir sets the "output_dir" field.
+func (bu *BuildUpdate) SetOutputDir(s string) *BuildUpdate {
+	bu.mutation.SetOutputDir(s)
+	return bu
+}
+
+// SetNillableOutputDir sets the "output_dir" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableOutputDir(s *string) *BuildUpdate {
+	if s != nil {
+		bu.SetOutputDir(*s)
+	}
+	return bu
+}
+
+// ClearOutputDir clears the value of the "output_dir" field.
+func (bu *BuildUpdate) ClearOutputDir() *BuildUpdate {
+	bu.mutation.ClearOutputDir()
+	return bu
+}
+
+// SetBuildDir sets the "build_dir" field.
+func (bu *BuildUpdate) SetBuildDir(s string) *BuildUpdate {
+	bu.mutation.SetBuildDir(s)
+	return bu
+}
+
+// SetNillableBuildDir sets the "build_dir" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableBuildDir(s *string) *BuildUpdate {
+	if s != nil {
+		bu.SetBuildDir(*s)
+	}
+	return bu
+}
+
+// ClearBuildDir clears the value of the "build_dir" field.
+func (bu *BuildUpdate) ClearBuildDir() *BuildUpdate {
+	bu.mutation.ClearBuildDir()
+	return bu
+}
+
+// SetAbcFileDir sets the "abc_file_dir" field.
+func (bu *BuildUpdate) SetAbcFileDir(s string) *BuildUpdate {
+	bu.mutation.SetAbcFileDir(s)
+	return bu
+}
+
+// SetNillableAbcFileDir sets the "abc_file_dir" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableAbcFileDir(s *string) *BuildUpdate {
+	if s != nil {
+		bu.SetAbcFileDir(*s)
+	}
+	return bu
+}
+
+// ClearAbcFileDir clears the value of the "abc_file_dir" field.
+func (bu *BuildUpdate) ClearAbcFileDir() *BuildUpdate {
+	bu.mutation.ClearAbcFileDir()
+	return bu
+}
+
+// SetPriorityThreshold sets the "priority_threshold" field.
+func (bu *BuildUpdate) SetPriorityThreshold(i int) *BuildUpdate {
+	bu.mutation.ResetPriorityThreshold()
+	bu.mutation.SetPriorityThreshold(i)
+	return bu
+}
+
+// SetNillablePriorityThreshold sets the "priority_threshold" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillablePriorityThreshold(i *int) *BuildUpdate {
+	if i != nil {
+		bu.SetPriorityThreshold(*i)
+	}
+	return bu
+}
+
+// AddPriorityThreshold adds i to the "priority_threshold" field.
+func (bu *BuildUpdate) AddPriorityThreshold(i int) *BuildUpdate {
+	bu.mutation.AddPriorityThreshold(i)
+	return bu
+}
+
+// ClearPriorityThreshold clears the value of the "priority_threshold" field.
+func (bu *BuildUpdate) ClearPriorityThreshold() *BuildUpdate {
+	bu.mutation.ClearPriorityThreshold()
+	return bu
+}
+
+// SetSampleID sets the "sample_id" field.
+func (bu *BuildUpdate) SetSampleID(s string) *BuildUpdate {
+	bu.mutation.SetSampleID(s)
+	return bu
+}
+
+// SetNillableSampleID sets the "sample_id" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableSampleID(s *string) *BuildUpdate {
+	if s != nil {
+		bu.SetSampleID(*s)
+	}
+	return bu
+}
+
+// ClearSampleID clears the value of the "sample_id" field.
+func (bu *BuildUpdate) ClearSampleID() *BuildUpdate {
+	bu.mutation.ClearSampleID()
+	return bu
+}
+
+// SetIncremental sets the "incremental" field.
+func (bu *BuildUpdate) SetIncremental(b bool) *BuildUpdate {
+	bu.mutation.SetIncremental(b)
+	return bu
+}
+
+// SetNillableIncremental sets the "incremental" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableIncremental(b *bool) *BuildUpdate {
+	if b != nil {
+		bu.SetIncremental(*b)
+	}
+	return bu
+}
+
+// SetProfile sets the "profile" field.
+func (bu *BuildUpdate) SetProfile(s string) *BuildUpdate {
+	bu.mutation.SetProfile(s)
+	return bu
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableProfile(s *string) *BuildUpdate {
+	if s != nil {
+		bu.SetProfile(*s)
+	}
+	return bu
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (bu *BuildUpdate) ClearProfile() *BuildUpdate {
+	bu.mutation.ClearProfile()
+	return bu
+}
+
+// SetSongOrder sets the "song_order" field.
+func (bu *BuildUpdate) SetSongOrder(s string) *BuildUpdate {
+	bu.mutation.SetSongOrder(s)
+	return bu
+}
+
+// SetNillableSongOrder sets the "song_order" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableSongOrder(s *string) *BuildUpdate {
+	if s != nil {
+		bu.SetSongOrder(*s)
+	}
+	return bu
+}
+
+// ClearSongOrder clears the value of the "song_order" field.
+func (bu *BuildUpdate) ClearSongOrder() *BuildUpdate {
+	bu.mutation.ClearSongOrder()
+	return bu
+}
+
+// SetSupplementSince sets the "supplement_since" field.
+func (bu *BuildUpdate) SetSupplementSince(s string) *BuildUpdate {
+	bu.mutation.SetSupplementSince(s)
+	return bu
+}
+
+// SetNillableSupplementSince sets the "supplement_since" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableSupplementSince(s *string) *BuildUpdate {
+	if s != nil {
+		bu.SetSupplementSince(*s)
+	}
+	return bu
+}
+
+// ClearSupplementSince clears the value of the "supplement_since" field.
+func (bu *BuildUpdate) ClearSupplementSince() *BuildUpdate {
+	bu.mutation.ClearSupplementSince()
+	return bu
+}
+
+// SetFinal sets the "final" field.
+func (bu *BuildUpdate) SetFinal(b bool) *BuildUpdate {
+	bu.mutation.SetFinal(b)
+	return bu
+}
+
+// SetNillableFinal sets the "final" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableFinal(b *bool) *BuildUpdate {
+	if b != nil {
+		bu.SetFinal(*b)
+	}
+	return bu
+}
+
+// SetGeneratedFiles sets the "generated_files" field.
+func (bu *BuildUpdate) SetGeneratedFiles(s []string) *BuildUpdate {
+	bu.mutation.SetGeneratedFiles(s)
+	return bu
+}
+
+// AppendGeneratedFiles appends s to the "generated_files" field.
+func (bu *BuildUpdate) AppendGeneratedFiles(s []string) *BuildUpdate {
+	bu.mutation.AppendGeneratedFiles(s)
+	return bu
+}
+
+// ClearGeneratedFiles clears the value of the "generated_files" field.
+func (bu *BuildUpdate) ClearGeneratedFiles() *BuildUpdate {
+	bu.mutation.ClearGeneratedFiles()
+	return bu
+}
+
+// SetReport sets the "report" field.
+func (bu *BuildUpdate) SetReport(b *buildreport.Report) *BuildUpdate {
+	bu.mutation.SetReport(b)
+	return bu
+}
+
+// ClearReport clears the value of the "report" field.
+func (bu *BuildUpdate) ClearReport() *BuildUpdate {
+	bu.mutation.ClearReport()
+	return bu
+}
+
+// SetManifest sets the "manifest" field.
+func (bu *BuildUpdate) SetManifest(b *buildreport.Manifest) *BuildUpdate {
+	bu.mutation.SetManifest(b)
+	return bu
+}
+
+// ClearManifest clears the value of the "manifest" field.
+func (bu *BuildUpdate) ClearManifest() *BuildUpdate {
+	bu.mutation.ClearManifest()
+	return bu
+}
+
+// SetError sets the "error" field.
+func (bu *BuildUpdate) SetError(s string) *BuildUpdate {
+	bu.mutation.SetError(s)
+	return bu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableError(s *string) *BuildUpdate {
+	if s != nil {
+		bu.SetError(*s)
+	}
+	return bu
+}
+
+// ClearError clears the value of the "error" field.
+func (bu *BuildUpdate) ClearError() *BuildUpdate {
+	bu.mutation.ClearError()
+	return bu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (bu *BuildUpdate) SetCompletedAt(t time.Time) *BuildUpdate {
+	bu.mutation.SetCompletedAt(t)
+	return bu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (bu *BuildUpdate) SetNillableCompletedAt(t *time.Time) *BuildUpdate {
+	if t != nil {
+		bu.SetCompletedAt(*t)
+	}
+	return bu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (bu *BuildUpdate) ClearCompletedAt() *BuildUpdate {
+	bu.mutation.ClearCompletedAt()
+	return bu
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (bu *BuildUpdate) SetProject(p *Project) *BuildUpdate {
+	return bu.SetProjectID(p.ID)
+}
+
+// Mutation returns the BuildMutation object of the builder.
+func (bu *BuildUpdate) Mutation() *BuildMutation {
+	return bu.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (bu *BuildUpdate) ClearProject() *BuildUpdate {
+	bu.mutation.ClearProject()
+	return bu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BuildUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BuildUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BuildUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BuildUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BuildUpdate) check() error {
+	if v, ok := bu.mutation.Status(); ok {
+		if err := build.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Build.status": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.Origin(); ok {
+		if err := build.OriginValidator(v); err != nil {
+			return &ValidationError{Name: "origin", err: fmt.Errorf(`ent: validator failed for field "Build.origin": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.Progress(); ok {
+		if err := build.ProgressValidator(v); err != nil {
+			return &ValidationError{Name: "progress", err: fmt.Errorf(`ent: validator failed for field "Build.progress": %w`, err)}
+		}
+	}
+	if bu.mutation.ProjectCleared() && len(bu.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Build.project"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bu *BuildUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BuildUpdate {
+	bu.modifiers = append(bu.modifiers, modifiers...)
+	return bu
+}
+
+func (bu *BuildUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(build.Table, build.Columns, sqlgraph.NewFieldSpec(build.FieldID, field.TypeInt))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Status(); ok {
+		_spec.SetField(build.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := bu.mutation.Origin(); ok {
+		_spec.SetField(build.FieldOrigin, field.TypeEnum, value)
+	}
+	if value, ok := bu.mutation.Progress(); ok {
+		_spec.SetField(build.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.AddedProgress(); ok {
+		_spec.AddField(build.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.Message(); ok {
+		_spec.SetField(build.FieldMessage, field.TypeString, value)
+	}
+	if bu.mutation.MessageCleared() {
+		_spec.ClearField(build.FieldMessage, field.TypeString)
+	}
+	if value, ok := bu.mutation.OutputDir(); ok {
+		_spec.SetField(build.FieldOutputDir, field.TypeString, value)
+	}
+	if bu.mutation.OutputDirCleared() {
+		_spec.ClearField(build.FieldOutputDir, field.TypeString)
+	}
+	if value, ok := bu.mutation.BuildDir(); ok {
+		_spec.SetField(build.FieldBuildDir, field.TypeString, value)
+	}
+	if bu.mutation.BuildDirCleared() {
+		_spec.ClearField(build.FieldBuildDir, field.TypeString)
+	}
+	if value, ok := bu.mutation.AbcFileDir(); ok {
+		_spec.SetField(build.FieldAbcFileDir, field.TypeString, value)
+	}
+	if bu.mutation.AbcFileDirCleared() {
+		_spec.ClearField(build.FieldAbcFileDir, field.TypeString)
+	}
+	if value, ok := bu.mutation.PriorityThreshold(); ok {
+		_spec.SetField(build.FieldPriorityThreshold, field.TypeInt, value)
+	}
+	if value, ok := bu.mutation.AddedPriorityThreshold(); ok {
+		_spec.AddField(build.FieldPriorityThreshold, field.TypeInt, value)
+	}
+	if bu.mutation.PriorityThresholdCleared() {
+		_spec.ClearField(build.FieldPriorityThreshold, field.TypeInt)
+	}
+	if value, ok := bu.mutation.SampleID(); ok {
+		_spec.SetField(build.FieldSampleID, field.TypeString, value)
+	}
+	if bu.mutation.SampleIDCleared() {
+		_spec.ClearField(build.FieldSampleID, field.TypeString)
+	}
+	if value, ok := bu.mutation.Incremental(); ok {
+		_spec.SetField(build.FieldIncremental, field.TypeBool, value)
+	}
+	if value, ok := bu.mutation.Profile(); ok {
+		_spec.SetField(build.FieldProfile, field.TypeString, value)
+	}
+	if bu.mutation.ProfileCleared() {
+		_spec.ClearField(build.FieldProfile, field.TypeString)
+	}
+	if value, ok := bu.mutation.SongOrder(); ok {
+		_spec.SetField(build.FieldSongOrder, field.TypeString, value)
+	}
+	if bu.mutation.SongOrderCleared() {
+		_spec.ClearField(build.FieldSongOrder, field.TypeString)
+	}
+	if value, ok := bu.mutation.SupplementSince(); ok {
+		_spec.SetField(build.FieldSupplementSince, field.TypeString, value)
+	}
+	if bu.mutation.SupplementSinceCleared() {
+		_spec.ClearField(build.FieldSupplementSince, field.TypeString)
+	}
+	if value, ok := bu.mutation.Final(); ok {
+		_spec.SetField(build.FieldFinal, field.TypeBool, value)
+	}
+	if value, ok := bu.mutation.GeneratedFiles(); ok {
+		_spec.SetField(build.FieldGeneratedFiles, field.TypeJSON, value)
+	}
+	if value, ok := bu.mutation.AppendedGeneratedFiles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, build.FieldGeneratedFiles, value)
+		})
+	}
+	if bu.mutation.GeneratedFilesCleared() {
+		_spec.ClearField(build.FieldGeneratedFiles, field.TypeJSON)
+	}
+	if value, ok := bu.mutation.Report(); ok {
+		_spec.SetField(build.FieldReport, field.TypeJSON, value)
+	}
+	if bu.mutation.ReportCleared() {
+		_spec.ClearField(build.FieldReport, field.TypeJSON)
+	}
+	if value, ok := bu.mutation.Manifest(); ok {
+		_spec.SetField(build.FieldManifest, field.TypeJSON, value)
+	}
+	if bu.mutation.ManifestCleared() {
+		_spec.ClearField(build.FieldManifest, field.TypeJSON)
+	}
+	if value, ok := bu.mutation.Error(); ok {
+		_spec.SetField(build.FieldError, field.TypeString, value)
+	}
+	if bu.mutation.ErrorCleared() {
+		_spec.ClearField(build.FieldError, field.TypeString)
+	}
+	if value, ok := bu.mutation.CompletedAt(); ok {
+		_spec.SetField(build.FieldCompletedAt, field.TypeTime, value)
+	}
+	if bu.mutation.CompletedAtCleared() {
+		_spec.ClearField(build.FieldCompletedAt, field.TypeTime)
+	}
+	if bu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   build.ProjectTable,
+			Columns: []string{build.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   build.ProjectTable,
+			Columns: []string{build.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(bu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{build.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BuildUpdateOne is the builder for updating a single Build entity.
+type BuildUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BuildMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetProjectID sets the "project_id" field.
+func (buo *BuildUpdateOne) SetProjectID(i int) *BuildUpdateOne {
+	buo.mutation.SetProjectID(i)
+	return buo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableProjectID(i *int) *BuildUpdateOne {
+	if i != nil {
+		buo.SetProjectID(*i)
+	}
+	return buo
+}
+
+// SetStatus sets the "status" field.
+func (buo *BuildUpdateOne) SetStatus(b build.Status) *BuildUpdateOne {
+	buo.mutation.SetStatus(b)
+	return buo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableStatus(b *build.Status) *BuildUpdateOne {
+	if b != nil {
+		buo.SetStatus(*b)
+	}
+	return buo
+}
+
+// SetOrigin sets the "origin" field.
+func (buo *BuildUpdateOne) SetOrigin(b build.Origin) *BuildUpdateOne {
+	buo.mutation.SetOrigin(b)
+	return buo
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableOrigin(b *build.Origin) *BuildUpdateOne {
+	if b != nil {
+		buo.SetOrigin(*b)
+	}
+	return buo
+}
+
+// SetProgress sets the "progress" field.
+func (buo *BuildUpdateOne) SetProgress(i int) *BuildUpdateOne {
+	buo.mutation.ResetProgress()
+	buo.mutation.SetProgress(i)
+	return buo
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableProgress(i *int) *BuildUpdateOne {
+	if i != nil {
+		buo.SetProgress(*i)
+	}
+	return buo
+}
+
+// AddProgress adds i to the "progress" field.
+func (buo *BuildUpdateOne) AddProgress(i int) *BuildUpdateOne {
+	buo.mutation.AddProgress(i)
+	return buo
+}
+
+// SetMessage sets the "message" field.
+func (buo *BuildUpdateOne) SetMessage(s string) *BuildUpdateOne {
+	buo.mutation.SetMessage(s)
+	return buo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableMessage(s *string) *BuildUpdateOne {
+	if s != nil {
+		buo.SetMessage(*s)
+	}
+	return buo
+}
+
+// ClearMessage clears the value of the "message" field.
+func (buo *BuildUpdateOne) ClearMessage() *BuildUpdateOne {
+	buo.mutation.ClearMessage()
+	return buo
+}
+
+// SetOutputDir sets the "output_dir" field.
+func (buo *BuildUpdateOne) SetOutputDir(s string) *BuildUpdateOne {
+	buo.mutation.SetOutputDir(s)
+	return buo
+}
+
+// SetNillableOutputDir sets the "output_dir" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableOutputDir(s *string) *BuildUpdateOne {
+	if s != nil {
+		buo.SetOutputDir(*s)
+	}
+	return buo
+}
+
+// ClearOutputDir clears the value of the "output_dir" field.
+func (buo *BuildUpdateOne) ClearOutputDir() *BuildUpdateOne {
+	buo.mutation.ClearOutputDir()
+	return buo
+}
+
+// SetBuildDir sets the "build_dir" field.
+func (buo *BuildUpdateOne) SetBuildDir(s string) *BuildUpdateOne {
+	buo.mutation.SetBuildDir(s)
+	return buo
+}
+
+// SetNillableBuildDir sets the "build_dir" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableBuildDir(s *string) *BuildUpdateOne {
+	if s != nil {
+		buo.SetBuildDir(*s)
+	}
+	return buo
+}
+
+// ClearBuildDir clears the value of the "build_dir" field.
+func (buo *BuildUpdateOne) ClearBuildDir() *BuildUpdateOne {
+	buo.mutation.ClearBuildDir()
+	return buo
+}
+
+// SetAbcFileDir sets the "abc_file_dir" field.
+func (buo *BuildUpdateOne) SetAbcFileDir(s string) *BuildUpdateOne {
+	buo.mutation.SetAbcFileDir(s)
+	return buo
+}
+
+// SetNillableAbcFileDir sets the "abc_file_dir" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableAbcFileDir(s *string) *BuildUpdateOne {
+	if s != nil {
+		buo.SetAbcFileDir(*s)
+	}
+	return buo
+}
+
+// ClearAbcFileDir clears the value of the "abc_file_dir" field.
+func (buo *BuildUpdateOne) ClearAbcFileDir() *BuildUpdateOne {
+	buo.mutation.ClearAbcFileDir()
+	return buo
+}
+
+// SetPriorityThreshold sets the "priority_threshold" field.
+func (buo *BuildUpdateOne) SetPriorityThreshold(i int) *BuildUpdateOne {
+	buo.mutation.ResetPriorityThreshold()
+	buo.mutation.SetPriorityThreshold(i)
+	return buo
+}
+
+// SetNillablePriorityThreshold sets the "priority_threshold" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillablePriorityThreshold(i *int) *BuildUpdateOne {
+	if i != nil {
+		buo.SetPriorityThreshold(*i)
+	}
+	return buo
+}
+
+// AddPriorityThreshold adds i to the "priority_threshold" field.
+func (buo *BuildUpdateOne) AddPriorityThreshold(i int) *BuildUpdateOne {
+	buo.mutation.AddPriorityThreshold(i)
+	return buo
+}
+
+// ClearPriorityThreshold clears the value of the "priority_threshold" field.
+func (buo *BuildUpdateOne) ClearPriorityThreshold() *BuildUpdateOne {
+	buo.mutation.ClearPriorityThreshold()
+	return buo
+}
+
+// SetSampleID sets the "sample_id" field.
+func (buo *BuildUpdateOne) SetSampleID(s string) *BuildUpdateOne {
+	buo.mutation.SetSampleID(s)
+	return buo
+}
+
+// SetNillableSampleID sets the "sample_id" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableSampleID(s *string) *BuildUpdateOne {
+	if s != nil {
+		buo.SetSampleID(*s)
+	}
+	return buo
+}
+
+// ClearSampleID clears the value of the "sample_id" field.
+func (buo *BuildUpdateOne) ClearSampleID() *BuildUpdateOne {
+	buo.mutation.ClearSampleID()
+	return buo
+}
+
+// SetIncremental sets the "incremental" field.
+func (buo *BuildUpdateOne) SetIncremental(b bool) *BuildUpdateOne {
+	buo.mutation.SetIncremental(b)
+	return buo
+}
+
+// SetNillableIncremental sets the "incremental" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableIncremental(b *bool) *BuildUpdateOne {
+	if b != nil {
+		buo.SetIncremental(*b)
+	}
+	return buo
+}
+
+// SetProfile sets the "profile" field.
+func (buo *BuildUpdateOne) SetProfile(s string) *BuildUpdateOne {
+	buo.mutation.SetProfile(s)
+	return buo
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableProfile(s *string) *BuildUpdateOne {
+	if s != nil {
+		buo.SetProfile(*s)
+	}
+	return buo
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (buo *BuildUpdateOne) ClearProfile() *BuildUpdateOne {
+	buo.mutation.ClearProfile()
+	return buo
+}
+
+// SetSongOrder sets the "song_order" field.
+func (buo *BuildUpdateOne) SetSongOrder(s string) *BuildUpdateOne {
+	buo.mutation.SetSongOrder(s)
+	return buo
+}
+
+// SetNillableSongOrder sets the "song_order" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableSongOrder(s *string) *BuildUpdateOne {
+	if s != nil {
+		buo.SetSongOrder(*s)
+	}
+	return buo
+}
+
+// ClearSongOrder clears the value of the "song_order" field.
+func (buo *BuildUpdateOne) ClearSongOrder() *BuildUpdateOne {
+	buo.mutation.ClearSongOrder()
+	return buo
+}
+
+// SetSupplementSince sets the "supplement_since" field.
+func (buo *BuildUpdateOne) SetSupplementSince(s string) *BuildUpdateOne {
+	buo.mutation.SetSupplementSince(s)
+	return buo
+}
+
+// SetNillableSupplementSince sets the "supplement_since" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableSupplementSince(s *string) *BuildUpdateOne {
+	if s != nil {
+		buo.SetSupplementSince(*s)
+	}
+	return buo
+}
+
+// ClearSupplementSince clears the value of the "supplement_since" field.
+func (buo *BuildUpdateOne) ClearSupplementSince() *BuildUpdateOne {
+	buo.mutation.ClearSupplementSince()
+	return buo
+}
+
+// SetFinal sets the "final" field.
+func (buo *BuildUpdateOne) SetFinal(b bool) *BuildUpdateOne {
+	buo.mutation.SetFinal(b)
+	return buo
+}
+
+// SetNillableFinal sets the "final" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableFinal(b *bool) *BuildUpdateOne {
+	if b != nil {
+		buo.SetFinal(*b)
+	}
+	return buo
+}
+
+// SetGeneratedFiles sets the "generated_files" field.
+func (buo *BuildUpdateOne) SetGeneratedFiles(s []string) *BuildUpdateOne {
+	buo.mutation.SetGeneratedFiles(s)
+	return buo
+}
+
+// AppendGeneratedFiles appends s to the "generated_files" field.
+func (buo *BuildUpdateOne) AppendGeneratedFiles(s []string) *BuildUpdateOne {
+	buo.mutation.AppendGeneratedFiles(s)
+	return buo
+}
+
+// ClearGeneratedFiles clears the value of the "generated_files" field.
+func (buo *BuildUpdateOne) ClearGeneratedFiles() *BuildUpdateOne {
+	buo.mutation.ClearGeneratedFiles()
+	return buo
+}
+
+// SetReport sets the "report" field.
+func (buo *BuildUpdateOne) SetReport(b *buildreport.Report) *BuildUpdateOne {
+	buo.mutation.SetReport(b)
+	return buo
+}
+
+// ClearReport clears the value of the "report" field.
+func (buo *BuildUpdateOne) ClearReport() *BuildUpdateOne {
+	buo.mutation.ClearReport()
+	return buo
+}
+
+// SetManifest sets the "manifest" field.
+func (buo *BuildUpdateOne) SetManifest(b *buildreport.Manifest) *BuildUpdateOne {
+	buo.mutation.SetManifest(b)
+	return buo
+}
+
+// ClearManifest clears the value of the "manifest" field.
+func (buo *BuildUpdateOne) ClearManifest() *BuildUpdateOne {
+	buo.mutation.ClearManifest()
+	return buo
+}
+
+// SetError sets the "error" field.
+func (buo *BuildUpdateOne) SetError(s string) *BuildUpdateOne {
+	buo.mutation.SetError(s)
+	return buo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableError(s *string) *BuildUpdateOne {
+	if s != nil {
+		buo.SetError(*s)
+	}
+	return buo
+}
+
+// ClearError clears the value of the "error" field.
+func (buo *BuildUpdateOne) ClearError() *BuildUpdateOne {
+	buo.mutation.ClearError()
+	return buo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (buo *BuildUpdateOne) SetCompletedAt(t time.Time) *BuildUpdateOne {
+	buo.mutation.SetCompletedAt(t)
+	return buo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (buo *BuildUpdateOne) SetNillableCompletedAt(t *time.Time) *BuildUpdateOne {
+	if t != nil {
+		buo.SetCompletedAt(*t)
+	}
+	return buo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (buo *BuildUpdateOne) ClearCompletedAt() *BuildUpdateOne {
+	buo.mutation.ClearCompletedAt()
+	return buo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (buo *BuildUpdateOne) SetProject(p *Project) *BuildUpdateOne {
+	return buo.SetProjectID(p.ID)
+}
+
+// Mutation returns the BuildMutation object of the builder.
+func (buo *BuildUpdateOne) Mutation() *BuildMutation {
+	return buo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (buo *BuildUpdateOne) ClearProject() *BuildUpdateOne {
+	buo.mutation.ClearProject()
+	return buo
+}
+
+// Where appends a list predicates to the BuildUpdate builder.
+func (buo *BuildUpdateOne) Where(ps ...predicate.Build) *BuildUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BuildUpdateOne) Select(field string, fields ...string) *BuildUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Build entity.
+func (buo *BuildUpdateOne) Save(ctx context.Context) (*Build, error) {
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BuildUpdateOne) SaveX(ctx context.Context) *Build {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BuildUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BuildUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BuildUpdateOne) check() error {
+	if v, ok := buo.mutation.Status(); ok {
+		if err := build.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Build.status": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.Origin(); ok {
+		if err := build.OriginValidator(v); err != nil {
+			return &ValidationError{Name: "origin", err: fmt.Errorf(`ent: validator failed for field "Build.origin": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.Progress(); ok {
+		if err := build.ProgressValidator(v); err != nil {
+			return &ValidationError{Name: "progress", err: fmt.Errorf(`ent: validator failed for field "Build.progress": %w`, err)}
+		}
+	}
+	if buo.mutation.ProjectCleared() && len(buo.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Build.project"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (buo *BuildUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BuildUpdateOne {
+	buo.modifiers = append(buo.modifiers, modifiers...)
+	return buo
+}
+
+func (buo *BuildUpdateOne) sqlSave(ctx context.Context) (_node *Build, err error) {
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(build.Table, build.Columns, sqlgraph.NewFieldSpec(build.FieldID, field.TypeInt))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Build.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, build.FieldID)
+		for _, f := range fields {
+			if !build.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != build.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Status(); ok {
+		_spec.SetField(build.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := buo.mutation.Origin(); ok {
+		_spec.SetField(build.FieldOrigin, field.TypeEnum, value)
+	}
+	if value, ok := buo.mutation.Progress(); ok {
+		_spec.SetField(build.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.AddedProgress(); ok {
+		_spec.AddField(build.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.Message(); ok {
+		_spec.SetField(build.FieldMessage, field.TypeString, value)
+	}
+	if buo.mutation.MessageCleared() {
+		_spec.ClearField(build.FieldMessage, field.TypeString)
+	}
+	if value, ok := buo.mutation.OutputDir(); ok {
+		_spec.SetField(build.FieldOutputDir, field.TypeString, value)
+	}
+	if buo.mutation.OutputDirCleared() {
+		_spec.ClearField(build.FieldOutputDir, field.TypeString)
+	}
+	if value, ok := buo.mutation.BuildDir(); ok {
+		_spec.SetField(build.FieldBuildDir, field.TypeString, value)
+	}
+	if buo.mutation.BuildDirCleared() {
+		_spec.ClearField(build.FieldBuildDir, field.TypeString)
+	}
+	if value, ok := buo.mutation.AbcFileDir(); ok {
+		_spec.SetField(build.FieldAbcFileDir, field.TypeString, value)
+	}
+	if buo.mutation.AbcFileDirCleared() {
+		_spec.ClearField(build.FieldAbcFileDir, field.TypeString)
+	}
+	if value, ok := buo.mutation.PriorityThreshold(); ok {
+		_spec.SetField(build.FieldPriorityThreshold, field.TypeInt, value)
+	}
+	if value, ok := buo.mutation.AddedPriorityThreshold(); ok {
+		_spec.AddField(build.FieldPriorityThreshold, field.TypeInt, value)
+	}
+	if buo.mutation.PriorityThresholdCleared() {
+		_spec.ClearField(build.FieldPriorityThreshold, field.TypeInt)
+	}
+	if value, ok := buo.mutation.SampleID(); ok {
+		_spec.SetField(build.FieldSampleID, field.TypeString, value)
+	}
+	if buo.mutation.SampleIDCleared() {
+		_spec.ClearField(build.FieldSampleID, field.TypeString)
+	}
+	if value, ok := buo.mutation.Incremental(); ok {
+		_spec.SetField(build.FieldIncremental, field.TypeBool, value)
+	}
+	if value, ok := buo.mutation.Profile(); ok {
+		_spec.SetField(build.FieldProfile, field.TypeString, value)
+	}
+	if buo.mutation.ProfileCleared() {
+		_spec.ClearField(build.FieldProfile, field.TypeString)
+	}
+	if value, ok := buo.mutation.SongOrder(); ok {
+		_spec.SetField(build.FieldSongOrder, field.TypeString, value)
+	}
+	if buo.mutation.SongOrderCleared() {
+		_spec.ClearField(build.FieldSongOrder, field.TypeString)
+	}
+	if value, ok := buo.mutation.SupplementSince(); ok {
+		_spec.SetField(build.FieldSupplementSince, field.TypeString, value)
+	}
+	if buo.mutation.SupplementSinceCleared() {
+		_spec.ClearField(build.FieldSupplementSince, field.TypeString)
+	}
+	if value, ok := buo.mutation.Final(); ok {
+		_spec.SetField(build.FieldFinal, field.TypeBool, value)
+	}
+	if value, ok := buo.mutation.GeneratedFiles(); ok {
+		_spec.SetField(build.FieldGeneratedFiles, field.TypeJSON, value)
+	}
+	if value, ok := buo.mutation.AppendedGeneratedFiles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, build.FieldGeneratedFiles, value)
+		})
+	}
+	if buo.mutation.GeneratedFilesCleared() {
+		_spec.ClearField(build.FieldGeneratedFiles, field.TypeJSON)
+	}
+	if value, ok := buo.mutation.Report(); ok {
+		_spec.SetField(build.FieldReport, field.TypeJSON, value)
+	}
+	if buo.mutation.ReportCleared() {
+		_spec.ClearField(build.FieldReport, field.TypeJSON)
+	}
+	if value, ok := buo.mutation.Manifest(); ok {
+		_spec.SetField(build.FieldManifest, field.TypeJSON, value)
+	}
+	if buo.mutation.ManifestCleared() {
+		_spec.ClearField(build.FieldManifest, field.TypeJSON)
+	}
+	if value, ok := buo.mutation.Error(); ok {
+		_spec.SetField(build.FieldError, field.TypeString, value)
+	}
+	if buo.mutation.ErrorCleared() {
+		_spec.ClearField(build.FieldError, field.TypeString)
+	}
+	if value, ok := buo.mutation.CompletedAt(); ok {
+		_spec.SetField(build.FieldCompletedAt, field.TypeTime, value)
+	}
+	if buo.mutation.CompletedAtCleared() {
+		_spec.ClearField(build.FieldCompletedAt, field.TypeTime)
+	}
+	if buo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   build.ProjectTable,
+			Columns: []string{build.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   build.ProjectTable,
+			Columns: []string{build.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(buo.modifiers...)
+	_node = &Build{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{build.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
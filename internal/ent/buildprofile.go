@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/bwl21/zupfmanager/internal/ent/buildprofile"
+	"github.com/bwl21/zupfmanager/internal/ent/project"
+)
+
+// BuildProfile is the model entity for the BuildProfile schema.
+type BuildProfile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int `json:"project_id,omitempty"`
+	// Name of the profile, e.g. Probedruck, Teilnehmer or Referent
+	Name string `json:"name,omitempty"`
+	// OutputDir holds the value of the "output_dir" field.
+	OutputDir string `json:"output_dir,omitempty"`
+	// AbcFileDir holds the value of the "abc_file_dir" field.
+	AbcFileDir string `json:"abc_file_dir,omitempty"`
+	// 0 if the profile does not set a threshold
+	PriorityThreshold int `json:"priority_threshold,omitempty"`
+	// SampleID holds the value of the "sample_id" field.
+	SampleID string `json:"sample_id,omitempty"`
+	// Merged over the project config for builds with this profile
+	Config map[string]interface{} `json:"config,omitempty"`
+	// Used for builds that do not name a profile
+	IsDefault bool `json:"is_default,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BuildProfileQuery when eager-loading is set.
+	Edges        BuildProfileEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BuildProfileEdges holds the relations/edges for other nodes in the graph.
+type BuildProfileEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BuildProfileEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BuildProfile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case buildprofile.FieldConfig:
+			values[i] = new([]byte)
+		case buildprofile.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case buildprofile.FieldID, buildprofile.FieldProjectID, buildprofile.FieldPriorityThreshold:
+			values[i] = new(sql.NullInt64)
+		case buildprofile.FieldName, buildprofile.FieldOutputDir, buildprofile.FieldAbcFileDir, buildprofile.FieldSampleID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BuildProfile fields.
+func (bp *BuildProfile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case buildprofile.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bp.ID = int(value.Int64)
+		case buildprofile.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				bp.ProjectID = int(value.Int64)
+			}
+		case buildprofile.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				bp.Name = value.String
+			}
+		case buildprofile.FieldOutputDir:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field output_dir", values[i])
+			} else if value.Valid {
+				bp.OutputDir = value.String
+			}
+		case buildprofile.FieldAbcFileDir:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field abc_file_dir", values[i])
+			} else if value.Valid {
+				bp.AbcFileDir = value.String
+			}
+		case buildprofile.FieldPriorityThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority_threshold", values[i])
+			} else if value.Valid {
+				bp.PriorityThreshold = int(value.Int64)
+			}
+		case buildprofile.FieldSampleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sample_id", values[i])
+			} else if value.Valid {
+				bp.SampleID = value.String
+			}
+		case buildprofile.FieldConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field config", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bp.Config); err != nil {
+					return fmt.Errorf("unmarshal field config: %w", err)
+				}
+			}
+		case buildprofile.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				bp.IsDefault = value.Bool
+			}
+		default:
+			bp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BuildProfile.
+// This includes values selected through modifiers, order, etc.
+func (bp *BuildProfile) Value(name string) (ent.Value, error) {
+	return bp.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the BuildProfile entity.
+func (bp *BuildProfile) QueryProject() *ProjectQuery {
+	return NewBuildProfileClient(bp.config).QueryProject(bp)
+}
+
+// Update returns a builder for updating this BuildProfile.
+// Note that you need to call BuildProfile.Unwrap() before calling this method if this BuildProfile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bp *BuildProfile) Update() *BuildProfileUpdateOne {
+	return NewBuildProfileClient(bp.config).UpdateOne(bp)
+}
+
+// Unwrap unwraps the BuildProfile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bp *BuildProfile) Unwrap() *BuildProfile {
+	_tx, ok := bp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BuildProfile is not a transactional entity")
+	}
+	bp.config.driver = _tx.drv
+	return bp
+}
+
+// String implements the fmt.Stringer.
+func (bp *BuildProfile) String() string {
+	var builder strings.Builder
+	builder.WriteString("BuildProfile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bp.ID))
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", bp.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(bp.Name)
+	builder.WriteString(", ")
+	builder.WriteString("output_dir=")
+	builder.WriteString(bp.OutputDir)
+	builder.WriteString(", ")
+	builder.WriteString("abc_file_dir=")
+	builder.WriteString(bp.AbcFileDir)
+	builder.WriteString(", ")
+	builder.WriteString("priority_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", bp.PriorityThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("sample_id=")
+	builder.WriteString(bp.SampleID)
+	builder.WriteString(", ")
+	builder.WriteString("config=")
+	builder.WriteString(fmt.Sprintf("%v", bp.Config))
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", bp.IsDefault))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BuildProfiles is a parsable slice of BuildProfile.
+type BuildProfiles []*BuildProfile
@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package buildprofile
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the buildprofile type in the database.
+	Label = "build_profile"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldOutputDir holds the string denoting the output_dir field in the database.
+	FieldOutputDir = "output_dir"
+	// FieldAbcFileDir holds the string denoting the abc_file_dir field in the database.
+	FieldAbcFileDir = "abc_file_dir"
+	// FieldPriorityThreshold holds the string denoting the priority_threshold field in the database.
+	FieldPriorityThreshold = "priority_threshold"
+	// FieldSampleID holds the string denoting the sample_id field in the database.
+	FieldSampleID = "sample_id"
+	// FieldConfig holds the string denoting the config field in the database.
+	FieldConfig = "config"
+	// FieldIsDefault holds the string denoting the is_default field in the database.
+	FieldIsDefault = "is_default"
+	// EdgeProject holds the string denoting the project edge name in mutations.
+	EdgeProject = "project"
+	// Table holds the table name of the buildprofile in the database.
+	Table = "build_profiles"
+	// ProjectTable is the table that holds the project relation/edge.
+	ProjectTable = "build_profiles"
+	// ProjectInverseTable is the table name for the Project entity.
+	// It exists in this package in order to avoid circular dependency with the "project" package.
+	ProjectInverseTable = "projects"
+	// ProjectColumn is the table column denoting the project relation/edge.
+	ProjectColumn = "project_id"
+)
+
+// Columns holds all SQL columns for buildprofile fields.
+var Columns = []string{
+	FieldID,
+	FieldProjectID,
+	FieldName,
+	FieldOutputDir,
+	FieldAbcFileDir,
+	FieldPriorityThreshold,
+	FieldSampleID,
+	FieldConfig,
+	FieldIsDefault,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultIsDefault holds the default value on creation for the "is_default" field.
+	DefaultIsDefault bool
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the BuildProfile queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByOutputDir orders the results by the output_dir field.
+func ByOutputDir(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputDir, opts...).ToFunc()
+}
+
+// ByAbcFileDir orders the results by the abc_file_dir field.
+func ByAbcFileDir(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAbcFileDir, opts...).ToFunc()
+}
+
+// ByPriorityThreshold orders the results by the priority_threshold field.
+func ByPriorityThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriorityThreshold, opts...).ToFunc()
+}
+
+// BySampleID orders the results by the sample_id field.
+func BySampleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSampleID, opts...).ToFunc()
+}
+
+// ByIsDefault orders the results by the is_default field.
+func ByIsDefault(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDefault, opts...).ToFunc()
+}
+
+// ByProjectField orders the results by project field.
+func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProjectStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProjectInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+	)
+}
@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package buildprofile
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bwl21/zupfmanager/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldLTE(FieldID, id))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldProjectID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldName, v))
+}
+
+// OutputDir applies equality check predicate on the "output_dir" field. It's identical to OutputDirEQ.
+func OutputDir(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldOutputDir, v))
+}
+
+// AbcFileDir applies equality check predicate on the "abc_file_dir" field. It's identical to AbcFileDirEQ.
+func AbcFileDir(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldAbcFileDir, v))
+}
+
+// PriorityThreshold applies equality check predicate on the "priority_threshold" field. It's identical to PriorityThresholdEQ.
+func PriorityThreshold(v int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldPriorityThreshold, v))
+}
+
+// SampleID applies equality check predicate on the "sample_id" field. It's identical to SampleIDEQ.
+func SampleID(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldSampleID, v))
+}
+
+// IsDefault applies equality check predicate on the "is_default" field. It's identical to IsDefaultEQ.
+func IsDefault(v bool) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldContainsFold(FieldName, v))
+}
+
+// OutputDirEQ applies the EQ predicate on the "output_dir" field.
+func OutputDirEQ(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldOutputDir, v))
+}
+
+// OutputDirNEQ applies the NEQ predicate on the "output_dir" field.
+func OutputDirNEQ(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNEQ(FieldOutputDir, v))
+}
+
+// OutputDirIn applies the In predicate on the "output_dir" field.
+func OutputDirIn(vs ...string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldIn(FieldOutputDir, vs...))
+}
+
+// OutputDirNotIn applies the NotIn predicate on the "output_dir" field.
+func OutputDirNotIn(vs ...string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNotIn(FieldOutputDir, vs...))
+}
+
+// OutputDirGT applies the GT predicate on the "output_dir" field.
+func OutputDirGT(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldGT(FieldOutputDir, v))
+}
+
+// OutputDirGTE applies the GTE predicate on the "output_dir" field.
+func OutputDirGTE(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldGTE(FieldOutputDir, v))
+}
+
+// OutputDirLT applies the LT predicate on the "output_dir" field.
+func OutputDirLT(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldLT(FieldOutputDir, v))
+}
+
+// OutputDirLTE applies the LTE predicate on the "output_dir" field.
+func OutputDirLTE(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldLTE(FieldOutputDir, v))
+}
+
+// OutputDirContains applies the Contains predicate on the "output_dir" field.
+func OutputDirContains(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldContains(FieldOutputDir, v))
+}
+
+// OutputDirHasPrefix applies the HasPrefix predicate on the "output_dir" field.
+func OutputDirHasPrefix(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldHasPrefix(FieldOutputDir, v))
+}
+
+// OutputDirHasSuffix applies the HasSuffix predicate on the "output_dir" field.
+func OutputDirHasSuffix(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldHasSuffix(FieldOutputDir, v))
+}
+
+// OutputDirIsNil applies the IsNil predicate on the "output_dir" field.
+func OutputDirIsNil() predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldIsNull(FieldOutputDir))
+}
+
+// OutputDirNotNil applies the NotNil predicate on the "output_dir" field.
+func OutputDirNotNil() predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNotNull(FieldOutputDir))
+}
+
+// OutputDirEqualFold applies the EqualFold predicate on the "output_dir" field.
+func OutputDirEqualFold(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEqualFold(FieldOutputDir, v))
+}
+
+// OutputDirContainsFold applies the ContainsFold predicate on the "output_dir" field.
+func OutputDirContainsFold(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldContainsFold(FieldOutputDir, v))
+}
+
+// AbcFileDirEQ applies the EQ predicate on the "abc_file_dir" field.
+func AbcFileDirEQ(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldAbcFileDir, v))
+}
+
+// AbcFileDirNEQ applies the NEQ predicate on the "abc_file_dir" field.
+func AbcFileDirNEQ(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNEQ(FieldAbcFileDir, v))
+}
+
+// AbcFileDirIn applies the In predicate on the "abc_file_dir" field.
+func AbcFileDirIn(vs ...string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldIn(FieldAbcFileDir, vs...))
+}
+
+// AbcFileDirNotIn applies the NotIn predicate on the "abc_file_dir" field.
+func AbcFileDirNotIn(vs ...string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNotIn(FieldAbcFileDir, vs...))
+}
+
+// AbcFileDirGT applies the GT predicate on the "abc_file_dir" field.
+func AbcFileDirGT(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldGT(FieldAbcFileDir, v))
+}
+
+// AbcFileDirGTE applies the GTE predicate on the "abc_file_dir" field.
+func AbcFileDirGTE(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldGTE(FieldAbcFileDir, v))
+}
+
+// AbcFileDirLT applies the LT predicate on the "abc_file_dir" field.
+func AbcFileDirLT(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldLT(FieldAbcFileDir, v))
+}
+
+// AbcFileDirLTE applies the LTE predicate on the "abc_file_dir" field.
+func AbcFileDirLTE(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldLTE(FieldAbcFileDir, v))
+}
+
+// AbcFileDirContains applies the Contains predicate on the "abc_file_dir" field.
+func AbcFileDirContains(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldContains(FieldAbcFileDir, v))
+}
+
+// AbcFileDirHasPrefix applies the HasPrefix predicate on the "abc_file_dir" field.
+func AbcFileDirHasPrefix(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldHasPrefix(FieldAbcFileDir, v))
+}
+
+// AbcFileDirHasSuffix applies the HasSuffix predicate on the "abc_file_dir" field.
+func AbcFileDirHasSuffix(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldHasSuffix(FieldAbcFileDir, v))
+}
+
+// AbcFileDirIsNil applies the IsNil predicate on the "abc_file_dir" field.
+func AbcFileDirIsNil() predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldIsNull(FieldAbcFileDir))
+}
+
+// AbcFileDirNotNil applies the NotNil predicate on the "abc_file_dir" field.
+func AbcFileDirNotNil() predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNotNull(FieldAbcFileDir))
+}
+
+// AbcFileDirEqualFold applies the EqualFold predicate on the "abc_file_dir" field.
+func AbcFileDirEqualFold(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEqualFold(FieldAbcFileDir, v))
+}
+
+// AbcFileDirContainsFold applies the ContainsFold predicate on the "abc_file_dir" field.
+func AbcFileDirContainsFold(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldContainsFold(FieldAbcFileDir, v))
+}
+
+// PriorityThresholdEQ applies the EQ predicate on the "priority_threshold" field.
+func PriorityThresholdEQ(v int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldPriorityThreshold, v))
+}
+
+// PriorityThresholdNEQ applies the NEQ predicate on the "priority_threshold" field.
+func PriorityThresholdNEQ(v int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNEQ(FieldPriorityThreshold, v))
+}
+
+// PriorityThresholdIn applies the In predicate on the "priority_threshold" field.
+func PriorityThresholdIn(vs ...int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldIn(FieldPriorityThreshold, vs...))
+}
+
+// PriorityThresholdNotIn applies the NotIn predicate on the "priority_threshold" field.
+func PriorityThresholdNotIn(vs ...int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNotIn(FieldPriorityThreshold, vs...))
+}
+
+// PriorityThresholdGT applies the GT predicate on the "priority_threshold" field.
+func PriorityThresholdGT(v int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldGT(FieldPriorityThreshold, v))
+}
+
+// PriorityThresholdGTE applies the GTE predicate on the "priority_threshold" field.
+func PriorityThresholdGTE(v int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldGTE(FieldPriorityThreshold, v))
+}
+
+// PriorityThresholdLT applies the LT predicate on the "priority_threshold" field.
+func PriorityThresholdLT(v int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldLT(FieldPriorityThreshold, v))
+}
+
+// PriorityThresholdLTE applies the LTE predicate on the "priority_threshold" field.
+func PriorityThresholdLTE(v int) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldLTE(FieldPriorityThreshold, v))
+}
+
+// PriorityThresholdIsNil applies the IsNil predicate on the "priority_threshold" field.
+func PriorityThresholdIsNil() predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldIsNull(FieldPriorityThreshold))
+}
+
+// PriorityThresholdNotNil applies the NotNil predicate on the "priority_threshold" field.
+func PriorityThresholdNotNil() predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNotNull(FieldPriorityThreshold))
+}
+
+// SampleIDEQ applies the EQ predicate on the "sample_id" field.
+func SampleIDEQ(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldSampleID, v))
+}
+
+// SampleIDNEQ applies the NEQ predicate on the "sample_id" field.
+func SampleIDNEQ(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNEQ(FieldSampleID, v))
+}
+
+// SampleIDIn applies the In predicate on the "sample_id" field.
+func SampleIDIn(vs ...string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldIn(FieldSampleID, vs...))
+}
+
+// SampleIDNotIn applies the NotIn predicate on the "sample_id" field.
+func SampleIDNotIn(vs ...string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNotIn(FieldSampleID, vs...))
+}
+
+// SampleIDGT applies the GT predicate on the "sample_id" field.
+func SampleIDGT(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldGT(FieldSampleID, v))
+}
+
+// SampleIDGTE applies the GTE predicate on the "sample_id" field.
+func SampleIDGTE(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldGTE(FieldSampleID, v))
+}
+
+// SampleIDLT applies the LT predicate on the "sample_id" field.
+func SampleIDLT(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldLT(FieldSampleID, v))
+}
+
+// SampleIDLTE applies the LTE predicate on the "sample_id" field.
+func SampleIDLTE(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldLTE(FieldSampleID, v))
+}
+
+// SampleIDContains applies the Contains predicate on the "sample_id" field.
+func SampleIDContains(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldContains(FieldSampleID, v))
+}
+
+// SampleIDHasPrefix applies the HasPrefix predicate on the "sample_id" field.
+func SampleIDHasPrefix(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldHasPrefix(FieldSampleID, v))
+}
+
+// SampleIDHasSuffix applies the HasSuffix predicate on the "sample_id" field.
+func SampleIDHasSuffix(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldHasSuffix(FieldSampleID, v))
+}
+
+// SampleIDIsNil applies the IsNil predicate on the "sample_id" field.
+func SampleIDIsNil() predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldIsNull(FieldSampleID))
+}
+
+// SampleIDNotNil applies the NotNil predicate on the "sample_id" field.
+func SampleIDNotNil() predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNotNull(FieldSampleID))
+}
+
+// SampleIDEqualFold applies the EqualFold predicate on the "sample_id" field.
+func SampleIDEqualFold(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEqualFold(FieldSampleID, v))
+}
+
+// SampleIDContainsFold applies the ContainsFold predicate on the "sample_id" field.
+func SampleIDContainsFold(v string) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldContainsFold(FieldSampleID, v))
+}
+
+// ConfigIsNil applies the IsNil predicate on the "config" field.
+func ConfigIsNil() predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldIsNull(FieldConfig))
+}
+
+// ConfigNotNil applies the NotNil predicate on the "config" field.
+func ConfigNotNil() predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNotNull(FieldConfig))
+}
+
+// IsDefaultEQ applies the EQ predicate on the "is_default" field.
+func IsDefaultEQ(v bool) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldEQ(FieldIsDefault, v))
+}
+
+// IsDefaultNEQ applies the NEQ predicate on the "is_default" field.
+func IsDefaultNEQ(v bool) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.FieldNEQ(FieldIsDefault, v))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.BuildProfile {
+	return predicate.BuildProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.BuildProfile {
+	return predicate.BuildProfile(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BuildProfile) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BuildProfile) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BuildProfile) predicate.BuildProfile {
+	return predicate.BuildProfile(sql.NotPredicates(p))
+}
@@ -0,0 +1,353 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bwl21/zupfmanager/internal/ent/buildprofile"
+	"github.com/bwl21/zupfmanager/internal/ent/project"
+)
+
+// BuildProfileCreate is the builder for creating a BuildProfile entity.
+type BuildProfileCreate struct {
+	config
+	mutation *BuildProfileMutation
+	hooks    []Hook
+}
+
+// SetProjectID sets the "project_id" field.
+func (bpc *BuildProfileCreate) SetProjectID(i int) *BuildProfileCreate {
+	bpc.mutation.SetProjectID(i)
+	return bpc
+}
+
+// SetName sets the "name" field.
+func (bpc *BuildProfileCreate) SetName(s string) *BuildProfileCreate {
+	bpc.mutation.SetName(s)
+	return bpc
+}
+
+// SetOutputDir sets the "output_dir" field.
+func (bpc *BuildProfileCreate) SetOutputDir(s string) *BuildProfileCreate {
+	bpc.mutation.SetOutputDir(s)
+	return bpc
+}
+
+// SetNillableOutputDir sets the "output_dir" field if the given value is not nil.
+func (bpc *BuildProfileCreate) SetNillableOutputDir(s *string) *BuildProfileCreate {
+	if s != nil {
+		bpc.SetOutputDir(*s)
+	}
+	return bpc
+}
+
+// SetAbcFileDir sets the "abc_file_dir" field.
+func (bpc *BuildProfileCreate) SetAbcFileDir(s string) *BuildProfileCreate {
+	bpc.mutation.SetAbcFileDir(s)
+	return bpc
+}
+
+// SetNillableAbcFileDir sets the "abc_file_dir" field if the given value is not nil.
+func (bpc *BuildProfileCreate) SetNillableAbcFileDir(s *string) *BuildProfileCreate {
+	if s != nil {
+		bpc.SetAbcFileDir(*s)
+	}
+	return bpc
+}
+
+// SetPriorityThreshold sets the "priority_threshold" field.
+func (bpc *BuildProfileCreate) SetPriorityThreshold(i int) *BuildProfileCreate {
+	bpc.mutation.SetPriorityThreshold(i)
+	return bpc
+}
+
+// SetNillablePriorityThreshold sets the "priority_threshold" field if the given value is not nil.
+func (bpc *BuildProfileCreate) SetNillablePriorityThreshold(i *int) *BuildProfileCreate {
+	if i != nil {
+		bpc.SetPriorityThreshold(*i)
+	}
+	return bpc
+}
+
+// SetSampleID sets the "sample_id" field.
+func (bpc *BuildProfileCreate) SetSampleID(s string) *BuildProfileCreate {
+	bpc.mutation.SetSampleID(s)
+	return bpc
+}
+
+// SetNillableSampleID sets the "sample_id" field if the given value is not nil.
+func (bpc *BuildProfileCreate) SetNillableSampleID(s *string) *BuildProfileCreate {
+	if s != nil {
+		bpc.SetSampleID(*s)
+	}
+	return bpc
+}
+
+// SetConfig sets the "config" field.
+func (bpc *BuildProfileCreate) SetConfig(m map[string]interface{}) *BuildProfileCreate {
+	bpc.mutation.SetConfig(m)
+	return bpc
+}
+
+// SetIsDefault sets the "is_default" field.
+func (bpc *BuildProfileCreate) SetIsDefault(b bool) *BuildProfileCreate {
+	bpc.mutation.SetIsDefault(b)
+	return bpc
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (bpc *BuildProfileCreate) SetNillableIsDefault(b *bool) *BuildProfileCreate {
+	if b != nil {
+		bpc.SetIsDefault(*b)
+	}
+	return bpc
+}
+
+// SetID sets the "id" field.
+func (bpc *BuildProfileCreate) SetID(i int) *BuildProfileCreate {
+	bpc.mutation.SetID(i)
+	return bpc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (bpc *BuildProfileCreate) SetProject(p *Project) *BuildProfileCreate {
+	return bpc.SetProjectID(p.ID)
+}
+
+// Mutation returns the BuildProfileMutation object of the builder.
+func (bpc *BuildProfileCreate) Mutation() *BuildProfileMutation {
+	return bpc.mutation
+}
+
+// Save creates the BuildProfile in the database.
+func (bpc *BuildProfileCreate) Save(ctx context.Context) (*BuildProfile, error) {
+	bpc.defaults()
+	return withHooks(ctx, bpc.sqlSave, bpc.mutation, bpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bpc *BuildProfileCreate) SaveX(ctx context.Context) *BuildProfile {
+	v, err := bpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bpc *BuildProfileCreate) Exec(ctx context.Context) error {
+	_, err := bpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpc *BuildProfileCreate) ExecX(ctx context.Context) {
+	if err := bpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bpc *BuildProfileCreate) defaults() {
+	if _, ok := bpc.mutation.IsDefault(); !ok {
+		v := buildprofile.DefaultIsDefault
+		bpc.mutation.SetIsDefault(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bpc *BuildProfileCreate) check() error {
+	if _, ok := bpc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "BuildProfile.project_id"`)}
+	}
+	if _, ok := bpc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "BuildProfile.name"`)}
+	}
+	if v, ok := bpc.mutation.Name(); ok {
+		if err := buildprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BuildProfile.name": %w`, err)}
+		}
+	}
+	if _, ok := bpc.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "BuildProfile.is_default"`)}
+	}
+	if v, ok := bpc.mutation.ID(); ok {
+		if err := buildprofile.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "BuildProfile.id": %w`, err)}
+		}
+	}
+	if len(bpc.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "BuildProfile.project"`)}
+	}
+	return nil
+}
+
+func (bpc *BuildProfileCreate) sqlSave(ctx context.Context) (*BuildProfile, error) {
+	if err := bpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	bpc.mutation.id = &_node.ID
+	bpc.mutation.done = true
+	return _node, nil
+}
+
+func (bpc *BuildProfileCreate) createSpec() (*BuildProfile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BuildProfile{config: bpc.config}
+		_spec = sqlgraph.NewCreateSpec(buildprofile.Table, sqlgraph.NewFieldSpec(buildprofile.FieldID, field.TypeInt))
+	)
+	if id, ok := bpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bpc.mutation.Name(); ok {
+		_spec.SetField(buildprofile.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := bpc.mutation.OutputDir(); ok {
+		_spec.SetField(buildprofile.FieldOutputDir, field.TypeString, value)
+		_node.OutputDir = value
+	}
+	if value, ok := bpc.mutation.AbcFileDir(); ok {
+		_spec.SetField(buildprofile.FieldAbcFileDir, field.TypeString, value)
+		_node.AbcFileDir = value
+	}
+	if value, ok := bpc.mutation.PriorityThreshold(); ok {
+		_spec.SetField(buildprofile.FieldPriorityThreshold, field.TypeInt, value)
+		_node.PriorityThreshold = value
+	}
+	if value, ok := bpc.mutation.SampleID(); ok {
+		_spec.SetField(buildprofile.FieldSampleID, field.TypeString, value)
+		_node.SampleID = value
+	}
+	if value, ok := bpc.mutation.Config(); ok {
+		_spec.SetField(buildprofile.FieldConfig, field.TypeJSON, value)
+		_node.Config = value
+	}
+	if value, ok := bpc.mutation.IsDefault(); ok {
+		_spec.SetField(buildprofile.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if nodes := bpc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   buildprofile.ProjectTable,
+			Columns: []string{buildprofile.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BuildProfileCreateBulk is the builder for creating many BuildProfile entities in bulk.
+type BuildProfileCreateBulk struct {
+	config
+	err      error
+	builders []*BuildProfileCreate
+}
+
+// Save creates the BuildProfile entities in the database.
+func (bpcb *BuildProfileCreateBulk) Save(ctx context.Context) ([]*BuildProfile, error) {
+	if bpcb.err != nil {
+		return nil, bpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bpcb.builders))
+	nodes := make([]*BuildProfile, len(bpcb.builders))
+	mutators := make([]Mutator, len(bpcb.builders))
+	for i := range bpcb.builders {
+		func(i int, root context.Context) {
+			builder := bpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BuildProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpcb *BuildProfileCreateBulk) SaveX(ctx context.Context) []*BuildProfile {
+	v, err := bpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bpcb *BuildProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := bpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpcb *BuildProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := bpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bwl21/zupfmanager/internal/ent/buildprofile"
+	"github.com/bwl21/zupfmanager/internal/ent/predicate"
+)
+
+// BuildProfileDelete is the builder for deleting a BuildProfile entity.
+type BuildProfileDelete struct {
+	config
+	hooks    []Hook
+	mutation *BuildProfileMutation
+}
+
+// Where appends a list predicates to the BuildProfileDelete builder.
+func (bpd *BuildProfileDelete) Where(ps ...predicate.BuildProfile) *BuildProfileDelete {
+	bpd.mutation.Where(ps...)
+	return bpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bpd *BuildProfileDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bpd.sqlExec, bpd.mutation, bpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpd *BuildProfileDelete) ExecX(ctx context.Context) int {
+	n, err := bpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bpd *BuildProfileDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(buildprofile.Table, sqlgraph.NewFieldSpec(buildprofile.FieldID, field.TypeInt))
+	if ps := bpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bpd.mutation.done = true
+	return affected, err
+}
+
+// BuildProfileDeleteOne is the builder for deleting a single BuildProfile entity.
+type BuildProfileDeleteOne struct {
+	bpd *BuildProfileDelete
+}
+
+// Where appends a list predicates to the BuildProfileDelete builder.
+func (bpdo *BuildProfileDeleteOne) Where(ps ...predicate.BuildProfile) *BuildProfileDeleteOne {
+	bpdo.bpd.mutation.Where(ps...)
+	return bpdo
+}
+
+// Exec executes the deletion query.
+func (bpdo *BuildProfileDeleteOne) Exec(ctx context.Context) error {
+	n, err := bpdo.bpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{buildprofile.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpdo *BuildProfileDeleteOne) ExecX(ctx context.Context) {
+	if err := bpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
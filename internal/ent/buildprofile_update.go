@@ -0,0 +1,664 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/bwl21/zupfmanager/internal/ent/buildprofile"
+	"github.com/bwl21/zupfmanager/internal/ent/predicate"
+	"github.com/bwl21/zupfmanager/internal/ent/project"
+)
+
+// BuildProfileUpdate is the builder for updating BuildProfile entities.
+type BuildProfileUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BuildProfileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BuildProfileUpdate builder.
+func (bpu *BuildProfileUpdate) Where(ps ...predicate.BuildProfile) *BuildProfileUpdate {
+	bpu.mutation.Where(ps...)
+	return bpu
+}
+
+// SetProjectID sets the "project_id" field.
+func (bpu *BuildProfileUpdate) SetProjectID(i int) *BuildProfileUpdate {
+	bpu.mutation.SetProjectID(i)
+	return bpu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (bpu *BuildProfileUpdate) SetNillableProjectID(i *int) *BuildProfileUpdate {
+	if i != nil {
+		bpu.SetProjectID(*i)
+	}
+	return bpu
+}
+
+// SetName sets the "name" field.
+func (bpu *BuildProfileUpdate) SetName(s string) *BuildProfileUpdate {
+	bpu.mutation.SetName(s)
+	return bpu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (bpu *BuildProfileUpdate) SetNillableName(s *string) *BuildProfileUpdate {
+	if s != nil {
+		bpu.SetName(*s)
+	}
+	return bpu
+}
+
+// SetOutputDir sets the "output_dir" field.
+func (bpu *BuildProfileUpdate) SetOutputDir(s string) *BuildProfileUpdate {
+	bpu.mutation.SetOutputDir(s)
+	return bpu
+}
+
+// SetNillableOutputDir sets the "output_dir" field if the given value is not nil.
+func (bpu *BuildProfileUpdate) SetNillableOutputDir(s *string) *BuildProfileUpdate {
+	if s != nil {
+		bpu.SetOutputDir(*s)
+	}
+	return bpu
+}
+
+// ClearOutputDir clears the value of the "output_dir" field.
+func (bpu *BuildProfileUpdate) ClearOutputDir() *BuildProfileUpdate {
+	bpu.mutation.ClearOutputDir()
+	return bpu
+}
+
+// SetAbcFileDir sets the "abc_file_dir" field.
+func (bpu *BuildProfileUpdate) SetAbcFileDir(s string) *BuildProfileUpdate {
+	bpu.mutation.SetAbcFileDir(s)
+	return bpu
+}
+
+// SetNillableAbcFileDir sets the "abc_file_dir" field if the given value is not nil.
+func (bpu *BuildProfileUpdate) SetNillableAbcFileDir(s *string) *BuildProfileUpdate {
+	if s != nil {
+		bpu.SetAbcFileDir(*s)
+	}
+	return bpu
+}
+
+// ClearAbcFileDir clears the value of the "abc_file_dir" field.
+func (bpu *BuildProfileUpdate) ClearAbcFileDir() *BuildProfileUpdate {
+	bpu.mutation.ClearAbcFileDir()
+	return bpu
+}
+
+// SetPriorityThreshold sets the "priority_threshold" field.
+func (bpu *BuildProfileUpdate) SetPriorityThreshold(i int) *BuildProfileUpdate {
+	bpu.mutation.ResetPriorityThreshold()
+	bpu.mutation.SetPriorityThreshold(i)
+	return bpu
+}
+
+// SetNillablePriorityThreshold sets the "priority_threshold" field if the given value is not nil.
+func (bpu *BuildProfileUpdate) SetNillablePriorityThreshold(i *int) *BuildProfileUpdate {
+	if i != nil {
+		bpu.SetPriorityThreshold(*i)
+	}
+	return bpu
+}
+
+// AddPriorityThreshold adds i to the "priority_threshold" field.
+func (bpu *BuildProfileUpdate) AddPriorityThreshold(i int) *BuildProfileUpdate {
+	bpu.mutation.AddPriorityThreshold(i)
+	return bpu
+}
+
+// ClearPriorityThreshold clears the value of the "priority_threshold" field.
+func (bpu *BuildProfileUpdate) ClearPriorityThreshold() *BuildProfileUpdate {
+	bpu.mutation.ClearPriorityThreshold()
+	return bpu
+}
+
+// SetSampleID sets the "sample_id" field.
+func (bpu *BuildProfileUpdate) SetSampleID(s string) *BuildProfileUpdate {
+	bpu.mutation.SetSampleID(s)
+	return bpu
+}
+
+// SetNillableSampleID sets the "sample_id" field if the given value is not nil.
+func (bpu *BuildProfileUpdate) SetNillableSampleID(s *string) *BuildProfileUpdate {
+	if s != nil {
+		bpu.SetSampleID(*s)
+	}
+	return bpu
+}
+
+// ClearSampleID clears the value of the "sample_id" field.
+func (bpu *BuildProfileUpdate) ClearSampleID() *BuildProfileUpdate {
+	bpu.mutation.ClearSampleID()
+	return bpu
+}
+
+// SetConfig sets the "config" field.
+func (bpu *BuildProfileUpdate) SetConfig(m map[string]interface{}) *BuildProfileUpdate {
+	bpu.mutation.SetConfig(m)
+	return bpu
+}
+
+// ClearConfig clears the value of the "config" field.
+func (bpu *BuildProfileUpdate) ClearConfig() *BuildProfileUpdate {
+	bpu.mutation.ClearConfig()
+	return bpu
+}
+
+// SetIsDefault sets the "is_default" field.
+func (bpu *BuildProfileUpdate) SetIsDefault(b bool) *BuildProfileUpdate {
+	bpu.mutation.SetIsDefault(b)
+	return bpu
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (bpu *BuildProfileUpdate) SetNillableIsDefault(b *bool) *BuildProfileUpdate {
+	if b != nil {
+		bpu.SetIsDefault(*b)
+	}
+	return bpu
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (bpu *BuildProfileUpdate) SetProject(p *Project) *BuildProfileUpdate {
+	return bpu.SetProjectID(p.ID)
+}
+
+// Mutation returns the BuildProfileMutation object of the builder.
+func (bpu *BuildProfileUpdate) Mutation() *BuildProfileMutation {
+	return bpu.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (bpu *BuildProfileUpdate) ClearProject() *BuildProfileUpdate {
+	bpu.mutation.ClearProject()
+	return bpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bpu *BuildProfileUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bpu.sqlSave, bpu.mutation, bpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpu *BuildProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := bpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bpu *BuildProfileUpdate) Exec(ctx context.Context) error {
+	_, err := bpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpu *BuildProfileUpdate) ExecX(ctx context.Context) {
+	if err := bpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bpu *BuildProfileUpdate) check() error {
+	if v, ok := bpu.mutation.Name(); ok {
+		if err := buildprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BuildProfile.name": %w`, err)}
+		}
+	}
+	if bpu.mutation.ProjectCleared() && len(bpu.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BuildProfile.project"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bpu *BuildProfileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BuildProfileUpdate {
+	bpu.modifiers = append(bpu.modifiers, modifiers...)
+	return bpu
+}
+
+func (bpu *BuildProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(buildprofile.Table, buildprofile.Columns, sqlgraph.NewFieldSpec(buildprofile.FieldID, field.TypeInt))
+	if ps := bpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bpu.mutation.Name(); ok {
+		_spec.SetField(buildprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := bpu.mutation.OutputDir(); ok {
+		_spec.SetField(buildprofile.FieldOutputDir, field.TypeString, value)
+	}
+	if bpu.mutation.OutputDirCleared() {
+		_spec.ClearField(buildprofile.FieldOutputDir, field.TypeString)
+	}
+	if value, ok := bpu.mutation.AbcFileDir(); ok {
+		_spec.SetField(buildprofile.FieldAbcFileDir, field.TypeString, value)
+	}
+	if bpu.mutation.AbcFileDirCleared() {
+		_spec.ClearField(buildprofile.FieldAbcFileDir, field.TypeString)
+	}
+	if value, ok := bpu.mutation.PriorityThreshold(); ok {
+		_spec.SetField(buildprofile.FieldPriorityThreshold, field.TypeInt, value)
+	}
+	if value, ok := bpu.mutation.AddedPriorityThreshold(); ok {
+		_spec.AddField(buildprofile.FieldPriorityThreshold, field.TypeInt, value)
+	}
+	if bpu.mutation.PriorityThresholdCleared() {
+		_spec.ClearField(buildprofile.FieldPriorityThreshold, field.TypeInt)
+	}
+	if value, ok := bpu.mutation.SampleID(); ok {
+		_spec.SetField(buildprofile.FieldSampleID, field.TypeString, value)
+	}
+	if bpu.mutation.SampleIDCleared() {
+		_spec.ClearField(buildprofile.FieldSampleID, field.TypeString)
+	}
+	if value, ok := bpu.mutation.Config(); ok {
+		_spec.SetField(buildprofile.FieldConfig, field.TypeJSON, value)
+	}
+	if bpu.mutation.ConfigCleared() {
+		_spec.ClearField(buildprofile.FieldConfig, field.TypeJSON)
+	}
+	if value, ok := bpu.mutation.IsDefault(); ok {
+		_spec.SetField(buildprofile.FieldIsDefault, field.TypeBool, value)
+	}
+	if bpu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   buildprofile.ProjectTable,
+			Columns: []string{buildprofile.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   buildprofile.ProjectTable,
+			Columns: []string{buildprofile.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(bpu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, bpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{buildprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bpu.mutation.done = true
+	return n, nil
+}
+
+// BuildProfileUpdateOne is the builder for updating a single BuildProfile entity.
+type BuildProfileUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BuildProfileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetProjectID sets the "project_id" field.
+func (bpuo *BuildProfileUpdateOne) SetProjectID(i int) *BuildProfileUpdateOne {
+	bpuo.mutation.SetProjectID(i)
+	return bpuo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (bpuo *BuildProfileUpdateOne) SetNillableProjectID(i *int) *BuildProfileUpdateOne {
+	if i != nil {
+		bpuo.SetProjectID(*i)
+	}
+	return bpuo
+}
+
+// SetName sets the "name" field.
+func (bpuo *BuildProfileUpdateOne) SetName(s string) *BuildProfileUpdateOne {
+	bpuo.mutation.SetName(s)
+	return bpuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (bpuo *BuildProfileUpdateOne) SetNillableName(s *string) *BuildProfileUpdateOne {
+	if s != nil {
+		bpuo.SetName(*s)
+	}
+	return bpuo
+}
+
+// SetOutputDir sets the "output_dir" field.
+func (bpuo *BuildProfileUpdateOne) SetOutputDir(s string) *BuildProfileUpdateOne {
+	bpuo.mutation.SetOutputDir(s)
+	return bpuo
+}
+
+// SetNillableOutputDir sets the "output_dir" field if the given value is not nil.
+func (bpuo *BuildProfileUpdateOne) SetNillableOutputDir(s *string) *BuildProfileUpdateOne {
+	if s != nil {
+		bpuo.SetOutputDir(*s)
+	}
+	return bpuo
+}
+
+// ClearOutputDir clears the value of the "output_dir" field.
+func (bpuo *BuildProfileUpdateOne) ClearOutputDir() *BuildProfileUpdateOne {
+	bpuo.mutation.ClearOutputDir()
+	return bpuo
+}
+
+// SetAbcFileDir sets the "abc_file_dir" field.
+func (bpuo *BuildProfileUpdateOne) SetAbcFileDir(s string) *BuildProfileUpdateOne {
+	bpuo.mutation.SetAbcFileDir(s)
+	return bpuo
+}
+
+// SetNillableAbcFileDir sets the "abc_file_dir" field if the given value is not nil.
+func (bpuo *BuildProfileUpdateOne) SetNillableAbcFileDir(s *string) *BuildProfileUpdateOne {
+	if s != nil {
+		bpuo.SetAbcFileDir(*s)
+	}
+	return bpuo
+}
+
+// ClearAbcFileDir clears the value of the "abc_file_dir" field.
+func (bpuo *BuildProfileUpdateOne) ClearAbcFileDir() *BuildProfileUpdateOne {
+	bpuo.mutation.ClearAbcFileDir()
+	return bpuo
+}
+
+// SetPriorityThreshold sets the "priority_threshold" field.
+func (bpuo *BuildProfileUpdateOne) SetPriorityThreshold(i int) *BuildProfileUpdateOne {
+	bpuo.mutation.ResetPriorityThreshold()
+	bpuo.mutation.SetPriorityThreshold(i)
+	return bpuo
+}
+
+// SetNillablePriorityThreshold sets the "priority_threshold" field if the given value is not nil.
+func (bpuo *BuildProfileUpdateOne) SetNillablePriorityThreshold(i *int) *BuildProfileUpdateOne {
+	if i != nil {
+		bpuo.SetPriorityThreshold(*i)
+	}
+	return bpuo
+}
+
+// AddPriorityThreshold adds i to the "priority_threshold" field.
+func (bpuo *BuildProfileUpdateOne) AddPriorityThreshold(i int) *BuildProfileUpdateOne {
+	bpuo.mutation.AddPriorityThreshold(i)
+	return bpuo
+}
+
+// ClearPriorityThreshold clears the value of the "priority_threshold" field.
+func (bpuo *BuildProfileUpdateOne) ClearPriorityThreshold() *BuildProfileUpdateOne {
+	bpuo.mutation.ClearPriorityThreshold()
+	return bpuo
+}
+
+// SetSampleID sets the "sample_id" field.
+func (bpuo *BuildProfileUpdateOne) SetSampleID(s string) *BuildProfileUpdateOne {
+	bpuo.mutation.SetSampleID(s)
+	return bpuo
+}
+
+// SetNillableSampleID sets the "sample_id" field if the given value is not nil.
+func (bpuo *BuildProfileUpdateOne) SetNillableSampleID(s *string) *BuildProfileUpdateOne {
+	if s != nil {
+		bpuo.SetSampleID(*s)
+	}
+	return bpuo
+}
+
+// ClearSampleID clears the value of the "sample_id" field.
+func (bpuo *BuildProfileUpdateOne) ClearSampleID() *BuildProfileUpdateOne {
+	bpuo.mutation.ClearSampleID()
+	return bpuo
+}
+
+// SetConfig sets the "config" field.
+func (bpuo *BuildProfileUpdateOne) SetConfig(m map[string]interface{}) *BuildProfileUpdateOne {
+	bpuo.mutation.SetConfig(m)
+	return bpuo
+}
+
+// ClearConfig clears the value of the "config" field.
+func (bpuo *BuildProfileUpdateOne) ClearConfig() *BuildProfileUpdateOne {
+	bpuo.mutation.ClearConfig()
+	return bpuo
+}
+
+// SetIsDefault sets the "is_default" field.
+func (bpuo *BuildProfileUpdateOne) SetIsDefault(b bool) *BuildProfileUpdateOne {
+	bpuo.mutation.SetIsDefault(b)
+	return bpuo
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (bpuo *BuildProfileUpdateOne) SetNillableIsDefault(b *bool) *BuildProfileUpdateOne {
+	if b != nil {
+		bpuo.SetIsDefault(*b)
+	}
+	return bpuo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (bpuo *BuildProfileUpdateOne) SetProject(p *Project) *BuildProfileUpdateOne {
+	return bpuo.SetProjectID(p.ID)
+}
+
+// Mutation returns the BuildProfileMutation object of the builder.
+func (bpuo *BuildProfileUpdateOne) Mutation() *BuildProfileMutation {
+	return bpuo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (bpuo *BuildProfileUpdateOne) ClearProject() *BuildProfileUpdateOne {
+	bpuo.mutation.ClearProject()
+	return bpuo
+}
+
+// Where appends a list predicates to the BuildProfileUpdate builder.
+func (bpuo *BuildProfileUpdateOne) Where(ps ...predicate.BuildProfile) *BuildProfileUpdateOne {
+	bpuo.mutation.Where(ps...)
+	return bpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bpuo *BuildProfileUpdateOne) Select(field string, fields ...string) *BuildProfileUpdateOne {
+	bpuo.fields = append([]string{field}, fields...)
+	return bpuo
+}
+
+// Save executes the query and returns the updated BuildProfile entity.
+func (bpuo *BuildProfileUpdateOne) Save(ctx context.Context) (*BuildProfile, error) {
+	return withHooks(ctx, bpuo.sqlSave, bpuo.mutation, bpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bpuo *BuildProfileUpdateOne) SaveX(ctx context.Context) *BuildProfile {
+	node, err := bpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bpuo *BuildProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := bpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bpuo *BuildProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := bpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bpuo *BuildProfileUpdateOne) check() error {
+	if v, ok := bpuo.mutation.Name(); ok {
+		if err := buildprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BuildProfile.name": %w`, err)}
+		}
+	}
+	if bpuo.mutation.ProjectCleared() && len(bpuo.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BuildProfile.project"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bpuo *BuildProfileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BuildProfileUpdateOne {
+	bpuo.modifiers = append(bpuo.modifiers, modifiers...)
+	return bpuo
+}
+
+func (bpuo *BuildProfileUpdateOne) sqlSave(ctx context.Context) (_node *BuildProfile, err error) {
+	if err := bpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(buildprofile.Table, buildprofile.Columns, sqlgraph.NewFieldSpec(buildprofile.FieldID, field.TypeInt))
+	id, ok := bpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BuildProfile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, buildprofile.FieldID)
+		for _, f := range fields {
+			if !buildprofile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != buildprofile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bpuo.mutation.Name(); ok {
+		_spec.SetField(buildprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := bpuo.mutation.OutputDir(); ok {
+		_spec.SetField(buildprofile.FieldOutputDir, field.TypeString, value)
+	}
+	if bpuo.mutation.OutputDirCleared() {
+		_spec.ClearField(buildprofile.FieldOutputDir, field.TypeString)
+	}
+	if value, ok := bpuo.mutation.AbcFileDir(); ok {
+		_spec.SetField(buildprofile.FieldAbcFileDir, field.TypeString, value)
+	}
+	if bpuo.mutation.AbcFileDirCleared() {
+		_spec.ClearField(buildprofile.FieldAbcFileDir, field.TypeString)
+	}
+	if value, ok := bpuo.mutation.PriorityThreshold(); ok {
+		_spec.SetField(buildprofile.FieldPriorityThreshold, field.TypeInt, value)
+	}
+	if value, ok := bpuo.mutation.AddedPriorityThreshold(); ok {
+		_spec.AddField(buildprofile.FieldPriorityThreshold, field.TypeInt, value)
+	}
+	if bpuo.mutation.PriorityThresholdCleared() {
+		_spec.ClearField(buildprofile.FieldPriorityThreshold, field.TypeInt)
+	}
+	if value, ok := bpuo.mutation.SampleID(); ok {
+		_spec.SetField(buildprofile.FieldSampleID, field.TypeString, value)
+	}
+	if bpuo.mutation.SampleIDCleared() {
+		_spec.ClearField(buildprofile.FieldSampleID, field.TypeString)
+	}
+	if value, ok := bpuo.mutation.Config(); ok {
+		_spec.SetField(buildprofile.FieldConfig, field.TypeJSON, value)
+	}
+	if bpuo.mutation.ConfigCleared() {
+		_spec.ClearField(buildprofile.FieldConfig, field.TypeJSON)
+	}
+	if value, ok := bpuo.mutation.IsDefault(); ok {
+		_spec.SetField(buildprofile.FieldIsDefault, field.TypeBool, value)
+	}
+	if bpuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   buildprofile.ProjectTable,
+			Columns: []string{buildprofile.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bpuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   buildprofile.ProjectTable,
+			Columns: []string{buildprofile.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(bpuo.modifiers...)
+	_node = &BuildProfile{config: bpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{buildprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bpuo.mutation.done = true
+	return _node, nil
+}
@@ -12,10 +12,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+	"github.com/bwl21/zupfmanager/internal/ent/buildprofile"
 	"github.com/bwl21/zupfmanager/internal/ent/project"
 	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
 	"github.com/bwl21/zupfmanager/internal/ent/setting"
 	"github.com/bwl21/zupfmanager/internal/ent/song"
+	"github.com/bwl21/zupfmanager/internal/ent/template"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +79,13 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			project.Table:     project.ValidColumn,
-			projectsong.Table: projectsong.ValidColumn,
-			setting.Table:     setting.ValidColumn,
-			song.Table:        song.ValidColumn,
+			build.Table:        build.ValidColumn,
+			buildprofile.Table: buildprofile.ValidColumn,
+			project.Table:      project.ValidColumn,
+			projectsong.Table:  projectsong.ValidColumn,
+			setting.Table:      setting.ValidColumn,
+			song.Table:         song.ValidColumn,
+			template.Table:     template.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
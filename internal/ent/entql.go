@@ -3,11 +3,14 @@
 package ent
 
 import (
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+	"github.com/bwl21/zupfmanager/internal/ent/buildprofile"
 	"github.com/bwl21/zupfmanager/internal/ent/predicate"
 	"github.com/bwl21/zupfmanager/internal/ent/project"
 	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
 	"github.com/bwl21/zupfmanager/internal/ent/setting"
 	"github.com/bwl21/zupfmanager/internal/ent/song"
+	"github.com/bwl21/zupfmanager/internal/ent/template"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -17,8 +20,64 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 4)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 7)}
 	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   build.Table,
+			Columns: build.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: build.FieldID,
+			},
+		},
+		Type: "Build",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			build.FieldBuildID:           {Type: field.TypeString, Column: build.FieldBuildID},
+			build.FieldProjectID:         {Type: field.TypeInt, Column: build.FieldProjectID},
+			build.FieldStatus:            {Type: field.TypeEnum, Column: build.FieldStatus},
+			build.FieldOrigin:            {Type: field.TypeEnum, Column: build.FieldOrigin},
+			build.FieldProgress:          {Type: field.TypeInt, Column: build.FieldProgress},
+			build.FieldMessage:           {Type: field.TypeString, Column: build.FieldMessage},
+			build.FieldOutputDir:         {Type: field.TypeString, Column: build.FieldOutputDir},
+			build.FieldBuildDir:          {Type: field.TypeString, Column: build.FieldBuildDir},
+			build.FieldAbcFileDir:        {Type: field.TypeString, Column: build.FieldAbcFileDir},
+			build.FieldPriorityThreshold: {Type: field.TypeInt, Column: build.FieldPriorityThreshold},
+			build.FieldSampleID:          {Type: field.TypeString, Column: build.FieldSampleID},
+			build.FieldIncremental:       {Type: field.TypeBool, Column: build.FieldIncremental},
+			build.FieldProfile:           {Type: field.TypeString, Column: build.FieldProfile},
+			build.FieldSongOrder:         {Type: field.TypeString, Column: build.FieldSongOrder},
+			build.FieldSupplementSince:   {Type: field.TypeString, Column: build.FieldSupplementSince},
+			build.FieldFinal:             {Type: field.TypeBool, Column: build.FieldFinal},
+			build.FieldGeneratedFiles:    {Type: field.TypeJSON, Column: build.FieldGeneratedFiles},
+			build.FieldReport:            {Type: field.TypeJSON, Column: build.FieldReport},
+			build.FieldManifest:          {Type: field.TypeJSON, Column: build.FieldManifest},
+			build.FieldError:             {Type: field.TypeString, Column: build.FieldError},
+			build.FieldStartedAt:         {Type: field.TypeTime, Column: build.FieldStartedAt},
+			build.FieldCompletedAt:       {Type: field.TypeTime, Column: build.FieldCompletedAt},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   buildprofile.Table,
+			Columns: buildprofile.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: buildprofile.FieldID,
+			},
+		},
+		Type: "BuildProfile",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			buildprofile.FieldProjectID:         {Type: field.TypeInt, Column: buildprofile.FieldProjectID},
+			buildprofile.FieldName:              {Type: field.TypeString, Column: buildprofile.FieldName},
+			buildprofile.FieldOutputDir:         {Type: field.TypeString, Column: buildprofile.FieldOutputDir},
+			buildprofile.FieldAbcFileDir:        {Type: field.TypeString, Column: buildprofile.FieldAbcFileDir},
+			buildprofile.FieldPriorityThreshold: {Type: field.TypeInt, Column: buildprofile.FieldPriorityThreshold},
+			buildprofile.FieldSampleID:          {Type: field.TypeString, Column: buildprofile.FieldSampleID},
+			buildprofile.FieldConfig:            {Type: field.TypeJSON, Column: buildprofile.FieldConfig},
+			buildprofile.FieldIsDefault:         {Type: field.TypeBool, Column: buildprofile.FieldIsDefault},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   project.Table,
 			Columns: project.Columns,
@@ -33,9 +92,11 @@ var schemaGraph = func() *sqlgraph.Schema {
 			project.FieldShortName:            {Type: field.TypeString, Column: project.FieldShortName},
 			project.FieldConfig:               {Type: field.TypeJSON, Column: project.FieldConfig},
 			project.FieldAbcFileDirPreference: {Type: field.TypeString, Column: project.FieldAbcFileDirPreference},
+			project.FieldVariables:            {Type: field.TypeJSON, Column: project.FieldVariables},
+			project.FieldReservedNumbers:      {Type: field.TypeJSON, Column: project.FieldReservedNumbers},
 		},
 	}
-	graph.Nodes[1] = &sqlgraph.Node{
+	graph.Nodes[3] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   projectsong.Table,
 			Columns: projectsong.Columns,
@@ -49,11 +110,15 @@ var schemaGraph = func() *sqlgraph.Schema {
 			projectsong.FieldPriority:   {Type: field.TypeInt, Column: projectsong.FieldPriority},
 			projectsong.FieldDifficulty: {Type: field.TypeEnum, Column: projectsong.FieldDifficulty},
 			projectsong.FieldComment:    {Type: field.TypeString, Column: projectsong.FieldComment},
+			projectsong.FieldPosition:   {Type: field.TypeInt, Column: projectsong.FieldPosition},
+			projectsong.FieldNumber:     {Type: field.TypeInt, Column: projectsong.FieldNumber},
+			projectsong.FieldCreatedAt:  {Type: field.TypeTime, Column: projectsong.FieldCreatedAt},
+			projectsong.FieldUpdatedAt:  {Type: field.TypeTime, Column: projectsong.FieldUpdatedAt},
 			projectsong.FieldProjectID:  {Type: field.TypeInt, Column: projectsong.FieldProjectID},
 			projectsong.FieldSongID:     {Type: field.TypeInt, Column: projectsong.FieldSongID},
 		},
 	}
-	graph.Nodes[2] = &sqlgraph.Node{
+	graph.Nodes[4] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   setting.Table,
 			Columns: setting.Columns,
@@ -68,7 +133,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			setting.FieldValue: {Type: field.TypeString, Column: setting.FieldValue},
 		},
 	}
-	graph.Nodes[3] = &sqlgraph.Node{
+	graph.Nodes[5] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   song.Table,
 			Columns: song.Columns,
@@ -86,6 +151,49 @@ var schemaGraph = func() *sqlgraph.Schema {
 			song.FieldTocinfo:   {Type: field.TypeString, Column: song.FieldTocinfo},
 		},
 	}
+	graph.Nodes[6] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   template.Table,
+			Columns: template.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: template.FieldID,
+			},
+		},
+		Type: "Template",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			template.FieldName:        {Type: field.TypeString, Column: template.FieldName},
+			template.FieldKind:        {Type: field.TypeString, Column: template.FieldKind},
+			template.FieldDescription: {Type: field.TypeString, Column: template.FieldDescription},
+			template.FieldContent:     {Type: field.TypeString, Column: template.FieldContent},
+			template.FieldCreatedAt:   {Type: field.TypeTime, Column: template.FieldCreatedAt},
+			template.FieldUpdatedAt:   {Type: field.TypeTime, Column: template.FieldUpdatedAt},
+		},
+	}
+	graph.MustAddE(
+		"project",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   build.ProjectTable,
+			Columns: []string{build.ProjectColumn},
+			Bidi:    false,
+		},
+		"Build",
+		"Project",
+	)
+	graph.MustAddE(
+		"project",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   buildprofile.ProjectTable,
+			Columns: []string{buildprofile.ProjectColumn},
+			Bidi:    false,
+		},
+		"BuildProfile",
+		"Project",
+	)
 	graph.MustAddE(
 		"project_songs",
 		&sqlgraph.EdgeSpec{
@@ -98,6 +206,30 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Project",
 		"ProjectSong",
 	)
+	graph.MustAddE(
+		"builds",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildsTable,
+			Columns: []string{project.BuildsColumn},
+			Bidi:    false,
+		},
+		"Project",
+		"Build",
+	)
+	graph.MustAddE(
+		"build_profiles",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildProfilesTable,
+			Columns: []string{project.BuildProfilesColumn},
+			Bidi:    false,
+		},
+		"Project",
+		"BuildProfile",
+	)
 	graph.MustAddE(
 		"project",
 		&sqlgraph.EdgeSpec{
@@ -143,6 +275,264 @@ type predicateAdder interface {
 	addPredicate(func(s *sql.Selector))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (bq *BuildQuery) addPredicate(pred func(s *sql.Selector)) {
+	bq.predicates = append(bq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the BuildQuery builder.
+func (bq *BuildQuery) Filter() *BuildFilter {
+	return &BuildFilter{config: bq.config, predicateAdder: bq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *BuildMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the BuildMutation builder.
+func (m *BuildMutation) Filter() *BuildFilter {
+	return &BuildFilter{config: m.config, predicateAdder: m}
+}
+
+// BuildFilter provides a generic filtering capability at runtime for BuildQuery.
+type BuildFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *BuildFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *BuildFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(build.FieldID))
+}
+
+// WhereBuildID applies the entql string predicate on the build_id field.
+func (f *BuildFilter) WhereBuildID(p entql.StringP) {
+	f.Where(p.Field(build.FieldBuildID))
+}
+
+// WhereProjectID applies the entql int predicate on the project_id field.
+func (f *BuildFilter) WhereProjectID(p entql.IntP) {
+	f.Where(p.Field(build.FieldProjectID))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *BuildFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(build.FieldStatus))
+}
+
+// WhereOrigin applies the entql string predicate on the origin field.
+func (f *BuildFilter) WhereOrigin(p entql.StringP) {
+	f.Where(p.Field(build.FieldOrigin))
+}
+
+// WhereProgress applies the entql int predicate on the progress field.
+func (f *BuildFilter) WhereProgress(p entql.IntP) {
+	f.Where(p.Field(build.FieldProgress))
+}
+
+// WhereMessage applies the entql string predicate on the message field.
+func (f *BuildFilter) WhereMessage(p entql.StringP) {
+	f.Where(p.Field(build.FieldMessage))
+}
+
+// WhereOutputDir applies the entql string predicate on the output_dir field.
+func (f *BuildFilter) WhereOutputDir(p entql.StringP) {
+	f.Where(p.Field(build.FieldOutputDir))
+}
+
+// WhereBuildDir applies the entql string predicate on the build_dir field.
+func (f *BuildFilter) WhereBuildDir(p entql.StringP) {
+	f.Where(p.Field(build.FieldBuildDir))
+}
+
+// WhereAbcFileDir applies the entql string predicate on the abc_file_dir field.
+func (f *BuildFilter) WhereAbcFileDir(p entql.StringP) {
+	f.Where(p.Field(build.FieldAbcFileDir))
+}
+
+// WherePriorityThreshold applies the entql int predicate on the priority_threshold field.
+func (f *BuildFilter) WherePriorityThreshold(p entql.IntP) {
+	f.Where(p.Field(build.FieldPriorityThreshold))
+}
+
+// WhereSampleID applies the entql string predicate on the sample_id field.
+func (f *BuildFilter) WhereSampleID(p entql.StringP) {
+	f.Where(p.Field(build.FieldSampleID))
+}
+
+// WhereIncremental applies the entql bool predicate on the incremental field.
+func (f *BuildFilter) WhereIncremental(p entql.BoolP) {
+	f.Where(p.Field(build.FieldIncremental))
+}
+
+// WhereProfile applies the entql string predicate on the profile field.
+func (f *BuildFilter) WhereProfile(p entql.StringP) {
+	f.Where(p.Field(build.FieldProfile))
+}
+
+// WhereSongOrder applies the entql string predicate on the song_order field.
+func (f *BuildFilter) WhereSongOrder(p entql.StringP) {
+	f.Where(p.Field(build.FieldSongOrder))
+}
+
+// WhereSupplementSince applies the entql string predicate on the supplement_since field.
+func (f *BuildFilter) WhereSupplementSince(p entql.StringP) {
+	f.Where(p.Field(build.FieldSupplementSince))
+}
+
+// WhereFinal applies the entql bool predicate on the final field.
+func (f *BuildFilter) WhereFinal(p entql.BoolP) {
+	f.Where(p.Field(build.FieldFinal))
+}
+
+// WhereGeneratedFiles applies the entql json.RawMessage predicate on the generated_files field.
+func (f *BuildFilter) WhereGeneratedFiles(p entql.BytesP) {
+	f.Where(p.Field(build.FieldGeneratedFiles))
+}
+
+// WhereReport applies the entql json.RawMessage predicate on the report field.
+func (f *BuildFilter) WhereReport(p entql.BytesP) {
+	f.Where(p.Field(build.FieldReport))
+}
+
+// WhereManifest applies the entql json.RawMessage predicate on the manifest field.
+func (f *BuildFilter) WhereManifest(p entql.BytesP) {
+	f.Where(p.Field(build.FieldManifest))
+}
+
+// WhereError applies the entql string predicate on the error field.
+func (f *BuildFilter) WhereError(p entql.StringP) {
+	f.Where(p.Field(build.FieldError))
+}
+
+// WhereStartedAt applies the entql time.Time predicate on the started_at field.
+func (f *BuildFilter) WhereStartedAt(p entql.TimeP) {
+	f.Where(p.Field(build.FieldStartedAt))
+}
+
+// WhereCompletedAt applies the entql time.Time predicate on the completed_at field.
+func (f *BuildFilter) WhereCompletedAt(p entql.TimeP) {
+	f.Where(p.Field(build.FieldCompletedAt))
+}
+
+// WhereHasProject applies a predicate to check if query has an edge project.
+func (f *BuildFilter) WhereHasProject() {
+	f.Where(entql.HasEdge("project"))
+}
+
+// WhereHasProjectWith applies a predicate to check if query has an edge project with a given conditions (other predicates).
+func (f *BuildFilter) WhereHasProjectWith(preds ...predicate.Project) {
+	f.Where(entql.HasEdgeWith("project", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (bpq *BuildProfileQuery) addPredicate(pred func(s *sql.Selector)) {
+	bpq.predicates = append(bpq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the BuildProfileQuery builder.
+func (bpq *BuildProfileQuery) Filter() *BuildProfileFilter {
+	return &BuildProfileFilter{config: bpq.config, predicateAdder: bpq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *BuildProfileMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the BuildProfileMutation builder.
+func (m *BuildProfileMutation) Filter() *BuildProfileFilter {
+	return &BuildProfileFilter{config: m.config, predicateAdder: m}
+}
+
+// BuildProfileFilter provides a generic filtering capability at runtime for BuildProfileQuery.
+type BuildProfileFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *BuildProfileFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *BuildProfileFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(buildprofile.FieldID))
+}
+
+// WhereProjectID applies the entql int predicate on the project_id field.
+func (f *BuildProfileFilter) WhereProjectID(p entql.IntP) {
+	f.Where(p.Field(buildprofile.FieldProjectID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *BuildProfileFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(buildprofile.FieldName))
+}
+
+// WhereOutputDir applies the entql string predicate on the output_dir field.
+func (f *BuildProfileFilter) WhereOutputDir(p entql.StringP) {
+	f.Where(p.Field(buildprofile.FieldOutputDir))
+}
+
+// WhereAbcFileDir applies the entql string predicate on the abc_file_dir field.
+func (f *BuildProfileFilter) WhereAbcFileDir(p entql.StringP) {
+	f.Where(p.Field(buildprofile.FieldAbcFileDir))
+}
+
+// WherePriorityThreshold applies the entql int predicate on the priority_threshold field.
+func (f *BuildProfileFilter) WherePriorityThreshold(p entql.IntP) {
+	f.Where(p.Field(buildprofile.FieldPriorityThreshold))
+}
+
+// WhereSampleID applies the entql string predicate on the sample_id field.
+func (f *BuildProfileFilter) WhereSampleID(p entql.StringP) {
+	f.Where(p.Field(buildprofile.FieldSampleID))
+}
+
+// WhereConfig applies the entql json.RawMessage predicate on the config field.
+func (f *BuildProfileFilter) WhereConfig(p entql.BytesP) {
+	f.Where(p.Field(buildprofile.FieldConfig))
+}
+
+// WhereIsDefault applies the entql bool predicate on the is_default field.
+func (f *BuildProfileFilter) WhereIsDefault(p entql.BoolP) {
+	f.Where(p.Field(buildprofile.FieldIsDefault))
+}
+
+// WhereHasProject applies a predicate to check if query has an edge project.
+func (f *BuildProfileFilter) WhereHasProject() {
+	f.Where(entql.HasEdge("project"))
+}
+
+// WhereHasProjectWith applies a predicate to check if query has an edge project with a given conditions (other predicates).
+func (f *BuildProfileFilter) WhereHasProjectWith(preds ...predicate.Project) {
+	f.Where(entql.HasEdgeWith("project", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (pq *ProjectQuery) addPredicate(pred func(s *sql.Selector)) {
 	pq.predicates = append(pq.predicates, pred)
@@ -172,7 +562,7 @@ type ProjectFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ProjectFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -203,6 +593,16 @@ func (f *ProjectFilter) WhereAbcFileDirPreference(p entql.StringP) {
 	f.Where(p.Field(project.FieldAbcFileDirPreference))
 }
 
+// WhereVariables applies the entql json.RawMessage predicate on the variables field.
+func (f *ProjectFilter) WhereVariables(p entql.BytesP) {
+	f.Where(p.Field(project.FieldVariables))
+}
+
+// WhereReservedNumbers applies the entql json.RawMessage predicate on the reserved_numbers field.
+func (f *ProjectFilter) WhereReservedNumbers(p entql.BytesP) {
+	f.Where(p.Field(project.FieldReservedNumbers))
+}
+
 // WhereHasProjectSongs applies a predicate to check if query has an edge project_songs.
 func (f *ProjectFilter) WhereHasProjectSongs() {
 	f.Where(entql.HasEdge("project_songs"))
@@ -217,6 +617,34 @@ func (f *ProjectFilter) WhereHasProjectSongsWith(preds ...predicate.ProjectSong)
 	})))
 }
 
+// WhereHasBuilds applies a predicate to check if query has an edge builds.
+func (f *ProjectFilter) WhereHasBuilds() {
+	f.Where(entql.HasEdge("builds"))
+}
+
+// WhereHasBuildsWith applies a predicate to check if query has an edge builds with a given conditions (other predicates).
+func (f *ProjectFilter) WhereHasBuildsWith(preds ...predicate.Build) {
+	f.Where(entql.HasEdgeWith("builds", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasBuildProfiles applies a predicate to check if query has an edge build_profiles.
+func (f *ProjectFilter) WhereHasBuildProfiles() {
+	f.Where(entql.HasEdge("build_profiles"))
+}
+
+// WhereHasBuildProfilesWith applies a predicate to check if query has an edge build_profiles with a given conditions (other predicates).
+func (f *ProjectFilter) WhereHasBuildProfilesWith(preds ...predicate.BuildProfile) {
+	f.Where(entql.HasEdgeWith("build_profiles", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (psq *ProjectSongQuery) addPredicate(pred func(s *sql.Selector)) {
 	psq.predicates = append(psq.predicates, pred)
@@ -246,7 +674,7 @@ type ProjectSongFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ProjectSongFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -272,6 +700,26 @@ func (f *ProjectSongFilter) WhereComment(p entql.StringP) {
 	f.Where(p.Field(projectsong.FieldComment))
 }
 
+// WherePosition applies the entql int predicate on the position field.
+func (f *ProjectSongFilter) WherePosition(p entql.IntP) {
+	f.Where(p.Field(projectsong.FieldPosition))
+}
+
+// WhereNumber applies the entql int predicate on the number field.
+func (f *ProjectSongFilter) WhereNumber(p entql.IntP) {
+	f.Where(p.Field(projectsong.FieldNumber))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ProjectSongFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(projectsong.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *ProjectSongFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(projectsong.FieldUpdatedAt))
+}
+
 // WhereProjectID applies the entql int predicate on the project_id field.
 func (f *ProjectSongFilter) WhereProjectID(p entql.IntP) {
 	f.Where(p.Field(projectsong.FieldProjectID))
@@ -339,7 +787,7 @@ type SettingFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *SettingFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -389,7 +837,7 @@ type SongFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *SongFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -438,3 +886,73 @@ func (f *SongFilter) WhereHasProjectSongsWith(preds ...predicate.ProjectSong) {
 		}
 	})))
 }
+
+// addPredicate implements the predicateAdder interface.
+func (tq *TemplateQuery) addPredicate(pred func(s *sql.Selector)) {
+	tq.predicates = append(tq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TemplateQuery builder.
+func (tq *TemplateQuery) Filter() *TemplateFilter {
+	return &TemplateFilter{config: tq.config, predicateAdder: tq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TemplateMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TemplateMutation builder.
+func (m *TemplateMutation) Filter() *TemplateFilter {
+	return &TemplateFilter{config: m.config, predicateAdder: m}
+}
+
+// TemplateFilter provides a generic filtering capability at runtime for TemplateQuery.
+type TemplateFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TemplateFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *TemplateFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(template.FieldID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *TemplateFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(template.FieldName))
+}
+
+// WhereKind applies the entql string predicate on the kind field.
+func (f *TemplateFilter) WhereKind(p entql.StringP) {
+	f.Where(p.Field(template.FieldKind))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *TemplateFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(template.FieldDescription))
+}
+
+// WhereContent applies the entql string predicate on the content field.
+func (f *TemplateFilter) WhereContent(p entql.StringP) {
+	f.Where(p.Field(template.FieldContent))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TemplateFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(template.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TemplateFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(template.FieldUpdatedAt))
+}
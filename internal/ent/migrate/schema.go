@@ -3,11 +3,97 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// BuildsColumns holds the columns for the "builds" table.
+	BuildsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "build_id", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "queued", "running", "completed", "completed_with_errors", "failed", "cancelled", "interrupted"}, Default: "pending"},
+		{Name: "origin", Type: field.TypeEnum, Enums: []string{"server", "cli"}, Default: "server"},
+		{Name: "progress", Type: field.TypeInt, Default: 0},
+		{Name: "message", Type: field.TypeString, Nullable: true},
+		{Name: "output_dir", Type: field.TypeString, Nullable: true},
+		{Name: "build_dir", Type: field.TypeString, Nullable: true},
+		{Name: "abc_file_dir", Type: field.TypeString, Nullable: true},
+		{Name: "priority_threshold", Type: field.TypeInt, Nullable: true},
+		{Name: "sample_id", Type: field.TypeString, Nullable: true},
+		{Name: "incremental", Type: field.TypeBool, Default: false},
+		{Name: "profile", Type: field.TypeString, Nullable: true},
+		{Name: "song_order", Type: field.TypeString, Nullable: true},
+		{Name: "supplement_since", Type: field.TypeString, Nullable: true},
+		{Name: "final", Type: field.TypeBool, Default: false},
+		{Name: "generated_files", Type: field.TypeJSON, Nullable: true},
+		{Name: "report", Type: field.TypeJSON, Nullable: true},
+		{Name: "manifest", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// BuildsTable holds the schema information for the "builds" table.
+	BuildsTable = &schema.Table{
+		Name:       "builds",
+		Columns:    BuildsColumns,
+		PrimaryKey: []*schema.Column{BuildsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "builds_projects_builds",
+				Columns:    []*schema.Column{BuildsColumns[22]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "build_project_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{BuildsColumns[22], BuildsColumns[20]},
+			},
+			{
+				Name:    "build_status",
+				Unique:  false,
+				Columns: []*schema.Column{BuildsColumns[2]},
+			},
+		},
+	}
+	// BuildProfilesColumns holds the columns for the "build_profiles" table.
+	BuildProfilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "output_dir", Type: field.TypeString, Nullable: true},
+		{Name: "abc_file_dir", Type: field.TypeString, Nullable: true},
+		{Name: "priority_threshold", Type: field.TypeInt, Nullable: true},
+		{Name: "sample_id", Type: field.TypeString, Nullable: true},
+		{Name: "config", Type: field.TypeJSON, Nullable: true},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// BuildProfilesTable holds the schema information for the "build_profiles" table.
+	BuildProfilesTable = &schema.Table{
+		Name:       "build_profiles",
+		Columns:    BuildProfilesColumns,
+		PrimaryKey: []*schema.Column{BuildProfilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "build_profiles_projects_build_profiles",
+				Columns:    []*schema.Column{BuildProfilesColumns[8]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "buildprofile_project_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{BuildProfilesColumns[8], BuildProfilesColumns[1]},
+			},
+		},
+	}
 	// ProjectsColumns holds the columns for the "projects" table.
 	ProjectsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -15,6 +101,8 @@ var (
 		{Name: "short_name", Type: field.TypeString},
 		{Name: "config", Type: field.TypeJSON, Nullable: true},
 		{Name: "abc_file_dir_preference", Type: field.TypeString, Nullable: true},
+		{Name: "variables", Type: field.TypeJSON, Nullable: true},
+		{Name: "reserved_numbers", Type: field.TypeJSON, Nullable: true},
 	}
 	// ProjectsTable holds the schema information for the "projects" table.
 	ProjectsTable = &schema.Table{
@@ -28,6 +116,10 @@ var (
 		{Name: "priority", Type: field.TypeInt},
 		{Name: "difficulty", Type: field.TypeEnum, Enums: []string{"easy", "medium", "hard", "expert"}, Default: "medium"},
 		{Name: "comment", Type: field.TypeString, Nullable: true},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "number", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "project_project_songs", Type: field.TypeInt, Nullable: true},
 		{Name: "project_id", Type: field.TypeInt},
 		{Name: "song_id", Type: field.TypeInt},
@@ -40,19 +132,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "project_songs_projects_project_songs",
-				Columns:    []*schema.Column{ProjectSongsColumns[4]},
+				Columns:    []*schema.Column{ProjectSongsColumns[8]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "project_songs_projects_project",
-				Columns:    []*schema.Column{ProjectSongsColumns[5]},
+				Columns:    []*schema.Column{ProjectSongsColumns[9]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "project_songs_songs_song",
-				Columns:    []*schema.Column{ProjectSongsColumns[6]},
+				Columns:    []*schema.Column{ProjectSongsColumns[10]},
 				RefColumns: []*schema.Column{SongsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -61,7 +153,15 @@ var (
 			{
 				Name:    "projectsong_project_id_song_id",
 				Unique:  true,
-				Columns: []*schema.Column{ProjectSongsColumns[5], ProjectSongsColumns[6]},
+				Columns: []*schema.Column{ProjectSongsColumns[9], ProjectSongsColumns[10]},
+			},
+			{
+				Name:    "projectsong_project_id_number",
+				Unique:  true,
+				Columns: []*schema.Column{ProjectSongsColumns[9], ProjectSongsColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "number > 0",
+				},
 			},
 		},
 	}
@@ -109,16 +209,44 @@ var (
 			},
 		},
 	}
+	// TemplatesColumns holds the columns for the "templates" table.
+	TemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TemplatesTable holds the schema information for the "templates" table.
+	TemplatesTable = &schema.Table{
+		Name:       "templates",
+		Columns:    TemplatesColumns,
+		PrimaryKey: []*schema.Column{TemplatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "template_kind_name",
+				Unique:  true,
+				Columns: []*schema.Column{TemplatesColumns[2], TemplatesColumns[1]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BuildsTable,
+		BuildProfilesTable,
 		ProjectsTable,
 		ProjectSongsTable,
 		SettingsTable,
 		SongsTable,
+		TemplatesTable,
 	}
 )
 
 func init() {
+	BuildsTable.ForeignKeys[0].RefTable = ProjectsTable
+	BuildProfilesTable.ForeignKeys[0].RefTable = ProjectsTable
 	ProjectSongsTable.ForeignKeys[0].RefTable = ProjectsTable
 	ProjectSongsTable.ForeignKeys[1].RefTable = ProjectsTable
 	ProjectSongsTable.ForeignKeys[2].RefTable = SongsTable
@@ -7,14 +7,19 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/bwl21/zupfmanager/internal/buildreport"
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+	"github.com/bwl21/zupfmanager/internal/ent/buildprofile"
 	"github.com/bwl21/zupfmanager/internal/ent/predicate"
 	"github.com/bwl21/zupfmanager/internal/ent/project"
 	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
 	"github.com/bwl21/zupfmanager/internal/ent/setting"
 	"github.com/bwl21/zupfmanager/internal/ent/song"
+	"github.com/bwl21/zupfmanager/internal/ent/template"
 )
 
 const (
@@ -26,42 +31,64 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeProject     = "Project"
-	TypeProjectSong = "ProjectSong"
-	TypeSetting     = "Setting"
-	TypeSong        = "Song"
+	TypeBuild        = "Build"
+	TypeBuildProfile = "BuildProfile"
+	TypeProject      = "Project"
+	TypeProjectSong  = "ProjectSong"
+	TypeSetting      = "Setting"
+	TypeSong         = "Song"
+	TypeTemplate     = "Template"
 )
 
-// ProjectMutation represents an operation that mutates the Project nodes in the graph.
-type ProjectMutation struct {
+// BuildMutation represents an operation that mutates the Build nodes in the graph.
+type BuildMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	title                   *string
-	short_name              *string
-	_config                 *map[string]interface{}
-	abc_file_dir_preference *string
-	clearedFields           map[string]struct{}
-	project_songs           map[int]struct{}
-	removedproject_songs    map[int]struct{}
-	clearedproject_songs    bool
-	done                    bool
-	oldValue                func(context.Context) (*Project, error)
-	predicates              []predicate.Project
-}
-
-var _ ent.Mutation = (*ProjectMutation)(nil)
-
-// projectOption allows management of the mutation configuration using functional options.
-type projectOption func(*ProjectMutation)
-
-// newProjectMutation creates new mutation for the Project entity.
-func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
-	m := &ProjectMutation{
+	op                    Op
+	typ                   string
+	id                    *int
+	build_id              *string
+	status                *build.Status
+	origin                *build.Origin
+	progress              *int
+	addprogress           *int
+	message               *string
+	output_dir            *string
+	build_dir             *string
+	abc_file_dir          *string
+	priority_threshold    *int
+	addpriority_threshold *int
+	sample_id             *string
+	incremental           *bool
+	profile               *string
+	song_order            *string
+	supplement_since      *string
+	final                 *bool
+	generated_files       *[]string
+	appendgenerated_files []string
+	report                **buildreport.Report
+	manifest              **buildreport.Manifest
+	error                 *string
+	started_at            *time.Time
+	completed_at          *time.Time
+	clearedFields         map[string]struct{}
+	project               *int
+	clearedproject        bool
+	done                  bool
+	oldValue              func(context.Context) (*Build, error)
+	predicates            []predicate.Build
+}
+
+var _ ent.Mutation = (*BuildMutation)(nil)
+
+// buildOption allows management of the mutation configuration using functional options.
+type buildOption func(*BuildMutation)
+
+// newBuildMutation creates new mutation for the Build entity.
+func newBuildMutation(c config, op Op, opts ...buildOption) *BuildMutation {
+	m := &BuildMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProject,
+		typ:           TypeBuild,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -70,20 +97,20 @@ func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation
 	return m
 }
 
-// withProjectID sets the ID field of the mutation.
-func withProjectID(id int) projectOption {
-	return func(m *ProjectMutation) {
+// withBuildID sets the ID field of the mutation.
+func withBuildID(id int) buildOption {
+	return func(m *BuildMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Project
+			value *Build
 		)
-		m.oldValue = func(ctx context.Context) (*Project, error) {
+		m.oldValue = func(ctx context.Context) (*Build, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Project.Get(ctx, id)
+					value, err = m.Client().Build.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -92,10 +119,10 @@ func withProjectID(id int) projectOption {
 	}
 }
 
-// withProject sets the old Project of the mutation.
-func withProject(node *Project) projectOption {
-	return func(m *ProjectMutation) {
-		m.oldValue = func(context.Context) (*Project, error) {
+// withBuild sets the old Build of the mutation.
+func withBuild(node *Build) buildOption {
+	return func(m *BuildMutation) {
+		m.oldValue = func(context.Context) (*Build, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -104,7 +131,7 @@ func withProject(node *Project) projectOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProjectMutation) Client() *Client {
+func (m BuildMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -112,7 +139,7 @@ func (m ProjectMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProjectMutation) Tx() (*Tx, error) {
+func (m BuildMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -122,14 +149,14 @@ func (m ProjectMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Project entities.
-func (m *ProjectMutation) SetID(id int) {
+// operation is only accepted on creation of Build entities.
+func (m *BuildMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProjectMutation) ID() (id int, exists bool) {
+func (m *BuildMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -140,7 +167,7 @@ func (m *ProjectMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BuildMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -149,299 +176,4297 @@ func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Build.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *ProjectMutation) SetTitle(s string) {
-	m.title = &s
+// SetBuildID sets the "build_id" field.
+func (m *BuildMutation) SetBuildID(s string) {
+	m.build_id = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ProjectMutation) Title() (r string, exists bool) {
-	v := m.title
+// BuildID returns the value of the "build_id" field in the mutation.
+func (m *BuildMutation) BuildID() (r string, exists bool) {
+	v := m.build_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldBuildID returns the old "build_id" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *BuildMutation) OldBuildID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldBuildID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldBuildID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldBuildID: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.BuildID, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ProjectMutation) ResetTitle() {
-	m.title = nil
+// ResetBuildID resets all changes to the "build_id" field.
+func (m *BuildMutation) ResetBuildID() {
+	m.build_id = nil
 }
 
-// SetShortName sets the "short_name" field.
-func (m *ProjectMutation) SetShortName(s string) {
-	m.short_name = &s
+// SetProjectID sets the "project_id" field.
+func (m *BuildMutation) SetProjectID(i int) {
+	m.project = &i
 }
 
-// ShortName returns the value of the "short_name" field in the mutation.
-func (m *ProjectMutation) ShortName() (r string, exists bool) {
-	v := m.short_name
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *BuildMutation) ProjectID() (r int, exists bool) {
+	v := m.project
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldShortName returns the old "short_name" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldProjectID returns the old "project_id" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldShortName(ctx context.Context) (v string, err error) {
+func (m *BuildMutation) OldProjectID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShortName is only allowed on UpdateOne operations")
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShortName requires an ID field in the mutation")
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShortName: %w", err)
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
 	}
-	return oldValue.ShortName, nil
+	return oldValue.ProjectID, nil
 }
 
-// ResetShortName resets all changes to the "short_name" field.
-func (m *ProjectMutation) ResetShortName() {
-	m.short_name = nil
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *BuildMutation) ResetProjectID() {
+	m.project = nil
 }
 
-// SetConfig sets the "config" field.
-func (m *ProjectMutation) SetConfig(value map[string]interface{}) {
-	m._config = &value
+// SetStatus sets the "status" field.
+func (m *BuildMutation) SetStatus(b build.Status) {
+	m.status = &b
 }
 
-// Config returns the value of the "config" field in the mutation.
-func (m *ProjectMutation) Config() (r map[string]interface{}, exists bool) {
-	v := m._config
+// Status returns the value of the "status" field in the mutation.
+func (m *BuildMutation) Status() (r build.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfig returns the old "config" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldConfig(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *BuildMutation) OldStatus(ctx context.Context) (v build.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfig requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Config, nil
-}
-
-// ClearConfig clears the value of the "config" field.
-func (m *ProjectMutation) ClearConfig() {
-	m._config = nil
-	m.clearedFields[project.FieldConfig] = struct{}{}
-}
-
-// ConfigCleared returns if the "config" field was cleared in this mutation.
-func (m *ProjectMutation) ConfigCleared() bool {
-	_, ok := m.clearedFields[project.FieldConfig]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetConfig resets all changes to the "config" field.
-func (m *ProjectMutation) ResetConfig() {
-	m._config = nil
-	delete(m.clearedFields, project.FieldConfig)
+// ResetStatus resets all changes to the "status" field.
+func (m *BuildMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetAbcFileDirPreference sets the "abc_file_dir_preference" field.
-func (m *ProjectMutation) SetAbcFileDirPreference(s string) {
-	m.abc_file_dir_preference = &s
+// SetOrigin sets the "origin" field.
+func (m *BuildMutation) SetOrigin(b build.Origin) {
+	m.origin = &b
 }
 
-// AbcFileDirPreference returns the value of the "abc_file_dir_preference" field in the mutation.
-func (m *ProjectMutation) AbcFileDirPreference() (r string, exists bool) {
-	v := m.abc_file_dir_preference
+// Origin returns the value of the "origin" field in the mutation.
+func (m *BuildMutation) Origin() (r build.Origin, exists bool) {
+	v := m.origin
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAbcFileDirPreference returns the old "abc_file_dir_preference" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldOrigin returns the old "origin" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldAbcFileDirPreference(ctx context.Context) (v string, err error) {
+func (m *BuildMutation) OldOrigin(ctx context.Context) (v build.Origin, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAbcFileDirPreference is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAbcFileDirPreference requires an ID field in the mutation")
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAbcFileDirPreference: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
 	}
-	return oldValue.AbcFileDirPreference, nil
+	return oldValue.Origin, nil
 }
 
-// ClearAbcFileDirPreference clears the value of the "abc_file_dir_preference" field.
-func (m *ProjectMutation) ClearAbcFileDirPreference() {
-	m.abc_file_dir_preference = nil
-	m.clearedFields[project.FieldAbcFileDirPreference] = struct{}{}
+// ResetOrigin resets all changes to the "origin" field.
+func (m *BuildMutation) ResetOrigin() {
+	m.origin = nil
 }
 
-// AbcFileDirPreferenceCleared returns if the "abc_file_dir_preference" field was cleared in this mutation.
-func (m *ProjectMutation) AbcFileDirPreferenceCleared() bool {
-	_, ok := m.clearedFields[project.FieldAbcFileDirPreference]
-	return ok
+// SetProgress sets the "progress" field.
+func (m *BuildMutation) SetProgress(i int) {
+	m.progress = &i
+	m.addprogress = nil
 }
 
-// ResetAbcFileDirPreference resets all changes to the "abc_file_dir_preference" field.
-func (m *ProjectMutation) ResetAbcFileDirPreference() {
-	m.abc_file_dir_preference = nil
-	delete(m.clearedFields, project.FieldAbcFileDirPreference)
+// Progress returns the value of the "progress" field in the mutation.
+func (m *BuildMutation) Progress() (r int, exists bool) {
+	v := m.progress
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddProjectSongIDs adds the "project_songs" edge to the ProjectSong entity by ids.
-func (m *ProjectMutation) AddProjectSongIDs(ids ...int) {
-	if m.project_songs == nil {
-		m.project_songs = make(map[int]struct{})
+// OldProgress returns the old "progress" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldProgress(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.project_songs[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProgress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
 	}
+	return oldValue.Progress, nil
 }
 
-// ClearProjectSongs clears the "project_songs" edge to the ProjectSong entity.
-func (m *ProjectMutation) ClearProjectSongs() {
-	m.clearedproject_songs = true
+// AddProgress adds i to the "progress" field.
+func (m *BuildMutation) AddProgress(i int) {
+	if m.addprogress != nil {
+		*m.addprogress += i
+	} else {
+		m.addprogress = &i
+	}
 }
 
-// ProjectSongsCleared reports if the "project_songs" edge to the ProjectSong entity was cleared.
-func (m *ProjectMutation) ProjectSongsCleared() bool {
-	return m.clearedproject_songs
+// AddedProgress returns the value that was added to the "progress" field in this mutation.
+func (m *BuildMutation) AddedProgress() (r int, exists bool) {
+	v := m.addprogress
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveProjectSongIDs removes the "project_songs" edge to the ProjectSong entity by IDs.
-func (m *ProjectMutation) RemoveProjectSongIDs(ids ...int) {
-	if m.removedproject_songs == nil {
-		m.removedproject_songs = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.project_songs, ids[i])
-		m.removedproject_songs[ids[i]] = struct{}{}
-	}
+// ResetProgress resets all changes to the "progress" field.
+func (m *BuildMutation) ResetProgress() {
+	m.progress = nil
+	m.addprogress = nil
 }
 
-// RemovedProjectSongs returns the removed IDs of the "project_songs" edge to the ProjectSong entity.
-func (m *ProjectMutation) RemovedProjectSongsIDs() (ids []int) {
-	for id := range m.removedproject_songs {
-		ids = append(ids, id)
-	}
-	return
+// SetMessage sets the "message" field.
+func (m *BuildMutation) SetMessage(s string) {
+	m.message = &s
 }
 
-// ProjectSongsIDs returns the "project_songs" edge IDs in the mutation.
-func (m *ProjectMutation) ProjectSongsIDs() (ids []int) {
-	for id := range m.project_songs {
-		ids = append(ids, id)
+// Message returns the value of the "message" field in the mutation.
+func (m *BuildMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetProjectSongs resets all changes to the "project_songs" edge.
-func (m *ProjectMutation) ResetProjectSongs() {
-	m.project_songs = nil
-	m.clearedproject_songs = false
-	m.removedproject_songs = nil
+// OldMessage returns the old "message" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
 }
 
-// Where appends a list predicates to the ProjectMutation builder.
-func (m *ProjectMutation) Where(ps ...predicate.Project) {
-	m.predicates = append(m.predicates, ps...)
+// ClearMessage clears the value of the "message" field.
+func (m *BuildMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[build.FieldMessage] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Project, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *BuildMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[build.FieldMessage]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *ProjectMutation) Op() Op {
-	return m.op
+// ResetMessage resets all changes to the "message" field.
+func (m *BuildMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, build.FieldMessage)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ProjectMutation) SetOp(op Op) {
-	m.op = op
+// SetOutputDir sets the "output_dir" field.
+func (m *BuildMutation) SetOutputDir(s string) {
+	m.output_dir = &s
 }
 
-// Type returns the node type of this mutation (Project).
-func (m *ProjectMutation) Type() string {
-	return m.typ
+// OutputDir returns the value of the "output_dir" field in the mutation.
+func (m *BuildMutation) OutputDir() (r string, exists bool) {
+	v := m.output_dir
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ProjectMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.title != nil {
-		fields = append(fields, project.FieldTitle)
-	}
-	if m.short_name != nil {
-		fields = append(fields, project.FieldShortName)
+// OldOutputDir returns the old "output_dir" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldOutputDir(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputDir is only allowed on UpdateOne operations")
 	}
-	if m._config != nil {
-		fields = append(fields, project.FieldConfig)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputDir requires an ID field in the mutation")
 	}
-	if m.abc_file_dir_preference != nil {
-		fields = append(fields, project.FieldAbcFileDirPreference)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputDir: %w", err)
 	}
-	return fields
+	return oldValue.OutputDir, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case project.FieldTitle:
-		return m.Title()
-	case project.FieldShortName:
+// ClearOutputDir clears the value of the "output_dir" field.
+func (m *BuildMutation) ClearOutputDir() {
+	m.output_dir = nil
+	m.clearedFields[build.FieldOutputDir] = struct{}{}
+}
+
+// OutputDirCleared returns if the "output_dir" field was cleared in this mutation.
+func (m *BuildMutation) OutputDirCleared() bool {
+	_, ok := m.clearedFields[build.FieldOutputDir]
+	return ok
+}
+
+// ResetOutputDir resets all changes to the "output_dir" field.
+func (m *BuildMutation) ResetOutputDir() {
+	m.output_dir = nil
+	delete(m.clearedFields, build.FieldOutputDir)
+}
+
+// SetBuildDir sets the "build_dir" field.
+func (m *BuildMutation) SetBuildDir(s string) {
+	m.build_dir = &s
+}
+
+// BuildDir returns the value of the "build_dir" field in the mutation.
+func (m *BuildMutation) BuildDir() (r string, exists bool) {
+	v := m.build_dir
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildDir returns the old "build_dir" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldBuildDir(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildDir is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildDir requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildDir: %w", err)
+	}
+	return oldValue.BuildDir, nil
+}
+
+// ClearBuildDir clears the value of the "build_dir" field.
+func (m *BuildMutation) ClearBuildDir() {
+	m.build_dir = nil
+	m.clearedFields[build.FieldBuildDir] = struct{}{}
+}
+
+// BuildDirCleared returns if the "build_dir" field was cleared in this mutation.
+func (m *BuildMutation) BuildDirCleared() bool {
+	_, ok := m.clearedFields[build.FieldBuildDir]
+	return ok
+}
+
+// ResetBuildDir resets all changes to the "build_dir" field.
+func (m *BuildMutation) ResetBuildDir() {
+	m.build_dir = nil
+	delete(m.clearedFields, build.FieldBuildDir)
+}
+
+// SetAbcFileDir sets the "abc_file_dir" field.
+func (m *BuildMutation) SetAbcFileDir(s string) {
+	m.abc_file_dir = &s
+}
+
+// AbcFileDir returns the value of the "abc_file_dir" field in the mutation.
+func (m *BuildMutation) AbcFileDir() (r string, exists bool) {
+	v := m.abc_file_dir
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAbcFileDir returns the old "abc_file_dir" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldAbcFileDir(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAbcFileDir is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAbcFileDir requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAbcFileDir: %w", err)
+	}
+	return oldValue.AbcFileDir, nil
+}
+
+// ClearAbcFileDir clears the value of the "abc_file_dir" field.
+func (m *BuildMutation) ClearAbcFileDir() {
+	m.abc_file_dir = nil
+	m.clearedFields[build.FieldAbcFileDir] = struct{}{}
+}
+
+// AbcFileDirCleared returns if the "abc_file_dir" field was cleared in this mutation.
+func (m *BuildMutation) AbcFileDirCleared() bool {
+	_, ok := m.clearedFields[build.FieldAbcFileDir]
+	return ok
+}
+
+// ResetAbcFileDir resets all changes to the "abc_file_dir" field.
+func (m *BuildMutation) ResetAbcFileDir() {
+	m.abc_file_dir = nil
+	delete(m.clearedFields, build.FieldAbcFileDir)
+}
+
+// SetPriorityThreshold sets the "priority_threshold" field.
+func (m *BuildMutation) SetPriorityThreshold(i int) {
+	m.priority_threshold = &i
+	m.addpriority_threshold = nil
+}
+
+// PriorityThreshold returns the value of the "priority_threshold" field in the mutation.
+func (m *BuildMutation) PriorityThreshold() (r int, exists bool) {
+	v := m.priority_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriorityThreshold returns the old "priority_threshold" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldPriorityThreshold(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriorityThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriorityThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriorityThreshold: %w", err)
+	}
+	return oldValue.PriorityThreshold, nil
+}
+
+// AddPriorityThreshold adds i to the "priority_threshold" field.
+func (m *BuildMutation) AddPriorityThreshold(i int) {
+	if m.addpriority_threshold != nil {
+		*m.addpriority_threshold += i
+	} else {
+		m.addpriority_threshold = &i
+	}
+}
+
+// AddedPriorityThreshold returns the value that was added to the "priority_threshold" field in this mutation.
+func (m *BuildMutation) AddedPriorityThreshold() (r int, exists bool) {
+	v := m.addpriority_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPriorityThreshold clears the value of the "priority_threshold" field.
+func (m *BuildMutation) ClearPriorityThreshold() {
+	m.priority_threshold = nil
+	m.addpriority_threshold = nil
+	m.clearedFields[build.FieldPriorityThreshold] = struct{}{}
+}
+
+// PriorityThresholdCleared returns if the "priority_threshold" field was cleared in this mutation.
+func (m *BuildMutation) PriorityThresholdCleared() bool {
+	_, ok := m.clearedFields[build.FieldPriorityThreshold]
+	return ok
+}
+
+// ResetPriorityThreshold resets all changes to the "priority_threshold" field.
+func (m *BuildMutation) ResetPriorityThreshold() {
+	m.priority_threshold = nil
+	m.addpriority_threshold = nil
+	delete(m.clearedFields, build.FieldPriorityThreshold)
+}
+
+// SetSampleID sets the "sample_id" field.
+func (m *BuildMutation) SetSampleID(s string) {
+	m.sample_id = &s
+}
+
+// SampleID returns the value of the "sample_id" field in the mutation.
+func (m *BuildMutation) SampleID() (r string, exists bool) {
+	v := m.sample_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSampleID returns the old "sample_id" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldSampleID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSampleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSampleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSampleID: %w", err)
+	}
+	return oldValue.SampleID, nil
+}
+
+// ClearSampleID clears the value of the "sample_id" field.
+func (m *BuildMutation) ClearSampleID() {
+	m.sample_id = nil
+	m.clearedFields[build.FieldSampleID] = struct{}{}
+}
+
+// SampleIDCleared returns if the "sample_id" field was cleared in this mutation.
+func (m *BuildMutation) SampleIDCleared() bool {
+	_, ok := m.clearedFields[build.FieldSampleID]
+	return ok
+}
+
+// ResetSampleID resets all changes to the "sample_id" field.
+func (m *BuildMutation) ResetSampleID() {
+	m.sample_id = nil
+	delete(m.clearedFields, build.FieldSampleID)
+}
+
+// SetIncremental sets the "incremental" field.
+func (m *BuildMutation) SetIncremental(b bool) {
+	m.incremental = &b
+}
+
+// Incremental returns the value of the "incremental" field in the mutation.
+func (m *BuildMutation) Incremental() (r bool, exists bool) {
+	v := m.incremental
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIncremental returns the old "incremental" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldIncremental(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIncremental is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIncremental requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIncremental: %w", err)
+	}
+	return oldValue.Incremental, nil
+}
+
+// ResetIncremental resets all changes to the "incremental" field.
+func (m *BuildMutation) ResetIncremental() {
+	m.incremental = nil
+}
+
+// SetProfile sets the "profile" field.
+func (m *BuildMutation) SetProfile(s string) {
+	m.profile = &s
+}
+
+// Profile returns the value of the "profile" field in the mutation.
+func (m *BuildMutation) Profile() (r string, exists bool) {
+	v := m.profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfile returns the old "profile" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldProfile(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfile: %w", err)
+	}
+	return oldValue.Profile, nil
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (m *BuildMutation) ClearProfile() {
+	m.profile = nil
+	m.clearedFields[build.FieldProfile] = struct{}{}
+}
+
+// ProfileCleared returns if the "profile" field was cleared in this mutation.
+func (m *BuildMutation) ProfileCleared() bool {
+	_, ok := m.clearedFields[build.FieldProfile]
+	return ok
+}
+
+// ResetProfile resets all changes to the "profile" field.
+func (m *BuildMutation) ResetProfile() {
+	m.profile = nil
+	delete(m.clearedFields, build.FieldProfile)
+}
+
+// SetSongOrder sets the "song_order" field.
+func (m *BuildMutation) SetSongOrder(s string) {
+	m.song_order = &s
+}
+
+// SongOrder returns the value of the "song_order" field in the mutation.
+func (m *BuildMutation) SongOrder() (r string, exists bool) {
+	v := m.song_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSongOrder returns the old "song_order" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldSongOrder(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSongOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSongOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSongOrder: %w", err)
+	}
+	return oldValue.SongOrder, nil
+}
+
+// ClearSongOrder clears the value of the "song_order" field.
+func (m *BuildMutation) ClearSongOrder() {
+	m.song_order = nil
+	m.clearedFields[build.FieldSongOrder] = struct{}{}
+}
+
+// SongOrderCleared returns if the "song_order" field was cleared in this mutation.
+func (m *BuildMutation) SongOrderCleared() bool {
+	_, ok := m.clearedFields[build.FieldSongOrder]
+	return ok
+}
+
+// ResetSongOrder resets all changes to the "song_order" field.
+func (m *BuildMutation) ResetSongOrder() {
+	m.song_order = nil
+	delete(m.clearedFields, build.FieldSongOrder)
+}
+
+// SetSupplementSince sets the "supplement_since" field.
+func (m *BuildMutation) SetSupplementSince(s string) {
+	m.supplement_since = &s
+}
+
+// SupplementSince returns the value of the "supplement_since" field in the mutation.
+func (m *BuildMutation) SupplementSince() (r string, exists bool) {
+	v := m.supplement_since
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSupplementSince returns the old "supplement_since" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldSupplementSince(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSupplementSince is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSupplementSince requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSupplementSince: %w", err)
+	}
+	return oldValue.SupplementSince, nil
+}
+
+// ClearSupplementSince clears the value of the "supplement_since" field.
+func (m *BuildMutation) ClearSupplementSince() {
+	m.supplement_since = nil
+	m.clearedFields[build.FieldSupplementSince] = struct{}{}
+}
+
+// SupplementSinceCleared returns if the "supplement_since" field was cleared in this mutation.
+func (m *BuildMutation) SupplementSinceCleared() bool {
+	_, ok := m.clearedFields[build.FieldSupplementSince]
+	return ok
+}
+
+// ResetSupplementSince resets all changes to the "supplement_since" field.
+func (m *BuildMutation) ResetSupplementSince() {
+	m.supplement_since = nil
+	delete(m.clearedFields, build.FieldSupplementSince)
+}
+
+// SetFinal sets the "final" field.
+func (m *BuildMutation) SetFinal(b bool) {
+	m.final = &b
+}
+
+// Final returns the value of the "final" field in the mutation.
+func (m *BuildMutation) Final() (r bool, exists bool) {
+	v := m.final
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinal returns the old "final" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldFinal(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinal: %w", err)
+	}
+	return oldValue.Final, nil
+}
+
+// ResetFinal resets all changes to the "final" field.
+func (m *BuildMutation) ResetFinal() {
+	m.final = nil
+}
+
+// SetGeneratedFiles sets the "generated_files" field.
+func (m *BuildMutation) SetGeneratedFiles(s []string) {
+	m.generated_files = &s
+	m.appendgenerated_files = nil
+}
+
+// GeneratedFiles returns the value of the "generated_files" field in the mutation.
+func (m *BuildMutation) GeneratedFiles() (r []string, exists bool) {
+	v := m.generated_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGeneratedFiles returns the old "generated_files" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldGeneratedFiles(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeneratedFiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeneratedFiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeneratedFiles: %w", err)
+	}
+	return oldValue.GeneratedFiles, nil
+}
+
+// AppendGeneratedFiles adds s to the "generated_files" field.
+func (m *BuildMutation) AppendGeneratedFiles(s []string) {
+	m.appendgenerated_files = append(m.appendgenerated_files, s...)
+}
+
+// AppendedGeneratedFiles returns the list of values that were appended to the "generated_files" field in this mutation.
+func (m *BuildMutation) AppendedGeneratedFiles() ([]string, bool) {
+	if len(m.appendgenerated_files) == 0 {
+		return nil, false
+	}
+	return m.appendgenerated_files, true
+}
+
+// ClearGeneratedFiles clears the value of the "generated_files" field.
+func (m *BuildMutation) ClearGeneratedFiles() {
+	m.generated_files = nil
+	m.appendgenerated_files = nil
+	m.clearedFields[build.FieldGeneratedFiles] = struct{}{}
+}
+
+// GeneratedFilesCleared returns if the "generated_files" field was cleared in this mutation.
+func (m *BuildMutation) GeneratedFilesCleared() bool {
+	_, ok := m.clearedFields[build.FieldGeneratedFiles]
+	return ok
+}
+
+// ResetGeneratedFiles resets all changes to the "generated_files" field.
+func (m *BuildMutation) ResetGeneratedFiles() {
+	m.generated_files = nil
+	m.appendgenerated_files = nil
+	delete(m.clearedFields, build.FieldGeneratedFiles)
+}
+
+// SetReport sets the "report" field.
+func (m *BuildMutation) SetReport(b *buildreport.Report) {
+	m.report = &b
+}
+
+// Report returns the value of the "report" field in the mutation.
+func (m *BuildMutation) Report() (r *buildreport.Report, exists bool) {
+	v := m.report
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReport returns the old "report" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldReport(ctx context.Context) (v *buildreport.Report, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReport is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReport requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReport: %w", err)
+	}
+	return oldValue.Report, nil
+}
+
+// ClearReport clears the value of the "report" field.
+func (m *BuildMutation) ClearReport() {
+	m.report = nil
+	m.clearedFields[build.FieldReport] = struct{}{}
+}
+
+// ReportCleared returns if the "report" field was cleared in this mutation.
+func (m *BuildMutation) ReportCleared() bool {
+	_, ok := m.clearedFields[build.FieldReport]
+	return ok
+}
+
+// ResetReport resets all changes to the "report" field.
+func (m *BuildMutation) ResetReport() {
+	m.report = nil
+	delete(m.clearedFields, build.FieldReport)
+}
+
+// SetManifest sets the "manifest" field.
+func (m *BuildMutation) SetManifest(b *buildreport.Manifest) {
+	m.manifest = &b
+}
+
+// Manifest returns the value of the "manifest" field in the mutation.
+func (m *BuildMutation) Manifest() (r *buildreport.Manifest, exists bool) {
+	v := m.manifest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManifest returns the old "manifest" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldManifest(ctx context.Context) (v *buildreport.Manifest, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManifest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManifest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManifest: %w", err)
+	}
+	return oldValue.Manifest, nil
+}
+
+// ClearManifest clears the value of the "manifest" field.
+func (m *BuildMutation) ClearManifest() {
+	m.manifest = nil
+	m.clearedFields[build.FieldManifest] = struct{}{}
+}
+
+// ManifestCleared returns if the "manifest" field was cleared in this mutation.
+func (m *BuildMutation) ManifestCleared() bool {
+	_, ok := m.clearedFields[build.FieldManifest]
+	return ok
+}
+
+// ResetManifest resets all changes to the "manifest" field.
+func (m *BuildMutation) ResetManifest() {
+	m.manifest = nil
+	delete(m.clearedFields, build.FieldManifest)
+}
+
+// SetError sets the "error" field.
+func (m *BuildMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *BuildMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *BuildMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[build.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *BuildMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[build.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *BuildMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, build.FieldError)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *BuildMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *BuildMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *BuildMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *BuildMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *BuildMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the Build entity.
+// If the Build object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *BuildMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[build.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *BuildMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[build.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *BuildMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, build.FieldCompletedAt)
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (m *BuildMutation) ClearProject() {
+	m.clearedproject = true
+	m.clearedFields[build.FieldProjectID] = struct{}{}
+}
+
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *BuildMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *BuildMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *BuildMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// Where appends a list predicates to the BuildMutation builder.
+func (m *BuildMutation) Where(ps ...predicate.Build) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BuildMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BuildMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Build, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BuildMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BuildMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Build).
+func (m *BuildMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BuildMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.build_id != nil {
+		fields = append(fields, build.FieldBuildID)
+	}
+	if m.project != nil {
+		fields = append(fields, build.FieldProjectID)
+	}
+	if m.status != nil {
+		fields = append(fields, build.FieldStatus)
+	}
+	if m.origin != nil {
+		fields = append(fields, build.FieldOrigin)
+	}
+	if m.progress != nil {
+		fields = append(fields, build.FieldProgress)
+	}
+	if m.message != nil {
+		fields = append(fields, build.FieldMessage)
+	}
+	if m.output_dir != nil {
+		fields = append(fields, build.FieldOutputDir)
+	}
+	if m.build_dir != nil {
+		fields = append(fields, build.FieldBuildDir)
+	}
+	if m.abc_file_dir != nil {
+		fields = append(fields, build.FieldAbcFileDir)
+	}
+	if m.priority_threshold != nil {
+		fields = append(fields, build.FieldPriorityThreshold)
+	}
+	if m.sample_id != nil {
+		fields = append(fields, build.FieldSampleID)
+	}
+	if m.incremental != nil {
+		fields = append(fields, build.FieldIncremental)
+	}
+	if m.profile != nil {
+		fields = append(fields, build.FieldProfile)
+	}
+	if m.song_order != nil {
+		fields = append(fields, build.FieldSongOrder)
+	}
+	if m.supplement_since != nil {
+		fields = append(fields, build.FieldSupplementSince)
+	}
+	if m.final != nil {
+		fields = append(fields, build.FieldFinal)
+	}
+	if m.generated_files != nil {
+		fields = append(fields, build.FieldGeneratedFiles)
+	}
+	if m.report != nil {
+		fields = append(fields, build.FieldReport)
+	}
+	if m.manifest != nil {
+		fields = append(fields, build.FieldManifest)
+	}
+	if m.error != nil {
+		fields = append(fields, build.FieldError)
+	}
+	if m.started_at != nil {
+		fields = append(fields, build.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, build.FieldCompletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BuildMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case build.FieldBuildID:
+		return m.BuildID()
+	case build.FieldProjectID:
+		return m.ProjectID()
+	case build.FieldStatus:
+		return m.Status()
+	case build.FieldOrigin:
+		return m.Origin()
+	case build.FieldProgress:
+		return m.Progress()
+	case build.FieldMessage:
+		return m.Message()
+	case build.FieldOutputDir:
+		return m.OutputDir()
+	case build.FieldBuildDir:
+		return m.BuildDir()
+	case build.FieldAbcFileDir:
+		return m.AbcFileDir()
+	case build.FieldPriorityThreshold:
+		return m.PriorityThreshold()
+	case build.FieldSampleID:
+		return m.SampleID()
+	case build.FieldIncremental:
+		return m.Incremental()
+	case build.FieldProfile:
+		return m.Profile()
+	case build.FieldSongOrder:
+		return m.SongOrder()
+	case build.FieldSupplementSince:
+		return m.SupplementSince()
+	case build.FieldFinal:
+		return m.Final()
+	case build.FieldGeneratedFiles:
+		return m.GeneratedFiles()
+	case build.FieldReport:
+		return m.Report()
+	case build.FieldManifest:
+		return m.Manifest()
+	case build.FieldError:
+		return m.Error()
+	case build.FieldStartedAt:
+		return m.StartedAt()
+	case build.FieldCompletedAt:
+		return m.CompletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BuildMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case build.FieldBuildID:
+		return m.OldBuildID(ctx)
+	case build.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case build.FieldStatus:
+		return m.OldStatus(ctx)
+	case build.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case build.FieldProgress:
+		return m.OldProgress(ctx)
+	case build.FieldMessage:
+		return m.OldMessage(ctx)
+	case build.FieldOutputDir:
+		return m.OldOutputDir(ctx)
+	case build.FieldBuildDir:
+		return m.OldBuildDir(ctx)
+	case build.FieldAbcFileDir:
+		return m.OldAbcFileDir(ctx)
+	case build.FieldPriorityThreshold:
+		return m.OldPriorityThreshold(ctx)
+	case build.FieldSampleID:
+		return m.OldSampleID(ctx)
+	case build.FieldIncremental:
+		return m.OldIncremental(ctx)
+	case build.FieldProfile:
+		return m.OldProfile(ctx)
+	case build.FieldSongOrder:
+		return m.OldSongOrder(ctx)
+	case build.FieldSupplementSince:
+		return m.OldSupplementSince(ctx)
+	case build.FieldFinal:
+		return m.OldFinal(ctx)
+	case build.FieldGeneratedFiles:
+		return m.OldGeneratedFiles(ctx)
+	case build.FieldReport:
+		return m.OldReport(ctx)
+	case build.FieldManifest:
+		return m.OldManifest(ctx)
+	case build.FieldError:
+		return m.OldError(ctx)
+	case build.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case build.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Build field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BuildMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case build.FieldBuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildID(v)
+		return nil
+	case build.FieldProjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case build.FieldStatus:
+		v, ok := value.(build.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case build.FieldOrigin:
+		v, ok := value.(build.Origin)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case build.FieldProgress:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgress(v)
+		return nil
+	case build.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case build.FieldOutputDir:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputDir(v)
+		return nil
+	case build.FieldBuildDir:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildDir(v)
+		return nil
+	case build.FieldAbcFileDir:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAbcFileDir(v)
+		return nil
+	case build.FieldPriorityThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriorityThreshold(v)
+		return nil
+	case build.FieldSampleID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSampleID(v)
+		return nil
+	case build.FieldIncremental:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIncremental(v)
+		return nil
+	case build.FieldProfile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfile(v)
+		return nil
+	case build.FieldSongOrder:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSongOrder(v)
+		return nil
+	case build.FieldSupplementSince:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSupplementSince(v)
+		return nil
+	case build.FieldFinal:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinal(v)
+		return nil
+	case build.FieldGeneratedFiles:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeneratedFiles(v)
+		return nil
+	case build.FieldReport:
+		v, ok := value.(*buildreport.Report)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReport(v)
+		return nil
+	case build.FieldManifest:
+		v, ok := value.(*buildreport.Manifest)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManifest(v)
+		return nil
+	case build.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case build.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case build.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Build field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BuildMutation) AddedFields() []string {
+	var fields []string
+	if m.addprogress != nil {
+		fields = append(fields, build.FieldProgress)
+	}
+	if m.addpriority_threshold != nil {
+		fields = append(fields, build.FieldPriorityThreshold)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BuildMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case build.FieldProgress:
+		return m.AddedProgress()
+	case build.FieldPriorityThreshold:
+		return m.AddedPriorityThreshold()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BuildMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case build.FieldProgress:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProgress(v)
+		return nil
+	case build.FieldPriorityThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriorityThreshold(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Build numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BuildMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(build.FieldMessage) {
+		fields = append(fields, build.FieldMessage)
+	}
+	if m.FieldCleared(build.FieldOutputDir) {
+		fields = append(fields, build.FieldOutputDir)
+	}
+	if m.FieldCleared(build.FieldBuildDir) {
+		fields = append(fields, build.FieldBuildDir)
+	}
+	if m.FieldCleared(build.FieldAbcFileDir) {
+		fields = append(fields, build.FieldAbcFileDir)
+	}
+	if m.FieldCleared(build.FieldPriorityThreshold) {
+		fields = append(fields, build.FieldPriorityThreshold)
+	}
+	if m.FieldCleared(build.FieldSampleID) {
+		fields = append(fields, build.FieldSampleID)
+	}
+	if m.FieldCleared(build.FieldProfile) {
+		fields = append(fields, build.FieldProfile)
+	}
+	if m.FieldCleared(build.FieldSongOrder) {
+		fields = append(fields, build.FieldSongOrder)
+	}
+	if m.FieldCleared(build.FieldSupplementSince) {
+		fields = append(fields, build.FieldSupplementSince)
+	}
+	if m.FieldCleared(build.FieldGeneratedFiles) {
+		fields = append(fields, build.FieldGeneratedFiles)
+	}
+	if m.FieldCleared(build.FieldReport) {
+		fields = append(fields, build.FieldReport)
+	}
+	if m.FieldCleared(build.FieldManifest) {
+		fields = append(fields, build.FieldManifest)
+	}
+	if m.FieldCleared(build.FieldError) {
+		fields = append(fields, build.FieldError)
+	}
+	if m.FieldCleared(build.FieldCompletedAt) {
+		fields = append(fields, build.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BuildMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BuildMutation) ClearField(name string) error {
+	switch name {
+	case build.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case build.FieldOutputDir:
+		m.ClearOutputDir()
+		return nil
+	case build.FieldBuildDir:
+		m.ClearBuildDir()
+		return nil
+	case build.FieldAbcFileDir:
+		m.ClearAbcFileDir()
+		return nil
+	case build.FieldPriorityThreshold:
+		m.ClearPriorityThreshold()
+		return nil
+	case build.FieldSampleID:
+		m.ClearSampleID()
+		return nil
+	case build.FieldProfile:
+		m.ClearProfile()
+		return nil
+	case build.FieldSongOrder:
+		m.ClearSongOrder()
+		return nil
+	case build.FieldSupplementSince:
+		m.ClearSupplementSince()
+		return nil
+	case build.FieldGeneratedFiles:
+		m.ClearGeneratedFiles()
+		return nil
+	case build.FieldReport:
+		m.ClearReport()
+		return nil
+	case build.FieldManifest:
+		m.ClearManifest()
+		return nil
+	case build.FieldError:
+		m.ClearError()
+		return nil
+	case build.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Build nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BuildMutation) ResetField(name string) error {
+	switch name {
+	case build.FieldBuildID:
+		m.ResetBuildID()
+		return nil
+	case build.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case build.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case build.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case build.FieldProgress:
+		m.ResetProgress()
+		return nil
+	case build.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case build.FieldOutputDir:
+		m.ResetOutputDir()
+		return nil
+	case build.FieldBuildDir:
+		m.ResetBuildDir()
+		return nil
+	case build.FieldAbcFileDir:
+		m.ResetAbcFileDir()
+		return nil
+	case build.FieldPriorityThreshold:
+		m.ResetPriorityThreshold()
+		return nil
+	case build.FieldSampleID:
+		m.ResetSampleID()
+		return nil
+	case build.FieldIncremental:
+		m.ResetIncremental()
+		return nil
+	case build.FieldProfile:
+		m.ResetProfile()
+		return nil
+	case build.FieldSongOrder:
+		m.ResetSongOrder()
+		return nil
+	case build.FieldSupplementSince:
+		m.ResetSupplementSince()
+		return nil
+	case build.FieldFinal:
+		m.ResetFinal()
+		return nil
+	case build.FieldGeneratedFiles:
+		m.ResetGeneratedFiles()
+		return nil
+	case build.FieldReport:
+		m.ResetReport()
+		return nil
+	case build.FieldManifest:
+		m.ResetManifest()
+		return nil
+	case build.FieldError:
+		m.ResetError()
+		return nil
+	case build.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case build.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Build field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BuildMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.project != nil {
+		edges = append(edges, build.EdgeProject)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BuildMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case build.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BuildMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BuildMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BuildMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedproject {
+		edges = append(edges, build.EdgeProject)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BuildMutation) EdgeCleared(name string) bool {
+	switch name {
+	case build.EdgeProject:
+		return m.clearedproject
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BuildMutation) ClearEdge(name string) error {
+	switch name {
+	case build.EdgeProject:
+		m.ClearProject()
+		return nil
+	}
+	return fmt.Errorf("unknown Build unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BuildMutation) ResetEdge(name string) error {
+	switch name {
+	case build.EdgeProject:
+		m.ResetProject()
+		return nil
+	}
+	return fmt.Errorf("unknown Build edge %s", name)
+}
+
+// BuildProfileMutation represents an operation that mutates the BuildProfile nodes in the graph.
+type BuildProfileMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	name                  *string
+	output_dir            *string
+	abc_file_dir          *string
+	priority_threshold    *int
+	addpriority_threshold *int
+	sample_id             *string
+	_config               *map[string]interface{}
+	is_default            *bool
+	clearedFields         map[string]struct{}
+	project               *int
+	clearedproject        bool
+	done                  bool
+	oldValue              func(context.Context) (*BuildProfile, error)
+	predicates            []predicate.BuildProfile
+}
+
+var _ ent.Mutation = (*BuildProfileMutation)(nil)
+
+// buildprofileOption allows management of the mutation configuration using functional options.
+type buildprofileOption func(*BuildProfileMutation)
+
+// newBuildProfileMutation creates new mutation for the BuildProfile entity.
+func newBuildProfileMutation(c config, op Op, opts ...buildprofileOption) *BuildProfileMutation {
+	m := &BuildProfileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBuildProfile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBuildProfileID sets the ID field of the mutation.
+func withBuildProfileID(id int) buildprofileOption {
+	return func(m *BuildProfileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BuildProfile
+		)
+		m.oldValue = func(ctx context.Context) (*BuildProfile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BuildProfile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBuildProfile sets the old BuildProfile of the mutation.
+func withBuildProfile(node *BuildProfile) buildprofileOption {
+	return func(m *BuildProfileMutation) {
+		m.oldValue = func(context.Context) (*BuildProfile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BuildProfileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BuildProfileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BuildProfile entities.
+func (m *BuildProfileMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BuildProfileMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BuildProfileMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BuildProfile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *BuildProfileMutation) SetProjectID(i int) {
+	m.project = &i
+}
+
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *BuildProfileMutation) ProjectID() (r int, exists bool) {
+	v := m.project
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectID returns the old "project_id" field's value of the BuildProfile entity.
+// If the BuildProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildProfileMutation) OldProjectID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
+	}
+	return oldValue.ProjectID, nil
+}
+
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *BuildProfileMutation) ResetProjectID() {
+	m.project = nil
+}
+
+// SetName sets the "name" field.
+func (m *BuildProfileMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BuildProfileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BuildProfile entity.
+// If the BuildProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildProfileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BuildProfileMutation) ResetName() {
+	m.name = nil
+}
+
+// SetOutputDir sets the "output_dir" field.
+func (m *BuildProfileMutation) SetOutputDir(s string) {
+	m.output_dir = &s
+}
+
+// OutputDir returns the value of the "output_dir" field in the mutation.
+func (m *BuildProfileMutation) OutputDir() (r string, exists bool) {
+	v := m.output_dir
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputDir returns the old "output_dir" field's value of the BuildProfile entity.
+// If the BuildProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildProfileMutation) OldOutputDir(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputDir is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputDir requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputDir: %w", err)
+	}
+	return oldValue.OutputDir, nil
+}
+
+// ClearOutputDir clears the value of the "output_dir" field.
+func (m *BuildProfileMutation) ClearOutputDir() {
+	m.output_dir = nil
+	m.clearedFields[buildprofile.FieldOutputDir] = struct{}{}
+}
+
+// OutputDirCleared returns if the "output_dir" field was cleared in this mutation.
+func (m *BuildProfileMutation) OutputDirCleared() bool {
+	_, ok := m.clearedFields[buildprofile.FieldOutputDir]
+	return ok
+}
+
+// ResetOutputDir resets all changes to the "output_dir" field.
+func (m *BuildProfileMutation) ResetOutputDir() {
+	m.output_dir = nil
+	delete(m.clearedFields, buildprofile.FieldOutputDir)
+}
+
+// SetAbcFileDir sets the "abc_file_dir" field.
+func (m *BuildProfileMutation) SetAbcFileDir(s string) {
+	m.abc_file_dir = &s
+}
+
+// AbcFileDir returns the value of the "abc_file_dir" field in the mutation.
+func (m *BuildProfileMutation) AbcFileDir() (r string, exists bool) {
+	v := m.abc_file_dir
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAbcFileDir returns the old "abc_file_dir" field's value of the BuildProfile entity.
+// If the BuildProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildProfileMutation) OldAbcFileDir(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAbcFileDir is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAbcFileDir requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAbcFileDir: %w", err)
+	}
+	return oldValue.AbcFileDir, nil
+}
+
+// ClearAbcFileDir clears the value of the "abc_file_dir" field.
+func (m *BuildProfileMutation) ClearAbcFileDir() {
+	m.abc_file_dir = nil
+	m.clearedFields[buildprofile.FieldAbcFileDir] = struct{}{}
+}
+
+// AbcFileDirCleared returns if the "abc_file_dir" field was cleared in this mutation.
+func (m *BuildProfileMutation) AbcFileDirCleared() bool {
+	_, ok := m.clearedFields[buildprofile.FieldAbcFileDir]
+	return ok
+}
+
+// ResetAbcFileDir resets all changes to the "abc_file_dir" field.
+func (m *BuildProfileMutation) ResetAbcFileDir() {
+	m.abc_file_dir = nil
+	delete(m.clearedFields, buildprofile.FieldAbcFileDir)
+}
+
+// SetPriorityThreshold sets the "priority_threshold" field.
+func (m *BuildProfileMutation) SetPriorityThreshold(i int) {
+	m.priority_threshold = &i
+	m.addpriority_threshold = nil
+}
+
+// PriorityThreshold returns the value of the "priority_threshold" field in the mutation.
+func (m *BuildProfileMutation) PriorityThreshold() (r int, exists bool) {
+	v := m.priority_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriorityThreshold returns the old "priority_threshold" field's value of the BuildProfile entity.
+// If the BuildProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildProfileMutation) OldPriorityThreshold(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriorityThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriorityThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriorityThreshold: %w", err)
+	}
+	return oldValue.PriorityThreshold, nil
+}
+
+// AddPriorityThreshold adds i to the "priority_threshold" field.
+func (m *BuildProfileMutation) AddPriorityThreshold(i int) {
+	if m.addpriority_threshold != nil {
+		*m.addpriority_threshold += i
+	} else {
+		m.addpriority_threshold = &i
+	}
+}
+
+// AddedPriorityThreshold returns the value that was added to the "priority_threshold" field in this mutation.
+func (m *BuildProfileMutation) AddedPriorityThreshold() (r int, exists bool) {
+	v := m.addpriority_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPriorityThreshold clears the value of the "priority_threshold" field.
+func (m *BuildProfileMutation) ClearPriorityThreshold() {
+	m.priority_threshold = nil
+	m.addpriority_threshold = nil
+	m.clearedFields[buildprofile.FieldPriorityThreshold] = struct{}{}
+}
+
+// PriorityThresholdCleared returns if the "priority_threshold" field was cleared in this mutation.
+func (m *BuildProfileMutation) PriorityThresholdCleared() bool {
+	_, ok := m.clearedFields[buildprofile.FieldPriorityThreshold]
+	return ok
+}
+
+// ResetPriorityThreshold resets all changes to the "priority_threshold" field.
+func (m *BuildProfileMutation) ResetPriorityThreshold() {
+	m.priority_threshold = nil
+	m.addpriority_threshold = nil
+	delete(m.clearedFields, buildprofile.FieldPriorityThreshold)
+}
+
+// SetSampleID sets the "sample_id" field.
+func (m *BuildProfileMutation) SetSampleID(s string) {
+	m.sample_id = &s
+}
+
+// SampleID returns the value of the "sample_id" field in the mutation.
+func (m *BuildProfileMutation) SampleID() (r string, exists bool) {
+	v := m.sample_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSampleID returns the old "sample_id" field's value of the BuildProfile entity.
+// If the BuildProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildProfileMutation) OldSampleID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSampleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSampleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSampleID: %w", err)
+	}
+	return oldValue.SampleID, nil
+}
+
+// ClearSampleID clears the value of the "sample_id" field.
+func (m *BuildProfileMutation) ClearSampleID() {
+	m.sample_id = nil
+	m.clearedFields[buildprofile.FieldSampleID] = struct{}{}
+}
+
+// SampleIDCleared returns if the "sample_id" field was cleared in this mutation.
+func (m *BuildProfileMutation) SampleIDCleared() bool {
+	_, ok := m.clearedFields[buildprofile.FieldSampleID]
+	return ok
+}
+
+// ResetSampleID resets all changes to the "sample_id" field.
+func (m *BuildProfileMutation) ResetSampleID() {
+	m.sample_id = nil
+	delete(m.clearedFields, buildprofile.FieldSampleID)
+}
+
+// SetConfig sets the "config" field.
+func (m *BuildProfileMutation) SetConfig(value map[string]interface{}) {
+	m._config = &value
+}
+
+// Config returns the value of the "config" field in the mutation.
+func (m *BuildProfileMutation) Config() (r map[string]interface{}, exists bool) {
+	v := m._config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfig returns the old "config" field's value of the BuildProfile entity.
+// If the BuildProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildProfileMutation) OldConfig(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+	}
+	return oldValue.Config, nil
+}
+
+// ClearConfig clears the value of the "config" field.
+func (m *BuildProfileMutation) ClearConfig() {
+	m._config = nil
+	m.clearedFields[buildprofile.FieldConfig] = struct{}{}
+}
+
+// ConfigCleared returns if the "config" field was cleared in this mutation.
+func (m *BuildProfileMutation) ConfigCleared() bool {
+	_, ok := m.clearedFields[buildprofile.FieldConfig]
+	return ok
+}
+
+// ResetConfig resets all changes to the "config" field.
+func (m *BuildProfileMutation) ResetConfig() {
+	m._config = nil
+	delete(m.clearedFields, buildprofile.FieldConfig)
+}
+
+// SetIsDefault sets the "is_default" field.
+func (m *BuildProfileMutation) SetIsDefault(b bool) {
+	m.is_default = &b
+}
+
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *BuildProfileMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "is_default" field's value of the BuildProfile entity.
+// If the BuildProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildProfileMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *BuildProfileMutation) ResetIsDefault() {
+	m.is_default = nil
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (m *BuildProfileMutation) ClearProject() {
+	m.clearedproject = true
+	m.clearedFields[buildprofile.FieldProjectID] = struct{}{}
+}
+
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *BuildProfileMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *BuildProfileMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *BuildProfileMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// Where appends a list predicates to the BuildProfileMutation builder.
+func (m *BuildProfileMutation) Where(ps ...predicate.BuildProfile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BuildProfileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BuildProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BuildProfile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BuildProfileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BuildProfileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BuildProfile).
+func (m *BuildProfileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BuildProfileMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.project != nil {
+		fields = append(fields, buildprofile.FieldProjectID)
+	}
+	if m.name != nil {
+		fields = append(fields, buildprofile.FieldName)
+	}
+	if m.output_dir != nil {
+		fields = append(fields, buildprofile.FieldOutputDir)
+	}
+	if m.abc_file_dir != nil {
+		fields = append(fields, buildprofile.FieldAbcFileDir)
+	}
+	if m.priority_threshold != nil {
+		fields = append(fields, buildprofile.FieldPriorityThreshold)
+	}
+	if m.sample_id != nil {
+		fields = append(fields, buildprofile.FieldSampleID)
+	}
+	if m._config != nil {
+		fields = append(fields, buildprofile.FieldConfig)
+	}
+	if m.is_default != nil {
+		fields = append(fields, buildprofile.FieldIsDefault)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BuildProfileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case buildprofile.FieldProjectID:
+		return m.ProjectID()
+	case buildprofile.FieldName:
+		return m.Name()
+	case buildprofile.FieldOutputDir:
+		return m.OutputDir()
+	case buildprofile.FieldAbcFileDir:
+		return m.AbcFileDir()
+	case buildprofile.FieldPriorityThreshold:
+		return m.PriorityThreshold()
+	case buildprofile.FieldSampleID:
+		return m.SampleID()
+	case buildprofile.FieldConfig:
+		return m.Config()
+	case buildprofile.FieldIsDefault:
+		return m.IsDefault()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BuildProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case buildprofile.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case buildprofile.FieldName:
+		return m.OldName(ctx)
+	case buildprofile.FieldOutputDir:
+		return m.OldOutputDir(ctx)
+	case buildprofile.FieldAbcFileDir:
+		return m.OldAbcFileDir(ctx)
+	case buildprofile.FieldPriorityThreshold:
+		return m.OldPriorityThreshold(ctx)
+	case buildprofile.FieldSampleID:
+		return m.OldSampleID(ctx)
+	case buildprofile.FieldConfig:
+		return m.OldConfig(ctx)
+	case buildprofile.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	}
+	return nil, fmt.Errorf("unknown BuildProfile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BuildProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case buildprofile.FieldProjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case buildprofile.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case buildprofile.FieldOutputDir:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputDir(v)
+		return nil
+	case buildprofile.FieldAbcFileDir:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAbcFileDir(v)
+		return nil
+	case buildprofile.FieldPriorityThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriorityThreshold(v)
+		return nil
+	case buildprofile.FieldSampleID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSampleID(v)
+		return nil
+	case buildprofile.FieldConfig:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfig(v)
+		return nil
+	case buildprofile.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BuildProfile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BuildProfileMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority_threshold != nil {
+		fields = append(fields, buildprofile.FieldPriorityThreshold)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BuildProfileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case buildprofile.FieldPriorityThreshold:
+		return m.AddedPriorityThreshold()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BuildProfileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case buildprofile.FieldPriorityThreshold:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriorityThreshold(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BuildProfile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BuildProfileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(buildprofile.FieldOutputDir) {
+		fields = append(fields, buildprofile.FieldOutputDir)
+	}
+	if m.FieldCleared(buildprofile.FieldAbcFileDir) {
+		fields = append(fields, buildprofile.FieldAbcFileDir)
+	}
+	if m.FieldCleared(buildprofile.FieldPriorityThreshold) {
+		fields = append(fields, buildprofile.FieldPriorityThreshold)
+	}
+	if m.FieldCleared(buildprofile.FieldSampleID) {
+		fields = append(fields, buildprofile.FieldSampleID)
+	}
+	if m.FieldCleared(buildprofile.FieldConfig) {
+		fields = append(fields, buildprofile.FieldConfig)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BuildProfileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BuildProfileMutation) ClearField(name string) error {
+	switch name {
+	case buildprofile.FieldOutputDir:
+		m.ClearOutputDir()
+		return nil
+	case buildprofile.FieldAbcFileDir:
+		m.ClearAbcFileDir()
+		return nil
+	case buildprofile.FieldPriorityThreshold:
+		m.ClearPriorityThreshold()
+		return nil
+	case buildprofile.FieldSampleID:
+		m.ClearSampleID()
+		return nil
+	case buildprofile.FieldConfig:
+		m.ClearConfig()
+		return nil
+	}
+	return fmt.Errorf("unknown BuildProfile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BuildProfileMutation) ResetField(name string) error {
+	switch name {
+	case buildprofile.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case buildprofile.FieldName:
+		m.ResetName()
+		return nil
+	case buildprofile.FieldOutputDir:
+		m.ResetOutputDir()
+		return nil
+	case buildprofile.FieldAbcFileDir:
+		m.ResetAbcFileDir()
+		return nil
+	case buildprofile.FieldPriorityThreshold:
+		m.ResetPriorityThreshold()
+		return nil
+	case buildprofile.FieldSampleID:
+		m.ResetSampleID()
+		return nil
+	case buildprofile.FieldConfig:
+		m.ResetConfig()
+		return nil
+	case buildprofile.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
+	}
+	return fmt.Errorf("unknown BuildProfile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BuildProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.project != nil {
+		edges = append(edges, buildprofile.EdgeProject)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BuildProfileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case buildprofile.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BuildProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BuildProfileMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BuildProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedproject {
+		edges = append(edges, buildprofile.EdgeProject)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BuildProfileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case buildprofile.EdgeProject:
+		return m.clearedproject
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BuildProfileMutation) ClearEdge(name string) error {
+	switch name {
+	case buildprofile.EdgeProject:
+		m.ClearProject()
+		return nil
+	}
+	return fmt.Errorf("unknown BuildProfile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BuildProfileMutation) ResetEdge(name string) error {
+	switch name {
+	case buildprofile.EdgeProject:
+		m.ResetProject()
+		return nil
+	}
+	return fmt.Errorf("unknown BuildProfile edge %s", name)
+}
+
+// ProjectMutation represents an operation that mutates the Project nodes in the graph.
+type ProjectMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	title                   *string
+	short_name              *string
+	_config                 *map[string]interface{}
+	abc_file_dir_preference *string
+	variables               *map[string]string
+	reserved_numbers        *[]int
+	appendreserved_numbers  []int
+	clearedFields           map[string]struct{}
+	project_songs           map[int]struct{}
+	removedproject_songs    map[int]struct{}
+	clearedproject_songs    bool
+	builds                  map[int]struct{}
+	removedbuilds           map[int]struct{}
+	clearedbuilds           bool
+	build_profiles          map[int]struct{}
+	removedbuild_profiles   map[int]struct{}
+	clearedbuild_profiles   bool
+	done                    bool
+	oldValue                func(context.Context) (*Project, error)
+	predicates              []predicate.Project
+}
+
+var _ ent.Mutation = (*ProjectMutation)(nil)
+
+// projectOption allows management of the mutation configuration using functional options.
+type projectOption func(*ProjectMutation)
+
+// newProjectMutation creates new mutation for the Project entity.
+func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
+	m := &ProjectMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProject,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectID sets the ID field of the mutation.
+func withProjectID(id int) projectOption {
+	return func(m *ProjectMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Project
+		)
+		m.oldValue = func(ctx context.Context) (*Project, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Project.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProject sets the old Project of the mutation.
+func withProject(node *Project) projectOption {
+	return func(m *ProjectMutation) {
+		m.oldValue = func(context.Context) (*Project, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Project entities.
+func (m *ProjectMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *ProjectMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ProjectMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ProjectMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetShortName sets the "short_name" field.
+func (m *ProjectMutation) SetShortName(s string) {
+	m.short_name = &s
+}
+
+// ShortName returns the value of the "short_name" field in the mutation.
+func (m *ProjectMutation) ShortName() (r string, exists bool) {
+	v := m.short_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShortName returns the old "short_name" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldShortName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShortName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShortName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShortName: %w", err)
+	}
+	return oldValue.ShortName, nil
+}
+
+// ResetShortName resets all changes to the "short_name" field.
+func (m *ProjectMutation) ResetShortName() {
+	m.short_name = nil
+}
+
+// SetConfig sets the "config" field.
+func (m *ProjectMutation) SetConfig(value map[string]interface{}) {
+	m._config = &value
+}
+
+// Config returns the value of the "config" field in the mutation.
+func (m *ProjectMutation) Config() (r map[string]interface{}, exists bool) {
+	v := m._config
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfig returns the old "config" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldConfig(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfig is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfig requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfig: %w", err)
+	}
+	return oldValue.Config, nil
+}
+
+// ClearConfig clears the value of the "config" field.
+func (m *ProjectMutation) ClearConfig() {
+	m._config = nil
+	m.clearedFields[project.FieldConfig] = struct{}{}
+}
+
+// ConfigCleared returns if the "config" field was cleared in this mutation.
+func (m *ProjectMutation) ConfigCleared() bool {
+	_, ok := m.clearedFields[project.FieldConfig]
+	return ok
+}
+
+// ResetConfig resets all changes to the "config" field.
+func (m *ProjectMutation) ResetConfig() {
+	m._config = nil
+	delete(m.clearedFields, project.FieldConfig)
+}
+
+// SetAbcFileDirPreference sets the "abc_file_dir_preference" field.
+func (m *ProjectMutation) SetAbcFileDirPreference(s string) {
+	m.abc_file_dir_preference = &s
+}
+
+// AbcFileDirPreference returns the value of the "abc_file_dir_preference" field in the mutation.
+func (m *ProjectMutation) AbcFileDirPreference() (r string, exists bool) {
+	v := m.abc_file_dir_preference
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAbcFileDirPreference returns the old "abc_file_dir_preference" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldAbcFileDirPreference(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAbcFileDirPreference is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAbcFileDirPreference requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAbcFileDirPreference: %w", err)
+	}
+	return oldValue.AbcFileDirPreference, nil
+}
+
+// ClearAbcFileDirPreference clears the value of the "abc_file_dir_preference" field.
+func (m *ProjectMutation) ClearAbcFileDirPreference() {
+	m.abc_file_dir_preference = nil
+	m.clearedFields[project.FieldAbcFileDirPreference] = struct{}{}
+}
+
+// AbcFileDirPreferenceCleared returns if the "abc_file_dir_preference" field was cleared in this mutation.
+func (m *ProjectMutation) AbcFileDirPreferenceCleared() bool {
+	_, ok := m.clearedFields[project.FieldAbcFileDirPreference]
+	return ok
+}
+
+// ResetAbcFileDirPreference resets all changes to the "abc_file_dir_preference" field.
+func (m *ProjectMutation) ResetAbcFileDirPreference() {
+	m.abc_file_dir_preference = nil
+	delete(m.clearedFields, project.FieldAbcFileDirPreference)
+}
+
+// SetVariables sets the "variables" field.
+func (m *ProjectMutation) SetVariables(value map[string]string) {
+	m.variables = &value
+}
+
+// Variables returns the value of the "variables" field in the mutation.
+func (m *ProjectMutation) Variables() (r map[string]string, exists bool) {
+	v := m.variables
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariables returns the old "variables" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldVariables(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariables is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariables requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariables: %w", err)
+	}
+	return oldValue.Variables, nil
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (m *ProjectMutation) ClearVariables() {
+	m.variables = nil
+	m.clearedFields[project.FieldVariables] = struct{}{}
+}
+
+// VariablesCleared returns if the "variables" field was cleared in this mutation.
+func (m *ProjectMutation) VariablesCleared() bool {
+	_, ok := m.clearedFields[project.FieldVariables]
+	return ok
+}
+
+// ResetVariables resets all changes to the "variables" field.
+func (m *ProjectMutation) ResetVariables() {
+	m.variables = nil
+	delete(m.clearedFields, project.FieldVariables)
+}
+
+// SetReservedNumbers sets the "reserved_numbers" field.
+func (m *ProjectMutation) SetReservedNumbers(i []int) {
+	m.reserved_numbers = &i
+	m.appendreserved_numbers = nil
+}
+
+// ReservedNumbers returns the value of the "reserved_numbers" field in the mutation.
+func (m *ProjectMutation) ReservedNumbers() (r []int, exists bool) {
+	v := m.reserved_numbers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReservedNumbers returns the old "reserved_numbers" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldReservedNumbers(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReservedNumbers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReservedNumbers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReservedNumbers: %w", err)
+	}
+	return oldValue.ReservedNumbers, nil
+}
+
+// AppendReservedNumbers adds i to the "reserved_numbers" field.
+func (m *ProjectMutation) AppendReservedNumbers(i []int) {
+	m.appendreserved_numbers = append(m.appendreserved_numbers, i...)
+}
+
+// AppendedReservedNumbers returns the list of values that were appended to the "reserved_numbers" field in this mutation.
+func (m *ProjectMutation) AppendedReservedNumbers() ([]int, bool) {
+	if len(m.appendreserved_numbers) == 0 {
+		return nil, false
+	}
+	return m.appendreserved_numbers, true
+}
+
+// ClearReservedNumbers clears the value of the "reserved_numbers" field.
+func (m *ProjectMutation) ClearReservedNumbers() {
+	m.reserved_numbers = nil
+	m.appendreserved_numbers = nil
+	m.clearedFields[project.FieldReservedNumbers] = struct{}{}
+}
+
+// ReservedNumbersCleared returns if the "reserved_numbers" field was cleared in this mutation.
+func (m *ProjectMutation) ReservedNumbersCleared() bool {
+	_, ok := m.clearedFields[project.FieldReservedNumbers]
+	return ok
+}
+
+// ResetReservedNumbers resets all changes to the "reserved_numbers" field.
+func (m *ProjectMutation) ResetReservedNumbers() {
+	m.reserved_numbers = nil
+	m.appendreserved_numbers = nil
+	delete(m.clearedFields, project.FieldReservedNumbers)
+}
+
+// AddProjectSongIDs adds the "project_songs" edge to the ProjectSong entity by ids.
+func (m *ProjectMutation) AddProjectSongIDs(ids ...int) {
+	if m.project_songs == nil {
+		m.project_songs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.project_songs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProjectSongs clears the "project_songs" edge to the ProjectSong entity.
+func (m *ProjectMutation) ClearProjectSongs() {
+	m.clearedproject_songs = true
+}
+
+// ProjectSongsCleared reports if the "project_songs" edge to the ProjectSong entity was cleared.
+func (m *ProjectMutation) ProjectSongsCleared() bool {
+	return m.clearedproject_songs
+}
+
+// RemoveProjectSongIDs removes the "project_songs" edge to the ProjectSong entity by IDs.
+func (m *ProjectMutation) RemoveProjectSongIDs(ids ...int) {
+	if m.removedproject_songs == nil {
+		m.removedproject_songs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.project_songs, ids[i])
+		m.removedproject_songs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProjectSongs returns the removed IDs of the "project_songs" edge to the ProjectSong entity.
+func (m *ProjectMutation) RemovedProjectSongsIDs() (ids []int) {
+	for id := range m.removedproject_songs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProjectSongsIDs returns the "project_songs" edge IDs in the mutation.
+func (m *ProjectMutation) ProjectSongsIDs() (ids []int) {
+	for id := range m.project_songs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProjectSongs resets all changes to the "project_songs" edge.
+func (m *ProjectMutation) ResetProjectSongs() {
+	m.project_songs = nil
+	m.clearedproject_songs = false
+	m.removedproject_songs = nil
+}
+
+// AddBuildIDs adds the "builds" edge to the Build entity by ids.
+func (m *ProjectMutation) AddBuildIDs(ids ...int) {
+	if m.builds == nil {
+		m.builds = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.builds[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBuilds clears the "builds" edge to the Build entity.
+func (m *ProjectMutation) ClearBuilds() {
+	m.clearedbuilds = true
+}
+
+// BuildsCleared reports if the "builds" edge to the Build entity was cleared.
+func (m *ProjectMutation) BuildsCleared() bool {
+	return m.clearedbuilds
+}
+
+// RemoveBuildIDs removes the "builds" edge to the Build entity by IDs.
+func (m *ProjectMutation) RemoveBuildIDs(ids ...int) {
+	if m.removedbuilds == nil {
+		m.removedbuilds = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.builds, ids[i])
+		m.removedbuilds[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBuilds returns the removed IDs of the "builds" edge to the Build entity.
+func (m *ProjectMutation) RemovedBuildsIDs() (ids []int) {
+	for id := range m.removedbuilds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BuildsIDs returns the "builds" edge IDs in the mutation.
+func (m *ProjectMutation) BuildsIDs() (ids []int) {
+	for id := range m.builds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBuilds resets all changes to the "builds" edge.
+func (m *ProjectMutation) ResetBuilds() {
+	m.builds = nil
+	m.clearedbuilds = false
+	m.removedbuilds = nil
+}
+
+// AddBuildProfileIDs adds the "build_profiles" edge to the BuildProfile entity by ids.
+func (m *ProjectMutation) AddBuildProfileIDs(ids ...int) {
+	if m.build_profiles == nil {
+		m.build_profiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.build_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBuildProfiles clears the "build_profiles" edge to the BuildProfile entity.
+func (m *ProjectMutation) ClearBuildProfiles() {
+	m.clearedbuild_profiles = true
+}
+
+// BuildProfilesCleared reports if the "build_profiles" edge to the BuildProfile entity was cleared.
+func (m *ProjectMutation) BuildProfilesCleared() bool {
+	return m.clearedbuild_profiles
+}
+
+// RemoveBuildProfileIDs removes the "build_profiles" edge to the BuildProfile entity by IDs.
+func (m *ProjectMutation) RemoveBuildProfileIDs(ids ...int) {
+	if m.removedbuild_profiles == nil {
+		m.removedbuild_profiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.build_profiles, ids[i])
+		m.removedbuild_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBuildProfiles returns the removed IDs of the "build_profiles" edge to the BuildProfile entity.
+func (m *ProjectMutation) RemovedBuildProfilesIDs() (ids []int) {
+	for id := range m.removedbuild_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BuildProfilesIDs returns the "build_profiles" edge IDs in the mutation.
+func (m *ProjectMutation) BuildProfilesIDs() (ids []int) {
+	for id := range m.build_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBuildProfiles resets all changes to the "build_profiles" edge.
+func (m *ProjectMutation) ResetBuildProfiles() {
+	m.build_profiles = nil
+	m.clearedbuild_profiles = false
+	m.removedbuild_profiles = nil
+}
+
+// Where appends a list predicates to the ProjectMutation builder.
+func (m *ProjectMutation) Where(ps ...predicate.Project) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Project, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProjectMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProjectMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Project).
+func (m *ProjectMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.title != nil {
+		fields = append(fields, project.FieldTitle)
+	}
+	if m.short_name != nil {
+		fields = append(fields, project.FieldShortName)
+	}
+	if m._config != nil {
+		fields = append(fields, project.FieldConfig)
+	}
+	if m.abc_file_dir_preference != nil {
+		fields = append(fields, project.FieldAbcFileDirPreference)
+	}
+	if m.variables != nil {
+		fields = append(fields, project.FieldVariables)
+	}
+	if m.reserved_numbers != nil {
+		fields = append(fields, project.FieldReservedNumbers)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case project.FieldTitle:
+		return m.Title()
+	case project.FieldShortName:
 		return m.ShortName()
 	case project.FieldConfig:
-		return m.Config()
+		return m.Config()
+	case project.FieldAbcFileDirPreference:
+		return m.AbcFileDirPreference()
+	case project.FieldVariables:
+		return m.Variables()
+	case project.FieldReservedNumbers:
+		return m.ReservedNumbers()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case project.FieldTitle:
+		return m.OldTitle(ctx)
+	case project.FieldShortName:
+		return m.OldShortName(ctx)
+	case project.FieldConfig:
+		return m.OldConfig(ctx)
+	case project.FieldAbcFileDirPreference:
+		return m.OldAbcFileDirPreference(ctx)
+	case project.FieldVariables:
+		return m.OldVariables(ctx)
+	case project.FieldReservedNumbers:
+		return m.OldReservedNumbers(ctx)
+	}
+	return nil, fmt.Errorf("unknown Project field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case project.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case project.FieldShortName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShortName(v)
+		return nil
+	case project.FieldConfig:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfig(v)
+		return nil
+	case project.FieldAbcFileDirPreference:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAbcFileDirPreference(v)
+		return nil
+	case project.FieldVariables:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariables(v)
+		return nil
+	case project.FieldReservedNumbers:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReservedNumbers(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProjectMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Project numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProjectMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(project.FieldConfig) {
+		fields = append(fields, project.FieldConfig)
+	}
+	if m.FieldCleared(project.FieldAbcFileDirPreference) {
+		fields = append(fields, project.FieldAbcFileDirPreference)
+	}
+	if m.FieldCleared(project.FieldVariables) {
+		fields = append(fields, project.FieldVariables)
+	}
+	if m.FieldCleared(project.FieldReservedNumbers) {
+		fields = append(fields, project.FieldReservedNumbers)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProjectMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectMutation) ClearField(name string) error {
+	switch name {
+	case project.FieldConfig:
+		m.ClearConfig()
+		return nil
 	case project.FieldAbcFileDirPreference:
-		return m.AbcFileDirPreference()
+		m.ClearAbcFileDirPreference()
+		return nil
+	case project.FieldVariables:
+		m.ClearVariables()
+		return nil
+	case project.FieldReservedNumbers:
+		m.ClearReservedNumbers()
+		return nil
+	}
+	return fmt.Errorf("unknown Project nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProjectMutation) ResetField(name string) error {
+	switch name {
+	case project.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case project.FieldShortName:
+		m.ResetShortName()
+		return nil
+	case project.FieldConfig:
+		m.ResetConfig()
+		return nil
+	case project.FieldAbcFileDirPreference:
+		m.ResetAbcFileDirPreference()
+		return nil
+	case project.FieldVariables:
+		m.ResetVariables()
+		return nil
+	case project.FieldReservedNumbers:
+		m.ResetReservedNumbers()
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProjectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.project_songs != nil {
+		edges = append(edges, project.EdgeProjectSongs)
+	}
+	if m.builds != nil {
+		edges = append(edges, project.EdgeBuilds)
+	}
+	if m.build_profiles != nil {
+		edges = append(edges, project.EdgeBuildProfiles)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeProjectSongs:
+		ids := make([]ent.Value, 0, len(m.project_songs))
+		for id := range m.project_songs {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeBuilds:
+		ids := make([]ent.Value, 0, len(m.builds))
+		for id := range m.builds {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeBuildProfiles:
+		ids := make([]ent.Value, 0, len(m.build_profiles))
+		for id := range m.build_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProjectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedproject_songs != nil {
+		edges = append(edges, project.EdgeProjectSongs)
+	}
+	if m.removedbuilds != nil {
+		edges = append(edges, project.EdgeBuilds)
+	}
+	if m.removedbuild_profiles != nil {
+		edges = append(edges, project.EdgeBuildProfiles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeProjectSongs:
+		ids := make([]ent.Value, 0, len(m.removedproject_songs))
+		for id := range m.removedproject_songs {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeBuilds:
+		ids := make([]ent.Value, 0, len(m.removedbuilds))
+		for id := range m.removedbuilds {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeBuildProfiles:
+		ids := make([]ent.Value, 0, len(m.removedbuild_profiles))
+		for id := range m.removedbuild_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProjectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedproject_songs {
+		edges = append(edges, project.EdgeProjectSongs)
+	}
+	if m.clearedbuilds {
+		edges = append(edges, project.EdgeBuilds)
+	}
+	if m.clearedbuild_profiles {
+		edges = append(edges, project.EdgeBuildProfiles)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProjectMutation) EdgeCleared(name string) bool {
+	switch name {
+	case project.EdgeProjectSongs:
+		return m.clearedproject_songs
+	case project.EdgeBuilds:
+		return m.clearedbuilds
+	case project.EdgeBuildProfiles:
+		return m.clearedbuild_profiles
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProjectMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Project unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProjectMutation) ResetEdge(name string) error {
+	switch name {
+	case project.EdgeProjectSongs:
+		m.ResetProjectSongs()
+		return nil
+	case project.EdgeBuilds:
+		m.ResetBuilds()
+		return nil
+	case project.EdgeBuildProfiles:
+		m.ResetBuildProfiles()
+		return nil
+	}
+	return fmt.Errorf("unknown Project edge %s", name)
+}
+
+// ProjectSongMutation represents an operation that mutates the ProjectSong nodes in the graph.
+type ProjectSongMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	priority       *int
+	addpriority    *int
+	difficulty     *projectsong.Difficulty
+	comment        *string
+	position       *int
+	addposition    *int
+	number         *int
+	addnumber      *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	project        *int
+	clearedproject bool
+	song           *int
+	clearedsong    bool
+	done           bool
+	oldValue       func(context.Context) (*ProjectSong, error)
+	predicates     []predicate.ProjectSong
+}
+
+var _ ent.Mutation = (*ProjectSongMutation)(nil)
+
+// projectsongOption allows management of the mutation configuration using functional options.
+type projectsongOption func(*ProjectSongMutation)
+
+// newProjectSongMutation creates new mutation for the ProjectSong entity.
+func newProjectSongMutation(c config, op Op, opts ...projectsongOption) *ProjectSongMutation {
+	m := &ProjectSongMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProjectSong,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectSongID sets the ID field of the mutation.
+func withProjectSongID(id int) projectsongOption {
+	return func(m *ProjectSongMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProjectSong
+		)
+		m.oldValue = func(ctx context.Context) (*ProjectSong, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProjectSong.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProjectSong sets the old ProjectSong of the mutation.
+func withProjectSong(node *ProjectSong) projectsongOption {
+	return func(m *ProjectSongMutation) {
+		m.oldValue = func(context.Context) (*ProjectSong, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectSongMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectSongMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProjectSong entities.
+func (m *ProjectSongMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectSongMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectSongMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProjectSong.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPriority sets the "priority" field.
+func (m *ProjectSongMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *ProjectSongMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the ProjectSong entity.
+// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSongMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *ProjectSongMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *ProjectSongMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *ProjectSongMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (m *ProjectSongMutation) SetDifficulty(pr projectsong.Difficulty) {
+	m.difficulty = &pr
+}
+
+// Difficulty returns the value of the "difficulty" field in the mutation.
+func (m *ProjectSongMutation) Difficulty() (r projectsong.Difficulty, exists bool) {
+	v := m.difficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDifficulty returns the old "difficulty" field's value of the ProjectSong entity.
+// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSongMutation) OldDifficulty(ctx context.Context) (v projectsong.Difficulty, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDifficulty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
+	}
+	return oldValue.Difficulty, nil
+}
+
+// ResetDifficulty resets all changes to the "difficulty" field.
+func (m *ProjectSongMutation) ResetDifficulty() {
+	m.difficulty = nil
+}
+
+// SetComment sets the "comment" field.
+func (m *ProjectSongMutation) SetComment(s string) {
+	m.comment = &s
+}
+
+// Comment returns the value of the "comment" field in the mutation.
+func (m *ProjectSongMutation) Comment() (r string, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComment returns the old "comment" field's value of the ProjectSong entity.
+// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSongMutation) OldComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+	}
+	return oldValue.Comment, nil
+}
+
+// ClearComment clears the value of the "comment" field.
+func (m *ProjectSongMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[projectsong.FieldComment] = struct{}{}
+}
+
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *ProjectSongMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[projectsong.FieldComment]
+	return ok
+}
+
+// ResetComment resets all changes to the "comment" field.
+func (m *ProjectSongMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, projectsong.FieldComment)
+}
+
+// SetPosition sets the "position" field.
+func (m *ProjectSongMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *ProjectSongMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the ProjectSong entity.
+// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSongMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *ProjectSongMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *ProjectSongMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *ProjectSongMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetNumber sets the "number" field.
+func (m *ProjectSongMutation) SetNumber(i int) {
+	m.number = &i
+	m.addnumber = nil
+}
+
+// Number returns the value of the "number" field in the mutation.
+func (m *ProjectSongMutation) Number() (r int, exists bool) {
+	v := m.number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumber returns the old "number" field's value of the ProjectSong entity.
+// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSongMutation) OldNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
+	}
+	return oldValue.Number, nil
+}
+
+// AddNumber adds i to the "number" field.
+func (m *ProjectSongMutation) AddNumber(i int) {
+	if m.addnumber != nil {
+		*m.addnumber += i
+	} else {
+		m.addnumber = &i
+	}
+}
+
+// AddedNumber returns the value that was added to the "number" field in this mutation.
+func (m *ProjectSongMutation) AddedNumber() (r int, exists bool) {
+	v := m.addnumber
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNumber resets all changes to the "number" field.
+func (m *ProjectSongMutation) ResetNumber() {
+	m.number = nil
+	m.addnumber = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProjectSongMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProjectSongMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProjectSong entity.
+// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSongMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *ProjectSongMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[projectsong.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *ProjectSongMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[projectsong.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProjectSongMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, projectsong.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProjectSongMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProjectSongMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProjectSong entity.
+// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSongMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *ProjectSongMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[projectsong.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *ProjectSongMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[projectsong.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProjectSongMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, projectsong.FieldUpdatedAt)
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *ProjectSongMutation) SetProjectID(i int) {
+	m.project = &i
+}
+
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *ProjectSongMutation) ProjectID() (r int, exists bool) {
+	v := m.project
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectID returns the old "project_id" field's value of the ProjectSong entity.
+// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSongMutation) OldProjectID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
+	}
+	return oldValue.ProjectID, nil
+}
+
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *ProjectSongMutation) ResetProjectID() {
+	m.project = nil
+}
+
+// SetSongID sets the "song_id" field.
+func (m *ProjectSongMutation) SetSongID(i int) {
+	m.song = &i
+}
+
+// SongID returns the value of the "song_id" field in the mutation.
+func (m *ProjectSongMutation) SongID() (r int, exists bool) {
+	v := m.song
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSongID returns the old "song_id" field's value of the ProjectSong entity.
+// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectSongMutation) OldSongID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSongID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSongID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSongID: %w", err)
+	}
+	return oldValue.SongID, nil
+}
+
+// ResetSongID resets all changes to the "song_id" field.
+func (m *ProjectSongMutation) ResetSongID() {
+	m.song = nil
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (m *ProjectSongMutation) ClearProject() {
+	m.clearedproject = true
+	m.clearedFields[projectsong.FieldProjectID] = struct{}{}
+}
+
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *ProjectSongMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *ProjectSongMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProject resets all changes to the "project" edge.
+func (m *ProjectSongMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
+}
+
+// ClearSong clears the "song" edge to the Song entity.
+func (m *ProjectSongMutation) ClearSong() {
+	m.clearedsong = true
+	m.clearedFields[projectsong.FieldSongID] = struct{}{}
+}
+
+// SongCleared reports if the "song" edge to the Song entity was cleared.
+func (m *ProjectSongMutation) SongCleared() bool {
+	return m.clearedsong
+}
+
+// SongIDs returns the "song" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SongID instead. It exists only for internal usage by the builders.
+func (m *ProjectSongMutation) SongIDs() (ids []int) {
+	if id := m.song; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSong resets all changes to the "song" edge.
+func (m *ProjectSongMutation) ResetSong() {
+	m.song = nil
+	m.clearedsong = false
+}
+
+// Where appends a list predicates to the ProjectSongMutation builder.
+func (m *ProjectSongMutation) Where(ps ...predicate.ProjectSong) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProjectSongMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectSongMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProjectSong, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProjectSongMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProjectSongMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProjectSong).
+func (m *ProjectSongMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectSongMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.priority != nil {
+		fields = append(fields, projectsong.FieldPriority)
+	}
+	if m.difficulty != nil {
+		fields = append(fields, projectsong.FieldDifficulty)
+	}
+	if m.comment != nil {
+		fields = append(fields, projectsong.FieldComment)
+	}
+	if m.position != nil {
+		fields = append(fields, projectsong.FieldPosition)
+	}
+	if m.number != nil {
+		fields = append(fields, projectsong.FieldNumber)
+	}
+	if m.created_at != nil {
+		fields = append(fields, projectsong.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, projectsong.FieldUpdatedAt)
+	}
+	if m.project != nil {
+		fields = append(fields, projectsong.FieldProjectID)
+	}
+	if m.song != nil {
+		fields = append(fields, projectsong.FieldSongID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectSongMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case projectsong.FieldPriority:
+		return m.Priority()
+	case projectsong.FieldDifficulty:
+		return m.Difficulty()
+	case projectsong.FieldComment:
+		return m.Comment()
+	case projectsong.FieldPosition:
+		return m.Position()
+	case projectsong.FieldNumber:
+		return m.Number()
+	case projectsong.FieldCreatedAt:
+		return m.CreatedAt()
+	case projectsong.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case projectsong.FieldProjectID:
+		return m.ProjectID()
+	case projectsong.FieldSongID:
+		return m.SongID()
 	}
 	return nil, false
 }
@@ -449,248 +4474,355 @@ func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProjectSongMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case project.FieldTitle:
-		return m.OldTitle(ctx)
-	case project.FieldShortName:
-		return m.OldShortName(ctx)
-	case project.FieldConfig:
-		return m.OldConfig(ctx)
-	case project.FieldAbcFileDirPreference:
-		return m.OldAbcFileDirPreference(ctx)
+	case projectsong.FieldPriority:
+		return m.OldPriority(ctx)
+	case projectsong.FieldDifficulty:
+		return m.OldDifficulty(ctx)
+	case projectsong.FieldComment:
+		return m.OldComment(ctx)
+	case projectsong.FieldPosition:
+		return m.OldPosition(ctx)
+	case projectsong.FieldNumber:
+		return m.OldNumber(ctx)
+	case projectsong.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case projectsong.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case projectsong.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case projectsong.FieldSongID:
+		return m.OldSongID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Project field %s", name)
+	return nil, fmt.Errorf("unknown ProjectSong field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectMutation) SetField(name string, value ent.Value) error {
+func (m *ProjectSongMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case project.FieldTitle:
-		v, ok := value.(string)
+	case projectsong.FieldPriority:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetPriority(v)
 		return nil
-	case project.FieldShortName:
+	case projectsong.FieldDifficulty:
+		v, ok := value.(projectsong.Difficulty)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDifficulty(v)
+		return nil
+	case projectsong.FieldComment:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetShortName(v)
+		m.SetComment(v)
 		return nil
-	case project.FieldConfig:
-		v, ok := value.(map[string]interface{})
+	case projectsong.FieldPosition:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConfig(v)
+		m.SetPosition(v)
 		return nil
-	case project.FieldAbcFileDirPreference:
-		v, ok := value.(string)
+	case projectsong.FieldNumber:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAbcFileDirPreference(v)
+		m.SetNumber(v)
+		return nil
+	case projectsong.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case projectsong.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case projectsong.FieldProjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case projectsong.FieldSongID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSongID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Project field %s", name)
+	return fmt.Errorf("unknown ProjectSong field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProjectMutation) AddedFields() []string {
-	return nil
+func (m *ProjectSongMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, projectsong.FieldPriority)
+	}
+	if m.addposition != nil {
+		fields = append(fields, projectsong.FieldPosition)
+	}
+	if m.addnumber != nil {
+		fields = append(fields, projectsong.FieldNumber)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProjectSongMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case projectsong.FieldPriority:
+		return m.AddedPriority()
+	case projectsong.FieldPosition:
+		return m.AddedPosition()
+	case projectsong.FieldNumber:
+		return m.AddedNumber()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectMutation) AddField(name string, value ent.Value) error {
+func (m *ProjectSongMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case projectsong.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case projectsong.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	case projectsong.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumber(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Project numeric field %s", name)
+	return fmt.Errorf("unknown ProjectSong numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProjectMutation) ClearedFields() []string {
+func (m *ProjectSongMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(project.FieldConfig) {
-		fields = append(fields, project.FieldConfig)
+	if m.FieldCleared(projectsong.FieldComment) {
+		fields = append(fields, projectsong.FieldComment)
 	}
-	if m.FieldCleared(project.FieldAbcFileDirPreference) {
-		fields = append(fields, project.FieldAbcFileDirPreference)
+	if m.FieldCleared(projectsong.FieldCreatedAt) {
+		fields = append(fields, projectsong.FieldCreatedAt)
+	}
+	if m.FieldCleared(projectsong.FieldUpdatedAt) {
+		fields = append(fields, projectsong.FieldUpdatedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProjectMutation) FieldCleared(name string) bool {
+func (m *ProjectSongMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProjectMutation) ClearField(name string) error {
+func (m *ProjectSongMutation) ClearField(name string) error {
 	switch name {
-	case project.FieldConfig:
-		m.ClearConfig()
+	case projectsong.FieldComment:
+		m.ClearComment()
 		return nil
-	case project.FieldAbcFileDirPreference:
-		m.ClearAbcFileDirPreference()
+	case projectsong.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case projectsong.FieldUpdatedAt:
+		m.ClearUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Project nullable field %s", name)
+	return fmt.Errorf("unknown ProjectSong nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProjectMutation) ResetField(name string) error {
+func (m *ProjectSongMutation) ResetField(name string) error {
 	switch name {
-	case project.FieldTitle:
-		m.ResetTitle()
+	case projectsong.FieldPriority:
+		m.ResetPriority()
 		return nil
-	case project.FieldShortName:
-		m.ResetShortName()
+	case projectsong.FieldDifficulty:
+		m.ResetDifficulty()
 		return nil
-	case project.FieldConfig:
-		m.ResetConfig()
+	case projectsong.FieldComment:
+		m.ResetComment()
 		return nil
-	case project.FieldAbcFileDirPreference:
-		m.ResetAbcFileDirPreference()
+	case projectsong.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case projectsong.FieldNumber:
+		m.ResetNumber()
+		return nil
+	case projectsong.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case projectsong.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case projectsong.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case projectsong.FieldSongID:
+		m.ResetSongID()
 		return nil
 	}
-	return fmt.Errorf("unknown Project field %s", name)
+	return fmt.Errorf("unknown ProjectSong field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProjectMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.project_songs != nil {
-		edges = append(edges, project.EdgeProjectSongs)
+func (m *ProjectSongMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.project != nil {
+		edges = append(edges, projectsong.EdgeProject)
+	}
+	if m.song != nil {
+		edges = append(edges, projectsong.EdgeSong)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
+func (m *ProjectSongMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case project.EdgeProjectSongs:
-		ids := make([]ent.Value, 0, len(m.project_songs))
-		for id := range m.project_songs {
-			ids = append(ids, id)
+	case projectsong.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	case projectsong.EdgeSong:
+		if id := m.song; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProjectMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedproject_songs != nil {
-		edges = append(edges, project.EdgeProjectSongs)
-	}
+func (m *ProjectSongMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case project.EdgeProjectSongs:
-		ids := make([]ent.Value, 0, len(m.removedproject_songs))
-		for id := range m.removedproject_songs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ProjectSongMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProjectMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedproject_songs {
-		edges = append(edges, project.EdgeProjectSongs)
+func (m *ProjectSongMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedproject {
+		edges = append(edges, projectsong.EdgeProject)
+	}
+	if m.clearedsong {
+		edges = append(edges, projectsong.EdgeSong)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProjectMutation) EdgeCleared(name string) bool {
+func (m *ProjectSongMutation) EdgeCleared(name string) bool {
 	switch name {
-	case project.EdgeProjectSongs:
-		return m.clearedproject_songs
+	case projectsong.EdgeProject:
+		return m.clearedproject
+	case projectsong.EdgeSong:
+		return m.clearedsong
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProjectMutation) ClearEdge(name string) error {
+func (m *ProjectSongMutation) ClearEdge(name string) error {
 	switch name {
+	case projectsong.EdgeProject:
+		m.ClearProject()
+		return nil
+	case projectsong.EdgeSong:
+		m.ClearSong()
+		return nil
 	}
-	return fmt.Errorf("unknown Project unique edge %s", name)
+	return fmt.Errorf("unknown ProjectSong unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProjectMutation) ResetEdge(name string) error {
+func (m *ProjectSongMutation) ResetEdge(name string) error {
 	switch name {
-	case project.EdgeProjectSongs:
-		m.ResetProjectSongs()
+	case projectsong.EdgeProject:
+		m.ResetProject()
+		return nil
+	case projectsong.EdgeSong:
+		m.ResetSong()
 		return nil
 	}
-	return fmt.Errorf("unknown Project edge %s", name)
+	return fmt.Errorf("unknown ProjectSong edge %s", name)
 }
 
-// ProjectSongMutation represents an operation that mutates the ProjectSong nodes in the graph.
-type ProjectSongMutation struct {
+// SettingMutation represents an operation that mutates the Setting nodes in the graph.
+type SettingMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	priority       *int
-	addpriority    *int
-	difficulty     *projectsong.Difficulty
-	comment        *string
-	clearedFields  map[string]struct{}
-	project        *int
-	clearedproject bool
-	song           *int
-	clearedsong    bool
-	done           bool
-	oldValue       func(context.Context) (*ProjectSong, error)
-	predicates     []predicate.ProjectSong
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	value         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Setting, error)
+	predicates    []predicate.Setting
 }
 
-var _ ent.Mutation = (*ProjectSongMutation)(nil)
-
-// projectsongOption allows management of the mutation configuration using functional options.
-type projectsongOption func(*ProjectSongMutation)
+var _ ent.Mutation = (*SettingMutation)(nil)
 
-// newProjectSongMutation creates new mutation for the ProjectSong entity.
-func newProjectSongMutation(c config, op Op, opts ...projectsongOption) *ProjectSongMutation {
-	m := &ProjectSongMutation{
+// settingOption allows management of the mutation configuration using functional options.
+type settingOption func(*SettingMutation)
+
+// newSettingMutation creates new mutation for the Setting entity.
+func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
+	m := &SettingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProjectSong,
+		typ:           TypeSetting,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -699,20 +4831,20 @@ func newProjectSongMutation(c config, op Op, opts ...projectsongOption) *Project
 	return m
 }
 
-// withProjectSongID sets the ID field of the mutation.
-func withProjectSongID(id int) projectsongOption {
-	return func(m *ProjectSongMutation) {
+// withSettingID sets the ID field of the mutation.
+func withSettingID(id int) settingOption {
+	return func(m *SettingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ProjectSong
+			value *Setting
 		)
-		m.oldValue = func(ctx context.Context) (*ProjectSong, error) {
+		m.oldValue = func(ctx context.Context) (*Setting, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ProjectSong.Get(ctx, id)
+					value, err = m.Client().Setting.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -721,10 +4853,10 @@ func withProjectSongID(id int) projectsongOption {
 	}
 }
 
-// withProjectSong sets the old ProjectSong of the mutation.
-func withProjectSong(node *ProjectSong) projectsongOption {
-	return func(m *ProjectSongMutation) {
-		m.oldValue = func(context.Context) (*ProjectSong, error) {
+// withSetting sets the old Setting of the mutation.
+func withSetting(node *Setting) settingOption {
+	return func(m *SettingMutation) {
+		m.oldValue = func(context.Context) (*Setting, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -733,7 +4865,7 @@ func withProjectSong(node *ProjectSong) projectsongOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProjectSongMutation) Client() *Client {
+func (m SettingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -741,7 +4873,7 @@ func (m ProjectSongMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProjectSongMutation) Tx() (*Tx, error) {
+func (m SettingMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -750,15 +4882,9 @@ func (m ProjectSongMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ProjectSong entities.
-func (m *ProjectSongMutation) SetID(id int) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProjectSongMutation) ID() (id int, exists bool) {
+func (m *SettingMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -769,7 +4895,7 @@ func (m *ProjectSongMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProjectSongMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -778,288 +4904,106 @@ func (m *ProjectSongMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProjectSong.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetPriority sets the "priority" field.
-func (m *ProjectSongMutation) SetPriority(i int) {
-	m.priority = &i
-	m.addpriority = nil
-}
-
-// Priority returns the value of the "priority" field in the mutation.
-func (m *ProjectSongMutation) Priority() (r int, exists bool) {
-	v := m.priority
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPriority returns the old "priority" field's value of the ProjectSong entity.
-// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectSongMutation) OldPriority(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
-	}
-	return oldValue.Priority, nil
-}
-
-// AddPriority adds i to the "priority" field.
-func (m *ProjectSongMutation) AddPriority(i int) {
-	if m.addpriority != nil {
-		*m.addpriority += i
-	} else {
-		m.addpriority = &i
-	}
-}
-
-// AddedPriority returns the value that was added to the "priority" field in this mutation.
-func (m *ProjectSongMutation) AddedPriority() (r int, exists bool) {
-	v := m.addpriority
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetPriority resets all changes to the "priority" field.
-func (m *ProjectSongMutation) ResetPriority() {
-	m.priority = nil
-	m.addpriority = nil
-}
-
-// SetDifficulty sets the "difficulty" field.
-func (m *ProjectSongMutation) SetDifficulty(pr projectsong.Difficulty) {
-	m.difficulty = &pr
-}
-
-// Difficulty returns the value of the "difficulty" field in the mutation.
-func (m *ProjectSongMutation) Difficulty() (r projectsong.Difficulty, exists bool) {
-	v := m.difficulty
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDifficulty returns the old "difficulty" field's value of the ProjectSong entity.
-// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectSongMutation) OldDifficulty(ctx context.Context) (v projectsong.Difficulty, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDifficulty requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
-	}
-	return oldValue.Difficulty, nil
-}
-
-// ResetDifficulty resets all changes to the "difficulty" field.
-func (m *ProjectSongMutation) ResetDifficulty() {
-	m.difficulty = nil
-}
-
-// SetComment sets the "comment" field.
-func (m *ProjectSongMutation) SetComment(s string) {
-	m.comment = &s
-}
-
-// Comment returns the value of the "comment" field in the mutation.
-func (m *ProjectSongMutation) Comment() (r string, exists bool) {
-	v := m.comment
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldComment returns the old "comment" field's value of the ProjectSong entity.
-// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectSongMutation) OldComment(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldComment is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldComment requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldComment: %w", err)
-	}
-	return oldValue.Comment, nil
-}
-
-// ClearComment clears the value of the "comment" field.
-func (m *ProjectSongMutation) ClearComment() {
-	m.comment = nil
-	m.clearedFields[projectsong.FieldComment] = struct{}{}
-}
-
-// CommentCleared returns if the "comment" field was cleared in this mutation.
-func (m *ProjectSongMutation) CommentCleared() bool {
-	_, ok := m.clearedFields[projectsong.FieldComment]
-	return ok
-}
-
-// ResetComment resets all changes to the "comment" field.
-func (m *ProjectSongMutation) ResetComment() {
-	m.comment = nil
-	delete(m.clearedFields, projectsong.FieldComment)
-}
-
-// SetProjectID sets the "project_id" field.
-func (m *ProjectSongMutation) SetProjectID(i int) {
-	m.project = &i
-}
-
-// ProjectID returns the value of the "project_id" field in the mutation.
-func (m *ProjectSongMutation) ProjectID() (r int, exists bool) {
-	v := m.project
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldProjectID returns the old "project_id" field's value of the ProjectSong entity.
-// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectSongMutation) OldProjectID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProjectID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
-	}
-	return oldValue.ProjectID, nil
-}
-
-// ResetProjectID resets all changes to the "project_id" field.
-func (m *ProjectSongMutation) ResetProjectID() {
-	m.project = nil
-}
-
-// SetSongID sets the "song_id" field.
-func (m *ProjectSongMutation) SetSongID(i int) {
-	m.song = &i
+// SetKey sets the "key" field.
+func (m *SettingMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// SongID returns the value of the "song_id" field in the mutation.
-func (m *ProjectSongMutation) SongID() (r int, exists bool) {
-	v := m.song
+// Key returns the value of the "key" field in the mutation.
+func (m *SettingMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSongID returns the old "song_id" field's value of the ProjectSong entity.
-// If the ProjectSong object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectSongMutation) OldSongID(ctx context.Context) (v int, err error) {
+func (m *SettingMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSongID is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSongID requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSongID: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.SongID, nil
-}
-
-// ResetSongID resets all changes to the "song_id" field.
-func (m *ProjectSongMutation) ResetSongID() {
-	m.song = nil
-}
-
-// ClearProject clears the "project" edge to the Project entity.
-func (m *ProjectSongMutation) ClearProject() {
-	m.clearedproject = true
-	m.clearedFields[projectsong.FieldProjectID] = struct{}{}
+	return oldValue.Key, nil
 }
 
-// ProjectCleared reports if the "project" edge to the Project entity was cleared.
-func (m *ProjectSongMutation) ProjectCleared() bool {
-	return m.clearedproject
+// ResetKey resets all changes to the "key" field.
+func (m *SettingMutation) ResetKey() {
+	m.key = nil
 }
 
-// ProjectIDs returns the "project" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProjectID instead. It exists only for internal usage by the builders.
-func (m *ProjectSongMutation) ProjectIDs() (ids []int) {
-	if id := m.project; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetValue sets the "value" field.
+func (m *SettingMutation) SetValue(s string) {
+	m.value = &s
 }
 
-// ResetProject resets all changes to the "project" edge.
-func (m *ProjectSongMutation) ResetProject() {
-	m.project = nil
-	m.clearedproject = false
+// Value returns the value of the "value" field in the mutation.
+func (m *SettingMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearSong clears the "song" edge to the Song entity.
-func (m *ProjectSongMutation) ClearSong() {
-	m.clearedsong = true
-	m.clearedFields[projectsong.FieldSongID] = struct{}{}
+// OldValue returns the old "value" field's value of the Setting entity.
+// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
 }
 
-// SongCleared reports if the "song" edge to the Song entity was cleared.
-func (m *ProjectSongMutation) SongCleared() bool {
-	return m.clearedsong
+// ClearValue clears the value of the "value" field.
+func (m *SettingMutation) ClearValue() {
+	m.value = nil
+	m.clearedFields[setting.FieldValue] = struct{}{}
 }
 
-// SongIDs returns the "song" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SongID instead. It exists only for internal usage by the builders.
-func (m *ProjectSongMutation) SongIDs() (ids []int) {
-	if id := m.song; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ValueCleared returns if the "value" field was cleared in this mutation.
+func (m *SettingMutation) ValueCleared() bool {
+	_, ok := m.clearedFields[setting.FieldValue]
+	return ok
 }
 
-// ResetSong resets all changes to the "song" edge.
-func (m *ProjectSongMutation) ResetSong() {
-	m.song = nil
-	m.clearedsong = false
+// ResetValue resets all changes to the "value" field.
+func (m *SettingMutation) ResetValue() {
+	m.value = nil
+	delete(m.clearedFields, setting.FieldValue)
 }
 
-// Where appends a list predicates to the ProjectSongMutation builder.
-func (m *ProjectSongMutation) Where(ps ...predicate.ProjectSong) {
+// Where appends a list predicates to the SettingMutation builder.
+func (m *SettingMutation) Where(ps ...predicate.Setting) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProjectSongMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProjectSongMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProjectSong, len(ps))
+func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Setting, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1067,39 +5011,30 @@ func (m *ProjectSongMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProjectSongMutation) Op() Op {
+func (m *SettingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProjectSongMutation) SetOp(op Op) {
+func (m *SettingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ProjectSong).
-func (m *ProjectSongMutation) Type() string {
+// Type returns the node type of this mutation (Setting).
+func (m *SettingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProjectSongMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.priority != nil {
-		fields = append(fields, projectsong.FieldPriority)
-	}
-	if m.difficulty != nil {
-		fields = append(fields, projectsong.FieldDifficulty)
-	}
-	if m.comment != nil {
-		fields = append(fields, projectsong.FieldComment)
-	}
-	if m.project != nil {
-		fields = append(fields, projectsong.FieldProjectID)
+func (m *SettingMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.key != nil {
+		fields = append(fields, setting.FieldKey)
 	}
-	if m.song != nil {
-		fields = append(fields, projectsong.FieldSongID)
+	if m.value != nil {
+		fields = append(fields, setting.FieldValue)
 	}
 	return fields
 }
@@ -1107,18 +5042,12 @@ func (m *ProjectSongMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProjectSongMutation) Field(name string) (ent.Value, bool) {
+func (m *SettingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case projectsong.FieldPriority:
-		return m.Priority()
-	case projectsong.FieldDifficulty:
-		return m.Difficulty()
-	case projectsong.FieldComment:
-		return m.Comment()
-	case projectsong.FieldProjectID:
-		return m.ProjectID()
-	case projectsong.FieldSongID:
-		return m.SongID()
+	case setting.FieldKey:
+		return m.Key()
+	case setting.FieldValue:
+		return m.Value()
 	}
 	return nil, false
 }
@@ -1126,271 +5055,182 @@ func (m *ProjectSongMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProjectSongMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case projectsong.FieldPriority:
-		return m.OldPriority(ctx)
-	case projectsong.FieldDifficulty:
-		return m.OldDifficulty(ctx)
-	case projectsong.FieldComment:
-		return m.OldComment(ctx)
-	case projectsong.FieldProjectID:
-		return m.OldProjectID(ctx)
-	case projectsong.FieldSongID:
-		return m.OldSongID(ctx)
+	case setting.FieldKey:
+		return m.OldKey(ctx)
+	case setting.FieldValue:
+		return m.OldValue(ctx)
 	}
-	return nil, fmt.Errorf("unknown ProjectSong field %s", name)
+	return nil, fmt.Errorf("unknown Setting field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectSongMutation) SetField(name string, value ent.Value) error {
+func (m *SettingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case projectsong.FieldPriority:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPriority(v)
-		return nil
-	case projectsong.FieldDifficulty:
-		v, ok := value.(projectsong.Difficulty)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDifficulty(v)
-		return nil
-	case projectsong.FieldComment:
+	case setting.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetComment(v)
-		return nil
-	case projectsong.FieldProjectID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProjectID(v)
+		m.SetKey(v)
 		return nil
-	case projectsong.FieldSongID:
-		v, ok := value.(int)
+	case setting.FieldValue:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSongID(v)
+		m.SetValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProjectSong field %s", name)
+	return fmt.Errorf("unknown Setting field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProjectSongMutation) AddedFields() []string {
-	var fields []string
-	if m.addpriority != nil {
-		fields = append(fields, projectsong.FieldPriority)
-	}
-	return fields
+func (m *SettingMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProjectSongMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case projectsong.FieldPriority:
-		return m.AddedPriority()
-	}
+func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectSongMutation) AddField(name string, value ent.Value) error {
+func (m *SettingMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case projectsong.FieldPriority:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPriority(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ProjectSong numeric field %s", name)
+	return fmt.Errorf("unknown Setting numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProjectSongMutation) ClearedFields() []string {
+func (m *SettingMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(projectsong.FieldComment) {
-		fields = append(fields, projectsong.FieldComment)
+	if m.FieldCleared(setting.FieldValue) {
+		fields = append(fields, setting.FieldValue)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProjectSongMutation) FieldCleared(name string) bool {
+func (m *SettingMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProjectSongMutation) ClearField(name string) error {
+func (m *SettingMutation) ClearField(name string) error {
 	switch name {
-	case projectsong.FieldComment:
-		m.ClearComment()
+	case setting.FieldValue:
+		m.ClearValue()
 		return nil
 	}
-	return fmt.Errorf("unknown ProjectSong nullable field %s", name)
+	return fmt.Errorf("unknown Setting nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProjectSongMutation) ResetField(name string) error {
+func (m *SettingMutation) ResetField(name string) error {
 	switch name {
-	case projectsong.FieldPriority:
-		m.ResetPriority()
-		return nil
-	case projectsong.FieldDifficulty:
-		m.ResetDifficulty()
-		return nil
-	case projectsong.FieldComment:
-		m.ResetComment()
-		return nil
-	case projectsong.FieldProjectID:
-		m.ResetProjectID()
+	case setting.FieldKey:
+		m.ResetKey()
 		return nil
-	case projectsong.FieldSongID:
-		m.ResetSongID()
+	case setting.FieldValue:
+		m.ResetValue()
 		return nil
 	}
-	return fmt.Errorf("unknown ProjectSong field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProjectSongMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.project != nil {
-		edges = append(edges, projectsong.EdgeProject)
-	}
-	if m.song != nil {
-		edges = append(edges, projectsong.EdgeSong)
-	}
+	return fmt.Errorf("unknown Setting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProjectSongMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case projectsong.EdgeProject:
-		if id := m.project; id != nil {
-			return []ent.Value{*id}
-		}
-	case projectsong.EdgeSong:
-		if id := m.song; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SettingMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProjectSongMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *SettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProjectSongMutation) RemovedIDs(name string) []ent.Value {
+func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProjectSongMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedproject {
-		edges = append(edges, projectsong.EdgeProject)
-	}
-	if m.clearedsong {
-		edges = append(edges, projectsong.EdgeSong)
-	}
+func (m *SettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProjectSongMutation) EdgeCleared(name string) bool {
-	switch name {
-	case projectsong.EdgeProject:
-		return m.clearedproject
-	case projectsong.EdgeSong:
-		return m.clearedsong
-	}
+func (m *SettingMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProjectSongMutation) ClearEdge(name string) error {
-	switch name {
-	case projectsong.EdgeProject:
-		m.ClearProject()
-		return nil
-	case projectsong.EdgeSong:
-		m.ClearSong()
-		return nil
-	}
-	return fmt.Errorf("unknown ProjectSong unique edge %s", name)
+func (m *SettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Setting unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProjectSongMutation) ResetEdge(name string) error {
-	switch name {
-	case projectsong.EdgeProject:
-		m.ResetProject()
-		return nil
-	case projectsong.EdgeSong:
-		m.ResetSong()
-		return nil
-	}
-	return fmt.Errorf("unknown ProjectSong edge %s", name)
+func (m *SettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Setting edge %s", name)
 }
 
-// SettingMutation represents an operation that mutates the Setting nodes in the graph.
-type SettingMutation struct {
+// SongMutation represents an operation that mutates the Song nodes in the graph.
+type SongMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	key           *string
-	value         *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Setting, error)
-	predicates    []predicate.Setting
+	op                   Op
+	typ                  string
+	id                   *int
+	title                *string
+	filename             *string
+	genre                *string
+	copyright            *string
+	tocinfo              *string
+	clearedFields        map[string]struct{}
+	project_songs        map[int]struct{}
+	removedproject_songs map[int]struct{}
+	clearedproject_songs bool
+	done                 bool
+	oldValue             func(context.Context) (*Song, error)
+	predicates           []predicate.Song
 }
 
-var _ ent.Mutation = (*SettingMutation)(nil)
+var _ ent.Mutation = (*SongMutation)(nil)
 
-// settingOption allows management of the mutation configuration using functional options.
-type settingOption func(*SettingMutation)
+// songOption allows management of the mutation configuration using functional options.
+type songOption func(*SongMutation)
 
-// newSettingMutation creates new mutation for the Setting entity.
-func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation {
-	m := &SettingMutation{
+// newSongMutation creates new mutation for the Song entity.
+func newSongMutation(c config, op Op, opts ...songOption) *SongMutation {
+	m := &SongMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSetting,
+		typ:           TypeSong,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1399,20 +5239,20 @@ func newSettingMutation(c config, op Op, opts ...settingOption) *SettingMutation
 	return m
 }
 
-// withSettingID sets the ID field of the mutation.
-func withSettingID(id int) settingOption {
-	return func(m *SettingMutation) {
+// withSongID sets the ID field of the mutation.
+func withSongID(id int) songOption {
+	return func(m *SongMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Setting
+			value *Song
 		)
-		m.oldValue = func(ctx context.Context) (*Setting, error) {
+		m.oldValue = func(ctx context.Context) (*Song, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Setting.Get(ctx, id)
+					value, err = m.Client().Song.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1421,10 +5261,10 @@ func withSettingID(id int) settingOption {
 	}
 }
 
-// withSetting sets the old Setting of the mutation.
-func withSetting(node *Setting) settingOption {
-	return func(m *SettingMutation) {
-		m.oldValue = func(context.Context) (*Setting, error) {
+// withSong sets the old Song of the mutation.
+func withSong(node *Song) songOption {
+	return func(m *SongMutation) {
+		m.oldValue = func(context.Context) (*Song, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1433,7 +5273,7 @@ func withSetting(node *Setting) settingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingMutation) Client() *Client {
+func (m SongMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1441,7 +5281,7 @@ func (m SettingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SettingMutation) Tx() (*Tx, error) {
+func (m SongMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1450,9 +5290,15 @@ func (m SettingMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Song entities.
+func (m *SongMutation) SetID(id int) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SettingMutation) ID() (id int, exists bool) {
+func (m *SongMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1463,7 +5309,7 @@ func (m *SettingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SongMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1472,106 +5318,294 @@ func (m *SettingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Setting.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Song.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKey sets the "key" field.
-func (m *SettingMutation) SetKey(s string) {
-	m.key = &s
+// SetTitle sets the "title" field.
+func (m *SongMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *SongMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Song entity.
+// If the Song object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SongMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *SongMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetFilename sets the "filename" field.
+func (m *SongMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *SongMutation) Filename() (r string, exists bool) {
+	v := m.filename
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilename returns the old "filename" field's value of the Song entity.
+// If the Song object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SongMutation) OldFilename(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilename requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+	}
+	return oldValue.Filename, nil
+}
+
+// ResetFilename resets all changes to the "filename" field.
+func (m *SongMutation) ResetFilename() {
+	m.filename = nil
+}
+
+// SetGenre sets the "genre" field.
+func (m *SongMutation) SetGenre(s string) {
+	m.genre = &s
+}
+
+// Genre returns the value of the "genre" field in the mutation.
+func (m *SongMutation) Genre() (r string, exists bool) {
+	v := m.genre
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenre returns the old "genre" field's value of the Song entity.
+// If the Song object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SongMutation) OldGenre(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenre is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenre requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenre: %w", err)
+	}
+	return oldValue.Genre, nil
+}
+
+// ClearGenre clears the value of the "genre" field.
+func (m *SongMutation) ClearGenre() {
+	m.genre = nil
+	m.clearedFields[song.FieldGenre] = struct{}{}
+}
+
+// GenreCleared returns if the "genre" field was cleared in this mutation.
+func (m *SongMutation) GenreCleared() bool {
+	_, ok := m.clearedFields[song.FieldGenre]
+	return ok
+}
+
+// ResetGenre resets all changes to the "genre" field.
+func (m *SongMutation) ResetGenre() {
+	m.genre = nil
+	delete(m.clearedFields, song.FieldGenre)
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *SettingMutation) Key() (r string, exists bool) {
-	v := m.key
+// SetCopyright sets the "copyright" field.
+func (m *SongMutation) SetCopyright(s string) {
+	m.copyright = &s
+}
+
+// Copyright returns the value of the "copyright" field in the mutation.
+func (m *SongMutation) Copyright() (r string, exists bool) {
+	v := m.copyright
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldCopyright returns the old "copyright" field's value of the Song entity.
+// If the Song object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *SongMutation) OldCopyright(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldCopyright is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldCopyright requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldCopyright: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.Copyright, nil
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *SettingMutation) ResetKey() {
-	m.key = nil
+// ClearCopyright clears the value of the "copyright" field.
+func (m *SongMutation) ClearCopyright() {
+	m.copyright = nil
+	m.clearedFields[song.FieldCopyright] = struct{}{}
 }
 
-// SetValue sets the "value" field.
-func (m *SettingMutation) SetValue(s string) {
-	m.value = &s
+// CopyrightCleared returns if the "copyright" field was cleared in this mutation.
+func (m *SongMutation) CopyrightCleared() bool {
+	_, ok := m.clearedFields[song.FieldCopyright]
+	return ok
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *SettingMutation) Value() (r string, exists bool) {
-	v := m.value
+// ResetCopyright resets all changes to the "copyright" field.
+func (m *SongMutation) ResetCopyright() {
+	m.copyright = nil
+	delete(m.clearedFields, song.FieldCopyright)
+}
+
+// SetTocinfo sets the "tocinfo" field.
+func (m *SongMutation) SetTocinfo(s string) {
+	m.tocinfo = &s
+}
+
+// Tocinfo returns the value of the "tocinfo" field in the mutation.
+func (m *SongMutation) Tocinfo() (r string, exists bool) {
+	v := m.tocinfo
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Setting entity.
-// If the Setting object wasn't provided to the builder, the object is fetched from the database.
+// OldTocinfo returns the old "tocinfo" field's value of the Song entity.
+// If the Song object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *SongMutation) OldTocinfo(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldTocinfo is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldTocinfo requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldTocinfo: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Tocinfo, nil
 }
 
-// ClearValue clears the value of the "value" field.
-func (m *SettingMutation) ClearValue() {
-	m.value = nil
-	m.clearedFields[setting.FieldValue] = struct{}{}
+// ClearTocinfo clears the value of the "tocinfo" field.
+func (m *SongMutation) ClearTocinfo() {
+	m.tocinfo = nil
+	m.clearedFields[song.FieldTocinfo] = struct{}{}
 }
 
-// ValueCleared returns if the "value" field was cleared in this mutation.
-func (m *SettingMutation) ValueCleared() bool {
-	_, ok := m.clearedFields[setting.FieldValue]
+// TocinfoCleared returns if the "tocinfo" field was cleared in this mutation.
+func (m *SongMutation) TocinfoCleared() bool {
+	_, ok := m.clearedFields[song.FieldTocinfo]
 	return ok
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *SettingMutation) ResetValue() {
-	m.value = nil
-	delete(m.clearedFields, setting.FieldValue)
+// ResetTocinfo resets all changes to the "tocinfo" field.
+func (m *SongMutation) ResetTocinfo() {
+	m.tocinfo = nil
+	delete(m.clearedFields, song.FieldTocinfo)
 }
 
-// Where appends a list predicates to the SettingMutation builder.
-func (m *SettingMutation) Where(ps ...predicate.Setting) {
+// AddProjectSongIDs adds the "project_songs" edge to the ProjectSong entity by ids.
+func (m *SongMutation) AddProjectSongIDs(ids ...int) {
+	if m.project_songs == nil {
+		m.project_songs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.project_songs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProjectSongs clears the "project_songs" edge to the ProjectSong entity.
+func (m *SongMutation) ClearProjectSongs() {
+	m.clearedproject_songs = true
+}
+
+// ProjectSongsCleared reports if the "project_songs" edge to the ProjectSong entity was cleared.
+func (m *SongMutation) ProjectSongsCleared() bool {
+	return m.clearedproject_songs
+}
+
+// RemoveProjectSongIDs removes the "project_songs" edge to the ProjectSong entity by IDs.
+func (m *SongMutation) RemoveProjectSongIDs(ids ...int) {
+	if m.removedproject_songs == nil {
+		m.removedproject_songs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.project_songs, ids[i])
+		m.removedproject_songs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProjectSongs returns the removed IDs of the "project_songs" edge to the ProjectSong entity.
+func (m *SongMutation) RemovedProjectSongsIDs() (ids []int) {
+	for id := range m.removedproject_songs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProjectSongsIDs returns the "project_songs" edge IDs in the mutation.
+func (m *SongMutation) ProjectSongsIDs() (ids []int) {
+	for id := range m.project_songs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProjectSongs resets all changes to the "project_songs" edge.
+func (m *SongMutation) ResetProjectSongs() {
+	m.project_songs = nil
+	m.clearedproject_songs = false
+	m.removedproject_songs = nil
+}
+
+// Where appends a list predicates to the SongMutation builder.
+func (m *SongMutation) Where(ps ...predicate.Song) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SettingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SongMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Setting, len(ps))
+func (m *SongMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Song, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1579,30 +5613,39 @@ func (m *SettingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SettingMutation) Op() Op {
+func (m *SongMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SettingMutation) SetOp(op Op) {
+func (m *SongMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Setting).
-func (m *SettingMutation) Type() string {
+// Type returns the node type of this mutation (Song).
+func (m *SongMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SettingMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.key != nil {
-		fields = append(fields, setting.FieldKey)
+func (m *SongMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.title != nil {
+		fields = append(fields, song.FieldTitle)
 	}
-	if m.value != nil {
-		fields = append(fields, setting.FieldValue)
+	if m.filename != nil {
+		fields = append(fields, song.FieldFilename)
+	}
+	if m.genre != nil {
+		fields = append(fields, song.FieldGenre)
+	}
+	if m.copyright != nil {
+		fields = append(fields, song.FieldCopyright)
+	}
+	if m.tocinfo != nil {
+		fields = append(fields, song.FieldTocinfo)
 	}
 	return fields
 }
@@ -1610,12 +5653,18 @@ func (m *SettingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SettingMutation) Field(name string) (ent.Value, bool) {
+func (m *SongMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case setting.FieldKey:
-		return m.Key()
-	case setting.FieldValue:
-		return m.Value()
+	case song.FieldTitle:
+		return m.Title()
+	case song.FieldFilename:
+		return m.Filename()
+	case song.FieldGenre:
+		return m.Genre()
+	case song.FieldCopyright:
+		return m.Copyright()
+	case song.FieldTocinfo:
+		return m.Tocinfo()
 	}
 	return nil, false
 }
@@ -1623,182 +5672,264 @@ func (m *SettingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SongMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case setting.FieldKey:
-		return m.OldKey(ctx)
-	case setting.FieldValue:
-		return m.OldValue(ctx)
+	case song.FieldTitle:
+		return m.OldTitle(ctx)
+	case song.FieldFilename:
+		return m.OldFilename(ctx)
+	case song.FieldGenre:
+		return m.OldGenre(ctx)
+	case song.FieldCopyright:
+		return m.OldCopyright(ctx)
+	case song.FieldTocinfo:
+		return m.OldTocinfo(ctx)
 	}
-	return nil, fmt.Errorf("unknown Setting field %s", name)
+	return nil, fmt.Errorf("unknown Song field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) SetField(name string, value ent.Value) error {
+func (m *SongMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case setting.FieldKey:
+	case song.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetTitle(v)
 		return nil
-	case setting.FieldValue:
+	case song.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case song.FieldGenre:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenre(v)
+		return nil
+	case song.FieldCopyright:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCopyright(v)
+		return nil
+	case song.FieldTocinfo:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetTocinfo(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown Song field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SettingMutation) AddedFields() []string {
+func (m *SongMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SettingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SongMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SettingMutation) AddField(name string, value ent.Value) error {
+func (m *SongMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Setting numeric field %s", name)
+	return fmt.Errorf("unknown Song numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SettingMutation) ClearedFields() []string {
+func (m *SongMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(setting.FieldValue) {
-		fields = append(fields, setting.FieldValue)
+	if m.FieldCleared(song.FieldGenre) {
+		fields = append(fields, song.FieldGenre)
+	}
+	if m.FieldCleared(song.FieldCopyright) {
+		fields = append(fields, song.FieldCopyright)
+	}
+	if m.FieldCleared(song.FieldTocinfo) {
+		fields = append(fields, song.FieldTocinfo)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SettingMutation) FieldCleared(name string) bool {
+func (m *SongMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SettingMutation) ClearField(name string) error {
+func (m *SongMutation) ClearField(name string) error {
 	switch name {
-	case setting.FieldValue:
-		m.ClearValue()
+	case song.FieldGenre:
+		m.ClearGenre()
+		return nil
+	case song.FieldCopyright:
+		m.ClearCopyright()
+		return nil
+	case song.FieldTocinfo:
+		m.ClearTocinfo()
 		return nil
 	}
-	return fmt.Errorf("unknown Setting nullable field %s", name)
+	return fmt.Errorf("unknown Song nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SettingMutation) ResetField(name string) error {
+func (m *SongMutation) ResetField(name string) error {
 	switch name {
-	case setting.FieldKey:
-		m.ResetKey()
+	case song.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case setting.FieldValue:
-		m.ResetValue()
+	case song.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case song.FieldGenre:
+		m.ResetGenre()
+		return nil
+	case song.FieldCopyright:
+		m.ResetCopyright()
+		return nil
+	case song.FieldTocinfo:
+		m.ResetTocinfo()
 		return nil
 	}
-	return fmt.Errorf("unknown Setting field %s", name)
+	return fmt.Errorf("unknown Song field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SettingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SongMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.project_songs != nil {
+		edges = append(edges, song.EdgeProjectSongs)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SettingMutation) AddedIDs(name string) []ent.Value {
+func (m *SongMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case song.EdgeProjectSongs:
+		ids := make([]ent.Value, 0, len(m.project_songs))
+		for id := range m.project_songs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SettingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SongMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedproject_songs != nil {
+		edges = append(edges, song.EdgeProjectSongs)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SettingMutation) RemovedIDs(name string) []ent.Value {
+func (m *SongMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case song.EdgeProjectSongs:
+		ids := make([]ent.Value, 0, len(m.removedproject_songs))
+		for id := range m.removedproject_songs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SettingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SongMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedproject_songs {
+		edges = append(edges, song.EdgeProjectSongs)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SettingMutation) EdgeCleared(name string) bool {
+func (m *SongMutation) EdgeCleared(name string) bool {
+	switch name {
+	case song.EdgeProjectSongs:
+		return m.clearedproject_songs
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SettingMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Setting unique edge %s", name)
+func (m *SongMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Song unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SettingMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Setting edge %s", name)
+func (m *SongMutation) ResetEdge(name string) error {
+	switch name {
+	case song.EdgeProjectSongs:
+		m.ResetProjectSongs()
+		return nil
+	}
+	return fmt.Errorf("unknown Song edge %s", name)
 }
 
-// SongMutation represents an operation that mutates the Song nodes in the graph.
-type SongMutation struct {
+// TemplateMutation represents an operation that mutates the Template nodes in the graph.
+type TemplateMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	title                *string
-	filename             *string
-	genre                *string
-	copyright            *string
-	tocinfo              *string
-	clearedFields        map[string]struct{}
-	project_songs        map[int]struct{}
-	removedproject_songs map[int]struct{}
-	clearedproject_songs bool
-	done                 bool
-	oldValue             func(context.Context) (*Song, error)
-	predicates           []predicate.Song
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	kind          *string
+	description   *string
+	content       *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Template, error)
+	predicates    []predicate.Template
 }
 
-var _ ent.Mutation = (*SongMutation)(nil)
+var _ ent.Mutation = (*TemplateMutation)(nil)
 
-// songOption allows management of the mutation configuration using functional options.
-type songOption func(*SongMutation)
+// templateOption allows management of the mutation configuration using functional options.
+type templateOption func(*TemplateMutation)
 
-// newSongMutation creates new mutation for the Song entity.
-func newSongMutation(c config, op Op, opts ...songOption) *SongMutation {
-	m := &SongMutation{
+// newTemplateMutation creates new mutation for the Template entity.
+func newTemplateMutation(c config, op Op, opts ...templateOption) *TemplateMutation {
+	m := &TemplateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSong,
+		typ:           TypeTemplate,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1807,20 +5938,20 @@ func newSongMutation(c config, op Op, opts ...songOption) *SongMutation {
 	return m
 }
 
-// withSongID sets the ID field of the mutation.
-func withSongID(id int) songOption {
-	return func(m *SongMutation) {
+// withTemplateID sets the ID field of the mutation.
+func withTemplateID(id int) templateOption {
+	return func(m *TemplateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Song
+			value *Template
 		)
-		m.oldValue = func(ctx context.Context) (*Song, error) {
+		m.oldValue = func(ctx context.Context) (*Template, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Song.Get(ctx, id)
+					value, err = m.Client().Template.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1829,10 +5960,10 @@ func withSongID(id int) songOption {
 	}
 }
 
-// withSong sets the old Song of the mutation.
-func withSong(node *Song) songOption {
-	return func(m *SongMutation) {
-		m.oldValue = func(context.Context) (*Song, error) {
+// withTemplate sets the old Template of the mutation.
+func withTemplate(node *Template) templateOption {
+	return func(m *TemplateMutation) {
+		m.oldValue = func(context.Context) (*Template, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1841,7 +5972,7 @@ func withSong(node *Song) songOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SongMutation) Client() *Client {
+func (m TemplateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1849,7 +5980,7 @@ func (m SongMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SongMutation) Tx() (*Tx, error) {
+func (m TemplateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1859,14 +5990,14 @@ func (m SongMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Song entities.
-func (m *SongMutation) SetID(id int) {
+// operation is only accepted on creation of Template entities.
+func (m *TemplateMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SongMutation) ID() (id int, exists bool) {
+func (m *TemplateMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1877,7 +6008,7 @@ func (m *SongMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SongMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TemplateMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1886,294 +6017,250 @@ func (m *SongMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Song.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Template.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *SongMutation) SetTitle(s string) {
-	m.title = &s
-}
-
-// Title returns the value of the "title" field in the mutation.
-func (m *SongMutation) Title() (r string, exists bool) {
-	v := m.title
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTitle returns the old "title" field's value of the Song entity.
-// If the Song object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SongMutation) OldTitle(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
-	}
-	return oldValue.Title, nil
-}
-
-// ResetTitle resets all changes to the "title" field.
-func (m *SongMutation) ResetTitle() {
-	m.title = nil
-}
-
-// SetFilename sets the "filename" field.
-func (m *SongMutation) SetFilename(s string) {
-	m.filename = &s
+// SetName sets the "name" field.
+func (m *TemplateMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Filename returns the value of the "filename" field in the mutation.
-func (m *SongMutation) Filename() (r string, exists bool) {
-	v := m.filename
+// Name returns the value of the "name" field in the mutation.
+func (m *TemplateMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilename returns the old "filename" field's value of the Song entity.
-// If the Song object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SongMutation) OldFilename(ctx context.Context) (v string, err error) {
+func (m *TemplateMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilename requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Filename, nil
+	return oldValue.Name, nil
 }
 
-// ResetFilename resets all changes to the "filename" field.
-func (m *SongMutation) ResetFilename() {
-	m.filename = nil
+// ResetName resets all changes to the "name" field.
+func (m *TemplateMutation) ResetName() {
+	m.name = nil
 }
 
-// SetGenre sets the "genre" field.
-func (m *SongMutation) SetGenre(s string) {
-	m.genre = &s
+// SetKind sets the "kind" field.
+func (m *TemplateMutation) SetKind(s string) {
+	m.kind = &s
 }
 
-// Genre returns the value of the "genre" field in the mutation.
-func (m *SongMutation) Genre() (r string, exists bool) {
-	v := m.genre
+// Kind returns the value of the "kind" field in the mutation.
+func (m *TemplateMutation) Kind() (r string, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGenre returns the old "genre" field's value of the Song entity.
-// If the Song object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SongMutation) OldGenre(ctx context.Context) (v string, err error) {
+func (m *TemplateMutation) OldKind(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGenre is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGenre requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGenre: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.Genre, nil
-}
-
-// ClearGenre clears the value of the "genre" field.
-func (m *SongMutation) ClearGenre() {
-	m.genre = nil
-	m.clearedFields[song.FieldGenre] = struct{}{}
-}
-
-// GenreCleared returns if the "genre" field was cleared in this mutation.
-func (m *SongMutation) GenreCleared() bool {
-	_, ok := m.clearedFields[song.FieldGenre]
-	return ok
-}
-
-// ResetGenre resets all changes to the "genre" field.
-func (m *SongMutation) ResetGenre() {
-	m.genre = nil
-	delete(m.clearedFields, song.FieldGenre)
+	return oldValue.Kind, nil
 }
 
-// SetCopyright sets the "copyright" field.
-func (m *SongMutation) SetCopyright(s string) {
-	m.copyright = &s
+// ResetKind resets all changes to the "kind" field.
+func (m *TemplateMutation) ResetKind() {
+	m.kind = nil
 }
 
-// Copyright returns the value of the "copyright" field in the mutation.
-func (m *SongMutation) Copyright() (r string, exists bool) {
-	v := m.copyright
+// SetDescription sets the "description" field.
+func (m *TemplateMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TemplateMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCopyright returns the old "copyright" field's value of the Song entity.
-// If the Song object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SongMutation) OldCopyright(ctx context.Context) (v string, err error) {
+func (m *TemplateMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCopyright is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCopyright requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCopyright: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Copyright, nil
+	return oldValue.Description, nil
 }
 
-// ClearCopyright clears the value of the "copyright" field.
-func (m *SongMutation) ClearCopyright() {
-	m.copyright = nil
-	m.clearedFields[song.FieldCopyright] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *TemplateMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[template.FieldDescription] = struct{}{}
 }
 
-// CopyrightCleared returns if the "copyright" field was cleared in this mutation.
-func (m *SongMutation) CopyrightCleared() bool {
-	_, ok := m.clearedFields[song.FieldCopyright]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TemplateMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[template.FieldDescription]
 	return ok
 }
 
-// ResetCopyright resets all changes to the "copyright" field.
-func (m *SongMutation) ResetCopyright() {
-	m.copyright = nil
-	delete(m.clearedFields, song.FieldCopyright)
+// ResetDescription resets all changes to the "description" field.
+func (m *TemplateMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, template.FieldDescription)
 }
 
-// SetTocinfo sets the "tocinfo" field.
-func (m *SongMutation) SetTocinfo(s string) {
-	m.tocinfo = &s
+// SetContent sets the "content" field.
+func (m *TemplateMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// Tocinfo returns the value of the "tocinfo" field in the mutation.
-func (m *SongMutation) Tocinfo() (r string, exists bool) {
-	v := m.tocinfo
+// Content returns the value of the "content" field in the mutation.
+func (m *TemplateMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTocinfo returns the old "tocinfo" field's value of the Song entity.
-// If the Song object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SongMutation) OldTocinfo(ctx context.Context) (v string, err error) {
+func (m *TemplateMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTocinfo is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTocinfo requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTocinfo: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.Tocinfo, nil
+	return oldValue.Content, nil
 }
 
-// ClearTocinfo clears the value of the "tocinfo" field.
-func (m *SongMutation) ClearTocinfo() {
-	m.tocinfo = nil
-	m.clearedFields[song.FieldTocinfo] = struct{}{}
+// ResetContent resets all changes to the "content" field.
+func (m *TemplateMutation) ResetContent() {
+	m.content = nil
 }
 
-// TocinfoCleared returns if the "tocinfo" field was cleared in this mutation.
-func (m *SongMutation) TocinfoCleared() bool {
-	_, ok := m.clearedFields[song.FieldTocinfo]
-	return ok
+// SetCreatedAt sets the "created_at" field.
+func (m *TemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ResetTocinfo resets all changes to the "tocinfo" field.
-func (m *SongMutation) ResetTocinfo() {
-	m.tocinfo = nil
-	delete(m.clearedFields, song.FieldTocinfo)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddProjectSongIDs adds the "project_songs" edge to the ProjectSong entity by ids.
-func (m *SongMutation) AddProjectSongIDs(ids ...int) {
-	if m.project_songs == nil {
-		m.project_songs = make(map[int]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.project_songs[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearProjectSongs clears the "project_songs" edge to the ProjectSong entity.
-func (m *SongMutation) ClearProjectSongs() {
-	m.clearedproject_songs = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ProjectSongsCleared reports if the "project_songs" edge to the ProjectSong entity was cleared.
-func (m *SongMutation) ProjectSongsCleared() bool {
-	return m.clearedproject_songs
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TemplateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemoveProjectSongIDs removes the "project_songs" edge to the ProjectSong entity by IDs.
-func (m *SongMutation) RemoveProjectSongIDs(ids ...int) {
-	if m.removedproject_songs == nil {
-		m.removedproject_songs = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.project_songs, ids[i])
-		m.removedproject_songs[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedProjectSongs returns the removed IDs of the "project_songs" edge to the ProjectSong entity.
-func (m *SongMutation) RemovedProjectSongsIDs() (ids []int) {
-	for id := range m.removedproject_songs {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the Template entity.
+// If the Template object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ProjectSongsIDs returns the "project_songs" edge IDs in the mutation.
-func (m *SongMutation) ProjectSongsIDs() (ids []int) {
-	for id := range m.project_songs {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetProjectSongs resets all changes to the "project_songs" edge.
-func (m *SongMutation) ResetProjectSongs() {
-	m.project_songs = nil
-	m.clearedproject_songs = false
-	m.removedproject_songs = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the SongMutation builder.
-func (m *SongMutation) Where(ps ...predicate.Song) {
+// Where appends a list predicates to the TemplateMutation builder.
+func (m *TemplateMutation) Where(ps ...predicate.Template) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SongMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TemplateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SongMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Song, len(ps))
+func (m *TemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Template, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2181,39 +6268,42 @@ func (m *SongMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SongMutation) Op() Op {
+func (m *TemplateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SongMutation) SetOp(op Op) {
+func (m *TemplateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Song).
-func (m *SongMutation) Type() string {
+// Type returns the node type of this mutation (Template).
+func (m *TemplateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SongMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.title != nil {
-		fields = append(fields, song.FieldTitle)
+func (m *TemplateMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, template.FieldName)
 	}
-	if m.filename != nil {
-		fields = append(fields, song.FieldFilename)
+	if m.kind != nil {
+		fields = append(fields, template.FieldKind)
 	}
-	if m.genre != nil {
-		fields = append(fields, song.FieldGenre)
+	if m.description != nil {
+		fields = append(fields, template.FieldDescription)
 	}
-	if m.copyright != nil {
-		fields = append(fields, song.FieldCopyright)
+	if m.content != nil {
+		fields = append(fields, template.FieldContent)
 	}
-	if m.tocinfo != nil {
-		fields = append(fields, song.FieldTocinfo)
+	if m.created_at != nil {
+		fields = append(fields, template.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, template.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2221,18 +6311,20 @@ func (m *SongMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SongMutation) Field(name string) (ent.Value, bool) {
+func (m *TemplateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case song.FieldTitle:
-		return m.Title()
-	case song.FieldFilename:
-		return m.Filename()
-	case song.FieldGenre:
-		return m.Genre()
-	case song.FieldCopyright:
-		return m.Copyright()
-	case song.FieldTocinfo:
-		return m.Tocinfo()
+	case template.FieldName:
+		return m.Name()
+	case template.FieldKind:
+		return m.Kind()
+	case template.FieldDescription:
+		return m.Description()
+	case template.FieldContent:
+		return m.Content()
+	case template.FieldCreatedAt:
+		return m.CreatedAt()
+	case template.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2240,231 +6332,195 @@ func (m *SongMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SongMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case song.FieldTitle:
-		return m.OldTitle(ctx)
-	case song.FieldFilename:
-		return m.OldFilename(ctx)
-	case song.FieldGenre:
-		return m.OldGenre(ctx)
-	case song.FieldCopyright:
-		return m.OldCopyright(ctx)
-	case song.FieldTocinfo:
-		return m.OldTocinfo(ctx)
-	}
-	return nil, fmt.Errorf("unknown Song field %s", name)
+	case template.FieldName:
+		return m.OldName(ctx)
+	case template.FieldKind:
+		return m.OldKind(ctx)
+	case template.FieldDescription:
+		return m.OldDescription(ctx)
+	case template.FieldContent:
+		return m.OldContent(ctx)
+	case template.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case template.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Template field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SongMutation) SetField(name string, value ent.Value) error {
+func (m *TemplateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case song.FieldTitle:
+	case template.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetName(v)
 		return nil
-	case song.FieldFilename:
+	case template.FieldKind:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFilename(v)
+		m.SetKind(v)
 		return nil
-	case song.FieldGenre:
+	case template.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGenre(v)
+		m.SetDescription(v)
 		return nil
-	case song.FieldCopyright:
+	case template.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCopyright(v)
+		m.SetContent(v)
 		return nil
-	case song.FieldTocinfo:
-		v, ok := value.(string)
+	case template.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTocinfo(v)
+		m.SetCreatedAt(v)
+		return nil
+	case template.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Song field %s", name)
+	return fmt.Errorf("unknown Template field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SongMutation) AddedFields() []string {
+func (m *TemplateMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SongMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TemplateMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SongMutation) AddField(name string, value ent.Value) error {
+func (m *TemplateMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Song numeric field %s", name)
+	return fmt.Errorf("unknown Template numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SongMutation) ClearedFields() []string {
+func (m *TemplateMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(song.FieldGenre) {
-		fields = append(fields, song.FieldGenre)
-	}
-	if m.FieldCleared(song.FieldCopyright) {
-		fields = append(fields, song.FieldCopyright)
-	}
-	if m.FieldCleared(song.FieldTocinfo) {
-		fields = append(fields, song.FieldTocinfo)
+	if m.FieldCleared(template.FieldDescription) {
+		fields = append(fields, template.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SongMutation) FieldCleared(name string) bool {
+func (m *TemplateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SongMutation) ClearField(name string) error {
+func (m *TemplateMutation) ClearField(name string) error {
 	switch name {
-	case song.FieldGenre:
-		m.ClearGenre()
-		return nil
-	case song.FieldCopyright:
-		m.ClearCopyright()
-		return nil
-	case song.FieldTocinfo:
-		m.ClearTocinfo()
+	case template.FieldDescription:
+		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown Song nullable field %s", name)
+	return fmt.Errorf("unknown Template nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SongMutation) ResetField(name string) error {
+func (m *TemplateMutation) ResetField(name string) error {
 	switch name {
-	case song.FieldTitle:
-		m.ResetTitle()
+	case template.FieldName:
+		m.ResetName()
 		return nil
-	case song.FieldFilename:
-		m.ResetFilename()
+	case template.FieldKind:
+		m.ResetKind()
 		return nil
-	case song.FieldGenre:
-		m.ResetGenre()
+	case template.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case song.FieldCopyright:
-		m.ResetCopyright()
+	case template.FieldContent:
+		m.ResetContent()
 		return nil
-	case song.FieldTocinfo:
-		m.ResetTocinfo()
+	case template.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case template.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Song field %s", name)
+	return fmt.Errorf("unknown Template field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SongMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.project_songs != nil {
-		edges = append(edges, song.EdgeProjectSongs)
-	}
+func (m *TemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SongMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case song.EdgeProjectSongs:
-		ids := make([]ent.Value, 0, len(m.project_songs))
-		for id := range m.project_songs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TemplateMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SongMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedproject_songs != nil {
-		edges = append(edges, song.EdgeProjectSongs)
-	}
+func (m *TemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SongMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case song.EdgeProjectSongs:
-		ids := make([]ent.Value, 0, len(m.removedproject_songs))
-		for id := range m.removedproject_songs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TemplateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SongMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedproject_songs {
-		edges = append(edges, song.EdgeProjectSongs)
-	}
+func (m *TemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SongMutation) EdgeCleared(name string) bool {
-	switch name {
-	case song.EdgeProjectSongs:
-		return m.clearedproject_songs
-	}
+func (m *TemplateMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SongMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Song unique edge %s", name)
+func (m *TemplateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Template unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SongMutation) ResetEdge(name string) error {
-	switch name {
-	case song.EdgeProjectSongs:
-		m.ResetProjectSongs()
-		return nil
-	}
-	return fmt.Errorf("unknown Song edge %s", name)
+func (m *TemplateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Template edge %s", name)
 }
@@ -6,6 +6,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Build is the predicate function for build builders.
+type Build func(*sql.Selector)
+
+// BuildProfile is the predicate function for buildprofile builders.
+type BuildProfile func(*sql.Selector)
+
 // Project is the predicate function for project builders.
 type Project func(*sql.Selector)
 
@@ -17,3 +23,6 @@ type Setting func(*sql.Selector)
 
 // Song is the predicate function for song builders.
 type Song func(*sql.Selector)
+
+// Template is the predicate function for template builders.
+type Template func(*sql.Selector)
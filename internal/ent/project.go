@@ -25,6 +25,10 @@ type Project struct {
 	Config map[string]interface{} `json:"config,omitempty"`
 	// User's preferred directory for ABC files
 	AbcFileDirPreference string `json:"abc_file_dir_preference,omitempty"`
+	// User-defined template variables of the project
+	Variables map[string]string `json:"variables,omitempty"`
+	// Numbers of removed songs that are not given to new songs
+	ReservedNumbers []int `json:"reserved_numbers,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProjectQuery when eager-loading is set.
 	Edges        ProjectEdges `json:"edges"`
@@ -35,9 +39,13 @@ type Project struct {
 type ProjectEdges struct {
 	// ProjectSongs holds the value of the project_songs edge.
 	ProjectSongs []*ProjectSong `json:"project_songs,omitempty"`
+	// Builds holds the value of the builds edge.
+	Builds []*Build `json:"builds,omitempty"`
+	// BuildProfiles holds the value of the build_profiles edge.
+	BuildProfiles []*BuildProfile `json:"build_profiles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // ProjectSongsOrErr returns the ProjectSongs value or an error if the edge
@@ -49,12 +57,30 @@ func (e ProjectEdges) ProjectSongsOrErr() ([]*ProjectSong, error) {
 	return nil, &NotLoadedError{edge: "project_songs"}
 }
 
+// BuildsOrErr returns the Builds value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) BuildsOrErr() ([]*Build, error) {
+	if e.loadedTypes[1] {
+		return e.Builds, nil
+	}
+	return nil, &NotLoadedError{edge: "builds"}
+}
+
+// BuildProfilesOrErr returns the BuildProfiles value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) BuildProfilesOrErr() ([]*BuildProfile, error) {
+	if e.loadedTypes[2] {
+		return e.BuildProfiles, nil
+	}
+	return nil, &NotLoadedError{edge: "build_profiles"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Project) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case project.FieldConfig:
+		case project.FieldConfig, project.FieldVariables, project.FieldReservedNumbers:
 			values[i] = new([]byte)
 		case project.FieldID:
 			values[i] = new(sql.NullInt64)
@@ -107,6 +133,22 @@ func (pr *Project) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.AbcFileDirPreference = value.String
 			}
+		case project.FieldVariables:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variables", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.Variables); err != nil {
+					return fmt.Errorf("unmarshal field variables: %w", err)
+				}
+			}
+		case project.FieldReservedNumbers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reserved_numbers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.ReservedNumbers); err != nil {
+					return fmt.Errorf("unmarshal field reserved_numbers: %w", err)
+				}
+			}
 		default:
 			pr.selectValues.Set(columns[i], values[i])
 		}
@@ -125,6 +167,16 @@ func (pr *Project) QueryProjectSongs() *ProjectSongQuery {
 	return NewProjectClient(pr.config).QueryProjectSongs(pr)
 }
 
+// QueryBuilds queries the "builds" edge of the Project entity.
+func (pr *Project) QueryBuilds() *BuildQuery {
+	return NewProjectClient(pr.config).QueryBuilds(pr)
+}
+
+// QueryBuildProfiles queries the "build_profiles" edge of the Project entity.
+func (pr *Project) QueryBuildProfiles() *BuildProfileQuery {
+	return NewProjectClient(pr.config).QueryBuildProfiles(pr)
+}
+
 // Update returns a builder for updating this Project.
 // Note that you need to call Project.Unwrap() before calling this method if this Project
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -159,6 +211,12 @@ func (pr *Project) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("abc_file_dir_preference=")
 	builder.WriteString(pr.AbcFileDirPreference)
+	builder.WriteString(", ")
+	builder.WriteString("variables=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Variables))
+	builder.WriteString(", ")
+	builder.WriteString("reserved_numbers=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ReservedNumbers))
 	builder.WriteByte(')')
 	return builder.String()
 }
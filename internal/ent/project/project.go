@@ -20,8 +20,16 @@ const (
 	FieldConfig = "config"
 	// FieldAbcFileDirPreference holds the string denoting the abc_file_dir_preference field in the database.
 	FieldAbcFileDirPreference = "abc_file_dir_preference"
+	// FieldVariables holds the string denoting the variables field in the database.
+	FieldVariables = "variables"
+	// FieldReservedNumbers holds the string denoting the reserved_numbers field in the database.
+	FieldReservedNumbers = "reserved_numbers"
 	// EdgeProjectSongs holds the string denoting the project_songs edge name in mutations.
 	EdgeProjectSongs = "project_songs"
+	// EdgeBuilds holds the string denoting the builds edge name in mutations.
+	EdgeBuilds = "builds"
+	// EdgeBuildProfiles holds the string denoting the build_profiles edge name in mutations.
+	EdgeBuildProfiles = "build_profiles"
 	// Table holds the table name of the project in the database.
 	Table = "projects"
 	// ProjectSongsTable is the table that holds the project_songs relation/edge.
@@ -31,6 +39,20 @@ const (
 	ProjectSongsInverseTable = "project_songs"
 	// ProjectSongsColumn is the table column denoting the project_songs relation/edge.
 	ProjectSongsColumn = "project_project_songs"
+	// BuildsTable is the table that holds the builds relation/edge.
+	BuildsTable = "builds"
+	// BuildsInverseTable is the table name for the Build entity.
+	// It exists in this package in order to avoid circular dependency with the "build" package.
+	BuildsInverseTable = "builds"
+	// BuildsColumn is the table column denoting the builds relation/edge.
+	BuildsColumn = "project_id"
+	// BuildProfilesTable is the table that holds the build_profiles relation/edge.
+	BuildProfilesTable = "build_profiles"
+	// BuildProfilesInverseTable is the table name for the BuildProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "buildprofile" package.
+	BuildProfilesInverseTable = "build_profiles"
+	// BuildProfilesColumn is the table column denoting the build_profiles relation/edge.
+	BuildProfilesColumn = "project_id"
 )
 
 // Columns holds all SQL columns for project fields.
@@ -40,6 +62,8 @@ var Columns = []string{
 	FieldShortName,
 	FieldConfig,
 	FieldAbcFileDirPreference,
+	FieldVariables,
+	FieldReservedNumbers,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -97,6 +121,34 @@ func ByProjectSongs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newProjectSongsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBuildsCount orders the results by builds count.
+func ByBuildsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBuildsStep(), opts...)
+	}
+}
+
+// ByBuilds orders the results by builds terms.
+func ByBuilds(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBuildsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBuildProfilesCount orders the results by build_profiles count.
+func ByBuildProfilesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBuildProfilesStep(), opts...)
+	}
+}
+
+// ByBuildProfiles orders the results by build_profiles terms.
+func ByBuildProfiles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBuildProfilesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProjectSongsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -104,3 +156,17 @@ func newProjectSongsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ProjectSongsTable, ProjectSongsColumn),
 	)
 }
+func newBuildsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BuildsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BuildsTable, BuildsColumn),
+	)
+}
+func newBuildProfilesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BuildProfilesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BuildProfilesTable, BuildProfilesColumn),
+	)
+}
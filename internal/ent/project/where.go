@@ -283,6 +283,26 @@ func AbcFileDirPreferenceContainsFold(v string) predicate.Project {
 	return predicate.Project(sql.FieldContainsFold(FieldAbcFileDirPreference, v))
 }
 
+// VariablesIsNil applies the IsNil predicate on the "variables" field.
+func VariablesIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldVariables))
+}
+
+// VariablesNotNil applies the NotNil predicate on the "variables" field.
+func VariablesNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldVariables))
+}
+
+// ReservedNumbersIsNil applies the IsNil predicate on the "reserved_numbers" field.
+func ReservedNumbersIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldReservedNumbers))
+}
+
+// ReservedNumbersNotNil applies the NotNil predicate on the "reserved_numbers" field.
+func ReservedNumbersNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldReservedNumbers))
+}
+
 // HasProjectSongs applies the HasEdge predicate on the "project_songs" edge.
 func HasProjectSongs() predicate.Project {
 	return predicate.Project(func(s *sql.Selector) {
@@ -306,6 +326,52 @@ func HasProjectSongsWith(preds ...predicate.ProjectSong) predicate.Project {
 	})
 }
 
+// HasBuilds applies the HasEdge predicate on the "builds" edge.
+func HasBuilds() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BuildsTable, BuildsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBuildsWith applies the HasEdge predicate on the "builds" edge with a given conditions (other predicates).
+func HasBuildsWith(preds ...predicate.Build) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newBuildsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBuildProfiles applies the HasEdge predicate on the "build_profiles" edge.
+func HasBuildProfiles() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BuildProfilesTable, BuildProfilesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBuildProfilesWith applies the HasEdge predicate on the "build_profiles" edge with a given conditions (other predicates).
+func HasBuildProfilesWith(preds ...predicate.BuildProfile) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newBuildProfilesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Project) predicate.Project {
 	return predicate.Project(sql.AndPredicates(predicates...))
@@ -9,6 +9,8 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+	"github.com/bwl21/zupfmanager/internal/ent/buildprofile"
 	"github.com/bwl21/zupfmanager/internal/ent/project"
 	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
 )
@@ -52,6 +54,18 @@ func (pc *ProjectCreate) SetNillableAbcFileDirPreference(s *string) *ProjectCrea
 	return pc
 }
 
+// SetVariables sets the "variables" field.
+func (pc *ProjectCreate) SetVariables(m map[string]string) *ProjectCreate {
+	pc.mutation.SetVariables(m)
+	return pc
+}
+
+// SetReservedNumbers sets the "reserved_numbers" field.
+func (pc *ProjectCreate) SetReservedNumbers(i []int) *ProjectCreate {
+	pc.mutation.SetReservedNumbers(i)
+	return pc
+}
+
 // SetID sets the "id" field.
 func (pc *ProjectCreate) SetID(i int) *ProjectCreate {
 	pc.mutation.SetID(i)
@@ -73,6 +87,36 @@ func (pc *ProjectCreate) AddProjectSongs(p ...*ProjectSong) *ProjectCreate {
 	return pc.AddProjectSongIDs(ids...)
 }
 
+// AddBuildIDs adds the "builds" edge to the Build entity by IDs.
+func (pc *ProjectCreate) AddBuildIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddBuildIDs(ids...)
+	return pc
+}
+
+// AddBuilds adds the "builds" edges to the Build entity.
+func (pc *ProjectCreate) AddBuilds(b ...*Build) *ProjectCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return pc.AddBuildIDs(ids...)
+}
+
+// AddBuildProfileIDs adds the "build_profiles" edge to the BuildProfile entity by IDs.
+func (pc *ProjectCreate) AddBuildProfileIDs(ids ...int) *ProjectCreate {
+	pc.mutation.AddBuildProfileIDs(ids...)
+	return pc
+}
+
+// AddBuildProfiles adds the "build_profiles" edges to the BuildProfile entity.
+func (pc *ProjectCreate) AddBuildProfiles(b ...*BuildProfile) *ProjectCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return pc.AddBuildProfileIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (pc *ProjectCreate) Mutation() *ProjectMutation {
 	return pc.mutation
@@ -176,6 +220,14 @@ func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		_spec.SetField(project.FieldAbcFileDirPreference, field.TypeString, value)
 		_node.AbcFileDirPreference = value
 	}
+	if value, ok := pc.mutation.Variables(); ok {
+		_spec.SetField(project.FieldVariables, field.TypeJSON, value)
+		_node.Variables = value
+	}
+	if value, ok := pc.mutation.ReservedNumbers(); ok {
+		_spec.SetField(project.FieldReservedNumbers, field.TypeJSON, value)
+		_node.ReservedNumbers = value
+	}
 	if nodes := pc.mutation.ProjectSongsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -192,6 +244,38 @@ func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.BuildsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildsTable,
+			Columns: []string{project.BuildsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(build.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.BuildProfilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildProfilesTable,
+			Columns: []string{project.BuildProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(buildprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,6 +12,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+	"github.com/bwl21/zupfmanager/internal/ent/buildprofile"
 	"github.com/bwl21/zupfmanager/internal/ent/predicate"
 	"github.com/bwl21/zupfmanager/internal/ent/project"
 	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
@@ -20,12 +22,14 @@ import (
 // ProjectQuery is the builder for querying Project entities.
 type ProjectQuery struct {
 	config
-	ctx              *QueryContext
-	order            []project.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Project
-	withProjectSongs *ProjectSongQuery
-	modifiers        []func(*sql.Selector)
+	ctx               *QueryContext
+	order             []project.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Project
+	withProjectSongs  *ProjectSongQuery
+	withBuilds        *BuildQuery
+	withBuildProfiles *BuildProfileQuery
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +88,50 @@ func (pq *ProjectQuery) QueryProjectSongs() *ProjectSongQuery {
 	return query
 }
 
+// QueryBuilds chains the current query on the "builds" edge.
+func (pq *ProjectQuery) QueryBuilds() *BuildQuery {
+	query := (&BuildClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(build.Table, build.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.BuildsTable, project.BuildsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBuildProfiles chains the current query on the "build_profiles" edge.
+func (pq *ProjectQuery) QueryBuildProfiles() *BuildProfileQuery {
+	query := (&BuildProfileClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(buildprofile.Table, buildprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.BuildProfilesTable, project.BuildProfilesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Project entity from the query.
 // Returns a *NotFoundError when no Project was found.
 func (pq *ProjectQuery) First(ctx context.Context) (*Project, error) {
@@ -271,12 +319,14 @@ func (pq *ProjectQuery) Clone() *ProjectQuery {
 		return nil
 	}
 	return &ProjectQuery{
-		config:           pq.config,
-		ctx:              pq.ctx.Clone(),
-		order:            append([]project.OrderOption{}, pq.order...),
-		inters:           append([]Interceptor{}, pq.inters...),
-		predicates:       append([]predicate.Project{}, pq.predicates...),
-		withProjectSongs: pq.withProjectSongs.Clone(),
+		config:            pq.config,
+		ctx:               pq.ctx.Clone(),
+		order:             append([]project.OrderOption{}, pq.order...),
+		inters:            append([]Interceptor{}, pq.inters...),
+		predicates:        append([]predicate.Project{}, pq.predicates...),
+		withProjectSongs:  pq.withProjectSongs.Clone(),
+		withBuilds:        pq.withBuilds.Clone(),
+		withBuildProfiles: pq.withBuildProfiles.Clone(),
 		// clone intermediate query.
 		sql:       pq.sql.Clone(),
 		path:      pq.path,
@@ -295,6 +345,28 @@ func (pq *ProjectQuery) WithProjectSongs(opts ...func(*ProjectSongQuery)) *Proje
 	return pq
 }
 
+// WithBuilds tells the query-builder to eager-load the nodes that are connected to
+// the "builds" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithBuilds(opts ...func(*BuildQuery)) *ProjectQuery {
+	query := (&BuildClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withBuilds = query
+	return pq
+}
+
+// WithBuildProfiles tells the query-builder to eager-load the nodes that are connected to
+// the "build_profiles" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithBuildProfiles(opts ...func(*BuildProfileQuery)) *ProjectQuery {
+	query := (&BuildProfileClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withBuildProfiles = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +445,10 @@ func (pq *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 	var (
 		nodes       = []*Project{}
 		_spec       = pq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			pq.withProjectSongs != nil,
+			pq.withBuilds != nil,
+			pq.withBuildProfiles != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -405,6 +479,20 @@ func (pq *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 			return nil, err
 		}
 	}
+	if query := pq.withBuilds; query != nil {
+		if err := pq.loadBuilds(ctx, query, nodes,
+			func(n *Project) { n.Edges.Builds = []*Build{} },
+			func(n *Project, e *Build) { n.Edges.Builds = append(n.Edges.Builds, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withBuildProfiles; query != nil {
+		if err := pq.loadBuildProfiles(ctx, query, nodes,
+			func(n *Project) { n.Edges.BuildProfiles = []*BuildProfile{} },
+			func(n *Project, e *BuildProfile) { n.Edges.BuildProfiles = append(n.Edges.BuildProfiles, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -439,6 +527,66 @@ func (pq *ProjectQuery) loadProjectSongs(ctx context.Context, query *ProjectSong
 	}
 	return nil
 }
+func (pq *ProjectQuery) loadBuilds(ctx context.Context, query *BuildQuery, nodes []*Project, init func(*Project), assign func(*Project, *Build)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(build.FieldProjectID)
+	}
+	query.Where(predicate.Build(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.BuildsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadBuildProfiles(ctx context.Context, query *BuildProfileQuery, nodes []*Project, init func(*Project), assign func(*Project, *BuildProfile)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(buildprofile.FieldProjectID)
+	}
+	query.Where(predicate.BuildProfile(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.BuildProfilesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pq *ProjectQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
@@ -9,7 +9,10 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+	"github.com/bwl21/zupfmanager/internal/ent/buildprofile"
 	"github.com/bwl21/zupfmanager/internal/ent/predicate"
 	"github.com/bwl21/zupfmanager/internal/ent/project"
 	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
@@ -89,6 +92,36 @@ func (pu *ProjectUpdate) ClearAbcFileDirPreference() *ProjectUpdate {
 	return pu
 }
 
+// SetVariables sets the "variables" field.
+func (pu *ProjectUpdate) SetVariables(m map[string]string) *ProjectUpdate {
+	pu.mutation.SetVariables(m)
+	return pu
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (pu *ProjectUpdate) ClearVariables() *ProjectUpdate {
+	pu.mutation.ClearVariables()
+	return pu
+}
+
+// SetReservedNumbers sets the "reserved_numbers" field.
+func (pu *ProjectUpdate) SetReservedNumbers(i []int) *ProjectUpdate {
+	pu.mutation.SetReservedNumbers(i)
+	return pu
+}
+
+// AppendReservedNumbers appends i to the "reserved_numbers" field.
+func (pu *ProjectUpdate) AppendReservedNumbers(i []int) *ProjectUpdate {
+	pu.mutation.AppendReservedNumbers(i)
+	return pu
+}
+
+// ClearReservedNumbers clears the value of the "reserved_numbers" field.
+func (pu *ProjectUpdate) ClearReservedNumbers() *ProjectUpdate {
+	pu.mutation.ClearReservedNumbers()
+	return pu
+}
+
 // AddProjectSongIDs adds the "project_songs" edge to the ProjectSong entity by IDs.
 func (pu *ProjectUpdate) AddProjectSongIDs(ids ...int) *ProjectUpdate {
 	pu.mutation.AddProjectSongIDs(ids...)
@@ -104,6 +137,36 @@ func (pu *ProjectUpdate) AddProjectSongs(p ...*ProjectSong) *ProjectUpdate {
 	return pu.AddProjectSongIDs(ids...)
 }
 
+// AddBuildIDs adds the "builds" edge to the Build entity by IDs.
+func (pu *ProjectUpdate) AddBuildIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddBuildIDs(ids...)
+	return pu
+}
+
+// AddBuilds adds the "builds" edges to the Build entity.
+func (pu *ProjectUpdate) AddBuilds(b ...*Build) *ProjectUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return pu.AddBuildIDs(ids...)
+}
+
+// AddBuildProfileIDs adds the "build_profiles" edge to the BuildProfile entity by IDs.
+func (pu *ProjectUpdate) AddBuildProfileIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.AddBuildProfileIDs(ids...)
+	return pu
+}
+
+// AddBuildProfiles adds the "build_profiles" edges to the BuildProfile entity.
+func (pu *ProjectUpdate) AddBuildProfiles(b ...*BuildProfile) *ProjectUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return pu.AddBuildProfileIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (pu *ProjectUpdate) Mutation() *ProjectMutation {
 	return pu.mutation
@@ -130,6 +193,48 @@ func (pu *ProjectUpdate) RemoveProjectSongs(p ...*ProjectSong) *ProjectUpdate {
 	return pu.RemoveProjectSongIDs(ids...)
 }
 
+// ClearBuilds clears all "builds" edges to the Build entity.
+func (pu *ProjectUpdate) ClearBuilds() *ProjectUpdate {
+	pu.mutation.ClearBuilds()
+	return pu
+}
+
+// RemoveBuildIDs removes the "builds" edge to Build entities by IDs.
+func (pu *ProjectUpdate) RemoveBuildIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveBuildIDs(ids...)
+	return pu
+}
+
+// RemoveBuilds removes "builds" edges to Build entities.
+func (pu *ProjectUpdate) RemoveBuilds(b ...*Build) *ProjectUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return pu.RemoveBuildIDs(ids...)
+}
+
+// ClearBuildProfiles clears all "build_profiles" edges to the BuildProfile entity.
+func (pu *ProjectUpdate) ClearBuildProfiles() *ProjectUpdate {
+	pu.mutation.ClearBuildProfiles()
+	return pu
+}
+
+// RemoveBuildProfileIDs removes the "build_profiles" edge to BuildProfile entities by IDs.
+func (pu *ProjectUpdate) RemoveBuildProfileIDs(ids ...int) *ProjectUpdate {
+	pu.mutation.RemoveBuildProfileIDs(ids...)
+	return pu
+}
+
+// RemoveBuildProfiles removes "build_profiles" edges to BuildProfile entities.
+func (pu *ProjectUpdate) RemoveBuildProfiles(b ...*BuildProfile) *ProjectUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return pu.RemoveBuildProfileIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *ProjectUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
@@ -208,6 +313,23 @@ func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.AbcFileDirPreferenceCleared() {
 		_spec.ClearField(project.FieldAbcFileDirPreference, field.TypeString)
 	}
+	if value, ok := pu.mutation.Variables(); ok {
+		_spec.SetField(project.FieldVariables, field.TypeJSON, value)
+	}
+	if pu.mutation.VariablesCleared() {
+		_spec.ClearField(project.FieldVariables, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.ReservedNumbers(); ok {
+		_spec.SetField(project.FieldReservedNumbers, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedReservedNumbers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, project.FieldReservedNumbers, value)
+		})
+	}
+	if pu.mutation.ReservedNumbersCleared() {
+		_spec.ClearField(project.FieldReservedNumbers, field.TypeJSON)
+	}
 	if pu.mutation.ProjectSongsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -253,6 +375,96 @@ func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.BuildsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildsTable,
+			Columns: []string{project.BuildsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(build.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedBuildsIDs(); len(nodes) > 0 && !pu.mutation.BuildsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildsTable,
+			Columns: []string{project.BuildsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(build.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.BuildsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildsTable,
+			Columns: []string{project.BuildsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(build.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.BuildProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildProfilesTable,
+			Columns: []string{project.BuildProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(buildprofile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedBuildProfilesIDs(); len(nodes) > 0 && !pu.mutation.BuildProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildProfilesTable,
+			Columns: []string{project.BuildProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(buildprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.BuildProfilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildProfilesTable,
+			Columns: []string{project.BuildProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(buildprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(pu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -335,6 +547,36 @@ func (puo *ProjectUpdateOne) ClearAbcFileDirPreference() *ProjectUpdateOne {
 	return puo
 }
 
+// SetVariables sets the "variables" field.
+func (puo *ProjectUpdateOne) SetVariables(m map[string]string) *ProjectUpdateOne {
+	puo.mutation.SetVariables(m)
+	return puo
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (puo *ProjectUpdateOne) ClearVariables() *ProjectUpdateOne {
+	puo.mutation.ClearVariables()
+	return puo
+}
+
+// SetReservedNumbers sets the "reserved_numbers" field.
+func (puo *ProjectUpdateOne) SetReservedNumbers(i []int) *ProjectUpdateOne {
+	puo.mutation.SetReservedNumbers(i)
+	return puo
+}
+
+// AppendReservedNumbers appends i to the "reserved_numbers" field.
+func (puo *ProjectUpdateOne) AppendReservedNumbers(i []int) *ProjectUpdateOne {
+	puo.mutation.AppendReservedNumbers(i)
+	return puo
+}
+
+// ClearReservedNumbers clears the value of the "reserved_numbers" field.
+func (puo *ProjectUpdateOne) ClearReservedNumbers() *ProjectUpdateOne {
+	puo.mutation.ClearReservedNumbers()
+	return puo
+}
+
 // AddProjectSongIDs adds the "project_songs" edge to the ProjectSong entity by IDs.
 func (puo *ProjectUpdateOne) AddProjectSongIDs(ids ...int) *ProjectUpdateOne {
 	puo.mutation.AddProjectSongIDs(ids...)
@@ -350,6 +592,36 @@ func (puo *ProjectUpdateOne) AddProjectSongs(p ...*ProjectSong) *ProjectUpdateOn
 	return puo.AddProjectSongIDs(ids...)
 }
 
+// AddBuildIDs adds the "builds" edge to the Build entity by IDs.
+func (puo *ProjectUpdateOne) AddBuildIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddBuildIDs(ids...)
+	return puo
+}
+
+// AddBuilds adds the "builds" edges to the Build entity.
+func (puo *ProjectUpdateOne) AddBuilds(b ...*Build) *ProjectUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return puo.AddBuildIDs(ids...)
+}
+
+// AddBuildProfileIDs adds the "build_profiles" edge to the BuildProfile entity by IDs.
+func (puo *ProjectUpdateOne) AddBuildProfileIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.AddBuildProfileIDs(ids...)
+	return puo
+}
+
+// AddBuildProfiles adds the "build_profiles" edges to the BuildProfile entity.
+func (puo *ProjectUpdateOne) AddBuildProfiles(b ...*BuildProfile) *ProjectUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return puo.AddBuildProfileIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (puo *ProjectUpdateOne) Mutation() *ProjectMutation {
 	return puo.mutation
@@ -376,6 +648,48 @@ func (puo *ProjectUpdateOne) RemoveProjectSongs(p ...*ProjectSong) *ProjectUpdat
 	return puo.RemoveProjectSongIDs(ids...)
 }
 
+// ClearBuilds clears all "builds" edges to the Build entity.
+func (puo *ProjectUpdateOne) ClearBuilds() *ProjectUpdateOne {
+	puo.mutation.ClearBuilds()
+	return puo
+}
+
+// RemoveBuildIDs removes the "builds" edge to Build entities by IDs.
+func (puo *ProjectUpdateOne) RemoveBuildIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveBuildIDs(ids...)
+	return puo
+}
+
+// RemoveBuilds removes "builds" edges to Build entities.
+func (puo *ProjectUpdateOne) RemoveBuilds(b ...*Build) *ProjectUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return puo.RemoveBuildIDs(ids...)
+}
+
+// ClearBuildProfiles clears all "build_profiles" edges to the BuildProfile entity.
+func (puo *ProjectUpdateOne) ClearBuildProfiles() *ProjectUpdateOne {
+	puo.mutation.ClearBuildProfiles()
+	return puo
+}
+
+// RemoveBuildProfileIDs removes the "build_profiles" edge to BuildProfile entities by IDs.
+func (puo *ProjectUpdateOne) RemoveBuildProfileIDs(ids ...int) *ProjectUpdateOne {
+	puo.mutation.RemoveBuildProfileIDs(ids...)
+	return puo
+}
+
+// RemoveBuildProfiles removes "build_profiles" edges to BuildProfile entities.
+func (puo *ProjectUpdateOne) RemoveBuildProfiles(b ...*BuildProfile) *ProjectUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return puo.RemoveBuildProfileIDs(ids...)
+}
+
 // Where appends a list predicates to the ProjectUpdate builder.
 func (puo *ProjectUpdateOne) Where(ps ...predicate.Project) *ProjectUpdateOne {
 	puo.mutation.Where(ps...)
@@ -484,6 +798,23 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 	if puo.mutation.AbcFileDirPreferenceCleared() {
 		_spec.ClearField(project.FieldAbcFileDirPreference, field.TypeString)
 	}
+	if value, ok := puo.mutation.Variables(); ok {
+		_spec.SetField(project.FieldVariables, field.TypeJSON, value)
+	}
+	if puo.mutation.VariablesCleared() {
+		_spec.ClearField(project.FieldVariables, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.ReservedNumbers(); ok {
+		_spec.SetField(project.FieldReservedNumbers, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedReservedNumbers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, project.FieldReservedNumbers, value)
+		})
+	}
+	if puo.mutation.ReservedNumbersCleared() {
+		_spec.ClearField(project.FieldReservedNumbers, field.TypeJSON)
+	}
 	if puo.mutation.ProjectSongsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -529,6 +860,96 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.BuildsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildsTable,
+			Columns: []string{project.BuildsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(build.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedBuildsIDs(); len(nodes) > 0 && !puo.mutation.BuildsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildsTable,
+			Columns: []string{project.BuildsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(build.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.BuildsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildsTable,
+			Columns: []string{project.BuildsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(build.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.BuildProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildProfilesTable,
+			Columns: []string{project.BuildProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(buildprofile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedBuildProfilesIDs(); len(nodes) > 0 && !puo.mutation.BuildProfilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildProfilesTable,
+			Columns: []string{project.BuildProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(buildprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.BuildProfilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BuildProfilesTable,
+			Columns: []string{project.BuildProfilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(buildprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(puo.modifiers...)
 	_node = &Project{config: puo.config}
 	_spec.Assign = _node.assignValues
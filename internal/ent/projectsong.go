@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -24,6 +25,14 @@ type ProjectSong struct {
 	Difficulty projectsong.Difficulty `json:"difficulty,omitempty"`
 	// Comment holds the value of the "comment" field.
 	Comment string `json:"comment,omitempty"`
+	// Position of the song in the manual order of the project
+	Position int `json:"position,omitempty"`
+	// Number of the song in the project, 0 until assigned. It stays fixed once assigned
+	Number int `json:"number,omitempty"`
+	// When the song was added to the project, zero for songs added before it was recorded
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Last change of priority, difficulty or comment
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// ProjectID holds the value of the "project_id" field.
 	ProjectID int `json:"project_id,omitempty"`
 	// SongID holds the value of the "song_id" field.
@@ -73,10 +82,12 @@ func (*ProjectSong) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case projectsong.FieldID, projectsong.FieldPriority, projectsong.FieldProjectID, projectsong.FieldSongID:
+		case projectsong.FieldID, projectsong.FieldPriority, projectsong.FieldPosition, projectsong.FieldNumber, projectsong.FieldProjectID, projectsong.FieldSongID:
 			values[i] = new(sql.NullInt64)
 		case projectsong.FieldDifficulty, projectsong.FieldComment:
 			values[i] = new(sql.NullString)
+		case projectsong.FieldCreatedAt, projectsong.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
 		case projectsong.ForeignKeys[0]: // project_project_songs
 			values[i] = new(sql.NullInt64)
 		default:
@@ -118,6 +129,30 @@ func (ps *ProjectSong) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ps.Comment = value.String
 			}
+		case projectsong.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				ps.Position = int(value.Int64)
+			}
+		case projectsong.FieldNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				ps.Number = int(value.Int64)
+			}
+		case projectsong.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ps.CreatedAt = value.Time
+			}
+		case projectsong.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ps.UpdatedAt = value.Time
+			}
 		case projectsong.FieldProjectID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field project_id", values[i])
@@ -192,6 +227,18 @@ func (ps *ProjectSong) String() string {
 	builder.WriteString("comment=")
 	builder.WriteString(ps.Comment)
 	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Position))
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(fmt.Sprintf("%v", ps.Number))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ps.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ps.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("project_id=")
 	builder.WriteString(fmt.Sprintf("%v", ps.ProjectID))
 	builder.WriteString(", ")
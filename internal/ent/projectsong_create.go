@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -55,6 +56,62 @@ func (psc *ProjectSongCreate) SetNillableComment(s *string) *ProjectSongCreate {
 	return psc
 }
 
+// SetPosition sets the "position" field.
+func (psc *ProjectSongCreate) SetPosition(i int) *ProjectSongCreate {
+	psc.mutation.SetPosition(i)
+	return psc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (psc *ProjectSongCreate) SetNillablePosition(i *int) *ProjectSongCreate {
+	if i != nil {
+		psc.SetPosition(*i)
+	}
+	return psc
+}
+
+// SetNumber sets the "number" field.
+func (psc *ProjectSongCreate) SetNumber(i int) *ProjectSongCreate {
+	psc.mutation.SetNumber(i)
+	return psc
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (psc *ProjectSongCreate) SetNillableNumber(i *int) *ProjectSongCreate {
+	if i != nil {
+		psc.SetNumber(*i)
+	}
+	return psc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (psc *ProjectSongCreate) SetCreatedAt(t time.Time) *ProjectSongCreate {
+	psc.mutation.SetCreatedAt(t)
+	return psc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (psc *ProjectSongCreate) SetNillableCreatedAt(t *time.Time) *ProjectSongCreate {
+	if t != nil {
+		psc.SetCreatedAt(*t)
+	}
+	return psc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psc *ProjectSongCreate) SetUpdatedAt(t time.Time) *ProjectSongCreate {
+	psc.mutation.SetUpdatedAt(t)
+	return psc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (psc *ProjectSongCreate) SetNillableUpdatedAt(t *time.Time) *ProjectSongCreate {
+	if t != nil {
+		psc.SetUpdatedAt(*t)
+	}
+	return psc
+}
+
 // SetProjectID sets the "project_id" field.
 func (psc *ProjectSongCreate) SetProjectID(i int) *ProjectSongCreate {
 	psc.mutation.SetProjectID(i)
@@ -122,6 +179,22 @@ func (psc *ProjectSongCreate) defaults() {
 		v := projectsong.DefaultDifficulty
 		psc.mutation.SetDifficulty(v)
 	}
+	if _, ok := psc.mutation.Position(); !ok {
+		v := projectsong.DefaultPosition
+		psc.mutation.SetPosition(v)
+	}
+	if _, ok := psc.mutation.Number(); !ok {
+		v := projectsong.DefaultNumber
+		psc.mutation.SetNumber(v)
+	}
+	if _, ok := psc.mutation.CreatedAt(); !ok {
+		v := projectsong.DefaultCreatedAt()
+		psc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := psc.mutation.UpdatedAt(); !ok {
+		v := projectsong.DefaultUpdatedAt()
+		psc.mutation.SetUpdatedAt(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -142,6 +215,12 @@ func (psc *ProjectSongCreate) check() error {
 			return &ValidationError{Name: "difficulty", err: fmt.Errorf(`ent: validator failed for field "ProjectSong.difficulty": %w`, err)}
 		}
 	}
+	if _, ok := psc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "ProjectSong.position"`)}
+	}
+	if _, ok := psc.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "ProjectSong.number"`)}
+	}
 	if _, ok := psc.mutation.ProjectID(); !ok {
 		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "ProjectSong.project_id"`)}
 	}
@@ -203,6 +282,22 @@ func (psc *ProjectSongCreate) createSpec() (*ProjectSong, *sqlgraph.CreateSpec)
 		_spec.SetField(projectsong.FieldComment, field.TypeString, value)
 		_node.Comment = value
 	}
+	if value, ok := psc.mutation.Position(); ok {
+		_spec.SetField(projectsong.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := psc.mutation.Number(); ok {
+		_spec.SetField(projectsong.FieldNumber, field.TypeInt, value)
+		_node.Number = value
+	}
+	if value, ok := psc.mutation.CreatedAt(); ok {
+		_spec.SetField(projectsong.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := psc.mutation.UpdatedAt(); ok {
+		_spec.SetField(projectsong.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	if nodes := psc.mutation.ProjectIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
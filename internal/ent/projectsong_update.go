@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -85,6 +86,68 @@ func (psu *ProjectSongUpdate) ClearComment() *ProjectSongUpdate {
 	return psu
 }
 
+// SetPosition sets the "position" field.
+func (psu *ProjectSongUpdate) SetPosition(i int) *ProjectSongUpdate {
+	psu.mutation.ResetPosition()
+	psu.mutation.SetPosition(i)
+	return psu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (psu *ProjectSongUpdate) SetNillablePosition(i *int) *ProjectSongUpdate {
+	if i != nil {
+		psu.SetPosition(*i)
+	}
+	return psu
+}
+
+// AddPosition adds i to the "position" field.
+func (psu *ProjectSongUpdate) AddPosition(i int) *ProjectSongUpdate {
+	psu.mutation.AddPosition(i)
+	return psu
+}
+
+// SetNumber sets the "number" field.
+func (psu *ProjectSongUpdate) SetNumber(i int) *ProjectSongUpdate {
+	psu.mutation.ResetNumber()
+	psu.mutation.SetNumber(i)
+	return psu
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (psu *ProjectSongUpdate) SetNillableNumber(i *int) *ProjectSongUpdate {
+	if i != nil {
+		psu.SetNumber(*i)
+	}
+	return psu
+}
+
+// AddNumber adds i to the "number" field.
+func (psu *ProjectSongUpdate) AddNumber(i int) *ProjectSongUpdate {
+	psu.mutation.AddNumber(i)
+	return psu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psu *ProjectSongUpdate) SetUpdatedAt(t time.Time) *ProjectSongUpdate {
+	psu.mutation.SetUpdatedAt(t)
+	return psu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (psu *ProjectSongUpdate) SetNillableUpdatedAt(t *time.Time) *ProjectSongUpdate {
+	if t != nil {
+		psu.SetUpdatedAt(*t)
+	}
+	return psu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (psu *ProjectSongUpdate) ClearUpdatedAt() *ProjectSongUpdate {
+	psu.mutation.ClearUpdatedAt()
+	return psu
+}
+
 // SetProjectID sets the "project_id" field.
 func (psu *ProjectSongUpdate) SetProjectID(i int) *ProjectSongUpdate {
 	psu.mutation.SetProjectID(i)
@@ -221,6 +284,27 @@ func (psu *ProjectSongUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if psu.mutation.CommentCleared() {
 		_spec.ClearField(projectsong.FieldComment, field.TypeString)
 	}
+	if value, ok := psu.mutation.Position(); ok {
+		_spec.SetField(projectsong.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.AddedPosition(); ok {
+		_spec.AddField(projectsong.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.Number(); ok {
+		_spec.SetField(projectsong.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := psu.mutation.AddedNumber(); ok {
+		_spec.AddField(projectsong.FieldNumber, field.TypeInt, value)
+	}
+	if psu.mutation.CreatedAtCleared() {
+		_spec.ClearField(projectsong.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := psu.mutation.UpdatedAt(); ok {
+		_spec.SetField(projectsong.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if psu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(projectsong.FieldUpdatedAt, field.TypeTime)
+	}
 	if psu.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -356,6 +440,68 @@ func (psuo *ProjectSongUpdateOne) ClearComment() *ProjectSongUpdateOne {
 	return psuo
 }
 
+// SetPosition sets the "position" field.
+func (psuo *ProjectSongUpdateOne) SetPosition(i int) *ProjectSongUpdateOne {
+	psuo.mutation.ResetPosition()
+	psuo.mutation.SetPosition(i)
+	return psuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (psuo *ProjectSongUpdateOne) SetNillablePosition(i *int) *ProjectSongUpdateOne {
+	if i != nil {
+		psuo.SetPosition(*i)
+	}
+	return psuo
+}
+
+// AddPosition adds i to the "position" field.
+func (psuo *ProjectSongUpdateOne) AddPosition(i int) *ProjectSongUpdateOne {
+	psuo.mutation.AddPosition(i)
+	return psuo
+}
+
+// SetNumber sets the "number" field.
+func (psuo *ProjectSongUpdateOne) SetNumber(i int) *ProjectSongUpdateOne {
+	psuo.mutation.ResetNumber()
+	psuo.mutation.SetNumber(i)
+	return psuo
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (psuo *ProjectSongUpdateOne) SetNillableNumber(i *int) *ProjectSongUpdateOne {
+	if i != nil {
+		psuo.SetNumber(*i)
+	}
+	return psuo
+}
+
+// AddNumber adds i to the "number" field.
+func (psuo *ProjectSongUpdateOne) AddNumber(i int) *ProjectSongUpdateOne {
+	psuo.mutation.AddNumber(i)
+	return psuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (psuo *ProjectSongUpdateOne) SetUpdatedAt(t time.Time) *ProjectSongUpdateOne {
+	psuo.mutation.SetUpdatedAt(t)
+	return psuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (psuo *ProjectSongUpdateOne) SetNillableUpdatedAt(t *time.Time) *ProjectSongUpdateOne {
+	if t != nil {
+		psuo.SetUpdatedAt(*t)
+	}
+	return psuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (psuo *ProjectSongUpdateOne) ClearUpdatedAt() *ProjectSongUpdateOne {
+	psuo.mutation.ClearUpdatedAt()
+	return psuo
+}
+
 // SetProjectID sets the "project_id" field.
 func (psuo *ProjectSongUpdateOne) SetProjectID(i int) *ProjectSongUpdateOne {
 	psuo.mutation.SetProjectID(i)
@@ -522,6 +668,27 @@ func (psuo *ProjectSongUpdateOne) sqlSave(ctx context.Context) (_node *ProjectSo
 	if psuo.mutation.CommentCleared() {
 		_spec.ClearField(projectsong.FieldComment, field.TypeString)
 	}
+	if value, ok := psuo.mutation.Position(); ok {
+		_spec.SetField(projectsong.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.AddedPosition(); ok {
+		_spec.AddField(projectsong.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.Number(); ok {
+		_spec.SetField(projectsong.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := psuo.mutation.AddedNumber(); ok {
+		_spec.AddField(projectsong.FieldNumber, field.TypeInt, value)
+	}
+	if psuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(projectsong.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := psuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(projectsong.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if psuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(projectsong.FieldUpdatedAt, field.TypeTime)
+	}
 	if psuo.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -3,17 +3,64 @@
 package ent
 
 import (
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+	"github.com/bwl21/zupfmanager/internal/ent/buildprofile"
 	"github.com/bwl21/zupfmanager/internal/ent/project"
 	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
 	"github.com/bwl21/zupfmanager/internal/ent/schema"
 	"github.com/bwl21/zupfmanager/internal/ent/setting"
 	"github.com/bwl21/zupfmanager/internal/ent/song"
+	"github.com/bwl21/zupfmanager/internal/ent/template"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	buildFields := schema.Build{}.Fields()
+	_ = buildFields
+	// buildDescBuildID is the schema descriptor for build_id field.
+	buildDescBuildID := buildFields[1].Descriptor()
+	// build.BuildIDValidator is a validator for the "build_id" field. It is called by the builders before save.
+	build.BuildIDValidator = buildDescBuildID.Validators[0].(func(string) error)
+	// buildDescProgress is the schema descriptor for progress field.
+	buildDescProgress := buildFields[5].Descriptor()
+	// build.DefaultProgress holds the default value on creation for the progress field.
+	build.DefaultProgress = buildDescProgress.Default.(int)
+	// build.ProgressValidator is a validator for the "progress" field. It is called by the builders before save.
+	build.ProgressValidator = buildDescProgress.Validators[0].(func(int) error)
+	// buildDescIncremental is the schema descriptor for incremental field.
+	buildDescIncremental := buildFields[12].Descriptor()
+	// build.DefaultIncremental holds the default value on creation for the incremental field.
+	build.DefaultIncremental = buildDescIncremental.Default.(bool)
+	// buildDescFinal is the schema descriptor for final field.
+	buildDescFinal := buildFields[16].Descriptor()
+	// build.DefaultFinal holds the default value on creation for the final field.
+	build.DefaultFinal = buildDescFinal.Default.(bool)
+	// buildDescStartedAt is the schema descriptor for started_at field.
+	buildDescStartedAt := buildFields[21].Descriptor()
+	// build.DefaultStartedAt holds the default value on creation for the started_at field.
+	build.DefaultStartedAt = buildDescStartedAt.Default.(func() time.Time)
+	// buildDescID is the schema descriptor for id field.
+	buildDescID := buildFields[0].Descriptor()
+	// build.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	build.IDValidator = buildDescID.Validators[0].(func(int) error)
+	buildprofileFields := schema.BuildProfile{}.Fields()
+	_ = buildprofileFields
+	// buildprofileDescName is the schema descriptor for name field.
+	buildprofileDescName := buildprofileFields[2].Descriptor()
+	// buildprofile.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	buildprofile.NameValidator = buildprofileDescName.Validators[0].(func(string) error)
+	// buildprofileDescIsDefault is the schema descriptor for is_default field.
+	buildprofileDescIsDefault := buildprofileFields[8].Descriptor()
+	// buildprofile.DefaultIsDefault holds the default value on creation for the is_default field.
+	buildprofile.DefaultIsDefault = buildprofileDescIsDefault.Default.(bool)
+	// buildprofileDescID is the schema descriptor for id field.
+	buildprofileDescID := buildprofileFields[0].Descriptor()
+	// buildprofile.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	buildprofile.IDValidator = buildprofileDescID.Validators[0].(func(int) error)
 	projectFields := schema.Project{}.Fields()
 	_ = projectFields
 	// projectDescTitle is the schema descriptor for title field.
@@ -34,6 +81,22 @@ func init() {
 	projectsongDescPriority := projectsongFields[1].Descriptor()
 	// projectsong.PriorityValidator is a validator for the "priority" field. It is called by the builders before save.
 	projectsong.PriorityValidator = projectsongDescPriority.Validators[0].(func(int) error)
+	// projectsongDescPosition is the schema descriptor for position field.
+	projectsongDescPosition := projectsongFields[4].Descriptor()
+	// projectsong.DefaultPosition holds the default value on creation for the position field.
+	projectsong.DefaultPosition = projectsongDescPosition.Default.(int)
+	// projectsongDescNumber is the schema descriptor for number field.
+	projectsongDescNumber := projectsongFields[5].Descriptor()
+	// projectsong.DefaultNumber holds the default value on creation for the number field.
+	projectsong.DefaultNumber = projectsongDescNumber.Default.(int)
+	// projectsongDescCreatedAt is the schema descriptor for created_at field.
+	projectsongDescCreatedAt := projectsongFields[6].Descriptor()
+	// projectsong.DefaultCreatedAt holds the default value on creation for the created_at field.
+	projectsong.DefaultCreatedAt = projectsongDescCreatedAt.Default.(func() time.Time)
+	// projectsongDescUpdatedAt is the schema descriptor for updated_at field.
+	projectsongDescUpdatedAt := projectsongFields[7].Descriptor()
+	// projectsong.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	projectsong.DefaultUpdatedAt = projectsongDescUpdatedAt.Default.(func() time.Time)
 	// projectsongDescID is the schema descriptor for id field.
 	projectsongDescID := projectsongFields[0].Descriptor()
 	// projectsong.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -58,4 +121,28 @@ func init() {
 	songDescID := songFields[0].Descriptor()
 	// song.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	song.IDValidator = songDescID.Validators[0].(func(int) error)
+	templateFields := schema.Template{}.Fields()
+	_ = templateFields
+	// templateDescName is the schema descriptor for name field.
+	templateDescName := templateFields[1].Descriptor()
+	// template.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	template.NameValidator = templateDescName.Validators[0].(func(string) error)
+	// templateDescKind is the schema descriptor for kind field.
+	templateDescKind := templateFields[2].Descriptor()
+	// template.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	template.KindValidator = templateDescKind.Validators[0].(func(string) error)
+	// templateDescCreatedAt is the schema descriptor for created_at field.
+	templateDescCreatedAt := templateFields[5].Descriptor()
+	// template.DefaultCreatedAt holds the default value on creation for the created_at field.
+	template.DefaultCreatedAt = templateDescCreatedAt.Default.(func() time.Time)
+	// templateDescUpdatedAt is the schema descriptor for updated_at field.
+	templateDescUpdatedAt := templateFields[6].Descriptor()
+	// template.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	template.DefaultUpdatedAt = templateDescUpdatedAt.Default.(func() time.Time)
+	// template.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	template.UpdateDefaultUpdatedAt = templateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// templateDescID is the schema descriptor for id field.
+	templateDescID := templateFields[0].Descriptor()
+	// template.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	template.IDValidator = templateDescID.Validators[0].(func(int) error)
 }
@@ -0,0 +1,105 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/bwl21/zupfmanager/internal/buildreport"
+)
+
+// Build holds the schema definition for the Build entity.
+type Build struct {
+	ent.Schema
+}
+
+// Fields of the Build.
+func (Build) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable().
+			StructTag(`json:"id,omitempty"`),
+		field.String("build_id").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Public build identifier (UUID)"),
+		field.Int("project_id"),
+		field.Enum("status").
+			Values("pending", "queued", "running", "completed", "completed_with_errors", "failed", "cancelled", "interrupted").
+			Default("pending"),
+		field.Enum("origin").
+			Values("server", "cli").
+			Default("server").
+			Comment("Process that runs the build, a server restart only interrupts server builds"),
+		field.Int("progress").
+			Range(0, 100).
+			Default(0),
+		field.String("message").
+			Optional(),
+		field.String("output_dir").
+			Optional(),
+		field.String("build_dir").
+			Optional().
+			Comment("Directory of the build relative to the output directory"),
+		field.String("abc_file_dir").
+			Optional(),
+		field.Int("priority_threshold").
+			Optional(),
+		field.String("sample_id").
+			Optional(),
+		field.Bool("incremental").
+			Default(false).
+			Comment("Songs with unchanged input were restored from the render cache"),
+		field.String("profile").
+			Optional().
+			Comment("Name of the build profile the build was started with"),
+		field.String("song_order").
+			Optional().
+			Comment("Order of the songs in the build, see core.SongOrders"),
+		field.String("supplement_since").
+			Optional().
+			Comment("Supplement builds contain only the songs added or changed since this time (RFC 3339)"),
+		field.Bool("final").
+			Default(false).
+			Comment("Final builds are not stamped with draft watermarks"),
+		field.JSON("generated_files", []string{}).
+			Optional(),
+		field.JSON("report", &buildreport.Report{}).
+			Optional().
+			Comment("Per-song results of the build"),
+		field.JSON("manifest", &buildreport.Manifest{}).
+			Optional().
+			Comment("Files written by the build, also stored as manifest.json in the output directory"),
+		field.String("error").
+			Optional(),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Build.
+func (Build) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("builds").
+			Field("project_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Build.
+func (Build) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "started_at"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BuildProfile holds the schema definition for the BuildProfile entity.
+type BuildProfile struct {
+	ent.Schema
+}
+
+// Fields of the BuildProfile.
+func (BuildProfile) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable().
+			StructTag(`json:"id,omitempty"`),
+		field.Int("project_id"),
+		field.String("name").
+			NotEmpty().
+			Comment("Name of the profile, e.g. Probedruck, Teilnehmer or Referent"),
+		field.String("output_dir").
+			Optional(),
+		field.String("abc_file_dir").
+			Optional(),
+		field.Int("priority_threshold").
+			Optional().
+			Comment("0 if the profile does not set a threshold"),
+		field.String("sample_id").
+			Optional(),
+		field.JSON("config", map[string]interface{}{}).
+			Optional().
+			Comment("Merged over the project config for builds with this profile"),
+		field.Bool("is_default").
+			Default(false).
+			Comment("Used for builds that do not name a profile"),
+	}
+}
+
+// Edges of the BuildProfile.
+func (BuildProfile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("build_profiles").
+			Field("project_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the BuildProfile.
+func (BuildProfile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "name").
+			Unique(),
+	}
+}
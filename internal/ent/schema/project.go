@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -27,6 +28,12 @@ func (Project) Fields() []ent.Field {
 		field.String("abc_file_dir_preference").
 			Optional().
 			Comment("User's preferred directory for ABC files"),
+		field.JSON("variables", map[string]string{}).
+			Optional().
+			Comment("User-defined template variables of the project"),
+		field.JSON("reserved_numbers", []int{}).
+			Optional().
+			Comment("Numbers of removed songs that are not given to new songs"),
 	}
 }
 
@@ -34,5 +41,9 @@ func (Project) Fields() []ent.Field {
 func (Project) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("project_songs", ProjectSong.Type),
+		edge.To("builds", Build.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("build_profiles", BuildProfile.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -1,7 +1,10 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -26,6 +29,21 @@ func (ProjectSong) Fields() []ent.Field {
 			Default("medium"),
 		field.String("comment").
 			Optional(),
+		field.Int("position").
+			Default(0).
+			Comment("Position of the song in the manual order of the project"),
+		field.Int("number").
+			Default(0).
+			Comment("Number of the song in the project, 0 until assigned. It stays fixed once assigned"),
+		field.Time("created_at").
+			Optional().
+			Default(time.Now).
+			Immutable().
+			Comment("When the song was added to the project, zero for songs added before it was recorded"),
+		field.Time("updated_at").
+			Optional().
+			Default(time.Now).
+			Comment("Last change of priority, difficulty or comment"),
 		field.Int("project_id"),
 		field.Int("song_id"),
 	}
@@ -50,5 +68,9 @@ func (ProjectSong) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("project_id", "song_id").
 			Unique(),
+		// Assigned numbers are unique within a project, unnumbered songs have 0
+		index.Fields("project_id", "number").
+			Unique().
+			Annotations(entsql.IndexWhere("number > 0")),
 	}
 }
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Template holds the schema definition for the Template entity.
+type Template struct {
+	ent.Schema
+}
+
+// Fields of the Template.
+func (Template) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable().
+			StructTag(`json:"id,omitempty"`),
+		field.String("name").
+			NotEmpty().
+			Comment("Name projects select the shared template by, e.g. zweispaltig"),
+		field.String("kind").
+			NotEmpty().
+			Comment("What the template renders, e.g. toc or toc-html"),
+		field.String("description").
+			Optional(),
+		field.Text("content"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Template.
+func (Template) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Template.
+func (Template) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "name").
+			Unique(),
+	}
+}
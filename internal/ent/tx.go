@@ -12,6 +12,10 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Build is the client for interacting with the Build builders.
+	Build *BuildClient
+	// BuildProfile is the client for interacting with the BuildProfile builders.
+	BuildProfile *BuildProfileClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
 	// ProjectSong is the client for interacting with the ProjectSong builders.
@@ -20,6 +24,8 @@ type Tx struct {
 	Setting *SettingClient
 	// Song is the client for interacting with the Song builders.
 	Song *SongClient
+	// Template is the client for interacting with the Template builders.
+	Template *TemplateClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +157,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Build = NewBuildClient(tx.config)
+	tx.BuildProfile = NewBuildProfileClient(tx.config)
 	tx.Project = NewProjectClient(tx.config)
 	tx.ProjectSong = NewProjectSongClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
 	tx.Song = NewSongClient(tx.config)
+	tx.Template = NewTemplateClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +173,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Project.QueryXXX(), the query will be executed
+// applies a query, for example: Build.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
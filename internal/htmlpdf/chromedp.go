@@ -62,6 +62,10 @@ func (c *ChromeDPConverter) ConvertToPDF(ctx context.Context, request *Conversio
 	taskCtx, cancel := chromedp.NewContext(c.allocCtx)
 	defer cancel()
 
+	// The browser runs on the allocator context, close it when the caller gives up
+	stop := context.AfterFunc(ctx, cancel)
+	defer stop()
+
 	// Create ChromeDP actions
 	absPath, err := filepath.Abs(request.HTMLFilePath)
 	if err != nil {
@@ -100,6 +104,9 @@ func (c *ChromeDPConverter) ConvertToPDF(ctx context.Context, request *Conversio
 	}))
 
 	err = chromedp.Run(taskCtx, actions...)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, fmt.Errorf("PDF generation cancelled: %w", ctxErr)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate PDF: %w", err)
 	}
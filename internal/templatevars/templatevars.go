@@ -0,0 +1,199 @@
+// Package templatevars expands #{name} variables in project configs and
+// table of contents templates.
+//
+// A reference has the form #{name}, #{name|default} or, for dates,
+// #{name:layout} with a Go time layout such as 02.01.2006. The default is
+// used when the variable is unknown or empty. Unknown variables without a
+// default are an error.
+package templatevars
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrUnknownVariable is returned for references to variables that are not
+// defined and have no default
+var ErrUnknownVariable = errors.New("unknown variable")
+
+// DefaultDateFormat is the layout of date variables without a format
+const DefaultDateFormat = "2006-01-02"
+
+var (
+	namePattern      = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	referencePattern = regexp.MustCompile(`#\{([A-Za-z_][A-Za-z0-9_]*)(?::([^|}]*))?(?:\|([^}]*))?\}`)
+)
+
+// ValidName reports whether name can be used as variable name
+func ValidName(name string) bool {
+	return namePattern.MatchString(name)
+}
+
+// Variables holds the values references are expanded with
+type Variables struct {
+	values map[string]string
+	dates  map[string]time.Time
+}
+
+// New returns an empty set of variables
+func New() *Variables {
+	return &Variables{
+		values: make(map[string]string),
+		dates:  make(map[string]time.Time),
+	}
+}
+
+// Clone returns a copy that can be extended without changing v
+func (v *Variables) Clone() *Variables {
+	clone := New()
+	for name, value := range v.values {
+		clone.values[name] = value
+	}
+	for name, date := range v.dates {
+		clone.dates[name] = date
+	}
+	return clone
+}
+
+// Set defines a text variable
+func (v *Variables) Set(name, value string) {
+	delete(v.dates, name)
+	v.values[name] = value
+}
+
+// SetDate defines a date variable, references may choose its format
+func (v *Variables) SetDate(name string, date time.Time) {
+	delete(v.values, name)
+	v.dates[name] = date
+}
+
+// Values returns all variables as text, dates in DefaultDateFormat
+func (v *Variables) Values() map[string]string {
+	values := make(map[string]string, len(v.values)+len(v.dates))
+	for name, value := range v.values {
+		values[name] = value
+	}
+	for name, date := range v.dates {
+		values[name] = date.Format(DefaultDateFormat)
+	}
+	return values
+}
+
+// Expand replaces all references in s
+func (v *Variables) Expand(s string) (string, error) {
+	return v.ExpandEscaped(s, nil)
+}
+
+// ExpandEscaped replaces all references in s and passes the values through
+// escape, e.g. html.EscapeString for HTML templates
+func (v *Variables) ExpandEscaped(s string, escape func(string) string) (string, error) {
+	var unknown []string
+	var err error
+
+	result := referencePattern.ReplaceAllStringFunc(s, func(reference string) string {
+		match := referencePattern.FindStringSubmatchIndex(reference)
+		name := reference[match[2]:match[3]]
+		format, hasFormat := submatch(reference, match, 2)
+
+		value, ok, lookupErr := v.lookup(name, format, hasFormat)
+		if lookupErr != nil {
+			if err == nil {
+				err = lookupErr
+			}
+			return reference
+		}
+		if fallback, hasDefault := submatch(reference, match, 3); hasDefault && value == "" {
+			value, ok = fallback, true
+		}
+		if !ok {
+			if !containsString(unknown, name) {
+				unknown = append(unknown, name)
+			}
+			return reference
+		}
+
+		if escape != nil {
+			value = escape(value)
+		}
+		return value
+	})
+
+	if err != nil {
+		return "", err
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return "", fmt.Errorf("%w: %s", ErrUnknownVariable, strings.Join(unknown, ", "))
+	}
+	return result, nil
+}
+
+// submatch returns the n-th group of a match and whether it took part in it
+func submatch(s string, match []int, n int) (string, bool) {
+	if match[2*n] < 0 {
+		return "", false
+	}
+	return s[match[2*n]:match[2*n+1]], true
+}
+
+// lookup returns the value of a variable in the requested format
+func (v *Variables) lookup(name, format string, hasFormat bool) (string, bool, error) {
+	if date, ok := v.dates[name]; ok {
+		if format == "" {
+			format = DefaultDateFormat
+		}
+		return date.Format(format), true, nil
+	}
+	value, ok := v.values[name]
+	if ok && hasFormat {
+		return "", false, fmt.Errorf("variable %s does not take a format", name)
+	}
+	return value, ok, nil
+}
+
+// ExpandJSON expands the references in all keys and string values of a
+// decoded JSON document. The values end up in strings of the document, so
+// quotes or backslashes in them cannot break its structure.
+func (v *Variables) ExpandJSON(value any) (any, error) {
+	switch value := value.(type) {
+	case string:
+		return v.Expand(value)
+	case map[string]any:
+		expanded := make(map[string]any, len(value))
+		for key, child := range value {
+			expandedKey, err := v.Expand(key)
+			if err != nil {
+				return nil, err
+			}
+			if expanded[expandedKey], err = v.ExpandJSON(child); err != nil {
+				return nil, err
+			}
+		}
+		return expanded, nil
+	case []any:
+		expanded := make([]any, len(value))
+		for i, child := range value {
+			var err error
+			if expanded[i], err = v.ExpandJSON(child); err != nil {
+				return nil, err
+			}
+		}
+		return expanded, nil
+	default:
+		return value, nil
+	}
+}
+
+// containsString reports whether a slice contains a string
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
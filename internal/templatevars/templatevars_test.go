@@ -0,0 +1,88 @@
+package templatevars
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpand(t *testing.T) {
+	vars := New()
+	vars.Set("title", "Amazing Grace")
+	vars.Set("genre", "")
+	vars.SetDate("build_date", time.Date(2025, 3, 7, 10, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"#{title}", "Amazing Grace"},
+		{"Nr. #{title} - #{title}", "Nr. Amazing Grace - Amazing Grace"},
+		{"#{genre|Volkslied}", "Volkslied"},
+		{"#{title|Volkslied}", "Amazing Grace"},
+		{"#{missing|}", ""},
+		{"#{missing|a: b}", "a: b"},
+		{"#{build_date}", "2025-03-07"},
+		{"#{build_date:02.01.2006}", "07.03.2025"},
+		{"#{build_date:2006}|#{build_date:}", "2025|2025-03-07"},
+		{"#{ title} #title {title}", "#{ title} #title {title}"},
+	}
+	for _, tt := range tests {
+		got, err := vars.Expand(tt.input)
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.want, got, tt.input)
+	}
+
+	_, err := vars.Expand("#{missing} #{title} #{other} #{missing}")
+	assert.ErrorIs(t, err, ErrUnknownVariable)
+	assert.EqualError(t, err, "unknown variable: missing, other")
+
+	_, err = vars.Expand("#{title:2006}")
+	assert.EqualError(t, err, "variable title does not take a format")
+}
+
+func TestExpandEscaped(t *testing.T) {
+	vars := New()
+	vars.Set("title", "Tom & Jerry")
+
+	got, err := vars.ExpandEscaped("<h1>#{title}</h1>", func(s string) string {
+		return "[" + s + "]"
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "<h1>[Tom & Jerry]</h1>", got)
+}
+
+func TestExpandJSON(t *testing.T) {
+	vars := New()
+	vars.Set("title", `Say "Hi" \o/`)
+	vars.Set("key", "T01")
+
+	config := map[string]any{
+		"#{key}_number": map[string]any{"text": "#{title}"},
+		"produce":       []any{float64(1), "#{key}"},
+		"show":          true,
+	}
+	expanded, err := vars.ExpandJSON(config)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"T01_number": map[string]any{"text": `Say "Hi" \o/`},
+		"produce":    []any{float64(1), "T01"},
+		"show":       true,
+	}, expanded)
+
+	// The input is left alone
+	assert.Equal(t, "#{title}", config["#{key}_number"].(map[string]any)["text"])
+
+	_, err = vars.ExpandJSON(map[string]any{"a": []any{"#{nope}"}})
+	assert.ErrorIs(t, err, ErrUnknownVariable)
+}
+
+func TestValidName(t *testing.T) {
+	assert.True(t, ValidName("season"))
+	assert.True(t, ValidName("_Ort2"))
+	assert.False(t, ValidName("2nd"))
+	assert.False(t, ValidName("a-b"))
+	assert.False(t, ValidName(""))
+}
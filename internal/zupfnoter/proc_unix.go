@@ -0,0 +1,17 @@
+//go:build !windows
+
+package zupfnoter
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// configureCommand starts zupfnoter in its own process group, so that
+// cancelling the command also stops every process node spawned.
+func configureCommand(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
@@ -0,0 +1,16 @@
+//go:build windows
+
+package zupfnoter
+
+import (
+	"os/exec"
+	"strconv"
+)
+
+// configureCommand makes cancelling the command stop node together with
+// all of its child processes.
+func configureCommand(cmd *exec.Cmd) {
+	cmd.Cancel = func() error {
+		return exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid)).Run()
+	}
+}
@@ -3,10 +3,15 @@ package zupfnoter
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log/slog"
 	"os"
 	"os/exec"
+	"regexp"
+	"sync"
+	"time"
 
 	_ "embed"
 )
@@ -31,6 +36,40 @@ func init() {
 	}
 }
 
+var (
+	versionOnce sync.Once
+	version     string
+
+	versionPattern = regexp.MustCompile(`"VERSION","(V_[^"]+)"`)
+)
+
+// Version returns the version of the zupfnoter script followed by a short hash
+// of its content, e.g. "V_1.15-1-g79f36737 (0123abcd)". The hash tells apart
+// scripts passed in ZUPFNOTER_PATH that carry the same version string.
+func Version() string {
+	versionOnce.Do(func() {
+		script, err := os.ReadFile(ZupfnoterPath)
+		if err != nil {
+			slog.Warn("failed to read zupfnoter script", "path", ZupfnoterPath, "error", err)
+			version = "unknown"
+			return
+		}
+
+		name := "unknown"
+		if match := versionPattern.FindSubmatch(script); match != nil {
+			name = string(match[1])
+		}
+		hash := sha256.Sum256(script)
+		version = fmt.Sprintf("%s (%s)", name, hex.EncodeToString(hash[:4]))
+	})
+	return version
+}
+
+// waitDelay bounds the time Run waits for output after the process was killed
+const waitDelay = 5 * time.Second
+
+// Run executes zupfnoter with the given arguments. If ctx is cancelled, node and
+// all processes started by it are killed.
 func Run(ctx context.Context, args ...string) (string, string, error) {
 	var stdoutBuf, stderrBuf bytes.Buffer
 
@@ -47,7 +86,12 @@ func Run(ctx context.Context, args ...string) (string, string, error) {
 	cmd := exec.CommandContext(ctx, "node", append([]string{ZupfnoterPath}, args...)...)
 	cmd.Stdout = &stdoutBuf
 	cmd.Stderr = &stderrBuf
+	cmd.WaitDelay = waitDelay
+	configureCommand(cmd)
 
 	err := cmd.Run()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		err = fmt.Errorf("zupfnoter cancelled: %w", ctxErr)
+	}
 	return stdoutBuf.String(), stderrBuf.String(), err
 }
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bwl21/zupfmanager/pkg/api/models"
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+// CompareBuilds compares two builds of a project
+// @Summary Compare builds
+// @Description List the files that were added, removed or changed by content between two finished builds, with their page counts and the pages of print files in the merged PDF of their folder, and the songs that were added, removed, renumbered or whose config or ABC file changed. Without to the latest finished build is compared. The output of the builds does not need to exist anymore.
+// @Tags projects
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param from query string true "ID of the older build"
+// @Param to query string false "ID of the newer build, defaults to the latest finished build"
+// @Success 200 {object} models.BuildDiffResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/builds/compare [get]
+func (h *ProjectHandler) CompareBuilds(c *gin.Context) {
+	projectID, ok := projectIDParam(c)
+	if !ok {
+		return
+	}
+	from := c.Query("from")
+	if from == "" {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid build ID",
+			Message: "The from parameter is required",
+		})
+		return
+	}
+
+	diff, err := h.services.Project.CompareBuilds(c.Request.Context(), projectID, from, c.Query("to"))
+	if err != nil {
+		switch err {
+		case core.ErrBuildNotFound:
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "Build not found",
+				Message: "The specified build does not exist in this project",
+			})
+		case core.ErrBuildOutputUnavailable:
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "Build not comparable",
+				Message: "Only completed builds can be compared",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "Failed to compare builds",
+				Message: err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, buildDiffResponse(diff))
+}
+
+// buildDiffResponse converts a core build diff to its API response
+func buildDiffResponse(diff *core.BuildDiff) models.BuildDiffResponse {
+	response := models.BuildDiffResponse{
+		ProjectID:      diff.ProjectID,
+		From:           buildDiffBuildResponse(diff.From),
+		To:             buildDiffBuildResponse(diff.To),
+		FilesAdded:     diff.FilesAdded,
+		FilesRemoved:   diff.FilesRemoved,
+		FilesChanged:   diff.FilesChanged,
+		FilesUnchanged: diff.FilesUnchanged,
+		Files:          make([]models.FileChangeResponse, len(diff.Files)),
+		Songs:          make([]models.SongChangeResponse, len(diff.Songs)),
+	}
+	for i, file := range diff.Files {
+		response.Files[i] = models.FileChangeResponse{
+			Path:         file.Path,
+			Change:       file.Change,
+			Edition:      file.Edition,
+			Folder:       file.Folder,
+			SongIndex:    file.SongIndex,
+			Song:         file.Song,
+			FromSHA256:   file.FromSHA256,
+			ToSHA256:     file.ToSHA256,
+			FromPages:    file.FromPages,
+			ToPages:      file.ToPages,
+			PagesChanged: file.PagesChanged(),
+			MergedFile:   file.MergedFile,
+			FirstPage:    file.FirstPage,
+			LastPage:     file.LastPage,
+		}
+	}
+	for i, song := range diff.Songs {
+		response.Songs[i] = models.SongChangeResponse{
+			ProjectSongID: song.ProjectSongID,
+			SongID:        song.SongID,
+			Title:         song.Title,
+			Filename:      song.Filename,
+			Edition:       song.Edition,
+			FromIndex:     song.FromIndex,
+			ToIndex:       song.ToIndex,
+			Changes:       song.Changes,
+		}
+	}
+	return response
+}
+
+// buildDiffBuildResponse converts a build of a build diff
+func buildDiffBuildResponse(build core.BuildDiffBuild) models.BuildDiffBuildResponse {
+	return models.BuildDiffBuildResponse{
+		BuildID:   build.BuildID,
+		StartedAt: build.StartedAt,
+		OutputDir: build.OutputDir,
+		BuildDir:  build.BuildDir,
+	}
+}
@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/bwl21/zupfmanager/pkg/api/models"
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+// ListBuildProfiles lists the build profiles of a project
+// @Summary List build profiles
+// @Description Get the named build profiles of a project ordered by name
+// @Tags projects
+// @Produce json
+// @Param id path int true "Project ID"
+// @Success 200 {object} models.BuildProfileListResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/profiles [get]
+func (h *ProjectHandler) ListBuildProfiles(c *gin.Context) {
+	projectID, ok := projectIDParam(c)
+	if !ok {
+		return
+	}
+
+	profiles, err := h.services.Project.ListBuildProfiles(c.Request.Context(), projectID)
+	if err != nil {
+		buildProfileError(c, err)
+		return
+	}
+
+	response := models.BuildProfileListResponse{
+		Profiles: make([]models.BuildProfileResponse, len(profiles)),
+		Count:    len(profiles),
+	}
+	for i, profile := range profiles {
+		response.Profiles[i] = buildProfileResponse(profile)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetBuildProfile gets a build profile of a project
+// @Summary Get build profile
+// @Description Get a build profile of a project by its ID
+// @Tags projects
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param profileId path int true "Build profile ID"
+// @Success 200 {object} models.BuildProfileResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/profiles/{profileId} [get]
+func (h *ProjectHandler) GetBuildProfile(c *gin.Context) {
+	projectID, profileID, ok := profileIDParams(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.services.Project.GetBuildProfile(c.Request.Context(), projectID, profileID)
+	if err != nil {
+		buildProfileError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, buildProfileResponse(profile))
+}
+
+// CreateBuildProfile creates a build profile for a project
+// @Summary Create build profile
+// @Description Create a named set of build parameters with a config overlay. A new default profile replaces the previous one.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body models.BuildProfileRequest true "Build profile"
+// @Success 201 {object} models.BuildProfileResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/profiles [post]
+func (h *ProjectHandler) CreateBuildProfile(c *gin.Context) {
+	projectID, ok := projectIDParam(c)
+	if !ok {
+		return
+	}
+
+	coreReq, ok := bindBuildProfileRequest(c, projectID)
+	if !ok {
+		return
+	}
+
+	profile, err := h.services.Project.CreateBuildProfile(c.Request.Context(), coreReq)
+	if err != nil {
+		buildProfileError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, buildProfileResponse(profile))
+}
+
+// UpdateBuildProfile replaces a build profile of a project
+// @Summary Update build profile
+// @Description Replace the settings of a build profile
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param profileId path int true "Build profile ID"
+// @Param request body models.BuildProfileRequest true "Build profile"
+// @Success 200 {object} models.BuildProfileResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/profiles/{profileId} [put]
+func (h *ProjectHandler) UpdateBuildProfile(c *gin.Context) {
+	projectID, profileID, ok := profileIDParams(c)
+	if !ok {
+		return
+	}
+
+	coreReq, ok := bindBuildProfileRequest(c, projectID)
+	if !ok {
+		return
+	}
+
+	profile, err := h.services.Project.UpdateBuildProfile(c.Request.Context(), profileID, coreReq)
+	if err != nil {
+		buildProfileError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, buildProfileResponse(profile))
+}
+
+// DeleteBuildProfile deletes a build profile of a project
+// @Summary Delete build profile
+// @Description Delete a build profile, builds made with it keep its name
+// @Tags projects
+// @Param id path int true "Project ID"
+// @Param profileId path int true "Build profile ID"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/profiles/{profileId} [delete]
+func (h *ProjectHandler) DeleteBuildProfile(c *gin.Context) {
+	projectID, profileID, ok := profileIDParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.services.Project.DeleteBuildProfile(c.Request.Context(), projectID, profileID); err != nil {
+		buildProfileError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// projectIDParam parses the project ID of the request path
+func projectIDParam(c *gin.Context) (int, bool) {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid project ID",
+			Message: "Project ID must be a valid integer",
+		})
+		return 0, false
+	}
+	return projectID, true
+}
+
+// profileIDParams parses the project and build profile IDs of the request path
+func profileIDParams(c *gin.Context) (int, int, bool) {
+	projectID, ok := projectIDParam(c)
+	if !ok {
+		return 0, 0, false
+	}
+	profileID, err := strconv.Atoi(c.Param("profileId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid profile ID",
+			Message: "Profile ID must be a valid integer",
+		})
+		return 0, 0, false
+	}
+	return projectID, profileID, true
+}
+
+// bindBuildProfileRequest reads a build profile from the request body
+func bindBuildProfileRequest(c *gin.Context, projectID int) (core.BuildProfileRequest, bool) {
+	var req models.BuildProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return core.BuildProfileRequest{}, false
+	}
+
+	return core.BuildProfileRequest{
+		ProjectID:         projectID,
+		Name:              req.Name,
+		OutputDir:         req.OutputDir,
+		AbcFileDir:        req.AbcFileDir,
+		PriorityThreshold: req.PriorityThreshold,
+		SampleID:          req.SampleID,
+		Config:            req.Config,
+		IsDefault:         req.IsDefault,
+	}, true
+}
+
+// buildProfileError writes the response for an error of a build profile operation
+func buildProfileError(c *gin.Context, err error) {
+	switch err {
+	case core.ErrProjectNotFound:
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "Project not found",
+			Message: "The specified project does not exist",
+		})
+		return
+	case core.ErrBuildProfileNotFound:
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "Build profile not found",
+			Message: "The specified build profile does not exist in this project",
+		})
+		return
+	}
+
+	if validationErr, ok := err.(core.ValidationErrors); ok {
+		details := make(map[string]string)
+		for _, ve := range validationErr {
+			details[ve.Field] = ve.Message
+		}
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation failed",
+			Message: err.Error(),
+			Details: details,
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+		Error:   "Failed to manage build profile",
+		Message: err.Error(),
+	})
+}
+
+// buildProfileResponse converts a core build profile to its API response
+func buildProfileResponse(profile *core.BuildProfile) models.BuildProfileResponse {
+	return models.BuildProfileResponse{
+		ID:                profile.ID,
+		ProjectID:         profile.ProjectID,
+		Name:              profile.Name,
+		OutputDir:         profile.OutputDir,
+		AbcFileDir:        profile.AbcFileDir,
+		PriorityThreshold: profile.PriorityThreshold,
+		SampleID:          profile.SampleID,
+		Config:            profile.Config,
+		IsDefault:         profile.IsDefault,
+	}
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bwl21/zupfmanager/pkg/api/models"
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+// CheckFolderRules shows where the folder rules of a project route the PDFs of a build
+// @Summary Check folder rules
+// @Description Route the PDFs a finished build wrote to pdf/ with the current folder rules of the project and list the rules each file hits and the druckdateien folders it would be copied into. Without build the latest finished build is checked.
+// @Tags projects
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param build query string false "ID of the build, defaults to the latest finished build"
+// @Success 200 {object} models.FolderRuleCheckResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/folder-rules/check [get]
+func (h *ProjectHandler) CheckFolderRules(c *gin.Context) {
+	projectID, ok := projectIDParam(c)
+	if !ok {
+		return
+	}
+
+	check, err := h.services.Project.CheckFolderRules(c.Request.Context(), projectID, c.Query("build"))
+	if err != nil {
+		if validationErr, ok := err.(core.ValidationErrors); ok {
+			details := make(map[string]string)
+			for _, ve := range validationErr {
+				details[ve.Field] = ve.Message
+			}
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation failed",
+				Message: err.Error(),
+				Details: details,
+			})
+			return
+		}
+		switch err {
+		case core.ErrProjectNotFound:
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "Project not found",
+				Message: "The specified project does not exist",
+			})
+		case core.ErrBuildNotFound:
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "Build not found",
+				Message: "The specified build does not exist in this project",
+			})
+		case core.ErrBuildOutputUnavailable:
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "Build not checkable",
+				Message: "Only completed builds can be checked",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "Failed to check folder rules",
+				Message: err.Error(),
+			})
+		}
+		return
+	}
+
+	response := models.FolderRuleCheckResponse{
+		ProjectID: check.ProjectID,
+		Build:     buildDiffBuildResponse(check.Build),
+		Files:     make([]models.FolderRuleCheckFileResponse, len(check.Files)),
+		Unmatched: check.Unmatched,
+		Excluded:  check.Excluded,
+	}
+	if check.Routing != nil {
+		routing := folderRoutingResponse(check.Routing)
+		response.Routing = &routing
+	}
+	for _, edition := range check.Editions {
+		response.Editions = append(response.Editions, models.FolderRuleEditionResponse{
+			Name:    edition.Name,
+			Routing: folderRoutingResponse(edition.Routing),
+		})
+	}
+	for i, file := range check.Files {
+		response.Files[i] = models.FolderRuleCheckFileResponse{
+			Path:        file.Path,
+			Edition:     file.Edition,
+			BuildFolder: file.BuildFolder,
+			Rules:       file.Rules,
+			Folders:     file.Folders,
+			Excluded:    file.Excluded,
+		}
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// folderRoutingResponse converts the folder rules of a project or edition to their API response
+func folderRoutingResponse(routing *core.FolderRouting) models.FolderRoutingResponse {
+	response := models.FolderRoutingResponse{
+		Mode:  routing.Mode,
+		Rules: make([]models.FolderRuleResponse, len(routing.Rules)),
+	}
+	for i, rule := range routing.Rules {
+		response.Rules[i] = models.FolderRuleResponse{
+			Match:   rule.Match,
+			Regex:   rule.Regex,
+			Folders: rule.Folders,
+			Exclude: rule.Exclude,
+		}
+	}
+	return response
+}
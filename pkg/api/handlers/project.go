@@ -1,14 +1,25 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/bwl21/zupfmanager/pkg/api/models"
 	"github.com/bwl21/zupfmanager/pkg/core"
 	"github.com/gin-gonic/gin"
 )
 
+// sseKeepAliveInterval is the interval of keep-alive comments on idle event streams
+const sseKeepAliveInterval = 15 * time.Second
+
 // ProjectHandler handles project-related API endpoints
 type ProjectHandler struct {
 	services *core.Services
@@ -80,6 +91,8 @@ func (h *ProjectHandler) CreateProject(c *gin.Context) {
 		ShortName:            project.ShortName,
 		Config:               project.Config,
 		AbcFileDirPreference: project.AbcFileDirPreference,
+		Variables:            project.Variables,
+		ReservedNumbers:      project.ReservedNumbers,
 	}
 
 	c.JSON(http.StatusCreated, response)
@@ -124,6 +137,8 @@ func (h *ProjectHandler) ListProjects(c *gin.Context) {
 			ShortName:            project.ShortName,
 			Config:               project.Config,
 			AbcFileDirPreference: project.AbcFileDirPreference,
+			Variables:            project.Variables,
+			ReservedNumbers:      project.ReservedNumbers,
 		}
 	}
 
@@ -153,15 +168,17 @@ func (h *ProjectHandler) GetDefaultConfig(c *gin.Context) {
 
 // BuildProject starts a project build operation
 // @Summary Build project
-// @Description Start building a project to generate ABC files, PDFs, and other outputs
+// @Description Start building a project to generate ABC files, PDFs, and other outputs. With incremental set, songs whose ABC file, final zupfnoter config and zupfnoter version are unchanged reuse the PDFs of an earlier build. With dry_run set, nothing is built; the response carries the build plan with the songs in order, their resolved config, expected PDFs, folders, table of contents templates and merged files.
 // @Tags projects
 // @Accept json
 // @Produce json
 // @Param id path int true "Project ID"
 // @Param request body models.BuildProjectRequest false "Build project request"
+// @Success 200 {object} models.BuildResultResponse "Dry run with build plan"
 // @Success 202 {object} models.BuildResultResponse
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 404 {object} models.ErrorResponse
+// @Failure 422 {object} models.ErrorResponse "Supplement build without songs"
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/v1/projects/{id}/build [post]
 func (h *ProjectHandler) BuildProject(c *gin.Context) {
@@ -199,6 +216,24 @@ func (h *ProjectHandler) BuildProject(c *gin.Context) {
 	if req.SampleID != nil {
 		coreReq.SampleID = *req.SampleID
 	}
+	if req.Incremental != nil {
+		coreReq.Incremental = *req.Incremental
+	}
+	if req.DryRun != nil {
+		coreReq.DryRun = *req.DryRun
+	}
+	if req.SongOrder != nil {
+		coreReq.SongOrder = *req.SongOrder
+	}
+	if req.SupplementSince != nil {
+		coreReq.SupplementSince = *req.SupplementSince
+	}
+	if req.Profile != nil {
+		coreReq.Profile = *req.Profile
+	}
+	if req.Final != nil {
+		coreReq.Final = *req.Final
+	}
 
 	// Start build using core service
 	buildResult, err := h.services.Project.BuildProject(c.Request.Context(), coreReq)
@@ -210,6 +245,13 @@ func (h *ProjectHandler) BuildProject(c *gin.Context) {
 				Message: "The specified project does not exist",
 			})
 		default:
+			if errors.Is(err, core.ErrEmptySupplement) {
+				c.JSON(http.StatusUnprocessableEntity, models.ErrorResponse{
+					Error:   "Empty supplement",
+					Message: err.Error(),
+				})
+				return
+			}
 			// Check if it's a validation error
 			if validationErr, ok := err.(core.ValidationErrors); ok {
 				details := make(map[string]string)
@@ -231,19 +273,13 @@ func (h *ProjectHandler) BuildProject(c *gin.Context) {
 		return
 	}
 
-	// Convert to response
-	response := models.BuildResultResponse{
-		BuildID:        buildResult.BuildID,
-		ProjectID:      buildResult.ProjectID,
-		Status:         buildResult.Status,
-		OutputDir:      buildResult.OutputDir,
-		GeneratedFiles: buildResult.GeneratedFiles,
-		StartedAt:      buildResult.StartedAt,
-		CompletedAt:    buildResult.CompletedAt,
-		Error:          buildResult.Error,
+	// A dry run is finished already, there is nothing to wait for
+	if coreReq.DryRun {
+		c.JSON(http.StatusOK, buildResultResponse(buildResult))
+		return
 	}
 
-	c.JSON(http.StatusAccepted, response)
+	c.JSON(http.StatusAccepted, buildResultResponse(buildResult))
 }
 
 // GetBuildStatus returns the status of a build operation
@@ -288,17 +324,387 @@ func (h *ProjectHandler) GetBuildStatus(c *gin.Context) {
 
 	// Convert to response
 	response := models.BuildStatusResponse{
-		Status:      buildStatus.Status,
-		Progress:    buildStatus.Progress,
-		Message:     buildStatus.Message,
-		StartedAt:   buildStatus.StartedAt,
-		CompletedAt: buildStatus.CompletedAt,
-		Error:       buildStatus.Error,
+		Status:        buildStatus.Status,
+		Progress:      buildStatus.Progress,
+		QueuePosition: buildStatus.QueuePosition,
+		Message:       buildStatus.Message,
+		StartedAt:     buildStatus.StartedAt,
+		CompletedAt:   buildStatus.CompletedAt,
+		Error:         buildStatus.Error,
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
+// GetBuild returns a build including its per-song report
+// @Summary Get build
+// @Description Get a build of a project including the per-song report with the zupfnoter output of each song and the manifest of all generated files
+// @Tags projects
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param buildId path string true "Build ID"
+// @Success 200 {object} models.BuildResultResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/builds/{buildId} [get]
+func (h *ProjectHandler) GetBuild(c *gin.Context) {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid project ID",
+			Message: "Project ID must be a valid integer",
+		})
+		return
+	}
+
+	buildID := c.Param("buildId")
+	if buildID == "" {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid build ID",
+			Message: "Build ID is required",
+		})
+		return
+	}
+
+	// Get build using core service
+	buildResult, err := h.services.Project.GetBuild(c.Request.Context(), projectID, buildID)
+	if err != nil {
+		switch err {
+		case core.ErrBuildNotFound:
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "Build not found",
+				Message: "The specified build does not exist",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "Failed to get build",
+				Message: err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, buildResultResponse(buildResult))
+}
+
+// CancelBuild cancels a queued or running build operation
+// @Summary Cancel build
+// @Description Cancel a queued or running project build. A running build is stopped and its partial output removed.
+// @Tags projects
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param buildId path string true "Build ID"
+// @Success 200 {object} models.MessageResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/builds/{buildId} [delete]
+func (h *ProjectHandler) CancelBuild(c *gin.Context) {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid project ID",
+			Message: "Project ID must be a valid integer",
+		})
+		return
+	}
+
+	buildID := c.Param("buildId")
+	if buildID == "" {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid build ID",
+			Message: "Build ID is required",
+		})
+		return
+	}
+
+	// Cancel build using core service
+	err = h.services.Project.CancelBuild(c.Request.Context(), projectID, buildID)
+	if err != nil {
+		switch err {
+		case core.ErrBuildNotFound:
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "Build not found",
+				Message: "The specified build does not exist",
+			})
+		case core.ErrBuildNotActive:
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "Build not active",
+				Message: "Only queued or running builds can be cancelled",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "Failed to cancel build",
+				Message: err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, models.MessageResponse{
+		Message: "Build cancellation requested",
+	})
+}
+
+// DownloadBuildArchive streams the output folders of a finished build as ZIP archive
+// @Summary Download build archive
+// @Description Download the druckdateien, pdf, abc and referenz folders of a finished build as ZIP archive. The folders parameter selects the folders to include, either comma separated or repeated; all of them are included by default. Every build keeps its own output directory; the last builds of the project are retained (5 by default, see buildRetention in the project configuration) and the output of a build removed by the retention answers with 410.
+// @Tags projects
+// @Produce application/zip
+// @Param id path int true "Project ID"
+// @Param buildId path string true "Build ID"
+// @Param folders query []string false "Folders to include (druckdateien, pdf, abc, referenz)" collectionFormat(csv)
+// @Success 200 {file} file
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 410 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/builds/{buildId}/archive [get]
+func (h *ProjectHandler) DownloadBuildArchive(c *gin.Context) {
+	var requested []string
+	for _, param := range c.QueryArray("folders") {
+		for _, folder := range strings.Split(param, ",") {
+			if folder = strings.TrimSpace(folder); folder != "" {
+				requested = append(requested, folder)
+			}
+		}
+	}
+	folders, err := core.ParseArtifactFolders(requested)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid folders",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	artifacts, ok := h.getBuildArtifacts(c)
+	if !ok {
+		return
+	}
+
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", "attachment; filename=\""+artifacts.ArchiveName()+"\"")
+	c.Status(http.StatusOK)
+
+	// Headers are sent already, a failure can only abort the download
+	if err := artifacts.WriteArchive(c.Request.Context(), c.Writer, folders); err != nil {
+		slog.Error("failed to write build archive", "buildID", artifacts.BuildID, "error", err)
+		c.Abort()
+	}
+}
+
+// DownloadBuildFile downloads a single file of a finished build
+// @Summary Download build file
+// @Description Download a single file of a finished build, such as a merged PDF in druckdateien/<short>_klein.pdf. The path is relative to the output directory and must lie in the druckdateien, pdf, abc or referenz folder.
+// @Tags projects
+// @Produce application/octet-stream
+// @Param id path int true "Project ID"
+// @Param buildId path string true "Build ID"
+// @Param path path string true "File path relative to the output directory"
+// @Success 200 {file} file
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 409 {object} models.ErrorResponse
+// @Failure 410 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/builds/{buildId}/files/{path} [get]
+func (h *ProjectHandler) DownloadBuildFile(c *gin.Context) {
+	artifacts, ok := h.getBuildArtifacts(c)
+	if !ok {
+		return
+	}
+
+	filePath, err := artifacts.FilePath(c.Param("path"))
+	if err != nil {
+		switch err {
+		case core.ErrInvalidArtifactPath:
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "Invalid file path",
+				Message: "Only files in the druckdateien, pdf, abc and referenz folders can be downloaded",
+			})
+		case core.ErrArtifactNotFound:
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "File not found",
+				Message: "The build did not generate the specified file",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "Failed to download file",
+				Message: err.Error(),
+			})
+		}
+		return
+	}
+
+	c.FileAttachment(filePath, filepath.Base(filePath))
+}
+
+// getBuildArtifacts loads the artifacts of the build in the request and
+// writes the error response if they are not available
+func (h *ProjectHandler) getBuildArtifacts(c *gin.Context) (*core.BuildArtifacts, bool) {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid project ID",
+			Message: "Project ID must be a valid integer",
+		})
+		return nil, false
+	}
+
+	buildID := c.Param("buildId")
+	if buildID == "" {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid build ID",
+			Message: "Build ID is required",
+		})
+		return nil, false
+	}
+
+	artifacts, err := h.services.Project.GetBuildArtifacts(c.Request.Context(), projectID, buildID)
+	if err != nil {
+		switch err {
+		case core.ErrBuildNotFound:
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "Build not found",
+				Message: "The specified build does not exist",
+			})
+		case core.ErrBuildOutputUnavailable:
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "Build output not available",
+				Message: "Only completed builds can be downloaded",
+			})
+		case core.ErrBuildOutputReplaced:
+			c.JSON(http.StatusGone, models.ErrorResponse{
+				Error:   "Build output replaced",
+				Message: "The output of this build has been replaced by a newer build",
+			})
+		case core.ErrBuildOutputRemoved:
+			c.JSON(http.StatusGone, models.ErrorResponse{
+				Error:   "Build output removed",
+				Message: "The output of this build has been removed, only the most recent builds are kept",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "Failed to get build output",
+				Message: err.Error(),
+			})
+		}
+		return nil, false
+	}
+
+	return artifacts, true
+}
+
+// StreamBuildEvents streams the events of a build as Server-Sent Events
+// @Summary Stream build events
+// @Description Stream progress, per-song and result events of a build as Server-Sent Events. Each event carries its ID, a client that reconnects with the Last-Event-ID header (or the last_event_id query parameter) gets all events it missed first. The stream ends after the result event.
+// @Tags projects
+// @Produce text/event-stream
+// @Param id path int true "Project ID"
+// @Param buildId path string true "Build ID"
+// @Param Last-Event-ID header int false "ID of the last event received"
+// @Param last_event_id query int false "ID of the last event received"
+// @Success 200 {object} models.BuildEventResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/builds/{buildId}/events [get]
+func (h *ProjectHandler) StreamBuildEvents(c *gin.Context) {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid project ID",
+			Message: "Project ID must be a valid integer",
+		})
+		return
+	}
+
+	buildID := c.Param("buildId")
+	if buildID == "" {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid build ID",
+			Message: "Build ID is required",
+		})
+		return
+	}
+
+	// EventSource sends the header on reconnects, the query parameter allows a resume on first connect
+	lastEventIDParam := c.GetHeader("Last-Event-ID")
+	if lastEventIDParam == "" {
+		lastEventIDParam = c.Query("last_event_id")
+	}
+	lastEventID := 0
+	if lastEventIDParam != "" {
+		id, err := strconv.Atoi(lastEventIDParam)
+		if err != nil || id < 0 {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "Invalid event ID",
+				Message: "Last event ID must be a non-negative integer",
+			})
+			return
+		}
+		lastEventID = id
+	}
+
+	// The stream also ends when the server shuts down
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	stop := context.AfterFunc(h.services.Context(), cancel)
+	defer stop()
+
+	events, err := h.services.Project.StreamBuildEvents(ctx, projectID, buildID, lastEventID)
+	if err != nil {
+		switch err {
+		case core.ErrBuildNotFound:
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "Build not found",
+				Message: "The specified build does not exist",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "Failed to stream build events",
+				Message: err.Error(),
+			})
+		}
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(buildEventResponse(event))
+			if err != nil {
+				return
+			}
+			fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+			c.Writer.Flush()
+		case <-keepAlive.C:
+			// Comments keep proxies from closing an idle connection
+			fmt.Fprint(c.Writer, ": keep-alive\n\n")
+			c.Writer.Flush()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // ListBuilds returns all builds for a project
 // @Summary List project builds
 // @Description Get all build operations for a project
@@ -330,18 +736,17 @@ func (h *ProjectHandler) ListBuilds(c *gin.Context) {
 		return
 	}
 
-	// Convert to response
+	// Convert to response, the zupfnoter output of the songs and the manifest
+	// are only part of the build details
 	responses := make([]models.BuildResultResponse, len(builds))
 	for i, build := range builds {
-		responses[i] = models.BuildResultResponse{
-			BuildID:        build.BuildID,
-			ProjectID:      build.ProjectID,
-			Status:         build.Status,
-			OutputDir:      build.OutputDir,
-			GeneratedFiles: build.GeneratedFiles,
-			StartedAt:      build.StartedAt,
-			CompletedAt:    build.CompletedAt,
-			Error:          build.Error,
+		responses[i] = buildResultResponse(build)
+		responses[i].Manifest = nil
+		if report := responses[i].Report; report != nil {
+			for j := range report.Songs {
+				report.Songs[j].Stdout = ""
+				report.Songs[j].Stderr = ""
+			}
 		}
 	}
 
@@ -387,7 +792,7 @@ func (h *ProjectHandler) ClearBuildHistory(c *gin.Context) {
 
 // GetBuildDefaults returns default values for build configuration
 // @Summary Get build defaults
-// @Description Get default values for build configuration including abc_file_dir from last import
+// @Description Get the values a build uses when the request leaves them out: those of the default build profile of the project, otherwise the project defaults (abc_file_dir from the project preference, its config or the last import)
 // @Tags projects
 // @Produce json
 // @Param id path int true "Project ID"
@@ -406,37 +811,29 @@ func (h *ProjectHandler) GetBuildDefaults(c *gin.Context) {
 		return
 	}
 
-	// Get the project to check if it exists and has abc_file_dir config
-	project, err := h.services.Project.Get(c.Request.Context(), projectID)
+	defaults, err := h.services.Project.GetBuildDefaults(c.Request.Context(), projectID)
 	if err != nil {
-		c.JSON(http.StatusNotFound, models.ErrorResponse{
-			Error:   "Project not found",
-			Message: "The specified project does not exist",
+		if err == core.ErrProjectNotFound {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "Project not found",
+				Message: "The specified project does not exist",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "Failed to get build defaults",
+			Message: err.Error(),
 		})
 		return
 	}
 
-	defaults := models.BuildDefaultsResponse{
-		OutputDir:         project.ShortName,
-		AbcFileDir:        "",
-		PriorityThreshold: 1,
-		SampleID:          "",
-	}
-
-	// Priority order: abc_file_dir_preference > abc_file_dir (from config) > last import directory
-	if project.AbcFileDirPreference != "" {
-		defaults.AbcFileDir = project.AbcFileDirPreference
-	} else if abcFileDir, ok := project.Config["abc_file_dir"].(string); ok && abcFileDir != "" {
-		defaults.AbcFileDir = abcFileDir
-	} else {
-		// Try to get the last import directory
-		lastImportDir, err := core.GetLastImportDir()
-		if err == nil && lastImportDir != "" {
-			defaults.AbcFileDir = lastImportDir
-		}
-	}
-
-	c.JSON(http.StatusOK, defaults)
+	c.JSON(http.StatusOK, models.BuildDefaultsResponse{
+		Profile:           defaults.Profile,
+		OutputDir:         defaults.OutputDir,
+		AbcFileDir:        defaults.AbcFileDir,
+		PriorityThreshold: defaults.PriorityThreshold,
+		SampleID:          defaults.SampleID,
+	})
 }
 
 // UpdateAbcFileDirPreference updates the abc_file_dir preference for a project
@@ -497,11 +894,84 @@ func (h *ProjectHandler) UpdateAbcFileDirPreference(c *gin.Context) {
 		ShortName:            updatedProject.ShortName,
 		Config:               updatedProject.Config,
 		AbcFileDirPreference: updatedProject.AbcFileDirPreference,
+		Variables:            updatedProject.Variables,
+		ReservedNumbers:      updatedProject.ReservedNumbers,
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
+// UpdateProjectVariables replaces the user-defined template variables of a project
+// @Summary Update project variables
+// @Description Replace the user-defined variables that project configs and table of contents templates refer to as #{name}. Names of built-in variables are rejected.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body models.UpdateProjectVariablesRequest true "Update project variables request"
+// @Success 200 {object} models.ProjectResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/variables [put]
+func (h *ProjectHandler) UpdateProjectVariables(c *gin.Context) {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid project ID",
+			Message: "Project ID must be a valid integer",
+		})
+		return
+	}
+
+	var req models.UpdateProjectVariablesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "invalid request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	project, err := h.services.Project.SetProjectVariables(c.Request.Context(), projectID, req.Variables)
+	if err != nil {
+		if err == core.ErrProjectNotFound {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "project not found",
+				Message: err.Error(),
+			})
+			return
+		}
+		if validationErr, ok := err.(core.ValidationErrors); ok {
+			details := make(map[string]string)
+			for _, ve := range validationErr {
+				details[ve.Field] = ve.Message
+			}
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation failed",
+				Message: err.Error(),
+				Details: details,
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "Failed to update project",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ProjectResponse{
+		ID:                   project.ID,
+		Title:                project.Title,
+		ShortName:            project.ShortName,
+		Config:               project.Config,
+		AbcFileDirPreference: project.AbcFileDirPreference,
+		Variables:            project.Variables,
+		ReservedNumbers:      project.ReservedNumbers,
+	})
+}
+
 // GetProject gets a project by ID
 // @Summary Get project by ID
 // @Description Get a specific project by its ID
@@ -539,6 +1009,8 @@ func (h *ProjectHandler) GetProject(c *gin.Context) {
 		ShortName:            project.ShortName,
 		Config:               project.Config,
 		AbcFileDirPreference: project.AbcFileDirPreference,
+		Variables:            project.Variables,
+		ReservedNumbers:      project.ReservedNumbers,
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -616,6 +1088,8 @@ func (h *ProjectHandler) UpdateProject(c *gin.Context) {
 		ShortName:            project.ShortName,
 		Config:               project.Config,
 		AbcFileDirPreference: project.AbcFileDirPreference,
+		Variables:            project.Variables,
+		ReservedNumbers:      project.ReservedNumbers,
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -653,3 +1127,229 @@ func (h *ProjectHandler) DeleteProject(c *gin.Context) {
 
 	c.Status(http.StatusNoContent)
 }
+
+// buildResultResponse converts a core build result to its API response
+func buildResultResponse(buildResult *core.BuildResult) models.BuildResultResponse {
+	response := models.BuildResultResponse{
+		BuildID:        buildResult.BuildID,
+		ProjectID:      buildResult.ProjectID,
+		Status:         buildResult.Status,
+		OutputDir:      buildResult.OutputDir,
+		BuildDir:       buildResult.BuildDir,
+		Profile:        buildResult.Profile,
+		GeneratedFiles: buildResult.GeneratedFiles,
+		StartedAt:      buildResult.StartedAt,
+		CompletedAt:    buildResult.CompletedAt,
+		Error:          buildResult.Error,
+	}
+	if buildResult.Report != nil {
+		response.Report = buildReportResponse(buildResult.Report)
+	}
+	if buildResult.Manifest != nil {
+		response.Manifest = buildManifestResponse(buildResult.Manifest)
+	}
+	if buildResult.Plan != nil {
+		response.Plan = buildPlanResponse(buildResult.Plan)
+	}
+	return response
+}
+
+// buildPlanResponse converts a core build plan to its API response
+func buildPlanResponse(plan *core.BuildPlan) *models.BuildPlanResponse {
+	response := &models.BuildPlanResponse{
+		ProjectID:         plan.ProjectID,
+		ShortName:         plan.ShortName,
+		OutputDir:         plan.OutputDir,
+		AbcFileDir:        plan.AbcFileDir,
+		PriorityThreshold: plan.PriorityThreshold,
+		SampleID:          plan.SampleID,
+		SongOrder:         plan.SongOrder,
+		SupplementSince:   plan.SupplementSince,
+		Profile:           plan.Profile,
+		Final:             plan.Final,
+		ZupfnoterVersion:  plan.ZupfnoterVersion,
+		FolderRouting:     folderRoutingResponse(plan.FolderRouting),
+		Songs:             plannedSongResponses(plan.Songs),
+		TOC:               plannedTOCResponse(plan.TOC),
+		MergedFiles:       plannedMergeResponses(plan.MergedFiles),
+		Watermarks:        plannedWatermarkResponses(plan.Watermarks),
+		Warnings:          plan.Warnings,
+	}
+	for _, edition := range plan.Editions {
+		response.Editions = append(response.Editions, models.PlannedEditionResponse{
+			Name:          edition.Name,
+			FolderRouting: folderRoutingResponse(edition.FolderRouting),
+			Songs:         plannedSongResponses(edition.Songs),
+			TOC:           plannedTOCResponse(edition.TOC),
+			MergedFiles:   plannedMergeResponses(edition.MergedFiles),
+			Watermarks:    plannedWatermarkResponses(edition.Watermarks),
+		})
+	}
+	return response
+}
+
+// plannedSongResponses converts the planned songs of a build plan or edition
+func plannedSongResponses(songs []core.PlannedSong) []models.PlannedSongResponse {
+	response := make([]models.PlannedSongResponse, len(songs))
+	for i, song := range songs {
+		response[i] = models.PlannedSongResponse{
+			Index:         song.Index,
+			ProjectSongID: song.ProjectSongID,
+			SongID:        song.SongID,
+			Title:         song.Title,
+			Filename:      song.Filename,
+			Priority:      song.Priority,
+			Copyright:     song.Copyright,
+			ABCPath:       song.ABCPath,
+			ABCExists:     song.ABCExists,
+			Config:        song.Config,
+			PDFs:          plannedPDFResponses(song.PDFs),
+			Warnings:      song.Warnings,
+		}
+	}
+	return response
+}
+
+// plannedTOCResponse converts the planned table of contents of a build plan or edition
+func plannedTOCResponse(toc core.PlannedTOC) models.PlannedTOCResponse {
+	return models.PlannedTOCResponse{
+		Template:     toc.Template,
+		HTMLTemplate: toc.HTMLTemplate,
+		PageNumbers:  toc.PageNumbers,
+		Registers:    toc.Registers,
+		PDFs:         plannedPDFResponses(toc.PDFs),
+		Warnings:     toc.Warnings,
+	}
+}
+
+// plannedMergeResponses converts the planned merged files of a build plan or edition
+func plannedMergeResponses(merges []core.PlannedMerge) []models.PlannedMergeResponse {
+	response := make([]models.PlannedMergeResponse, len(merges))
+	for i, merge := range merges {
+		response[i] = models.PlannedMergeResponse{
+			Path:   merge.Path,
+			Folder: merge.Folder,
+			Files:  merge.Files,
+		}
+	}
+	return response
+}
+
+// plannedWatermarkResponses converts the watermarks of a build plan or edition
+func plannedWatermarkResponses(watermarks []core.PlannedWatermark) []models.PlannedWatermarkResponse {
+	var response []models.PlannedWatermarkResponse
+	for _, watermark := range watermarks {
+		response = append(response, models.PlannedWatermarkResponse{
+			Name:    watermark.Name,
+			Text:    watermark.Text,
+			Image:   watermark.Image,
+			Folders: watermark.Folders,
+		})
+	}
+	return response
+}
+
+// plannedPDFResponses converts the planned PDFs of a song or the table of contents
+func plannedPDFResponses(pdfs []core.PlannedPDF) []models.PlannedPDFResponse {
+	response := make([]models.PlannedPDFResponse, len(pdfs))
+	for i, pdf := range pdfs {
+		response[i] = models.PlannedPDFResponse{
+			Path:      pdf.Path,
+			Extract:   pdf.Extract,
+			Folder:    pdf.Folder,
+			Target:    pdf.Target,
+			Reference: pdf.Reference,
+			Folders:   pdf.Folders,
+			Targets:   pdf.Targets,
+			Excluded:  pdf.Excluded,
+		}
+	}
+	return response
+}
+
+// buildManifestResponse converts a core build manifest to its API response
+func buildManifestResponse(manifest *core.BuildManifest) *models.BuildManifestResponse {
+	response := &models.BuildManifestResponse{
+		GeneratedAt: manifest.GeneratedAt,
+		FileCount:   manifest.FileCount,
+		TotalSize:   manifest.TotalSize,
+		Files:       make([]models.ManifestFileResponse, len(manifest.Files)),
+	}
+	for i, file := range manifest.Files {
+		response.Files[i] = models.ManifestFileResponse{
+			Path:      file.Path,
+			Size:      file.Size,
+			SHA256:    file.SHA256,
+			Pages:     file.Pages,
+			SongIndex: file.SongIndex,
+			Song:      file.Song,
+			Folder:    file.Folder,
+			Edition:   file.Edition,
+		}
+	}
+	return response
+}
+
+// buildReportResponse converts a core build report to its API response
+func buildReportResponse(report *core.BuildReport) *models.BuildReportResponse {
+	response := &models.BuildReportResponse{
+		Total:            report.Total,
+		Succeeded:        report.Succeeded,
+		Failed:           report.Failed,
+		Skipped:          report.Skipped,
+		Cached:           report.Cached,
+		ZupfnoterVersion: report.ZupfnoterVersion,
+		Songs:            make([]models.SongReportResponse, len(report.Songs)),
+	}
+	for i, song := range report.Songs {
+		response.Songs[i] = models.SongReportResponse{
+			Index:         song.Index,
+			ProjectSongID: song.ProjectSongID,
+			SongID:        song.SongID,
+			Title:         song.Title,
+			Filename:      song.Filename,
+			Edition:       song.Edition,
+			Status:        song.Status,
+			StartedAt:     song.StartedAt,
+			DurationMs:    song.DurationMs,
+			Stdout:        song.Stdout,
+			Stderr:        song.Stderr,
+			PDFs:          song.PDFs,
+			HTMLWarnings:  song.HTMLWarnings,
+			Error:         song.Error,
+			ABCHash:       song.ABCHash,
+			ConfigHash:    song.ConfigHash,
+			CacheKey:      song.CacheKey,
+			Cached:        song.Cached,
+		}
+	}
+	return response
+}
+
+// buildEventResponse converts a core build event to its API response
+func buildEventResponse(event core.BuildEvent) models.BuildEventResponse {
+	response := models.BuildEventResponse{
+		ID:       event.ID,
+		BuildID:  event.BuildID,
+		Type:     string(event.Type),
+		Time:     event.Time,
+		Status:   event.Status,
+		Progress: event.Progress,
+		Message:  event.Message,
+		Error:    event.Error,
+	}
+	if event.Song != nil {
+		response.Song = &models.BuildEventSong{
+			Index:    event.Song.Index,
+			Title:    event.Song.Title,
+			Filename: event.Song.Filename,
+			Edition:  event.Song.Edition,
+			Cached:   event.Song.Cached,
+		}
+	}
+	if event.Result != nil {
+		result := buildResultResponse(event.Result)
+		response.Result = &result
+	}
+	return response
+}
@@ -121,6 +121,8 @@ func (h *ProjectSongHandler) AddSongToProject(c *gin.Context) {
 		SongID:     projectSong.SongID,
 		Difficulty: projectSong.Difficulty,
 		Priority:   projectSong.Priority,
+		Position:   projectSong.Position,
+		Number:     projectSong.Number,
 		Comment:    projectSong.Comment,
 	}
 
@@ -279,6 +281,8 @@ func (h *ProjectSongHandler) UpdateProjectSong(c *gin.Context) {
 		SongID:     projectSong.SongID,
 		Difficulty: projectSong.Difficulty,
 		Priority:   projectSong.Priority,
+		Position:   projectSong.Position,
+		Number:     projectSong.Number,
 		Comment:    projectSong.Comment,
 	}
 
@@ -336,7 +340,267 @@ func (h *ProjectSongHandler) ListProjectSongs(c *gin.Context) {
 		return
 	}
 
-	// Convert to response
+	responses := projectSongResponses(projectSongs)
+	c.JSON(http.StatusOK, models.ProjectSongsResponse{
+		ProjectSongs: responses,
+		Total:        len(responses),
+	})
+}
+
+// ReorderProjectSongs sets the manual order of the songs in a project
+// @Summary Reorder project songs
+// @Description Store the manual order of the songs in a project. The list must contain every song of the project exactly once. Builds use this order with the song order "manual".
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body models.ReorderProjectSongsRequest true "Song IDs in the new order"
+// @Success 200 {object} models.ProjectSongsResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/songs/order [put]
+func (h *ProjectSongHandler) ReorderProjectSongs(c *gin.Context) {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid project ID",
+			Message: "Project ID must be a valid integer",
+		})
+		return
+	}
+
+	var req models.ReorderProjectSongsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	projectSongs, err := h.services.Project.ReorderProjectSongs(c.Request.Context(), projectID, req.SongIDs)
+	if err != nil {
+		switch err {
+		case core.ErrProjectNotFound:
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "Project not found",
+				Message: "The specified project does not exist",
+			})
+		default:
+			if validationErr, ok := err.(core.ValidationErrors); ok {
+				details := make(map[string]string)
+				for _, ve := range validationErr {
+					details[ve.Field] = ve.Message
+				}
+				c.JSON(http.StatusBadRequest, models.ErrorResponse{
+					Error:   "Validation failed",
+					Message: validationErr.Error(),
+					Details: details,
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "Database error",
+				Message: err.Error(),
+			})
+		}
+		return
+	}
+
+	responses := projectSongResponses(projectSongs)
+	c.JSON(http.StatusOK, models.ProjectSongsResponse{
+		ProjectSongs: responses,
+		Total:        len(responses),
+	})
+}
+
+// RenumberProjectSongs numbers all songs of a project from 1
+// @Summary Renumber project songs
+// @Description Number all songs of a project from 1 in the given song order, the song order of the project by default. Reserved numbers of removed songs are released. Numbers otherwise stay fixed once assigned.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body models.RenumberProjectSongsRequest false "Song order of the new numbers"
+// @Success 200 {object} models.ProjectSongsResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/songs/renumber [post]
+func (h *ProjectSongHandler) RenumberProjectSongs(c *gin.Context) {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid project ID",
+			Message: "Project ID must be a valid integer",
+		})
+		return
+	}
+
+	var req models.RenumberProjectSongsRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "Invalid request body",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+	songOrder := ""
+	if req.SongOrder != nil {
+		songOrder = *req.SongOrder
+	}
+
+	projectSongs, err := h.services.Project.RenumberProjectSongs(c.Request.Context(), projectID, songOrder)
+	if err != nil {
+		switch err {
+		case core.ErrProjectNotFound:
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "Project not found",
+				Message: "The specified project does not exist",
+			})
+		default:
+			if validationErr, ok := err.(core.ValidationErrors); ok {
+				details := make(map[string]string)
+				for _, ve := range validationErr {
+					details[ve.Field] = ve.Message
+				}
+				c.JSON(http.StatusBadRequest, models.ErrorResponse{
+					Error:   "Validation failed",
+					Message: validationErr.Error(),
+					Details: details,
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "Database error",
+				Message: err.Error(),
+			})
+		}
+		return
+	}
+
+	responses := projectSongResponses(projectSongs)
+	c.JSON(http.StatusOK, models.ProjectSongsResponse{
+		ProjectSongs: responses,
+		Total:        len(responses),
+	})
+}
+
+// GetResolvedSongConfig returns the final zupfnoter config of a song in a project
+// @Summary Get resolved song config
+// @Description Get the zupfnoter config a build passes for a song: the project config with its variables substituted, merged with the config of the ABC file. Origins maps the JSON pointer of every value to "project", "placeholder" or "abc_file".
+// @Tags projects
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param songId path int true "Song ID"
+// @Param abc_file_dir query string false "Directory of the ABC files, defaults to the one of the project"
+// @Param priority_threshold query int false "Priority threshold of the build, determines the index of the song" minimum(1) maximum(4)
+// @Param sample_id query string false "Sample ID"
+// @Param song_order query string false "Song order of the build, determines the index of the song" Enums(title,manual,priority,difficulty,genre)
+// @Param profile query string false "Build profile whose parameters and config overlay the build uses"
+// @Success 200 {object} models.ResolvedSongConfigResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 422 {object} models.ErrorResponse "Song is not part of a build with this priority threshold"
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/songs/{songId}/resolved-config [get]
+func (h *ProjectSongHandler) GetResolvedSongConfig(c *gin.Context) {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid project ID",
+			Message: "Project ID must be a valid integer",
+		})
+		return
+	}
+
+	songID, err := strconv.Atoi(c.Param("songId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid song ID",
+			Message: "Song ID must be a valid integer",
+		})
+		return
+	}
+
+	coreReq := core.ResolveSongConfigRequest{
+		ProjectID:  projectID,
+		SongID:     songID,
+		AbcFileDir: c.Query("abc_file_dir"),
+		SampleID:   c.Query("sample_id"),
+		SongOrder:  c.Query("song_order"),
+		Profile:    c.Query("profile"),
+	}
+	if threshold := c.Query("priority_threshold"); threshold != "" {
+		coreReq.PriorityThreshold, err = strconv.Atoi(threshold)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "Invalid priority threshold",
+				Message: "Priority threshold must be a valid integer",
+			})
+			return
+		}
+	}
+
+	resolved, err := h.services.Project.ResolveSongConfig(c.Request.Context(), coreReq)
+	if err != nil {
+		switch err {
+		case core.ErrProjectNotFound:
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "Project not found",
+				Message: "The specified project does not exist",
+			})
+		case core.ErrProjectSongNotFound:
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "Project-song relationship not found",
+				Message: "The song is not in the specified project",
+			})
+		case core.ErrSongNotInBuild:
+			c.JSON(http.StatusUnprocessableEntity, models.ErrorResponse{
+				Error:   "Song not part of the build",
+				Message: "The priority of the song is above the priority threshold",
+			})
+		default:
+			if validationErr, ok := err.(core.ValidationErrors); ok {
+				details := make(map[string]string)
+				for _, ve := range validationErr {
+					details[ve.Field] = ve.Message
+				}
+				c.JSON(http.StatusBadRequest, models.ErrorResponse{
+					Error:   "validation failed",
+					Message: err.Error(),
+					Details: details,
+				})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "Failed to resolve song config",
+				Message: err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ResolvedSongConfigResponse{
+		ProjectID:     resolved.ProjectID,
+		SongID:        resolved.SongID,
+		ProjectSongID: resolved.ProjectSongID,
+		Title:         resolved.Title,
+		Filename:      resolved.Filename,
+		Index:         resolved.Index,
+		ABCPath:       resolved.ABCPath,
+		ABCExists:     resolved.ABCExists,
+		Variables:     resolved.Variables,
+		Config:        resolved.Config,
+		Origins:       resolved.Origins,
+	})
+}
+
+// projectSongResponses converts project songs with their song details to responses
+func projectSongResponses(projectSongs []*core.ProjectSong) []models.ProjectSongResponse {
 	responses := make([]models.ProjectSongResponse, len(projectSongs))
 	for i, ps := range projectSongs {
 		responses[i] = models.ProjectSongResponse{
@@ -345,6 +609,8 @@ func (h *ProjectSongHandler) ListProjectSongs(c *gin.Context) {
 			SongID:     ps.SongID,
 			Difficulty: ps.Difficulty,
 			Priority:   ps.Priority,
+			Position:   ps.Position,
+			Number:     ps.Number,
 			Comment:    ps.Comment,
 		}
 
@@ -375,9 +641,5 @@ func (h *ProjectSongHandler) ListProjectSongs(c *gin.Context) {
 			responses[i].Song = songResponse
 		}
 	}
-
-	c.JSON(http.StatusOK, models.ProjectSongsResponse{
-		ProjectSongs: responses,
-		Total:        len(responses),
-	})
+	return responses
 }
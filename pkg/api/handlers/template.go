@@ -0,0 +1,448 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/bwl21/zupfmanager/pkg/api/models"
+	"github.com/bwl21/zupfmanager/pkg/core"
+	"github.com/gin-gonic/gin"
+)
+
+// TemplateHandler handles the API endpoints of the template registry
+type TemplateHandler struct {
+	services *core.Services
+}
+
+// NewTemplateHandler creates a new template handler
+func NewTemplateHandler(services *core.Services) *TemplateHandler {
+	return &TemplateHandler{
+		services: services,
+	}
+}
+
+// ListTemplates lists the templates of all sources
+// @Summary List templates
+// @Description Get the shared, user and built-in templates by kind without content. With a project its templates are listed as well and the ones it uses are marked active.
+// @Tags templates
+// @Produce json
+// @Param project query int false "Project ID"
+// @Success 200 {object} models.TemplateListResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/templates [get]
+func (h *TemplateHandler) ListTemplates(c *gin.Context) {
+	projectID, ok := projectQueryParam(c)
+	if !ok {
+		return
+	}
+
+	templates, err := h.services.Project.ListTemplates(c.Request.Context(), projectID)
+	if err != nil {
+		templateError(c, err)
+		return
+	}
+
+	response := models.TemplateListResponse{
+		Templates: make([]models.TemplateResponse, len(templates)),
+		Count:     len(templates),
+	}
+	for i, template := range templates {
+		response.Templates[i] = templateResponse(template)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetTemplate gets a template with its content
+// @Summary Get template
+// @Description Get a template of a kind. Without source the template the project uses is returned, without project the one of the server-wide order. Shared templates are selected by name.
+// @Tags templates
+// @Produce json
+// @Param kind path string true "Template kind" Enums(toc, toc-html)
+// @Param source query string false "Template source" Enums(project, shared, user, built-in)
+// @Param name query string false "Name of the shared template"
+// @Param project query int false "Project ID"
+// @Success 200 {object} models.TemplateResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/templates/{kind} [get]
+func (h *TemplateHandler) GetTemplate(c *gin.Context) {
+	projectID, ok := projectQueryParam(c)
+	if !ok {
+		return
+	}
+
+	template, err := h.services.Project.GetTemplate(c.Request.Context(), core.TemplateRef{
+		Kind:      c.Param("kind"),
+		Source:    c.Query("source"),
+		Name:      c.Query("name"),
+		ProjectID: projectID,
+	})
+	if err != nil {
+		templateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, templateResponse(template))
+}
+
+// CreateSharedTemplate uploads a shared template
+// @Summary Create shared template
+// @Description Store a named template in the database. Projects select it in their config as "templates": {"<kind>": "<name>"}.
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param request body models.TemplateRequest true "Shared template"
+// @Success 201 {object} models.TemplateResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/templates/shared [post]
+func (h *TemplateHandler) CreateSharedTemplate(c *gin.Context) {
+	req, ok := bindTemplateRequest(c)
+	if !ok {
+		return
+	}
+
+	template, err := h.services.Project.CreateSharedTemplate(c.Request.Context(), req)
+	if err != nil {
+		templateError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, templateResponse(template))
+}
+
+// UpdateSharedTemplate edits a shared template
+// @Summary Update shared template
+// @Description Replace name, description and content of a shared template, its kind stays as it is
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param templateId path int true "Shared template ID"
+// @Param request body models.TemplateRequest true "Shared template"
+// @Success 200 {object} models.TemplateResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/templates/shared/{templateId} [put]
+func (h *TemplateHandler) UpdateSharedTemplate(c *gin.Context) {
+	templateID, ok := templateIDParam(c)
+	if !ok {
+		return
+	}
+	req, ok := bindTemplateRequest(c)
+	if !ok {
+		return
+	}
+
+	template, err := h.services.Project.UpdateSharedTemplate(c.Request.Context(), templateID, req)
+	if err != nil {
+		templateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, templateResponse(template))
+}
+
+// DeleteSharedTemplate deletes a shared template
+// @Summary Delete shared template
+// @Description Delete a shared template. Projects that still select it fail to build until they select another one.
+// @Tags templates
+// @Param templateId path int true "Shared template ID"
+// @Success 204
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/templates/shared/{templateId} [delete]
+func (h *TemplateHandler) DeleteSharedTemplate(c *gin.Context) {
+	templateID, ok := templateIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.services.Project.DeleteSharedTemplate(c.Request.Context(), templateID); err != nil {
+		templateError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// GetTemplateOrder gets the order templates are looked up in
+// @Summary Get template order
+// @Description Get the order the template sources are looked up in, of a project or server-wide. The built-in templates come last.
+// @Tags templates
+// @Produce json
+// @Param project query int false "Project ID"
+// @Success 200 {object} models.TemplateOrderResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/templates/order [get]
+func (h *TemplateHandler) GetTemplateOrder(c *gin.Context) {
+	projectID, ok := projectQueryParam(c)
+	if !ok {
+		return
+	}
+
+	order, err := h.services.Project.GetTemplateOrder(c.Request.Context(), projectID)
+	if err != nil {
+		templateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, models.TemplateOrderResponse{Order: order})
+}
+
+// SetTemplateOrder sets the server-wide template order
+// @Summary Set template order
+// @Description Set the order the template sources are looked up in for projects without templateOrder in their config. An empty order restores the default order project, shared, user.
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param request body models.TemplateOrderRequest true "Template order"
+// @Success 200 {object} models.TemplateOrderResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/templates/order [put]
+func (h *TemplateHandler) SetTemplateOrder(c *gin.Context) {
+	var req models.TemplateOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.services.Project.SetTemplateOrder(ctx, req.Order); err != nil {
+		templateError(c, err)
+		return
+	}
+	order, err := h.services.Project.GetTemplateOrder(ctx, 0)
+	if err != nil {
+		templateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, models.TemplateOrderResponse{Order: order})
+}
+
+// SetProjectTemplate sets the template of a project
+// @Summary Set project template
+// @Description Write a template into the template directory of a project, where it overrides shared and user templates in the default order
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param kind path string true "Template kind" Enums(toc, toc-html)
+// @Param request body models.ProjectTemplateRequest true "Template content"
+// @Success 200 {object} models.TemplateResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/templates/{kind} [put]
+func (h *TemplateHandler) SetProjectTemplate(c *gin.Context) {
+	projectID, ok := projectIDParam(c)
+	if !ok {
+		return
+	}
+	var req models.ProjectTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	template, err := h.services.Project.SetProjectTemplate(c.Request.Context(), projectID, c.Param("kind"), req.Content)
+	if err != nil {
+		templateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, templateResponse(template))
+}
+
+// CopyTemplateToProject copies a shared template into a project
+// @Summary Copy shared template into project
+// @Description Copy a shared template into the template directory of a project, where it can be changed without affecting other projects
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body models.CopyTemplateRequest true "Shared template"
+// @Success 200 {object} models.TemplateResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/templates/copy [post]
+func (h *TemplateHandler) CopyTemplateToProject(c *gin.Context) {
+	projectID, ok := projectIDParam(c)
+	if !ok {
+		return
+	}
+	var req models.CopyTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	template, err := h.services.Project.CopyTemplateToProject(c.Request.Context(), req.TemplateID, projectID)
+	if err != nil {
+		templateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, templateResponse(template))
+}
+
+// PreviewTemplate renders a template with the songs of a project
+// @Summary Preview template
+// @Description Render a template with the songs and variables of a project the way a build with the default parameters would. Content previews changes that are not stored yet. The ABC table of contents is not passed to zupfnoter.
+// @Tags templates
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param kind path string true "Template kind" Enums(toc, toc-html)
+// @Param request body models.TemplatePreviewRequest false "Template to preview"
+// @Success 200 {object} models.TemplatePreviewResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/v1/projects/{id}/templates/{kind}/preview [post]
+func (h *TemplateHandler) PreviewTemplate(c *gin.Context) {
+	projectID, ok := projectIDParam(c)
+	if !ok {
+		return
+	}
+	var req models.TemplatePreviewRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "Invalid request body",
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	preview, err := h.services.Project.PreviewTemplate(c.Request.Context(), core.TemplatePreviewRequest{
+		TemplateRef: core.TemplateRef{
+			Kind:      c.Param("kind"),
+			Source:    req.Source,
+			Name:      req.Name,
+			ProjectID: projectID,
+		},
+		Content: req.Content,
+	})
+	if err != nil {
+		templateError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, models.TemplatePreviewResponse{
+		Template:    templateResponse(preview.Template),
+		ContentType: preview.ContentType,
+		Content:     preview.Content,
+	})
+}
+
+// projectQueryParam parses the optional project query parameter, 0 if it is missing
+func projectQueryParam(c *gin.Context) (int, bool) {
+	value := c.Query("project")
+	if value == "" {
+		return 0, true
+	}
+	projectID, err := strconv.Atoi(value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid project ID",
+			Message: "Project ID must be a valid integer",
+		})
+		return 0, false
+	}
+	return projectID, true
+}
+
+// templateIDParam parses the shared template ID of the request path
+func templateIDParam(c *gin.Context) (int, bool) {
+	templateID, err := strconv.Atoi(c.Param("templateId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid template ID",
+			Message: "Template ID must be a valid integer",
+		})
+		return 0, false
+	}
+	return templateID, true
+}
+
+// bindTemplateRequest reads a shared template from the request body
+func bindTemplateRequest(c *gin.Context) (core.TemplateRequest, bool) {
+	var req models.TemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "Invalid request body",
+			Message: err.Error(),
+		})
+		return core.TemplateRequest{}, false
+	}
+	return core.TemplateRequest{
+		Name:        req.Name,
+		Kind:        req.Kind,
+		Description: req.Description,
+		Content:     req.Content,
+	}, true
+}
+
+// templateError writes the response for an error of a template operation
+func templateError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, core.ErrProjectNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "Project not found",
+			Message: "The specified project does not exist",
+		})
+		return
+	case errors.Is(err, core.ErrTemplateNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "Template not found",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if validationErr, ok := err.(core.ValidationErrors); ok {
+		details := make(map[string]string)
+		for _, ve := range validationErr {
+			details[ve.Field] = ve.Message
+		}
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation failed",
+			Message: err.Error(),
+			Details: details,
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+		Error:   "Failed to manage template",
+		Message: err.Error(),
+	})
+}
+
+// templateResponse converts a core template to its API response
+func templateResponse(template *core.Template) models.TemplateResponse {
+	return models.TemplateResponse{
+		ID:          template.ID,
+		Kind:        template.Kind,
+		Source:      template.Source,
+		Name:        template.Name,
+		Description: template.Description,
+		Path:        template.Path,
+		Origin:      template.Origin(),
+		Content:     template.Content,
+		UpdatedAt:   template.UpdatedAt,
+		Active:      template.Active,
+	}
+}
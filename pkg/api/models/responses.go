@@ -76,6 +76,8 @@ type ProjectResponse struct {
 	ShortName            string                 `json:"short_name" example:"my-project"`
 	Config               map[string]interface{} `json:"config,omitempty"`
 	AbcFileDirPreference string                 `json:"abc_file_dir_preference,omitempty" example:"/path/to/abc/files"`
+	Variables            map[string]string      `json:"variables,omitempty"`
+	ReservedNumbers      []int                  `json:"reserved_numbers,omitempty" example:"4,7"`
 } // @name ProjectResponse
 
 // ProjectListResponse represents a list of projects response
@@ -159,6 +161,8 @@ type ProjectSongResponse struct {
 	SongID     int              `json:"song_id" example:"1"`
 	Difficulty string           `json:"difficulty" example:"medium" enums:"easy,medium,hard,expert"`
 	Priority   int              `json:"priority" example:"1" minimum:"1" maximum:"4"`
+	Position   int              `json:"position" example:"3"`
+	Number     int              `json:"number" example:"12"`
 	Comment    *string          `json:"comment,omitempty" example:"Great song"`
 	Song       *SongResponse    `json:"song,omitempty"`
 	Project    *ProjectResponse `json:"project,omitempty"`
@@ -170,51 +174,440 @@ type ProjectSongsResponse struct {
 	Total        int                   `json:"total" example:"5"`
 } // @name ProjectSongsResponse
 
+// ResolvedSongConfigResponse represents the zupfnoter config a build passes for a song
+type ResolvedSongConfigResponse struct {
+	ProjectID     int                    `json:"project_id" example:"1"`
+	SongID        int                    `json:"song_id" example:"7"`
+	ProjectSongID int                    `json:"project_song_id" example:"12"`
+	Title         string                 `json:"title" example:"Amazing Grace"`
+	Filename      string                 `json:"filename" example:"amazing_grace.abc"`
+	Index         int                    `json:"index" example:"1"`
+	ABCPath       string                 `json:"abc_path" example:"/path/to/abc/amazing_grace.abc"`
+	ABCExists     bool                   `json:"abc_exists" example:"true"`
+	Variables     map[string]string      `json:"variables"`
+	Config        map[string]interface{} `json:"config"`
+	Origins       map[string]string      `json:"origins"`
+} // @name ResolvedSongConfigResponse
+
 // BuildProjectRequest represents a request to build a project
 type BuildProjectRequest struct {
 	OutputDir         *string `json:"output_dir,omitempty" example:"my-project"`
 	AbcFileDir        *string `json:"abc_file_dir,omitempty" example:"/path/to/abc/files"`
 	PriorityThreshold *int    `json:"priority_threshold,omitempty" example:"2" minimum:"1" maximum:"4"`
 	SampleID          *string `json:"sample_id,omitempty" example:"sample123"`
+	Incremental       *bool   `json:"incremental,omitempty" example:"true"`
+	DryRun            *bool   `json:"dry_run,omitempty" example:"false"`
+	SongOrder         *string `json:"song_order,omitempty" example:"manual" enums:"title,manual,priority,difficulty,genre"`
+	SupplementSince   *string `json:"supplement_since,omitempty" example:"2025-06-01"`
+	Profile           *string `json:"profile,omitempty" example:"Teilnehmer"`
+	Final             *bool   `json:"final,omitempty" example:"false"`
 } // @name BuildProjectRequest
 
 // BuildStatusResponse represents the status of a build operation
 type BuildStatusResponse struct {
-	Status      string `json:"status" example:"running" enums:"pending,running,completed,failed"`
-	Progress    int    `json:"progress" example:"75" minimum:"0" maximum:"100"`
-	Message     string `json:"message,omitempty" example:"Building songs..."`
-	StartedAt   string `json:"started_at,omitempty" example:"2025-08-17T18:00:00Z"`
-	CompletedAt string `json:"completed_at,omitempty" example:"2025-08-17T18:05:00Z"`
-	Error       string `json:"error,omitempty" example:"Build failed: file not found"`
+	Status        string `json:"status" example:"running" enums:"queued,running,completed,completed_with_errors,failed,cancelled,interrupted"`
+	Progress      int    `json:"progress" example:"75" minimum:"0" maximum:"100"`
+	QueuePosition int    `json:"queue_position,omitempty" example:"2"`
+	Message       string `json:"message,omitempty" example:"Building songs..."`
+	StartedAt     string `json:"started_at,omitempty" example:"2025-08-17T18:00:00Z"`
+	CompletedAt   string `json:"completed_at,omitempty" example:"2025-08-17T18:05:00Z"`
+	Error         string `json:"error,omitempty" example:"Build failed: file not found"`
 } // @name BuildStatusResponse
 
 // BuildResultResponse represents the result of a build operation
 type BuildResultResponse struct {
-	BuildID        string   `json:"build_id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	ProjectID      int      `json:"project_id" example:"1"`
-	Status         string   `json:"status" example:"completed" enums:"pending,running,completed,failed"`
-	OutputDir      string   `json:"output_dir" example:"my-project"`
-	GeneratedFiles []string `json:"generated_files,omitempty" example:"my-project/druckdateien,my-project/pdf"`
-	StartedAt      string   `json:"started_at" example:"2025-08-17T18:00:00Z"`
-	CompletedAt    string   `json:"completed_at,omitempty" example:"2025-08-17T18:05:00Z"`
-	Error          string   `json:"error,omitempty" example:"Build failed: file not found"`
+	BuildID        string                 `json:"build_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ProjectID      int                    `json:"project_id" example:"1"`
+	Status         string                 `json:"status" example:"completed" enums:"queued,running,completed,completed_with_errors,failed,cancelled,interrupted,dry_run"`
+	OutputDir      string                 `json:"output_dir" example:"my-project"`
+	BuildDir       string                 `json:"build_dir,omitempty" example:"builds/20250817-180000.000-550e8400-e29b-41d4-a716-446655440000"`
+	Profile        string                 `json:"profile,omitempty" example:"Teilnehmer"`
+	GeneratedFiles []string               `json:"generated_files,omitempty" example:"my-project/druckdateien,my-project/pdf"`
+	StartedAt      string                 `json:"started_at" example:"2025-08-17T18:00:00Z"`
+	CompletedAt    string                 `json:"completed_at,omitempty" example:"2025-08-17T18:05:00Z"`
+	Error          string                 `json:"error,omitempty" example:"Build failed: file not found"`
+	Report         *BuildReportResponse   `json:"report,omitempty"`
+	Manifest       *BuildManifestResponse `json:"manifest,omitempty"`
+	Plan           *BuildPlanResponse     `json:"plan,omitempty"`
 } // @name BuildResultResponse
 
+// BuildPlanResponse represents what a build would do, returned by dry runs
+type BuildPlanResponse struct {
+	ProjectID         int                        `json:"project_id" example:"1"`
+	ShortName         string                     `json:"short_name" example:"MBT-2025"`
+	OutputDir         string                     `json:"output_dir" example:"MBT-2025"`
+	AbcFileDir        string                     `json:"abc_file_dir" example:"/path/to/abc/files"`
+	PriorityThreshold int                        `json:"priority_threshold" example:"4"`
+	SampleID          string                     `json:"sample_id,omitempty" example:"sample123"`
+	SongOrder         string                     `json:"song_order" example:"title"`
+	SupplementSince   string                     `json:"supplement_since,omitempty" example:"2025-06-01T00:00:00+02:00"`
+	Profile           string                     `json:"profile,omitempty" example:"Teilnehmer"`
+	Final             bool                       `json:"final,omitempty" example:"false"`
+	ZupfnoterVersion  string                     `json:"zupfnoter_version" example:"V_1.15-1-g79f36737 (98c58af2)"`
+	FolderRouting     FolderRoutingResponse      `json:"folder_routing"`
+	Songs             []PlannedSongResponse      `json:"songs"`
+	TOC               PlannedTOCResponse         `json:"toc"`
+	MergedFiles       []PlannedMergeResponse     `json:"merged_files"`
+	Watermarks        []PlannedWatermarkResponse `json:"watermarks,omitempty"`
+	Editions          []PlannedEditionResponse   `json:"editions,omitempty"`
+	Warnings          []string                   `json:"warnings,omitempty" example:"01 Amazing Grace: ABC file /path/to/abc/files/amazing_grace.abc not found"`
+} // @name BuildPlanResponse
+
+// PlannedEditionResponse represents an edition of a build plan, its paths are relative to the directory of the edition
+type PlannedEditionResponse struct {
+	Name          string                     `json:"name" example:"teilnehmer"`
+	FolderRouting FolderRoutingResponse      `json:"folder_routing"`
+	Songs         []PlannedSongResponse      `json:"songs"`
+	TOC           PlannedTOCResponse         `json:"toc"`
+	MergedFiles   []PlannedMergeResponse     `json:"merged_files"`
+	Watermarks    []PlannedWatermarkResponse `json:"watermarks,omitempty"`
+} // @name PlannedEditionResponse
+
+// PlannedSongResponse represents a song of a build plan in build order
+type PlannedSongResponse struct {
+	Index         int                    `json:"index" example:"1"`
+	ProjectSongID int                    `json:"project_song_id" example:"12"`
+	SongID        int                    `json:"song_id" example:"7"`
+	Title         string                 `json:"title" example:"Amazing Grace"`
+	Filename      string                 `json:"filename" example:"amazing_grace.abc"`
+	Priority      int                    `json:"priority" example:"1"`
+	Copyright     string                 `json:"copyright,omitempty" example:"Public Domain"`
+	ABCPath       string                 `json:"abc_path" example:"/path/to/abc/files/amazing_grace.abc"`
+	ABCExists     bool                   `json:"abc_exists" example:"true"`
+	Config        map[string]interface{} `json:"config,omitempty"`
+	PDFs          []PlannedPDFResponse   `json:"pdfs"`
+	Warnings      []string               `json:"warnings,omitempty" example:"extract 5 has no title, zupfnoter skips it"`
+} // @name PlannedSongResponse
+
+// PlannedPDFResponse represents a PDF a build is expected to write
+type PlannedPDFResponse struct {
+	Path      string   `json:"path" example:"pdf/amazing_grace_-A1_a3.pdf"`
+	Extract   string   `json:"extract,omitempty" example:"0"`
+	Folder    string   `json:"folder,omitempty" example:"klein"`
+	Target    string   `json:"target,omitempty" example:"druckdateien/klein/01_amazing_grace_-A1_a3.pdf"`
+	Reference string   `json:"reference,omitempty" example:"referenz/Public Domain/amazing_grace_-A1_a3.pdf"`
+	Folders   []string `json:"folders,omitempty" example:"klein,archiv"`
+	Targets   []string `json:"targets,omitempty" example:"druckdateien/klein/01_amazing_grace_-A1_a3.pdf,druckdateien/archiv/01_amazing_grace_-A1_a3.pdf"`
+	Excluded  bool     `json:"excluded,omitempty" example:"false"`
+} // @name PlannedPDFResponse
+
+// FolderRoutingResponse represents the ordered folder rules of a project
+type FolderRoutingResponse struct {
+	Mode  string               `json:"mode" example:"first"`
+	Rules []FolderRuleResponse `json:"rules"`
+} // @name FolderRoutingResponse
+
+// FolderRuleResponse represents a rule routing print files into druckdateien folders
+type FolderRuleResponse struct {
+	Match   string   `json:"match,omitempty" example:"*_-A*_a3.pdf"`
+	Regex   string   `json:"regex,omitempty" example:"_-[BX]\\d+_a3\\.pdf$"`
+	Folders []string `json:"folders,omitempty" example:"klein"`
+	Exclude bool     `json:"exclude,omitempty" example:"false"`
+} // @name FolderRuleResponse
+
+// FolderRuleCheckResponse represents where the folder rules of a project route the PDFs of a build
+type FolderRuleCheckResponse struct {
+	ProjectID int                           `json:"project_id" example:"1"`
+	Build     BuildDiffBuildResponse        `json:"build"`
+	Routing   *FolderRoutingResponse        `json:"routing,omitempty"`
+	Editions  []FolderRuleEditionResponse   `json:"editions,omitempty"`
+	Files     []FolderRuleCheckFileResponse `json:"files"`
+	Unmatched int                           `json:"unmatched" example:"0"`
+	Excluded  int                           `json:"excluded" example:"2"`
+} // @name FolderRuleCheckResponse
+
+// FolderRuleEditionResponse represents the folder rules of an edition
+type FolderRuleEditionResponse struct {
+	Name    string                `json:"name" example:"teilnehmer"`
+	Routing FolderRoutingResponse `json:"routing"`
+} // @name FolderRuleEditionResponse
+
+// FolderRuleCheckFileResponse represents a PDF of a build with the folder rules it hits, rules are indices into the rules of its edition
+type FolderRuleCheckFileResponse struct {
+	Path        string   `json:"path" example:"pdf/amazing_grace_-A1_a3.pdf"`
+	Edition     string   `json:"edition,omitempty" example:"teilnehmer"`
+	BuildFolder string   `json:"build_folder,omitempty" example:"klein"`
+	Rules       []int    `json:"rules"`
+	Folders     []string `json:"folders"`
+	Excluded    bool     `json:"excluded,omitempty" example:"false"`
+} // @name FolderRuleCheckFileResponse
+
+// PlannedTOCResponse represents the table of contents of a build plan
+type PlannedTOCResponse struct {
+	Template     string               `json:"template" example:"MBT-2025/tpl/999_inhaltsverzeichnis_template.abc"`
+	HTMLTemplate string               `json:"html_template" example:"built-in"`
+	PageNumbers  bool                 `json:"page_numbers" example:"true"`
+	Registers    []string             `json:"registers,omitempty" example:"Register,Themenregister"`
+	PDFs         []PlannedPDFResponse `json:"pdfs"`
+	Warnings     []string             `json:"warnings,omitempty"`
+} // @name PlannedTOCResponse
+
+// PlannedMergeResponse represents a merged PDF of a build plan with its parts in order
+type PlannedMergeResponse struct {
+	Path   string   `json:"path" example:"druckdateien/MBT-2025_klein.pdf"`
+	Folder string   `json:"folder" example:"klein"`
+	Files  []string `json:"files" example:"druckdateien/klein/01_amazing_grace_-A1_a3.pdf"`
+} // @name PlannedMergeResponse
+
+// PlannedWatermarkResponse represents a watermark of a build plan, stamped
+// on the print files of its folders or of all folders
+type PlannedWatermarkResponse struct {
+	Name    string   `json:"name" example:"entwurf"`
+	Text    string   `json:"text,omitempty" example:"ENTWURF A"`
+	Image   string   `json:"image,omitempty" example:"MBT-2025/tpl/logo.png"`
+	Folders []string `json:"folders,omitempty" example:"klein,gross"`
+} // @name PlannedWatermarkResponse
+
+// BuildManifestResponse represents the files written by a build
+type BuildManifestResponse struct {
+	GeneratedAt string                 `json:"generated_at" example:"2025-08-17T18:05:00Z"`
+	FileCount   int                    `json:"file_count" example:"420"`
+	TotalSize   int64                  `json:"total_size" example:"73400320"`
+	Files       []ManifestFileResponse `json:"files"`
+} // @name BuildManifestResponse
+
+// ManifestFileResponse represents a single file of the build output
+type ManifestFileResponse struct {
+	Path      string `json:"path" example:"druckdateien/klein/01_amazing_grace_-M_a4.pdf"`
+	Size      int64  `json:"size" example:"48213"`
+	SHA256    string `json:"sha256" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	Pages     int    `json:"pages,omitempty" example:"2"`
+	SongIndex int    `json:"song_index,omitempty" example:"1"`
+	Song      string `json:"song,omitempty" example:"Amazing Grace"`
+	Folder    string `json:"folder,omitempty" example:"klein"`
+	Edition   string `json:"edition,omitempty" example:"teilnehmer"`
+} // @name ManifestFileResponse
+
+// BuildReportResponse represents the per-song results of a build
+type BuildReportResponse struct {
+	Total            int                  `json:"total" example:"80"`
+	Succeeded        int                  `json:"succeeded" example:"78"`
+	Failed           int                  `json:"failed" example:"2"`
+	Skipped          int                  `json:"skipped" example:"0"`
+	Cached           int                  `json:"cached" example:"75"`
+	ZupfnoterVersion string               `json:"zupfnoter_version,omitempty" example:"V_1.15-1-g79f36737 (98c58af2)"`
+	Songs            []SongReportResponse `json:"songs"`
+} // @name BuildReportResponse
+
+// SongReportResponse represents the result of building a single project song
+type SongReportResponse struct {
+	Index         int      `json:"index" example:"1"`
+	ProjectSongID int      `json:"project_song_id" example:"12"`
+	SongID        int      `json:"song_id" example:"7"`
+	Title         string   `json:"title" example:"Amazing Grace"`
+	Filename      string   `json:"filename" example:"amazing_grace.abc"`
+	Edition       string   `json:"edition,omitempty" example:"teilnehmer"`
+	Status        string   `json:"status" example:"completed" enums:"completed,failed,skipped"`
+	StartedAt     string   `json:"started_at,omitempty" example:"2025-08-17T18:00:05Z"`
+	DurationMs    int64    `json:"duration_ms" example:"5400"`
+	Stdout        string   `json:"stdout,omitempty"`
+	Stderr        string   `json:"stderr,omitempty"`
+	PDFs          []string `json:"pdfs,omitempty" example:"pdf/amazing_grace_-A_a3.pdf"`
+	HTMLWarnings  []string `json:"html_warnings,omitempty" example:"failed to convert HTML to PDF for Amazing Grace"`
+	Error         string   `json:"error,omitempty" example:"Zupfnoter failed for amazing_grace.abc: exit status 1"`
+	ABCHash       string   `json:"abc_hash,omitempty" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	ConfigHash    string   `json:"config_hash,omitempty" example:"60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"`
+	CacheKey      string   `json:"cache_key,omitempty" example:"fd61a03af4f77d870fc21e05e7e80678095c92d808cfb3b5c279ee04c74aca13"`
+	Cached        bool     `json:"cached,omitempty" example:"true"`
+} // @name SongReportResponse
+
 // BuildListResponse represents a list of build results
 type BuildListResponse struct {
 	Builds []BuildResultResponse `json:"builds"`
 	Total  int                   `json:"total" example:"3"`
 } // @name BuildListResponse
 
+// BuildEventResponse represents a single event of a build event stream
+type BuildEventResponse struct {
+	ID       int                  `json:"id" example:"12"`
+	BuildID  string               `json:"build_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Type     string               `json:"type" example:"song_completed" enums:"status,progress,song_started,song_completed,song_failed,result"`
+	Time     string               `json:"time" example:"2025-08-17T18:01:00Z"`
+	Status   string               `json:"status,omitempty" example:"running"`
+	Progress int                  `json:"progress,omitempty" example:"40" minimum:"0" maximum:"100"`
+	Message  string               `json:"message,omitempty" example:"Built song 3/80: Amazing Grace"`
+	Song     *BuildEventSong      `json:"song,omitempty"`
+	Error    string               `json:"error,omitempty" example:"Zupfnoter failed for song.abc"`
+	Result   *BuildResultResponse `json:"result,omitempty"`
+} // @name BuildEventResponse
+
+// BuildEventSong identifies the song of a song event
+type BuildEventSong struct {
+	Index    int    `json:"index" example:"3"`
+	Title    string `json:"title" example:"Amazing Grace"`
+	Filename string `json:"filename" example:"amazing_grace.abc"`
+	Edition  string `json:"edition,omitempty" example:"teilnehmer"`
+	Cached   bool   `json:"cached,omitempty" example:"false"`
+} // @name BuildEventSong
+
 // BuildDefaultsResponse represents default values for build configuration
 type BuildDefaultsResponse struct {
+	Profile           string `json:"profile,omitempty" example:"Probedruck"`
 	OutputDir         string `json:"output_dir" example:"my-project"`
 	AbcFileDir        string `json:"abc_file_dir" example:"/path/to/abc/files"`
 	PriorityThreshold int    `json:"priority_threshold" example:"4" minimum:"1" maximum:"4"`
 	SampleID          string `json:"sample_id" example:""`
 } // @name BuildDefaultsResponse
 
+// BuildProfileRequest represents a request to create or update a build profile
+type BuildProfileRequest struct {
+	Name              string                 `json:"name" binding:"required" example:"Teilnehmer"`
+	OutputDir         string                 `json:"output_dir,omitempty" example:"MBT-2025/teilnehmer"`
+	AbcFileDir        string                 `json:"abc_file_dir,omitempty" example:"/path/to/abc/files"`
+	PriorityThreshold int                    `json:"priority_threshold,omitempty" example:"2" minimum:"1" maximum:"4"`
+	SampleID          string                 `json:"sample_id,omitempty" example:"final"`
+	Config            map[string]interface{} `json:"config,omitempty"`
+	IsDefault         bool                   `json:"is_default,omitempty" example:"false"`
+} // @name BuildProfileRequest
+
+// BuildProfileResponse represents a build profile of a project
+type BuildProfileResponse struct {
+	ID                int                    `json:"id" example:"1"`
+	ProjectID         int                    `json:"project_id" example:"1"`
+	Name              string                 `json:"name" example:"Teilnehmer"`
+	OutputDir         string                 `json:"output_dir,omitempty" example:"MBT-2025/teilnehmer"`
+	AbcFileDir        string                 `json:"abc_file_dir,omitempty" example:"/path/to/abc/files"`
+	PriorityThreshold int                    `json:"priority_threshold,omitempty" example:"2"`
+	SampleID          string                 `json:"sample_id,omitempty" example:"final"`
+	Config            map[string]interface{} `json:"config,omitempty"`
+	IsDefault         bool                   `json:"is_default" example:"false"`
+} // @name BuildProfileResponse
+
+// BuildProfileListResponse represents the build profiles of a project
+type BuildProfileListResponse struct {
+	Profiles []BuildProfileResponse `json:"profiles"`
+	Count    int                    `json:"count" example:"3"`
+} // @name BuildProfileListResponse
+
 // UpdateAbcFileDirRequest represents a request to update ABC file directory preference
 type UpdateAbcFileDirRequest struct {
 	AbcFileDir string `json:"abc_file_dir" binding:"required" example:"/path/to/abc/files"`
 } // @name UpdateAbcFileDirRequest
+
+// UpdateProjectVariablesRequest replaces the user-defined template variables of a project
+type UpdateProjectVariablesRequest struct {
+	Variables map[string]string `json:"variables"`
+} // @name UpdateProjectVariablesRequest
+
+// ReorderProjectSongsRequest sets the manual order of the songs in a project
+type ReorderProjectSongsRequest struct {
+	SongIDs []int `json:"song_ids" binding:"required" example:"3,1,2"`
+} // @name ReorderProjectSongsRequest
+
+// RenumberProjectSongsRequest numbers all songs of a project from 1
+type RenumberProjectSongsRequest struct {
+	SongOrder *string `json:"song_order,omitempty" example:"title" enums:"title,manual,priority,difficulty,genre"`
+} // @name RenumberProjectSongsRequest
+
+// BuildDiffResponse represents the files and songs that changed between two builds
+type BuildDiffResponse struct {
+	ProjectID      int                    `json:"project_id" example:"1"`
+	From           BuildDiffBuildResponse `json:"from"`
+	To             BuildDiffBuildResponse `json:"to"`
+	FilesAdded     int                    `json:"files_added" example:"2"`
+	FilesRemoved   int                    `json:"files_removed" example:"1"`
+	FilesChanged   int                    `json:"files_changed" example:"4"`
+	FilesUnchanged int                    `json:"files_unchanged" example:"413"`
+	Files          []FileChangeResponse   `json:"files"`
+	Songs          []SongChangeResponse   `json:"songs"`
+} // @name BuildDiffResponse
+
+// BuildDiffBuildResponse identifies a build of a build diff
+type BuildDiffBuildResponse struct {
+	BuildID   string `json:"build_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	StartedAt string `json:"started_at" example:"2025-08-17T18:00:00Z"`
+	OutputDir string `json:"output_dir" example:"MBT-2025"`
+	BuildDir  string `json:"build_dir,omitempty" example:"builds/20250817-180000.000-550e8400-e29b-41d4-a716-446655440000"`
+} // @name BuildDiffBuildResponse
+
+// FileChangeResponse represents a file that was added, removed or changed between two builds
+type FileChangeResponse struct {
+	Path         string `json:"path" example:"druckdateien/klein/03_amazing_grace_-A1_a3.pdf"`
+	Change       string `json:"change" example:"changed" enums:"added,removed,changed"`
+	Edition      string `json:"edition,omitempty" example:"teilnehmer"`
+	Folder       string `json:"folder,omitempty" example:"klein"`
+	SongIndex    int    `json:"song_index,omitempty" example:"3"`
+	Song         string `json:"song,omitempty" example:"Amazing Grace"`
+	FromSHA256   string `json:"from_sha256,omitempty"`
+	ToSHA256     string `json:"to_sha256,omitempty"`
+	FromPages    int    `json:"from_pages,omitempty" example:"1"`
+	ToPages      int    `json:"to_pages,omitempty" example:"2"`
+	PagesChanged bool   `json:"pages_changed,omitempty" example:"true"`
+	MergedFile   string `json:"merged_file,omitempty" example:"druckdateien/MBT-2025_klein.pdf"`
+	FirstPage    int    `json:"first_page,omitempty" example:"5"`
+	LastPage     int    `json:"last_page,omitempty" example:"6"`
+} // @name FileChangeResponse
+
+// SongChangeResponse represents a song that changed between two builds
+type SongChangeResponse struct {
+	ProjectSongID int      `json:"project_song_id" example:"12"`
+	SongID        int      `json:"song_id" example:"7"`
+	Title         string   `json:"title" example:"Amazing Grace"`
+	Filename      string   `json:"filename" example:"amazing_grace.abc"`
+	Edition       string   `json:"edition,omitempty" example:"teilnehmer"`
+	FromIndex     int      `json:"from_index,omitempty" example:"3"`
+	ToIndex       int      `json:"to_index,omitempty" example:"4"`
+	Changes       []string `json:"changes" example:"renumbered,abc_changed" enums:"added,removed,renumbered,config_changed,abc_changed"`
+} // @name SongChangeResponse
+
+// TemplateResponse represents a template of the table of contents
+type TemplateResponse struct {
+	ID          int    `json:"id,omitempty" example:"3"`
+	Kind        string `json:"kind" example:"toc-html" enums:"toc,toc-html"`
+	Source      string `json:"source" example:"shared" enums:"project,shared,user,built-in"`
+	Name        string `json:"name,omitempty" example:"zweispaltig"`
+	Description string `json:"description,omitempty" example:"Zwei Spalten nach Genre"`
+	Path        string `json:"path,omitempty" example:"MBT-2025/tpl/999_inhaltsverzeichnis_template.html"`
+	Origin      string `json:"origin" example:"shared:zweispaltig"`
+	Content     string `json:"content,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty" example:"2025-08-17T18:00:00Z"`
+	Active      bool   `json:"active,omitempty" example:"true"`
+} // @name TemplateResponse
+
+// TemplateListResponse represents the templates of all sources
+type TemplateListResponse struct {
+	Templates []TemplateResponse `json:"templates"`
+	Count     int                `json:"count" example:"5"`
+} // @name TemplateListResponse
+
+// TemplateRequest represents a request to create or update a shared template
+type TemplateRequest struct {
+	Name        string `json:"name" binding:"required" example:"zweispaltig"`
+	Kind        string `json:"kind,omitempty" example:"toc-html" enums:"toc,toc-html"`
+	Description string `json:"description,omitempty" example:"Zwei Spalten nach Genre"`
+	Content     string `json:"content"`
+} // @name TemplateRequest
+
+// ProjectTemplateRequest represents a request to set the template of a project
+type ProjectTemplateRequest struct {
+	Content string `json:"content"`
+} // @name ProjectTemplateRequest
+
+// CopyTemplateRequest represents a request to copy a shared template into a project
+type CopyTemplateRequest struct {
+	TemplateID int `json:"template_id" binding:"required" example:"3"`
+} // @name CopyTemplateRequest
+
+// TemplatePreviewRequest represents a request to render a template with the songs of a project
+type TemplatePreviewRequest struct {
+	Source  string `json:"source,omitempty" example:"shared" enums:"project,shared,user,built-in"`
+	Name    string `json:"name,omitempty" example:"zweispaltig"`
+	Content string `json:"content,omitempty"`
+} // @name TemplatePreviewRequest
+
+// TemplatePreviewResponse represents a template rendered with the songs of a project
+type TemplatePreviewResponse struct {
+	Template    TemplateResponse `json:"template"`
+	ContentType string           `json:"content_type" example:"text/html"`
+	Content     string           `json:"content"`
+} // @name TemplatePreviewResponse
+
+// TemplateOrderRequest represents a request to set the server-wide template order
+type TemplateOrderRequest struct {
+	Order []string `json:"order" example:"shared,project,user"`
+} // @name TemplateOrderRequest
+
+// TemplateOrderResponse represents the order templates are looked up in
+type TemplateOrderResponse struct {
+	Order []string `json:"order" example:"project,shared,user"`
+} // @name TemplateOrderResponse
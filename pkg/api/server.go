@@ -33,6 +33,7 @@ type Server struct {
 	projectHandler     *handlers.ProjectHandler
 	songHandler        *handlers.SongHandler
 	projectSongHandler *handlers.ProjectSongHandler
+	templateHandler    *handlers.TemplateHandler
 
 	// Frontend serving
 	frontendPath string
@@ -78,6 +79,7 @@ func NewServer(services *core.Services, opts ...ServerOptions) *Server {
 		projectHandler:     handlers.NewProjectHandler(services),
 		songHandler:        handlers.NewSongHandler(services),
 		projectSongHandler: handlers.NewProjectSongHandler(services),
+		templateHandler:    handlers.NewTemplateHandler(services),
 		frontendPath:       frontendPath,
 		useEmbedded:        useEmbedded,
 		version:            version,
@@ -133,19 +135,52 @@ func (s *Server) RegisterRoutes() {
 			projects.PUT("/:id", s.projectHandler.UpdateProject)
 			projects.DELETE("/:id", s.projectHandler.DeleteProject)
 			projects.PUT("/:id/abc-file-dir", s.projectHandler.UpdateAbcFileDirPreference)
+			projects.PUT("/:id/variables", s.projectHandler.UpdateProjectVariables)
 
 			// Project-Song relationship endpoints
 			projects.GET("/:id/songs", s.projectSongHandler.ListProjectSongs)
+			projects.PUT("/:id/songs/order", s.projectSongHandler.ReorderProjectSongs)
+			projects.POST("/:id/songs/renumber", s.projectSongHandler.RenumberProjectSongs)
 			projects.POST("/:id/songs/:songId", s.projectSongHandler.AddSongToProject)
 			projects.PUT("/:id/songs/:songId", s.projectSongHandler.UpdateProjectSong)
 			projects.DELETE("/:id/songs/:songId", s.projectSongHandler.RemoveSongFromProject)
+			projects.GET("/:id/songs/:songId/resolved-config", s.projectSongHandler.GetResolvedSongConfig)
 			
 			// Project build endpoints
 			projects.POST("/:id/build", s.projectHandler.BuildProject)
 			projects.GET("/:id/build/defaults", s.projectHandler.GetBuildDefaults)
+			projects.GET("/:id/profiles", s.projectHandler.ListBuildProfiles)
+			projects.POST("/:id/profiles", s.projectHandler.CreateBuildProfile)
+			projects.GET("/:id/profiles/:profileId", s.projectHandler.GetBuildProfile)
+			projects.PUT("/:id/profiles/:profileId", s.projectHandler.UpdateBuildProfile)
+			projects.DELETE("/:id/profiles/:profileId", s.projectHandler.DeleteBuildProfile)
 			projects.GET("/:id/builds", s.projectHandler.ListBuilds)
 			projects.DELETE("/:id/builds", s.projectHandler.ClearBuildHistory)
+			projects.GET("/:id/builds/compare", s.projectHandler.CompareBuilds)
+			projects.GET("/:id/folder-rules/check", s.projectHandler.CheckFolderRules)
+			projects.GET("/:id/builds/:buildId", s.projectHandler.GetBuild)
 			projects.GET("/:id/builds/:buildId/status", s.projectHandler.GetBuildStatus)
+			projects.GET("/:id/builds/:buildId/events", s.projectHandler.StreamBuildEvents)
+			projects.DELETE("/:id/builds/:buildId", s.projectHandler.CancelBuild)
+			projects.GET("/:id/builds/:buildId/archive", s.projectHandler.DownloadBuildArchive)
+			projects.GET("/:id/builds/:buildId/files/*path", s.projectHandler.DownloadBuildFile)
+
+			// Project template endpoints
+			projects.POST("/:id/templates/copy", s.templateHandler.CopyTemplateToProject)
+			projects.PUT("/:id/templates/:kind", s.templateHandler.SetProjectTemplate)
+			projects.POST("/:id/templates/:kind/preview", s.templateHandler.PreviewTemplate)
+		}
+
+		// Template endpoints
+		templates := v1.Group("/templates")
+		{
+			templates.GET("", s.templateHandler.ListTemplates)
+			templates.GET("/order", s.templateHandler.GetTemplateOrder)
+			templates.PUT("/order", s.templateHandler.SetTemplateOrder)
+			templates.POST("/shared", s.templateHandler.CreateSharedTemplate)
+			templates.PUT("/shared/:templateId", s.templateHandler.UpdateSharedTemplate)
+			templates.DELETE("/shared/:templateId", s.templateHandler.DeleteSharedTemplate)
+			templates.GET("/:kind", s.templateHandler.GetTemplate)
 		}
 
 		// Song endpoints
@@ -0,0 +1,247 @@
+package core
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+)
+
+// Project config keys of the bookmarks of merged PDFs and of the sections
+// of a project
+const (
+	bookmarksConfigKey = "bookmarks"
+	sectionsConfigKey  = "sections"
+)
+
+// Groupings of the song bookmarks of a merged PDF: by the section a song
+// number falls into or by the genre of the song
+const (
+	BookmarkGroupSection = "section"
+	BookmarkGroupGenre   = "genre"
+)
+
+// BookmarkGroups are the valid groupings of song bookmarks
+var BookmarkGroups = []string{BookmarkGroupSection, BookmarkGroupGenre}
+
+// tocBookmarkTitle is the bookmark title of the table of contents
+const tocBookmarkTitle = "Inhaltsverzeichnis"
+
+// bookmarkSettings are the bookmark settings of a project, e.g.
+//
+//	"bookmarks": {"enabled": true, "groupBy": "section"}
+type bookmarkSettings struct {
+	Enabled  bool
+	GroupBy  string
+	Sections []songSection
+}
+
+// songSection is a section of a project, it starts with the song numbered
+// From and ends before the next section, e.g.
+//
+//	"sections": [{"title": "Advent", "from": 1}, {"title": "Weihnachten", "from": 20}]
+type songSection struct {
+	Title string
+	From  int
+}
+
+// projectBookmarks returns the bookmark settings of the project config.
+// Bookmarks are enabled and not grouped unless configured otherwise.
+func projectBookmarks(project *ent.Project) (bookmarkSettings, error) {
+	settings := bookmarkSettings{Enabled: true}
+
+	var errors ValidationErrors
+	config, _ := project.Config[bookmarksConfigKey].(map[string]interface{})
+	if enabled, ok := config["enabled"].(bool); ok {
+		settings.Enabled = enabled
+	}
+	if groupBy, _ := config["groupBy"].(string); groupBy != "" {
+		if containsString(BookmarkGroups, groupBy) {
+			settings.GroupBy = groupBy
+		} else {
+			errors = append(errors, ValidationError{
+				Field:   "config." + bookmarksConfigKey + ".groupBy",
+				Message: fmt.Sprintf("groupBy must be one of %s", strings.Join(BookmarkGroups, ", ")),
+			})
+		}
+	}
+
+	sections, err := projectSections(project)
+	if err != nil {
+		errors = append(errors, err.(ValidationErrors)...)
+	}
+	settings.Sections = sections
+
+	if errors.HasErrors() {
+		return settings, errors
+	}
+	return settings, nil
+}
+
+// projectSections returns the sections of the project config ordered by
+// the number of their first song
+func projectSections(project *ent.Project) ([]songSection, error) {
+	value, ok := project.Config[sectionsConfigKey]
+	if !ok {
+		return nil, nil
+	}
+	entries, ok := value.([]interface{})
+	if !ok {
+		return nil, ValidationErrors{{
+			Field:   "config." + sectionsConfigKey,
+			Message: "sections must be a list of objects with title and from",
+		}}
+	}
+
+	var errors ValidationErrors
+	sections := make([]songSection, 0, len(entries))
+	for i, entry := range entries {
+		field := fmt.Sprintf("config.%s[%d]", sectionsConfigKey, i)
+		section, _ := entry.(map[string]interface{})
+		title, _ := section["title"].(string)
+		from, _ := section["from"].(float64)
+		if strings.TrimSpace(title) == "" {
+			errors = append(errors, ValidationError{Field: field + ".title", Message: "title is required and cannot be empty"})
+			continue
+		}
+		if from < 1 || from != float64(int(from)) {
+			errors = append(errors, ValidationError{Field: field + ".from", Message: "from must be a song number of at least 1"})
+			continue
+		}
+		sections = append(sections, songSection{Title: title, From: int(from)})
+	}
+	if errors.HasErrors() {
+		return nil, errors
+	}
+
+	sort.SliceStable(sections, func(i, j int) bool {
+		return sections[i].From < sections[j].From
+	})
+	return sections, nil
+}
+
+// sectionTitle returns the title of the section the song number falls
+// into, "" for numbers before the first section
+func sectionTitle(sections []songSection, number int) string {
+	title := ""
+	for _, section := range sections {
+		if section.From > number {
+			break
+		}
+		title = section.Title
+	}
+	return title
+}
+
+// bookmarkGroup returns the title of the group of a song bookmark, "" if
+// the song is not grouped
+func (b bookmarkSettings) bookmarkGroup(ps *ent.ProjectSong) string {
+	switch b.GroupBy {
+	case BookmarkGroupSection:
+		return sectionTitle(b.Sections, ps.Number)
+	case BookmarkGroupGenre:
+		return strings.TrimSpace(ps.Edges.Song.Genre)
+	}
+	return ""
+}
+
+// addMergedPDFBookmarks replaces the bookmarks of the merged PDF dest with
+// a bookmark tree of the files it was merged from, in merge order
+func (s *projectService) addMergedPDFBookmarks(dest string, files []string, projectSongs []*ent.ProjectSong, settings bookmarkSettings) error {
+	pages := make([]int, len(files))
+	for i, file := range files {
+		count, err := api.PageCountFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to count pages of %s: %w", filepath.Base(file), err)
+		}
+		pages[i] = count
+	}
+
+	bookmarks := mergedPDFBookmarks(files, pages, projectSongs, settings)
+	if len(bookmarks) == 0 {
+		return nil
+	}
+	if err := api.AddBookmarksFile(dest, dest, bookmarks, true, nil); err != nil {
+		return fmt.Errorf("failed to add bookmarks: %w", err)
+	}
+	return nil
+}
+
+// mergedPDFBookmarks returns the bookmark tree of a PDF merged from files
+// with the given page counts. Files of the table of contents and of the
+// same song get one bookmark on their first page. Files carry the number of
+// their song as prefix, files without a song of the build are front matter
+// and named after the file. Song bookmarks are grouped if configured, a group
+// is listed where its first song starts.
+func mergedPDFBookmarks(files []string, pages []int, projectSongs []*ent.ProjectSong, settings bookmarkSettings) []pdfcpu.Bookmark {
+	songs := make(map[int]*ent.ProjectSong, len(projectSongs))
+	for _, ps := range projectSongs {
+		songs[ps.Number] = ps
+	}
+
+	var bookmarks []pdfcpu.Bookmark
+	groups := make(map[string]int) // group title -> index in bookmarks
+	lastKey := ""
+	page := 1
+	for i, file := range files {
+		filePage := page
+		page += pages[i]
+		if pages[i] == 0 {
+			continue
+		}
+
+		name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		key, title, group := "file:"+name, frontMatterTitle(name), ""
+		if strings.Contains(strings.ToLower(name), "inhaltsverzeichnis") {
+			key, title = "toc", tocBookmarkTitle
+		} else if alphabeticalRegister.ownsFile(name) {
+			key, title = "register", alphabeticalRegister.Title
+		} else if groupedRegister.ownsFile(name) {
+			key, title = "grouped-register", groupedRegister.Title
+		} else if prefix, _, found := strings.Cut(name, "_"); found {
+			if number, err := strconv.Atoi(prefix); err == nil && number > 0 && songs[number] != nil {
+				ps := songs[number]
+				key = fmt.Sprintf("song:%d", number)
+				title = fmt.Sprintf("%02d %s", number, ps.Edges.Song.Title)
+				group = settings.bookmarkGroup(ps)
+			}
+		}
+		// Further files of the same entry, e.g. other extracts of a song
+		if key == lastKey {
+			continue
+		}
+		lastKey = key
+
+		bookmark := pdfcpu.Bookmark{Title: title, PageFrom: filePage}
+		if group == "" {
+			bookmarks = append(bookmarks, bookmark)
+			continue
+		}
+		index, ok := groups[group]
+		if !ok {
+			index = len(bookmarks)
+			groups[group] = index
+			bookmarks = append(bookmarks, pdfcpu.Bookmark{Title: group, PageFrom: filePage, Bold: true})
+		}
+		bookmarks[index].Kids = append(bookmarks[index].Kids, bookmark)
+	}
+	return bookmarks
+}
+
+// frontMatterTitle returns the bookmark title of a file without a song,
+// its name without the number prefixes
+func frontMatterTitle(name string) string {
+	for {
+		prefix, rest, found := strings.Cut(name, "_")
+		if _, err := strconv.Atoi(prefix); !found || err != nil || rest == "" {
+			break
+		}
+		name = rest
+	}
+	return strings.TrimSpace(strings.ReplaceAll(name, "_", " "))
+}
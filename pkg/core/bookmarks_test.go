@@ -0,0 +1,119 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectBookmarks(t *testing.T) {
+	settings, err := projectBookmarks(&ent.Project{})
+	require.NoError(t, err)
+	assert.Equal(t, bookmarkSettings{Enabled: true}, settings)
+
+	settings, err = projectBookmarks(&ent.Project{Config: map[string]any{
+		"bookmarks": map[string]any{"enabled": false, "groupBy": "section"},
+		"sections": []any{
+			map[string]any{"title": "Weihnachten", "from": 20.0},
+			map[string]any{"title": "Advent", "from": 1.0},
+		},
+	}})
+	require.NoError(t, err)
+	assert.False(t, settings.Enabled)
+	assert.Equal(t, []songSection{{Title: "Advent", From: 1}, {Title: "Weihnachten", From: 20}}, settings.Sections)
+	assert.Equal(t, "Advent", sectionTitle(settings.Sections, 19))
+	assert.Equal(t, "Weihnachten", sectionTitle(settings.Sections, 20))
+	assert.Empty(t, sectionTitle([]songSection{{Title: "Advent", From: 5}}, 4))
+
+	_, err = projectBookmarks(&ent.Project{Config: map[string]any{
+		"bookmarks": map[string]any{"groupBy": "composer"},
+		"sections":  []any{map[string]any{"title": ""}, map[string]any{"title": "Advent", "from": 1.5}},
+	}})
+	require.IsType(t, ValidationErrors{}, err)
+	assert.Len(t, err.(ValidationErrors), 3)
+}
+
+func TestMergedPDFBookmarks(t *testing.T) {
+	song := func(number int, title, genre string) *ent.ProjectSong {
+		return &ent.ProjectSong{Number: number, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: title, Genre: genre}}}
+	}
+	songs := []*ent.ProjectSong{song(1, "Alpha", "Choral"), song(2, "Beta", ""), song(3, "Gamma", "Choral")}
+	files := []string{
+		"klein/00_00_inhaltsverzeichnis_-A3_a3.pdf",
+		"klein/00_00_inhaltsverzeichnis_noten.pdf",
+		"klein/00_vorwort.pdf",
+		"klein/01_alpha_-A1_a3.pdf",
+		"klein/01_alpha_-B1_a3.pdf",
+		"klein/02_beta_-A1_a3.pdf",
+		"klein/03_gamma_-A1_a3.pdf",
+	}
+	pages := []int{1, 1, 2, 1, 1, 2, 1}
+
+	assert.Equal(t, []pdfcpu.Bookmark{
+		{Title: "Inhaltsverzeichnis", PageFrom: 1},
+		{Title: "vorwort", PageFrom: 3},
+		{Title: "01 Alpha", PageFrom: 5},
+		{Title: "02 Beta", PageFrom: 7},
+		{Title: "03 Gamma", PageFrom: 9},
+	}, mergedPDFBookmarks(files, pages, songs, bookmarkSettings{Enabled: true}))
+
+	// A group is listed where its first song starts, songs without genre stay on top
+	bookmarks := mergedPDFBookmarks(files, pages, songs, bookmarkSettings{Enabled: true, GroupBy: BookmarkGroupGenre})
+	require.Len(t, bookmarks, 4)
+	assert.Equal(t, pdfcpu.Bookmark{Title: "Choral", PageFrom: 5, Bold: true, Kids: []pdfcpu.Bookmark{
+		{Title: "01 Alpha", PageFrom: 5},
+		{Title: "03 Gamma", PageFrom: 9},
+	}}, bookmarks[2])
+	assert.Equal(t, pdfcpu.Bookmark{Title: "02 Beta", PageFrom: 7}, bookmarks[3])
+
+	bookmarks = mergedPDFBookmarks(files, pages, songs, bookmarkSettings{
+		Enabled:  true,
+		GroupBy:  BookmarkGroupSection,
+		Sections: []songSection{{Title: "Teil 1", From: 1}, {Title: "Teil 2", From: 3}},
+	})
+	require.Len(t, bookmarks, 4)
+	assert.Equal(t, "Teil 1", bookmarks[2].Title)
+	assert.Len(t, bookmarks[2].Kids, 2)
+	assert.Equal(t, "Teil 2", bookmarks[3].Title)
+	assert.Equal(t, 9, bookmarks[3].PageFrom)
+}
+
+func TestProjectService_AddMergedPDFBookmarks(t *testing.T) {
+	dir := t.TempDir()
+	var files []string
+	for i, name := range []string{"00_00_inhaltsverzeichnis_noten.pdf", "01_alpha_-A1_a3.pdf", "02_beta_-A1_a3.pdf"} {
+		file := filepath.Join(dir, "klein", name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0755))
+		require.NoError(t, os.WriteFile(file, minimalPDF(i+1), 0644))
+		files = append(files, file)
+	}
+	songs := []*ent.ProjectSong{
+		{Number: 1, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Alpha"}}},
+		{Number: 2, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Beta"}}},
+	}
+
+	s := &projectService{}
+	dest := filepath.Join(dir, "TST_klein.pdf")
+	merged, err := s.mergePDFs(filepath.Join(dir, "klein"), dest)
+	require.NoError(t, err)
+	assert.Equal(t, files, merged)
+	require.NoError(t, s.addMergedPDFBookmarks(dest, merged, songs, bookmarkSettings{Enabled: true}))
+
+	f, err := os.Open(dest)
+	require.NoError(t, err)
+	defer f.Close()
+	bookmarks, err := api.Bookmarks(f, nil)
+	require.NoError(t, err)
+	require.Len(t, bookmarks, 3)
+	assert.Equal(t, "Inhaltsverzeichnis", bookmarks[0].Title)
+	assert.Equal(t, "01 Alpha", bookmarks[1].Title)
+	assert.Equal(t, 2, bookmarks[1].PageFrom)
+	assert.Equal(t, "02 Beta", bookmarks[2].Title)
+	assert.Equal(t, 4, bookmarks[2].PageFrom)
+}
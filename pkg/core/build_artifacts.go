@@ -0,0 +1,266 @@
+package core
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+)
+
+var (
+	ErrBuildOutputUnavailable = errors.New("build has no downloadable output")
+	ErrBuildOutputReplaced    = errors.New("build output has been replaced by a newer build")
+	ErrBuildOutputRemoved     = errors.New("build output has been removed by the build retention")
+	ErrInvalidArtifactFolder  = errors.New("invalid artifact folder")
+	ErrInvalidArtifactPath    = errors.New("invalid artifact path")
+	ErrArtifactNotFound       = errors.New("artifact not found")
+)
+
+// ArtifactFolders are the folders of a build output that can be downloaded
+var ArtifactFolders = []string{"druckdateien", "pdf", "abc", "referenz"}
+
+// BuildArtifacts gives access to the downloadable files of a finished build.
+// Only files listed in the manifest of the build are served.
+type BuildArtifacts struct {
+	BuildID   string
+	OutputDir string
+	BuildDir  string // relative to OutputDir, empty for builds without their own directory
+	files     []ManifestFile
+}
+
+// GetBuildArtifacts returns the artifacts of a finished build of a project. Every build
+// has its own directory, its artifacts are available until the build
+// retention removes it. Builds from before build directories wrote into the
+// output directory itself, their artifacts are only available as long as no
+// newer build has written to it.
+func (s *projectService) GetBuildArtifacts(ctx context.Context, projectID int, buildID string) (*BuildArtifacts, error) {
+	entBuild, err := s.getProjectBuild(ctx, projectID, buildID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch entBuild.Status {
+	case build.StatusCompleted, build.StatusCompletedWithErrors:
+	default:
+		return nil, ErrBuildOutputUnavailable
+	}
+	if entBuild.Manifest == nil {
+		return nil, ErrBuildOutputUnavailable
+	}
+
+	artifacts := &BuildArtifacts{
+		BuildID:   entBuild.BuildID,
+		OutputDir: entBuild.OutputDir,
+		BuildDir:  entBuild.BuildDir,
+		files:     entBuild.Manifest.Files,
+	}
+	if entBuild.BuildDir != "" {
+		if _, err := os.Stat(filepath.Join(artifacts.root(), ManifestFilename)); err != nil {
+			return nil, ErrBuildOutputRemoved
+		}
+		return artifacts, nil
+	}
+
+	// The manifest on disk is rewritten by every build
+	current, err := readManifest(entBuild.OutputDir)
+	if err != nil {
+		return nil, ErrBuildOutputReplaced
+	}
+	if current.GeneratedAt != entBuild.Manifest.GeneratedAt ||
+		current.FileCount != entBuild.Manifest.FileCount ||
+		current.TotalSize != entBuild.Manifest.TotalSize {
+		return nil, ErrBuildOutputReplaced
+	}
+
+	return artifacts, nil
+}
+
+// root returns the directory that holds the files of the build
+func (a *BuildArtifacts) root() string {
+	return filepath.Join(a.OutputDir, a.BuildDir)
+}
+
+// readManifest reads manifest.json from an output directory
+func readManifest(outputDir string) (*BuildManifest, error) {
+	data, err := os.ReadFile(filepath.Join(outputDir, ManifestFilename))
+	if err != nil {
+		return nil, err
+	}
+	var manifest BuildManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// ParseArtifactFolders validates the requested folders and returns them in
+// their canonical order without duplicates. No folders means all of them.
+func ParseArtifactFolders(folders []string) ([]string, error) {
+	if len(folders) == 0 {
+		return ArtifactFolders, nil
+	}
+
+	requested := make(map[string]bool)
+	for _, folder := range folders {
+		folder = strings.TrimSpace(folder)
+		if !isArtifactFolder(folder) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidArtifactFolder, folder)
+		}
+		requested[folder] = true
+	}
+
+	var result []string
+	for _, folder := range ArtifactFolders {
+		if requested[folder] {
+			result = append(result, folder)
+		}
+	}
+	return result, nil
+}
+
+// isArtifactFolder reports whether a folder may be downloaded
+func isArtifactFolder(folder string) bool {
+	return containsString(ArtifactFolders, folder)
+}
+
+// ArchiveName returns the file name of the ZIP archive of the build
+func (a *BuildArtifacts) ArchiveName() string {
+	name := filepath.Base(filepath.Clean(a.OutputDir))
+	buildID := a.BuildID
+	if len(buildID) > 8 {
+		buildID = buildID[:8]
+	}
+	return fmt.Sprintf("%s_%s.zip", name, buildID)
+}
+
+// WriteArchive writes the files of the given folders as ZIP archive to w.
+// The entries are placed below a directory named after the output directory.
+func (a *BuildArtifacts) WriteArchive(ctx context.Context, w io.Writer, folders []string) error {
+	folders, err := ParseArtifactFolders(folders)
+	if err != nil {
+		return err
+	}
+	root := strings.TrimSuffix(a.ArchiveName(), ".zip")
+
+	zw := zip.NewWriter(w)
+	for _, file := range a.files {
+		if !containsString(folders, a.folder(file.Path)) {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := a.addToArchive(zw, root, file.Path); err != nil {
+			return fmt.Errorf("failed to add %s to archive: %w", file.Path, err)
+		}
+	}
+	return zw.Close()
+}
+
+// addToArchive copies a single file into the archive
+func (a *BuildArtifacts) addToArchive(zw *zip.Writer, root, relPath string) error {
+	fullPath, err := a.FilePath(relPath)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(fullPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	header.Name = path.Join(root, relPath)
+	header.Method = zip.Deflate
+
+	entry, err := zw.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(entry, f)
+	return err
+}
+
+// FilePath resolves a path relative to the output directory, such as
+// druckdateien/<short>_klein.pdf or <edition>/druckdateien/<short>_klein.pdf,
+// to the file on disk. Only files of the build manifest within the artifact
+// folders are resolved.
+func (a *BuildArtifacts) FilePath(relPath string) (string, error) {
+	relPath = strings.TrimPrefix(filepath.ToSlash(relPath), "/")
+	if relPath == "" || strings.Contains(relPath, "\\") || path.Clean(relPath) != relPath {
+		return "", ErrInvalidArtifactPath
+	}
+	for _, part := range strings.Split(relPath, "/") {
+		if part == ".." {
+			return "", ErrInvalidArtifactPath
+		}
+	}
+	if !isArtifactFolder(a.folder(relPath)) {
+		return "", ErrInvalidArtifactPath
+	}
+
+	listed := false
+	for _, file := range a.files {
+		if file.Path == relPath {
+			listed = true
+			break
+		}
+	}
+	if !listed {
+		return "", ErrArtifactNotFound
+	}
+
+	// Symlinks must not lead out of the build directory
+	root, err := filepath.EvalSymlinks(a.root())
+	if err != nil {
+		return "", ErrArtifactNotFound
+	}
+	fullPath, err := filepath.EvalSymlinks(filepath.Join(root, filepath.FromSlash(relPath)))
+	if err != nil {
+		return "", ErrArtifactNotFound
+	}
+	if rel, err := filepath.Rel(root, fullPath); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", ErrInvalidArtifactPath
+	}
+
+	return fullPath, nil
+}
+
+// folder returns the top level folder of a path in the output directory of
+// the build. The directory of an edition does not count.
+func (a *BuildArtifacts) folder(relPath string) string {
+	folder, rest, _ := strings.Cut(relPath, "/")
+	for _, file := range a.files {
+		if file.Edition != "" && file.Edition == folder {
+			folder, _, _ = strings.Cut(rest, "/")
+			break
+		}
+	}
+	return folder
+}
+
+// containsString reports whether a slice contains a string
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
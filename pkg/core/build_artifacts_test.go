@@ -0,0 +1,156 @@
+package core
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseArtifactFolders(t *testing.T) {
+	folders, err := ParseArtifactFolders(nil)
+	require.NoError(t, err)
+	assert.Equal(t, ArtifactFolders, folders)
+
+	folders, err = ParseArtifactFolders([]string{"pdf", " druckdateien", "pdf"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"druckdateien", "pdf"}, folders)
+
+	_, err = ParseArtifactFolders([]string{"log"})
+	assert.ErrorIs(t, err, ErrInvalidArtifactFolder)
+	_, err = ParseArtifactFolders([]string{".."})
+	assert.ErrorIs(t, err, ErrInvalidArtifactFolder)
+}
+
+func TestProjectService_GetBuildArtifacts(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Artifacts Project",
+		ShortName: "ART",
+	})
+	require.NoError(t, err)
+
+	outputDir := filepath.Join(t.TempDir(), "ART")
+	files := map[string]string{
+		"druckdateien/ART_klein.pdf":            "merged",
+		"druckdateien/klein/01_song_-A1_a3.pdf": "song",
+		"pdf/song_-A1_a3.pdf":                   "pdf",
+		"abc/song.abc":                          "X:1\n",
+		"log/song.abc.err.log":                  "log",
+	}
+	for path, content := range files {
+		fullPath := filepath.Join(outputDir, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0755))
+		require.NoError(t, os.WriteFile(fullPath, []byte(content), 0644))
+	}
+	// A secret next to the output directory must never be served
+	require.NoError(t, os.WriteFile(filepath.Join(filepath.Dir(outputDir), "secret.txt"), []byte("secret"), 0644))
+
+	manifest, err := (&projectService{}).createManifest(&ent.Project{ShortName: "ART"}, outputDir, &BuildReport{})
+	require.NoError(t, err)
+
+	_, err = services.DB().Build.Create().
+		SetBuildID("abcdef12-3456").
+		SetProjectID(project.ID).
+		SetStatus("completed").
+		SetProgress(100).
+		SetOutputDir(outputDir).
+		SetManifest(manifest).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = services.DB().Build.Create().
+		SetBuildID("running-build").
+		SetProjectID(project.ID).
+		SetStatus("running").
+		SetOutputDir(outputDir).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = services.Project.GetBuildArtifacts(ctx, project.ID, "unknown-build")
+	assert.Equal(t, ErrBuildNotFound, err)
+	_, err = services.Project.GetBuildArtifacts(ctx, project.ID, "running-build")
+	assert.Equal(t, ErrBuildOutputUnavailable, err)
+	// Builds are only found in their own project
+	_, err = services.Project.GetBuildArtifacts(ctx, project.ID+1, "abcdef12-3456")
+	assert.Equal(t, ErrBuildNotFound, err)
+
+	artifacts, err := services.Project.GetBuildArtifacts(ctx, project.ID, "abcdef12-3456")
+	require.NoError(t, err)
+	assert.Equal(t, "ART_abcdef12.zip", artifacts.ArchiveName())
+
+	t.Run("archive", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, artifacts.WriteArchive(ctx, &buf, []string{"druckdateien", "abc"}))
+
+		reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+		var names []string
+		for _, file := range reader.File {
+			names = append(names, file.Name)
+		}
+		sort.Strings(names)
+		assert.Equal(t, []string{
+			"ART_abcdef12/abc/song.abc",
+			"ART_abcdef12/druckdateien/ART_klein.pdf",
+			"ART_abcdef12/druckdateien/klein/01_song_-A1_a3.pdf",
+		}, names)
+
+		assert.ErrorIs(t, artifacts.WriteArchive(ctx, &buf, []string{"log"}), ErrInvalidArtifactFolder)
+	})
+
+	t.Run("single file", func(t *testing.T) {
+		path, err := artifacts.FilePath("/druckdateien/ART_klein.pdf")
+		require.NoError(t, err)
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "merged", string(content))
+
+		for _, invalid := range []string{
+			"../secret.txt",
+			"druckdateien/../../secret.txt",
+			"druckdateien/./ART_klein.pdf",
+			"druckdateien\\..\\..\\secret.txt",
+			"log/song.abc.err.log",
+			"manifest.json",
+			"",
+		} {
+			_, err := artifacts.FilePath(invalid)
+			assert.Equal(t, ErrInvalidArtifactPath, err, invalid)
+		}
+
+		_, err = artifacts.FilePath("druckdateien/ART_gross.pdf")
+		assert.Equal(t, ErrArtifactNotFound, err)
+	})
+
+	t.Run("symlink", func(t *testing.T) {
+		link := filepath.Join(outputDir, "pdf", "link.pdf")
+		require.NoError(t, os.Symlink(filepath.Join(filepath.Dir(outputDir), "secret.txt"), link))
+		defer os.Remove(link)
+
+		linked := &BuildArtifacts{
+			BuildID:   artifacts.BuildID,
+			OutputDir: outputDir,
+			files:     append(artifacts.files, ManifestFile{Path: "pdf/link.pdf"}),
+		}
+		_, err := linked.FilePath("pdf/link.pdf")
+		assert.Equal(t, ErrInvalidArtifactPath, err)
+	})
+
+	t.Run("replaced by a newer build", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(outputDir, ManifestFilename)))
+		_, err := services.Project.GetBuildArtifacts(ctx, project.ID, "abcdef12-3456")
+		assert.Equal(t, ErrBuildOutputReplaced, err)
+	})
+}
@@ -0,0 +1,174 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/zupfnoter"
+)
+
+// songCacheDir is the directory below the output directory that keeps the
+// zupfnoter output of every song for incremental builds
+const songCacheDir = ".cache"
+
+// songCacheMaxAge is how long a cache entry no build refers to is kept.
+// Builds with a priority threshold, supplements and profiles refer to a part
+// of the entries only, the others stay for the next full build.
+const songCacheMaxAge = 30 * 24 * time.Hour
+
+// songCache stores the zupfnoter output of songs, keyed by everything that
+// goes into rendering them. Every song is rendered into its cache entry first
+// and copied into the build output from there, so builds that are not
+// incremental fill the cache as well. Incremental builds skip zupfnoter for
+// songs that already have an entry, every build skips it for entries it
+// rendered itself, e.g. for an earlier edition.
+type songCache struct {
+	dir         string
+	incremental bool
+	version     string
+
+	mu       sync.Mutex
+	used     map[string]bool
+	rendered map[string]bool
+}
+
+// newSongCache creates the render cache of an output directory
+func newSongCache(outputDir string, incremental bool) *songCache {
+	return &songCache{
+		dir:         filepath.Join(outputDir, songCacheDir, "songs"),
+		incremental: incremental,
+		version:     zupfnoter.Version(),
+		used:        make(map[string]bool),
+		rendered:    make(map[string]bool),
+	}
+}
+
+// songCacheKey hashes the ABC file and the final zupfnoter config of a song.
+// The key combines both hashes with the zupfnoter version.
+func (c *songCache) songCacheKey(abcFile []byte, config map[string]any) (abcHash, configHash, key string, err error) {
+	// Map keys are marshalled in sorted order, equal configs hash equally
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	abcHash = sha256Hex(abcFile)
+	configHash = sha256Hex(configJSON)
+	key = sha256Hex([]byte(strings.Join([]string{abcHash, configHash, c.version}, "\n")))
+	return abcHash, configHash, key, nil
+}
+
+// sha256Hex returns the hex encoded SHA-256 hash of data
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// lookup returns the directory of a cache entry and whether the build may
+// reuse it. It records that the build refers to the entry.
+func (c *songCache) lookup(key string) (string, bool) {
+	c.mu.Lock()
+	c.used[key] = true
+	rendered := c.rendered[key]
+	c.mu.Unlock()
+
+	entry := filepath.Join(c.dir, key)
+	if !c.incremental && !rendered {
+		return entry, false
+	}
+	info, err := os.Stat(entry)
+	if err != nil || !info.IsDir() {
+		return entry, false
+	}
+	// The modification time tells prune when a build referred to the entry last
+	now := time.Now()
+	if err := os.Chtimes(entry, now, now); err != nil {
+		slog.Warn("failed to touch cache entry", "entry", key, "error", err)
+	}
+	return entry, true
+}
+
+// stage returns an empty directory to render a song into. commit turns it
+// into the cache entry of the key.
+func (c *songCache) stage(key string) (string, error) {
+	staging := filepath.Join(c.dir, key+".partial")
+	if err := os.RemoveAll(staging); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(staging, 0755); err != nil {
+		return "", err
+	}
+	return staging, nil
+}
+
+// commit replaces the cache entry of a key with a staging directory
+func (c *songCache) commit(key, staging string) (string, error) {
+	entry := filepath.Join(c.dir, key)
+	if err := os.RemoveAll(entry); err != nil {
+		return "", err
+	}
+	if err := os.Rename(staging, entry); err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	c.rendered[key] = true
+	c.mu.Unlock()
+	return entry, nil
+}
+
+// prune removes the entries no build referred to for songCacheMaxAge, e.g.
+// those of songs that changed or left the project. Entries the current
+// build did not refer to stay until then.
+func (c *songCache) prune() {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, entry := range entries {
+		if c.used[entry.Name()] {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < songCacheMaxAge {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(c.dir, entry.Name())); err != nil {
+			slog.Warn("failed to remove cache entry", "entry", entry.Name(), "error", err)
+		}
+	}
+}
+
+// restoreSongOutput copies the zupfnoter output of a cache entry into the build output.
+// PDFs go to pdf, the error logs of zupfnoter go to log.
+func (s *projectService) restoreSongOutput(entry, outputDir string) error {
+	files, err := os.ReadDir(entry)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		targetDir := "pdf"
+		if strings.HasSuffix(file.Name(), ".err.log") {
+			targetDir = "log"
+		}
+		if err := s.copyFile(filepath.Join(entry, file.Name()), filepath.Join(outputDir, targetDir, file.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
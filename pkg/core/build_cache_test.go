@@ -0,0 +1,130 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSongCache_Key(t *testing.T) {
+	cache := newSongCache(t.TempDir(), true)
+	config := map[string]any{"produce": []any{1, 2}, "extract": map[string]any{"0": "a", "1": "b"}}
+
+	abcHash, configHash, key, err := cache.songCacheKey([]byte("X:1\n"), config)
+	require.NoError(t, err)
+
+	_, _, same, err := cache.songCacheKey([]byte("X:1\n"), map[string]any{"extract": map[string]any{"1": "b", "0": "a"}, "produce": []any{1, 2}})
+	require.NoError(t, err)
+	assert.Equal(t, key, same)
+
+	otherABC, otherConfig, changed, err := cache.songCacheKey([]byte("X:2\n"), config)
+	require.NoError(t, err)
+	assert.NotEqual(t, abcHash, otherABC)
+	assert.Equal(t, configHash, otherConfig)
+	assert.NotEqual(t, key, changed)
+
+	// A new zupfnoter version invalidates all entries
+	cache.version = "V_2.0"
+	_, _, newVersion, err := cache.songCacheKey([]byte("X:1\n"), config)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, newVersion)
+}
+
+func TestProjectService_IncrementalBuild(t *testing.T) {
+	// Zupfnoter does not write anything in debug mode, the test fills the cache itself
+	t.Setenv("ZUPFNOTER_DEBUG", "1")
+
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Incremental Project",
+		ShortName: "INC",
+	})
+	require.NoError(t, err)
+
+	abcDir := t.TempDir()
+	outputDir := filepath.Join(t.TempDir(), "INC")
+	priority := 1
+	for _, name := range []string{"alpha", "beta"} {
+		require.NoError(t, os.WriteFile(filepath.Join(abcDir, name+".abc"), []byte("X:1\nT:"+name+"\n"), 0644))
+		song, err := services.DB().Song.Create().SetTitle(name).SetFilename(name + ".abc").Save(ctx)
+		require.NoError(t, err)
+		_, err = services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+			ProjectID: project.ID,
+			SongID:    song.ID,
+			Priority:  &priority,
+		})
+		require.NoError(t, err)
+	}
+
+	build := func(incremental bool) *BuildReport {
+		t.Helper()
+		result, err := services.Project.RunProjectBuild(ctx, BuildProjectRequest{
+			ProjectID:   project.ID,
+			AbcFileDir:  abcDir,
+			OutputDir:   outputDir,
+			Incremental: incremental,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "completed", result.Status, result.Error)
+		return result.Report
+	}
+
+	// A full build fills the cache but does not use it
+	report := build(false)
+	assert.Equal(t, 0, report.Cached)
+	assert.NotEmpty(t, report.ZupfnoterVersion)
+	alpha, beta := report.Songs[0], report.Songs[1]
+	require.Equal(t, "alpha", alpha.Title)
+	assert.Len(t, alpha.ABCHash, 64)
+	assert.Len(t, alpha.ConfigHash, 64)
+	assert.DirExists(t, filepath.Join(outputDir, songCacheDir, "songs", alpha.CacheKey))
+
+	// Pretend zupfnoter rendered a PDF for alpha
+	pdfName := "alpha_-A1_a3.pdf"
+	require.NoError(t, os.WriteFile(filepath.Join(outputDir, songCacheDir, "songs", alpha.CacheKey, pdfName), minimalPDF(1), 0644))
+
+	report = build(true)
+	assert.Equal(t, 2, report.Cached)
+	assert.True(t, report.Songs[0].Cached)
+	assert.Equal(t, []string{"pdf/" + pdfName}, report.Songs[0].PDFs)
+	assert.FileExists(t, filepath.Join(outputDir, LatestBuildLink, "druckdateien", "klein", "01_"+pdfName))
+
+	// A changed ABC file is rendered again, its old entry is removed once it is old
+	require.NoError(t, os.WriteFile(filepath.Join(abcDir, "beta.abc"), []byte("X:1\nT:beta\nK:G\n"), 0644))
+	report = build(true)
+	assert.Equal(t, 1, report.Cached)
+	assert.True(t, report.Songs[0].Cached)
+	assert.False(t, report.Songs[1].Cached)
+	assert.NotEqual(t, beta.CacheKey, report.Songs[1].CacheKey)
+	assert.Equal(t, beta.ConfigHash, report.Songs[1].ConfigHash)
+	assert.DirExists(t, filepath.Join(outputDir, songCacheDir, "songs", report.Songs[1].CacheKey))
+	oldBeta := filepath.Join(outputDir, songCacheDir, "songs", beta.CacheKey)
+	assert.DirExists(t, oldBeta)
+	old := time.Now().Add(-songCacheMaxAge - time.Hour)
+	require.NoError(t, os.Chtimes(oldBeta, old, old))
+
+	// Builds of a part of the songs keep the entries of the others
+	newBeta := report.Songs[1].CacheKey
+	require.NoError(t, os.Chtimes(filepath.Join(outputDir, songCacheDir, "songs", alpha.CacheKey), old, old))
+	require.NoError(t, services.DB().ProjectSong.Update().SetPriority(2).Where(projectsong.SongID(report.Songs[1].SongID)).Exec(ctx))
+	result, err := services.Project.RunProjectBuild(ctx, BuildProjectRequest{
+		ProjectID: project.ID, AbcFileDir: abcDir, OutputDir: outputDir, Incremental: true, PriorityThreshold: 1,
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.Report.Songs, 1)
+	assert.NoDirExists(t, oldBeta)
+	assert.DirExists(t, filepath.Join(outputDir, songCacheDir, "songs", newBeta))
+	// Reusing an entry makes it recent again
+	info, err := os.Stat(filepath.Join(outputDir, songCacheDir, "songs", alpha.CacheKey))
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), info.ModTime(), time.Minute)
+}
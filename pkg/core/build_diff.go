@@ -0,0 +1,375 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+)
+
+// Changes of a file between two builds
+const (
+	FileAdded   = "added"
+	FileRemoved = "removed"
+	FileChanged = "changed"
+)
+
+// Changes of a song between two builds
+const (
+	SongAdded         = "added"
+	SongRemoved       = "removed"
+	SongRenumbered    = "renumbered"
+	SongConfigChanged = "config_changed"
+	SongABCChanged    = "abc_changed"
+)
+
+// BuildDiff lists the files and songs that changed between two builds of a
+// project. Unchanged files and songs are only counted.
+type BuildDiff struct {
+	ProjectID      int            `json:"project_id"`
+	From           BuildDiffBuild `json:"from"`
+	To             BuildDiffBuild `json:"to"`
+	FilesAdded     int            `json:"files_added"`
+	FilesRemoved   int            `json:"files_removed"`
+	FilesChanged   int            `json:"files_changed"`
+	FilesUnchanged int            `json:"files_unchanged"`
+	Files          []FileChange   `json:"files"`
+	Songs          []SongChange   `json:"songs"`
+}
+
+// BuildDiffBuild identifies a build of a diff
+type BuildDiffBuild struct {
+	BuildID   string `json:"build_id"`
+	StartedAt string `json:"started_at"`
+	OutputDir string `json:"output_dir"`
+	BuildDir  string `json:"build_dir,omitempty"`
+}
+
+// FileChange is a file of the build output that was added, removed or whose
+// content changed. Print files in the druckdateien folders carry the pages
+// they take in the merged PDF of their folder in the newer build.
+type FileChange struct {
+	Path       string `json:"path"`
+	Change     string `json:"change"`
+	Edition    string `json:"edition,omitempty"`
+	Folder     string `json:"folder,omitempty"`
+	SongIndex  int    `json:"song_index,omitempty"`
+	Song       string `json:"song,omitempty"`
+	FromSHA256 string `json:"from_sha256,omitempty"`
+	ToSHA256   string `json:"to_sha256,omitempty"`
+	FromPages  int    `json:"from_pages,omitempty"`
+	ToPages    int    `json:"to_pages,omitempty"`
+	MergedFile string `json:"merged_file,omitempty"`
+	FirstPage  int    `json:"first_page,omitempty"`
+	LastPage   int    `json:"last_page,omitempty"`
+}
+
+// PagesChanged reports whether the page count of a changed file differs
+func (f FileChange) PagesChanged() bool {
+	return f.Change == FileChanged && f.FromPages != f.ToPages
+}
+
+// SongChange is a song that was added, removed, renumbered or whose ABC file
+// or config changed between two builds
+type SongChange struct {
+	ProjectSongID int      `json:"project_song_id"`
+	SongID        int      `json:"song_id"`
+	Title         string   `json:"title"`
+	Filename      string   `json:"filename"`
+	Edition       string   `json:"edition,omitempty"`
+	FromIndex     int      `json:"from_index,omitempty"`
+	ToIndex       int      `json:"to_index,omitempty"`
+	Changes       []string `json:"changes"`
+}
+
+// CompareBuilds compares two finished builds of a project by their manifests
+// and reports. Without toBuildID the latest finished build is compared. The
+// output of the builds does not need to exist anymore.
+func (s *projectService) CompareBuilds(ctx context.Context, projectID int, fromBuildID, toBuildID string) (*BuildDiff, error) {
+	from, err := s.getComparableBuild(ctx, projectID, fromBuildID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getComparableBuild(ctx, projectID, toBuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &BuildDiff{
+		ProjectID: projectID,
+		From:      buildDiffBuild(from),
+		To:        buildDiffBuild(to),
+		Files:     diffManifests(from.Manifest, to.Manifest),
+		Songs:     diffReports(from.Report, to.Report),
+	}
+	for _, file := range diff.Files {
+		switch file.Change {
+		case FileAdded:
+			diff.FilesAdded++
+		case FileRemoved:
+			diff.FilesRemoved++
+		case FileChanged:
+			diff.FilesChanged++
+		}
+	}
+	diff.FilesUnchanged = len(to.Manifest.Files) - diff.FilesAdded - diff.FilesChanged
+	return diff, nil
+}
+
+// getComparableBuild returns a finished build of a project with its manifest,
+// the latest one without buildID
+func (s *projectService) getComparableBuild(ctx context.Context, projectID int, buildID string) (*ent.Build, error) {
+	if buildID == "" {
+		entBuild, err := s.db.Build.Query().
+			Where(
+				build.ProjectID(projectID),
+				build.StatusIn(build.StatusCompleted, build.StatusCompletedWithErrors),
+				build.ManifestNotNil(),
+			).
+			Order(ent.Desc(build.FieldStartedAt), ent.Desc(build.FieldID)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, ErrBuildNotFound
+		}
+		return entBuild, err
+	}
+
+	entBuild, err := s.getBuild(ctx, buildID)
+	if err != nil {
+		return nil, err
+	}
+	if entBuild.ProjectID != projectID {
+		return nil, ErrBuildNotFound
+	}
+	if entBuild.Manifest == nil {
+		return nil, ErrBuildOutputUnavailable
+	}
+	return entBuild, nil
+}
+
+// buildDiffBuild identifies a build in a diff
+func buildDiffBuild(entBuild *ent.Build) BuildDiffBuild {
+	return BuildDiffBuild{
+		BuildID:   entBuild.BuildID,
+		StartedAt: entBuild.StartedAt.Format(time.RFC3339),
+		OutputDir: entBuild.OutputDir,
+		BuildDir:  entBuild.BuildDir,
+	}
+}
+
+// diffManifests compares the files of two builds by path and content hash
+func diffManifests(from, to *BuildManifest) []FileChange {
+	fromFiles := make(map[string]ManifestFile, len(from.Files))
+	for _, file := range from.Files {
+		fromFiles[file.Path] = file
+	}
+	pages := mergedPages(to)
+
+	changes := []FileChange{}
+	for _, file := range to.Files {
+		change := FileChange{
+			Path:      file.Path,
+			Edition:   file.Edition,
+			Folder:    file.Folder,
+			SongIndex: file.SongIndex,
+			Song:      file.Song,
+			ToSHA256:  file.SHA256,
+			ToPages:   file.Pages,
+		}
+		old, found := fromFiles[file.Path]
+		delete(fromFiles, file.Path)
+		switch {
+		case !found:
+			change.Change = FileAdded
+		case old.SHA256 != file.SHA256:
+			change.Change = FileChanged
+			change.FromSHA256, change.FromPages = old.SHA256, old.Pages
+		default:
+			continue
+		}
+		if merged, ok := pages[file.Path]; ok {
+			change.MergedFile, change.FirstPage, change.LastPage = merged.file, merged.first, merged.last
+		}
+		changes = append(changes, change)
+	}
+	for _, file := range fromFiles {
+		changes = append(changes, FileChange{
+			Path:       file.Path,
+			Change:     FileRemoved,
+			Edition:    file.Edition,
+			Folder:     file.Folder,
+			SongIndex:  file.SongIndex,
+			Song:       file.Song,
+			FromSHA256: file.SHA256,
+			FromPages:  file.Pages,
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes
+}
+
+// mergedPage is the page range of a print file in the merged PDF of its folder
+type mergedPage struct {
+	file        string
+	first, last int
+}
+
+// mergedPages determines the pages every print file takes in the merged PDF
+// of its druckdateien folder. The files of a folder are merged in path order.
+func mergedPages(manifest *BuildManifest) map[string]mergedPage {
+	mergedFiles := make(map[string]string)
+	folderFiles := make(map[string][]ManifestFile)
+	for _, file := range manifest.Files {
+		dir, name := path.Split(file.Path)
+		if !strings.EqualFold(path.Ext(name), ".pdf") || file.Folder == "" {
+			continue
+		}
+		switch strings.TrimSuffix(strings.TrimPrefix(dir, file.Edition+"/"), "/") {
+		case "druckdateien":
+			mergedFiles[path.Join(dir, file.Folder)] = file.Path
+		case path.Join("druckdateien", file.Folder):
+			folderFiles[strings.TrimSuffix(dir, "/")] = append(folderFiles[strings.TrimSuffix(dir, "/")], file)
+		}
+	}
+
+	pages := make(map[string]mergedPage)
+	for dir, files := range folderFiles {
+		merged, ok := mergedFiles[dir]
+		if !ok {
+			continue
+		}
+		sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+		next := 1
+		for _, file := range files {
+			if file.Pages == 0 {
+				continue
+			}
+			pages[file.Path] = mergedPage{file: merged, first: next, last: next + file.Pages - 1}
+			next += file.Pages
+		}
+	}
+	return pages
+}
+
+// diffReports compares the songs of two builds by project song and edition
+func diffReports(from, to *BuildReport) []SongChange {
+	if from == nil || to == nil {
+		return []SongChange{}
+	}
+	songKey := func(song SongReport) string {
+		return fmt.Sprintf("%s/%d", song.Edition, song.ProjectSongID)
+	}
+	fromSongs := make(map[string]SongReport, len(from.Songs))
+	for _, song := range from.Songs {
+		fromSongs[songKey(song)] = song
+	}
+
+	changes := []SongChange{}
+	for _, song := range to.Songs {
+		change := SongChange{
+			ProjectSongID: song.ProjectSongID,
+			SongID:        song.SongID,
+			Title:         song.Title,
+			Filename:      song.Filename,
+			Edition:       song.Edition,
+			ToIndex:       song.Index,
+			Changes:       []string{},
+		}
+		old, found := fromSongs[songKey(song)]
+		delete(fromSongs, songKey(song))
+		if !found {
+			change.Changes = append(change.Changes, SongAdded)
+		} else {
+			change.FromIndex = old.Index
+			if old.Index != song.Index {
+				change.Changes = append(change.Changes, SongRenumbered)
+			}
+			// Songs that were not built have no hashes to compare
+			if old.ConfigHash != "" && song.ConfigHash != "" && old.ConfigHash != song.ConfigHash {
+				change.Changes = append(change.Changes, SongConfigChanged)
+			}
+			if old.ABCHash != "" && song.ABCHash != "" && old.ABCHash != song.ABCHash {
+				change.Changes = append(change.Changes, SongABCChanged)
+			}
+		}
+		if len(change.Changes) > 0 {
+			changes = append(changes, change)
+		}
+	}
+	for _, song := range fromSongs {
+		changes = append(changes, SongChange{
+			ProjectSongID: song.ProjectSongID,
+			SongID:        song.SongID,
+			Title:         song.Title,
+			Filename:      song.Filename,
+			Edition:       song.Edition,
+			FromIndex:     song.Index,
+			Changes:       []string{SongRemoved},
+		})
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Edition != changes[j].Edition {
+			return changes[i].Edition < changes[j].Edition
+		}
+		return songDiffIndex(changes[i]) < songDiffIndex(changes[j])
+	})
+	return changes
+}
+
+// songDiffIndex is the position of a changed song, removed songs keep their old one
+func songDiffIndex(change SongChange) int {
+	if change.ToIndex != 0 {
+		return change.ToIndex
+	}
+	return change.FromIndex
+}
+
+// ChangedPrintFiles returns the print files of the druckdateien folders that
+// the newer build added or changed, in the order they are merged. Without
+// folders all folders are included.
+func (d *BuildDiff) ChangedPrintFiles(folders ...string) []string {
+	var files []string
+	for _, file := range d.Files {
+		if file.Change == FileRemoved || file.MergedFile == "" {
+			continue
+		}
+		if len(folders) > 0 && !containsString(folders, file.Folder) {
+			continue
+		}
+		files = append(files, file.Path)
+	}
+	return files
+}
+
+// WriteChangedPagesPDF merges the print files the newer build of a diff added
+// or changed into a single PDF for reprinting and returns their number.
+// Nothing is written if no print file changed.
+func (s *projectService) WriteChangedPagesPDF(ctx context.Context, diff *BuildDiff, folders []string, dest string) (int, error) {
+	relPaths := diff.ChangedPrintFiles(folders...)
+	if len(relPaths) == 0 {
+		return 0, nil
+	}
+
+	artifacts, err := s.GetBuildArtifacts(ctx, diff.ProjectID, diff.To.BuildID)
+	if err != nil {
+		return 0, err
+	}
+	files := make([]string, len(relPaths))
+	for i, relPath := range relPaths {
+		if files[i], err = artifacts.FilePath(relPath); err != nil {
+			return 0, fmt.Errorf("failed to resolve %s: %w", relPath, err)
+		}
+	}
+
+	if err := s.mergePDFFiles(files, dest); err != nil {
+		return 0, err
+	}
+	return len(files), nil
+}
@@ -0,0 +1,140 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffManifests(t *testing.T) {
+	from := &BuildManifest{Files: []ManifestFile{
+		{Path: "druckdateien/klein/01_alpha_-A1_a3.pdf", SHA256: "a1", Pages: 1, Folder: "klein", SongIndex: 1},
+		{Path: "druckdateien/klein/02_beta_-A1_a3.pdf", SHA256: "b1", Pages: 1, Folder: "klein", SongIndex: 2},
+		{Path: "druckdateien/klein/03_gamma_-A1_a3.pdf", SHA256: "c1", Pages: 1, Folder: "klein", SongIndex: 3},
+		{Path: "druckdateien/MBT_klein.pdf", SHA256: "m1", Pages: 3, Folder: "klein"},
+	}}
+	to := &BuildManifest{Files: []ManifestFile{
+		{Path: "druckdateien/klein/01_alpha_-A1_a3.pdf", SHA256: "a1", Pages: 1, Folder: "klein", SongIndex: 1},
+		{Path: "druckdateien/klein/02_beta_-A1_a3.pdf", SHA256: "b2", Pages: 2, Folder: "klein", SongIndex: 2},
+		{Path: "druckdateien/klein/04_delta_-A1_a3.pdf", SHA256: "d1", Pages: 1, Folder: "klein", SongIndex: 4},
+		{Path: "druckdateien/MBT_klein.pdf", SHA256: "m2", Pages: 4, Folder: "klein"},
+		{Path: "abc/delta.abc", SHA256: "d0"},
+	}}
+
+	changes := diffManifests(from, to)
+	require.Len(t, changes, 5)
+	assert.Equal(t, FileChange{Path: "abc/delta.abc", Change: FileAdded, ToSHA256: "d0"}, changes[0])
+	assert.Equal(t, "druckdateien/MBT_klein.pdf", changes[1].Path)
+	assert.Empty(t, changes[1].MergedFile)
+
+	beta := changes[2]
+	assert.Equal(t, FileChanged, beta.Change)
+	assert.True(t, beta.PagesChanged())
+	assert.Equal(t, "druckdateien/MBT_klein.pdf", beta.MergedFile)
+	assert.Equal(t, 2, beta.FirstPage)
+	assert.Equal(t, 3, beta.LastPage)
+
+	assert.Equal(t, FileRemoved, changes[3].Change)
+	assert.Equal(t, "c1", changes[3].FromSHA256)
+	assert.Zero(t, changes[3].FirstPage)
+	assert.Equal(t, FileAdded, changes[4].Change)
+	assert.Equal(t, 4, changes[4].FirstPage)
+
+	diff := &BuildDiff{Files: changes}
+	assert.Equal(t, []string{"druckdateien/klein/02_beta_-A1_a3.pdf", "druckdateien/klein/04_delta_-A1_a3.pdf"}, diff.ChangedPrintFiles())
+	assert.Empty(t, diff.ChangedPrintFiles("gross"))
+}
+
+func TestDiffReports(t *testing.T) {
+	from := &BuildReport{Songs: []SongReport{
+		{Index: 1, ProjectSongID: 10, Title: "alpha", ABCHash: "a", ConfigHash: "c"},
+		{Index: 2, ProjectSongID: 20, Title: "beta", ABCHash: "b", ConfigHash: "c"},
+		{Index: 3, ProjectSongID: 30, Title: "gamma", ABCHash: "g", ConfigHash: "c"},
+	}}
+	to := &BuildReport{Songs: []SongReport{
+		{Index: 1, ProjectSongID: 10, Title: "alpha", ABCHash: "a", ConfigHash: "c"},
+		{Index: 3, ProjectSongID: 20, Title: "beta", ABCHash: "b2", ConfigHash: "c2"},
+		{Index: 2, ProjectSongID: 40, Title: "delta", ABCHash: "d", ConfigHash: "c"},
+	}}
+
+	changes := diffReports(from, to)
+	require.Len(t, changes, 3)
+	assert.Equal(t, "delta", changes[0].Title)
+	assert.Equal(t, []string{SongAdded}, changes[0].Changes)
+	assert.Equal(t, "beta", changes[1].Title)
+	assert.Equal(t, []string{SongRenumbered, SongConfigChanged, SongABCChanged}, changes[1].Changes)
+	assert.Equal(t, 2, changes[1].FromIndex)
+	assert.Equal(t, "gamma", changes[2].Title)
+	assert.Equal(t, []string{SongRemoved}, changes[2].Changes)
+}
+
+func TestProjectService_CompareBuilds(t *testing.T) {
+	// Zupfnoter does not write anything in debug mode
+	t.Setenv("ZUPFNOTER_DEBUG", "1")
+
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Diff Project",
+		ShortName: "DIF",
+	})
+	require.NoError(t, err)
+
+	abcDir := t.TempDir()
+	outputDir := filepath.Join(t.TempDir(), "DIF")
+	priority := 1
+	for _, name := range []string{"alpha", "beta"} {
+		require.NoError(t, os.WriteFile(filepath.Join(abcDir, name+".abc"), []byte("X:1\nT:"+name+"\n"), 0644))
+		song, err := services.DB().Song.Create().SetTitle(name).SetFilename(name + ".abc").Save(ctx)
+		require.NoError(t, err)
+		_, err = services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+			ProjectID: project.ID,
+			SongID:    song.ID,
+			Priority:  &priority,
+		})
+		require.NoError(t, err)
+	}
+
+	build := func() *BuildResult {
+		t.Helper()
+		result, err := services.Project.RunProjectBuild(ctx, BuildProjectRequest{
+			ProjectID:  project.ID,
+			AbcFileDir: abcDir,
+			OutputDir:  outputDir,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "completed", result.Status, result.Error)
+		return result
+	}
+
+	first := build()
+	require.NoError(t, os.WriteFile(filepath.Join(abcDir, "beta.abc"), []byte("X:1\nT:beta\nK:G\n"), 0644))
+	second := build()
+
+	// Without a second build the latest one is compared
+	diff, err := services.Project.CompareBuilds(ctx, project.ID, first.BuildID, "")
+	require.NoError(t, err)
+	assert.Equal(t, second.BuildID, diff.To.BuildID)
+	assert.Equal(t, 1, diff.FilesChanged)
+	assert.Equal(t, second.Manifest.FileCount-1, diff.FilesUnchanged)
+	require.Len(t, diff.Files, 1)
+	assert.Equal(t, "abc/beta.abc", diff.Files[0].Path)
+	require.Len(t, diff.Songs, 1)
+	assert.Equal(t, []string{SongABCChanged}, diff.Songs[0].Changes)
+
+	// Nothing to reprint, zupfnoter did not render any pages
+	count, err := services.Project.WriteChangedPagesPDF(ctx, diff, nil, filepath.Join(t.TempDir(), "changed.pdf"))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	_, err = services.Project.CompareBuilds(ctx, project.ID+1, first.BuildID, second.BuildID)
+	assert.Equal(t, ErrBuildNotFound, err)
+	_, err = services.Project.CompareBuilds(ctx, project.ID, "unknown", second.BuildID)
+	assert.Equal(t, ErrBuildNotFound, err)
+}
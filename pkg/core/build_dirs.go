@@ -0,0 +1,149 @@
+package core
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/google/uuid"
+)
+
+// buildsDir is the directory below the output directory that holds one
+// directory per build
+const buildsDir = "builds"
+
+// LatestBuildLink is the symlink in the output directory that points to the
+// directory of the last successful build. Where symlinks can not be created,
+// e.g. on Windows without the privilege, it is a file that holds the path of
+// the build directory relative to the output directory.
+const LatestBuildLink = "latest"
+
+// symlink creates symlinks, tests replace it to check the fallback
+var symlink = os.Symlink
+
+// DefaultBuildRetention is the number of build directories kept per output
+// directory if the project config does not set "buildRetention"
+const DefaultBuildRetention = 5
+
+// buildRetentionConfigKey is the project config key of the build retention
+const buildRetentionConfigKey = "buildRetention"
+
+// buildDirTimeLayout is the layout of the start time in build directory names
+const buildDirTimeLayout = "20060102-150405.000"
+
+// buildDirName returns the directory of a build relative to the output
+// directory. Names sort in the order the builds were started.
+func buildDirName(startedAt time.Time, buildID string) string {
+	return filepath.Join(buildsDir, startedAt.Format(buildDirTimeLayout)+"-"+buildID)
+}
+
+// newBuildDirName returns the directory of a build that has no build record
+func newBuildDirName() string {
+	return buildDirName(time.Now(), uuid.New().String())
+}
+
+// projectBuildRetention returns the number of build directories to keep
+func projectBuildRetention(project *ent.Project) (int, error) {
+	value, ok := project.Config[buildRetentionConfigKey]
+	if !ok {
+		return DefaultBuildRetention, nil
+	}
+
+	var retention int
+	switch v := value.(type) {
+	case float64:
+		retention = int(v)
+		if float64(retention) != v {
+			retention = 0
+		}
+	case int:
+		retention = v
+	}
+	if retention < 1 {
+		return 0, ValidationErrors{{
+			Field:   "config." + buildRetentionConfigKey,
+			Message: fmt.Sprintf("%s must be a whole number of at least 1", buildRetentionConfigKey),
+		}}
+	}
+	return retention, nil
+}
+
+// publishLatestBuild points the latest link of the output directory to a
+// build directory. The link is replaced atomically, readers see either the
+// previous or the new build. Without symlinks latest is a file with the path.
+func publishLatestBuild(outputDir, buildDir string) error {
+	tmpLink := filepath.Join(outputDir, fmt.Sprintf(".%s-%s", LatestBuildLink, uuid.New().String()))
+	if err := symlink(buildDir, tmpLink); err != nil {
+		slog.Info("Symlinks not available, writing latest as file", "error", err)
+		if err := os.WriteFile(tmpLink, []byte(filepath.ToSlash(buildDir)+"\n"), 0644); err != nil {
+			return fmt.Errorf("failed to create link: %w", err)
+		}
+	}
+	if err := os.Rename(tmpLink, filepath.Join(outputDir, LatestBuildLink)); err != nil {
+		os.Remove(tmpLink)
+		return fmt.Errorf("failed to replace link: %w", err)
+	}
+	return nil
+}
+
+// latestBuildDir returns the build directory the latest link or file points
+// to relative to the output directory, or "" if there is none
+func latestBuildDir(outputDir string) string {
+	latest := filepath.Join(outputDir, LatestBuildLink)
+	if target, err := os.Readlink(latest); err == nil {
+		return filepath.Clean(target)
+	}
+	content, err := os.ReadFile(latest)
+	if err != nil {
+		return ""
+	}
+	target := strings.TrimSpace(string(content))
+	if target == "" {
+		return ""
+	}
+	return filepath.Clean(filepath.FromSlash(target))
+}
+
+// pruneBuildDirs removes all but the newest keep finished build directories of
+// an output directory. Only directories with a manifest count, those without
+// one belong to builds that are still running. The directory of the latest
+// build is always kept.
+func pruneBuildDirs(outputDir string, keep int) error {
+	entries, err := os.ReadDir(filepath.Join(outputDir, buildsDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(outputDir, buildsDir, entry.Name(), ManifestFilename)); err != nil {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+
+	latest := latestBuildDir(outputDir)
+	for i, name := range names {
+		dir := filepath.Join(buildsDir, name)
+		if i < keep || dir == latest {
+			continue
+		}
+		slog.Info("Removing old build directory", "directory", dir)
+		if err := os.RemoveAll(filepath.Join(outputDir, dir)); err != nil {
+			return fmt.Errorf("failed to remove build directory %s: %w", dir, err)
+		}
+	}
+	return nil
+}
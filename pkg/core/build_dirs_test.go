@@ -0,0 +1,145 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectBuildRetention(t *testing.T) {
+	retention, err := projectBuildRetention(&ent.Project{})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultBuildRetention, retention)
+
+	retention, err = projectBuildRetention(&ent.Project{Config: map[string]interface{}{"buildRetention": float64(2)}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, retention)
+
+	for _, invalid := range []interface{}{float64(0), 1.5, "3"} {
+		_, err = projectBuildRetention(&ent.Project{Config: map[string]interface{}{"buildRetention": invalid}})
+		assert.IsType(t, ValidationErrors{}, err, invalid)
+	}
+}
+
+func TestPruneBuildDirs(t *testing.T) {
+	outputDir := t.TempDir()
+	started := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	var dirs []string
+	for i := 0; i < 5; i++ {
+		dir := buildDirName(started.Add(time.Duration(i)*time.Minute), "build")
+		require.NoError(t, os.MkdirAll(filepath.Join(outputDir, dir), 0755))
+		dirs = append(dirs, dir)
+	}
+	// The newest build is still running and has no manifest yet
+	for _, dir := range dirs[:4] {
+		require.NoError(t, os.WriteFile(filepath.Join(outputDir, dir, ManifestFilename), []byte("{}"), 0644))
+	}
+
+	// The latest build survives although it is older than the kept builds
+	require.NoError(t, publishLatestBuild(outputDir, dirs[0]))
+	require.NoError(t, publishLatestBuild(outputDir, dirs[1]))
+	assert.Equal(t, dirs[1], latestBuildDir(outputDir))
+
+	require.NoError(t, pruneBuildDirs(outputDir, 1))
+	assert.NoDirExists(t, filepath.Join(outputDir, dirs[0]))
+	assert.DirExists(t, filepath.Join(outputDir, dirs[1]))
+	assert.NoDirExists(t, filepath.Join(outputDir, dirs[2]))
+	assert.DirExists(t, filepath.Join(outputDir, dirs[3]))
+	assert.DirExists(t, filepath.Join(outputDir, dirs[4]))
+	assert.FileExists(t, filepath.Join(outputDir, LatestBuildLink, ManifestFilename))
+}
+
+func TestPublishLatestBuildWithoutSymlinks(t *testing.T) {
+	symlink = func(string, string) error { return &os.LinkError{Op: "symlink", Err: os.ErrPermission} }
+	defer func() { symlink = os.Symlink }()
+
+	outputDir := t.TempDir()
+	first := buildDirName(time.Now(), "first")
+	second := buildDirName(time.Now().Add(time.Minute), "second")
+	require.NoError(t, publishLatestBuild(outputDir, first))
+	require.NoError(t, publishLatestBuild(outputDir, second))
+	assert.Equal(t, second, latestBuildDir(outputDir))
+	assert.FileExists(t, filepath.Join(outputDir, LatestBuildLink))
+}
+
+func TestProjectService_BuildDirs(t *testing.T) {
+	// Zupfnoter does not write anything in debug mode
+	t.Setenv("ZUPFNOTER_DEBUG", "1")
+
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Build Dirs Project",
+		ShortName: "DIR",
+		Config:    map[string]interface{}{"buildRetention": 2},
+	})
+	require.NoError(t, err)
+
+	abcDir := t.TempDir()
+	outputDir := filepath.Join(t.TempDir(), "DIR")
+	require.NoError(t, os.WriteFile(filepath.Join(abcDir, "alpha.abc"), []byte("X:1\nT:alpha\n"), 0644))
+	song, err := services.DB().Song.Create().SetTitle("alpha").SetFilename("alpha.abc").Save(ctx)
+	require.NoError(t, err)
+	priority := 1
+	_, err = services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+		ProjectID: project.ID,
+		SongID:    song.ID,
+		Priority:  &priority,
+	})
+	require.NoError(t, err)
+
+	var results []*BuildResult
+	for i := 0; i < 3; i++ {
+		result, err := services.Project.RunProjectBuild(ctx, BuildProjectRequest{
+			ProjectID:  project.ID,
+			AbcFileDir: abcDir,
+			OutputDir:  outputDir,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "completed", result.Status, result.Error)
+		results = append(results, result)
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// Every build has its own directory, the latest link points to the last one
+	last := results[2]
+	assert.Contains(t, last.BuildDir, last.BuildID)
+	assert.Equal(t, last.BuildDir, latestBuildDir(outputDir))
+	assert.FileExists(t, filepath.Join(outputDir, LatestBuildLink, ManifestFilename))
+
+	// Only the two newest builds are kept
+	assert.NoDirExists(t, filepath.Join(outputDir, results[0].BuildDir))
+	_, err = services.Project.GetBuildArtifacts(ctx, project.ID, results[0].BuildID)
+	assert.Equal(t, ErrBuildOutputRemoved, err)
+
+	for _, result := range results[1:] {
+		artifacts, err := services.Project.GetBuildArtifacts(ctx, project.ID, result.BuildID)
+		require.NoError(t, err)
+		assert.Equal(t, "DIR_"+result.BuildID[:8]+".zip", artifacts.ArchiveName())
+	}
+
+	// A failed build removes its directory and does not push out the finished ones
+	require.NoError(t, services.DB().Project.UpdateOneID(project.ID).SetConfig(map[string]interface{}{
+		"buildRetention": 2,
+		"editions": map[string]interface{}{
+			"a": map[string]interface{}{},
+			"b": map[string]interface{}{"folderRules": []interface{}{map[string]interface{}{"regex": "(", "folders": []interface{}{"x"}}}},
+		},
+	}).Exec(ctx))
+	failed, err := services.Project.RunProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID, AbcFileDir: abcDir, OutputDir: outputDir})
+	require.Error(t, err)
+	require.NotNil(t, failed)
+	require.Equal(t, "failed", failed.Status)
+	assert.NoDirExists(t, filepath.Join(outputDir, failed.BuildDir))
+	for _, result := range results[1:] {
+		assert.DirExists(t, filepath.Join(outputDir, result.BuildDir))
+	}
+}
@@ -0,0 +1,190 @@
+package core
+
+import (
+	"sync"
+	"time"
+)
+
+// BuildEventType identifies the kind of a build event
+type BuildEventType string
+
+const (
+	BuildEventStatus        BuildEventType = "status"
+	BuildEventProgress      BuildEventType = "progress"
+	BuildEventSongStarted   BuildEventType = "song_started"
+	BuildEventSongCompleted BuildEventType = "song_completed"
+	BuildEventSongFailed    BuildEventType = "song_failed"
+	BuildEventResult        BuildEventType = "result"
+)
+
+// defaultMaxFinishedEventLogs is the number of finished builds whose events are
+// kept in memory, so that late clients can still replay them
+const defaultMaxFinishedEventLogs = 50
+
+// buildEventPollInterval is how often the build history is read for builds
+// whose events are not published in this process
+var buildEventPollInterval = time.Second
+
+// subscriberBuffer is the number of live events a subscriber may lag behind
+// before it is dropped
+const subscriberBuffer = 64
+
+// BuildEvent is a single structured update of a running build.
+// IDs start at 1 and increase by one for every event of a build.
+type BuildEvent struct {
+	ID       int             `json:"id"`
+	BuildID  string          `json:"build_id"`
+	Type     BuildEventType  `json:"type"`
+	Time     string          `json:"time"`
+	Status   string          `json:"status,omitempty"`
+	Progress int             `json:"progress,omitempty"`
+	Message  string          `json:"message,omitempty"`
+	Song     *BuildEventSong `json:"song,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	Result   *BuildResult    `json:"result,omitempty"`
+}
+
+// BuildEventSong identifies the song a song event refers to
+type BuildEventSong struct {
+	Index    int    `json:"index"`
+	Title    string `json:"title"`
+	Filename string `json:"filename"`
+	Edition  string `json:"edition,omitempty"`
+	Cached   bool   `json:"cached,omitempty"` // zupfnoter output was reused, only set on song_completed
+}
+
+// BuildEventCallback receives the events of a build while it runs
+type BuildEventCallback func(event BuildEvent)
+
+// buildEventLog holds all events of one build and its live subscribers
+type buildEventLog struct {
+	events      []BuildEvent
+	subscribers map[chan BuildEvent]struct{}
+	done        bool
+}
+
+// BuildEventHub keeps the event log of every build and fans events out to
+// subscribers. A subscriber that reconnects passes the ID of the last event it
+// has seen and gets everything it missed replayed first. A result event ends
+// the stream of a build. All methods are safe for concurrent use.
+type BuildEventHub struct {
+	mu          sync.Mutex
+	logs        map[string]*buildEventLog
+	finished    []string // finished builds, oldest first
+	maxFinished int
+}
+
+// NewBuildEventHub creates a new, empty build event hub
+func NewBuildEventHub() *BuildEventHub {
+	return &BuildEventHub{
+		logs:        make(map[string]*buildEventLog),
+		maxFinished: defaultMaxFinishedEventLogs,
+	}
+}
+
+// Publish assigns the next ID to an event of a build, stores it and sends it to
+// all subscribers. Events published after the result event are dropped.
+func (h *BuildEventHub) Publish(buildID string, event BuildEvent) BuildEvent {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	log := h.logLocked(buildID)
+	if log.done {
+		return event
+	}
+
+	event.ID = len(log.events) + 1
+	event.BuildID = buildID
+	if event.Time == "" {
+		event.Time = time.Now().Format(time.RFC3339)
+	}
+	log.events = append(log.events, event)
+
+	for ch := range log.subscribers {
+		select {
+		case ch <- event:
+		default:
+			// Too slow, the client will reconnect and replay what it missed
+			delete(log.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	if event.Type == BuildEventResult {
+		h.finishLocked(buildID, log)
+	}
+
+	return event
+}
+
+// Subscribe returns a channel that first receives all events of a build after
+// lastEventID and then every new event. The channel is closed after the result
+// event, when the subscriber falls too far behind or when unsubscribe is called.
+func (h *BuildEventHub) Subscribe(buildID string, lastEventID int) (events <-chan BuildEvent, unsubscribe func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	log := h.logLocked(buildID)
+
+	if lastEventID < 0 {
+		lastEventID = 0
+	}
+	var missed []BuildEvent
+	if lastEventID < len(log.events) {
+		missed = log.events[lastEventID:]
+	}
+
+	ch := make(chan BuildEvent, len(missed)+subscriberBuffer)
+	for _, event := range missed {
+		ch <- event
+	}
+
+	if log.done {
+		close(ch)
+		return ch, func() {}
+	}
+
+	log.subscribers[ch] = struct{}{}
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := log.subscribers[ch]; ok {
+			delete(log.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Has reports whether the hub holds events of a build
+func (h *BuildEventHub) Has(buildID string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, ok := h.logs[buildID]
+	return ok
+}
+
+// logLocked returns the event log of a build and creates it if needed; h.mu must be held
+func (h *BuildEventHub) logLocked(buildID string) *buildEventLog {
+	log, ok := h.logs[buildID]
+	if !ok {
+		log = &buildEventLog{subscribers: make(map[chan BuildEvent]struct{})}
+		h.logs[buildID] = log
+	}
+	return log
+}
+
+// finishLocked closes all subscriptions of a build and evicts the oldest
+// finished builds; h.mu must be held
+func (h *BuildEventHub) finishLocked(buildID string, log *buildEventLog) {
+	log.done = true
+	for ch := range log.subscribers {
+		close(ch)
+	}
+	log.subscribers = nil
+
+	h.finished = append(h.finished, buildID)
+	for len(h.finished) > h.maxFinished {
+		delete(h.logs, h.finished[0])
+		h.finished = h.finished[1:]
+	}
+}
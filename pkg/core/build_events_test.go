@@ -0,0 +1,154 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// drain collects events from a channel until it is closed
+func drain(t *testing.T, events <-chan BuildEvent) []BuildEvent {
+	t.Helper()
+	var received []BuildEvent
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return received
+			}
+			received = append(received, event)
+		case <-timeout:
+			t.Fatal("event stream was not closed")
+		}
+	}
+}
+
+func TestBuildEventHub_ReplayAfterReconnect(t *testing.T) {
+	hub := NewBuildEventHub()
+
+	first, unsubscribe := hub.Subscribe("build", 0)
+	hub.Publish("build", BuildEvent{Type: BuildEventProgress, Progress: 10})
+	hub.Publish("build", BuildEvent{Type: BuildEventSongStarted, Song: &BuildEventSong{Index: 1, Title: "Song"}})
+
+	event := <-first
+	assert.Equal(t, 1, event.ID)
+	assert.Equal(t, "build", event.BuildID)
+	unsubscribe()
+
+	// The client missed event 2 while it was disconnected
+	hub.Publish("build", BuildEvent{Type: BuildEventSongCompleted, Song: &BuildEventSong{Index: 1, Title: "Song"}})
+	second, _ := hub.Subscribe("build", event.ID)
+	hub.Publish("build", BuildEvent{Type: BuildEventResult, Status: "completed"})
+
+	received := drain(t, second)
+	require.Len(t, received, 3)
+	assert.Equal(t, []int{2, 3, 4}, []int{received[0].ID, received[1].ID, received[2].ID})
+	assert.Equal(t, BuildEventSongStarted, received[0].Type)
+	assert.Equal(t, BuildEventResult, received[2].Type)
+
+	// A finished build replays its log and closes the stream right away
+	replay, _ := hub.Subscribe("build", 0)
+	assert.Len(t, drain(t, replay), 4)
+
+	// Nothing is published after the result
+	hub.Publish("build", BuildEvent{Type: BuildEventProgress, Progress: 100})
+	replay, _ = hub.Subscribe("build", 0)
+	assert.Len(t, drain(t, replay), 4)
+}
+
+func TestBuildEventHub_EvictsFinishedBuilds(t *testing.T) {
+	hub := NewBuildEventHub()
+	hub.maxFinished = 2
+
+	for _, id := range []string{"a", "b", "c"} {
+		hub.Publish(id, BuildEvent{Type: BuildEventResult, Status: "completed"})
+	}
+
+	assert.False(t, hub.Has("a"))
+	assert.True(t, hub.Has("b"))
+	assert.True(t, hub.Has("c"))
+}
+
+func TestProjectService_StreamBuildEvents(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Build Events Project",
+		ShortName: "build-events",
+	})
+	require.NoError(t, err)
+
+	// Builds from before a server restart are only known from the history
+	_, err = services.DB().Build.Create().
+		SetBuildID("old-build").
+		SetProjectID(project.ID).
+		SetStatus("failed").
+		SetProgress(100).
+		SetError("Build failed: boom").
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	events, err := services.Project.StreamBuildEvents(ctx, project.ID, "old-build", 0)
+	require.NoError(t, err)
+	received := drain(t, events)
+	require.Len(t, received, 1)
+	assert.Equal(t, BuildEventResult, received[0].Type)
+	assert.Equal(t, "failed", received[0].Status)
+	require.NotNil(t, received[0].Result)
+	assert.Equal(t, "Build failed: boom", received[0].Result.Error)
+
+	_, err = services.Project.StreamBuildEvents(ctx, project.ID, "unknown-build", 0)
+	assert.Equal(t, ErrBuildNotFound, err)
+	_, err = services.Project.StreamBuildEvents(ctx, project.ID+1, "old-build", 0)
+	assert.Equal(t, ErrBuildNotFound, err)
+
+	// A build run by the CLI in another process is followed in the history
+	buildEventPollInterval = 10 * time.Millisecond
+	defer func() { buildEventPollInterval = time.Second }()
+	cliBuild, err := services.DB().Build.Create().
+		SetBuildID("cli-build").
+		SetProjectID(project.ID).
+		SetStatus("running").
+		SetProgress(10).
+		SetMessage("Starting build process").
+		Save(ctx)
+	require.NoError(t, err)
+	events, err = services.Project.StreamBuildEvents(ctx, project.ID, "cli-build", 3)
+	require.NoError(t, err)
+	first := <-events
+	assert.Equal(t, BuildEventStatus, first.Type)
+	assert.Equal(t, 4, first.ID)
+	assert.Equal(t, "running", first.Status)
+	require.NoError(t, cliBuild.Update().SetStatus("completed").SetProgress(100).SetCompletedAt(time.Now()).Exec(ctx))
+	received = drain(t, events)
+	require.NotEmpty(t, received)
+	assert.Equal(t, BuildEventResult, received[len(received)-1].Type)
+	assert.Equal(t, "completed", received[len(received)-1].Status)
+
+	// A queued build streams its events until it ends
+	release := make(chan struct{})
+	defer close(release)
+	services.BuildQueue.Enqueue("blocker", project.ID, func(ctx context.Context) {
+		<-release
+	})
+	result, err := services.Project.BuildProject(ctx, BuildProjectRequest{ProjectID: project.ID, PriorityThreshold: 1})
+	require.NoError(t, err)
+
+	events, err = services.Project.StreamBuildEvents(ctx, project.ID, result.BuildID, 0)
+	require.NoError(t, err)
+	require.NoError(t, services.Project.CancelBuild(ctx, project.ID, result.BuildID))
+
+	received = drain(t, events)
+	require.Len(t, received, 2)
+	assert.Equal(t, BuildEventStatus, received[0].Type)
+	assert.Equal(t, "queued", received[0].Status)
+	assert.Equal(t, BuildEventResult, received[1].Type)
+	assert.Equal(t, "cancelled", received[1].Status)
+}
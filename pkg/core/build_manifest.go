@@ -0,0 +1,212 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/buildreport"
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+)
+
+// ManifestFilename is the name of the manifest written into the output directory
+const ManifestFilename = "manifest.json"
+
+// BuildManifest lists the files written by a build
+type BuildManifest = buildreport.Manifest
+
+// ManifestFile describes a single file of the build output
+type ManifestFile = buildreport.ManifestFile
+
+// createManifest scans the output directory of a build, writes the result as
+// manifest.json into it and returns it. The files of editions are listed with
+// the directory of their edition.
+func (s *projectService) createManifest(project *ent.Project, outputDir string, report *BuildReport) (*BuildManifest, error) {
+	manifest := &BuildManifest{
+		GeneratedAt: time.Now().Format(time.RFC3339),
+		Files:       []ManifestFile{},
+	}
+
+	editions, err := projectEditions(project)
+	if err != nil {
+		return nil, err
+	}
+	if len(editions) == 0 {
+		routing, err := projectFolderRouting(project)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.scanBuildOutput(manifest, project.ShortName, routing, outputDir, "", report); err != nil {
+			return nil, err
+		}
+	}
+	for _, edition := range editions {
+		routing, err := FolderRoutingFromConfig(edition.Config)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.scanBuildOutput(manifest, project.ShortName, routing, filepath.Join(outputDir, edition.Name), edition.Name, report); err != nil {
+			return nil, err
+		}
+	}
+	manifest.FileCount = len(manifest.Files)
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, ManifestFilename), data, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	slog.Info("created build manifest", "files", manifest.FileCount, "size", manifest.TotalSize)
+	return manifest, nil
+}
+
+// scanBuildOutput adds the files of the output directories below dir to the
+// manifest. The paths of an edition start with its name.
+func (s *projectService) scanBuildOutput(manifest *BuildManifest, shortName string, routing *FolderRouting, dir, edition string, report *BuildReport) error {
+	for _, outputDir := range buildOutputDirs {
+		root := filepath.Join(dir, outputDir)
+		if _, err := os.Stat(root); os.IsNotExist(err) {
+			continue
+		}
+
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			relPath, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			file, err := s.manifestFile(path, filepath.ToSlash(relPath))
+			if err != nil {
+				return err
+			}
+			file.SongIndex, file.Song = manifestSong(file.Path, report)
+			file.Folder = manifestFolder(file.Path, shortName, routing)
+			if edition != "" {
+				file.Path = edition + "/" + file.Path
+				file.Edition = edition
+			}
+
+			manifest.Files = append(manifest.Files, *file)
+			manifest.TotalSize += file.Size
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to scan build output %s: %w", filepath.Join(edition, outputDir), err)
+		}
+	}
+	return nil
+}
+
+// manifestFile reads size, checksum and page count of a generated file
+func (s *projectService) manifestFile(path, relPath string) (*ManifestFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &ManifestFile{
+		Path:   relPath,
+		Size:   size,
+		SHA256: hex.EncodeToString(hash.Sum(nil)),
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".pdf") {
+		pages, err := api.PageCountFile(path)
+		if err != nil {
+			// A broken PDF is listed anyway, the page count stays empty
+			slog.Warn("failed to count PDF pages", "file", relPath, "error", err)
+		} else {
+			file.Pages = pages
+		}
+	}
+
+	return file, nil
+}
+
+// manifestSong determines the song that produced a file. Files in druckdateien
+// carry the song index as prefix, all other files start with the ABC file name.
+func manifestSong(relPath string, report *BuildReport) (int, string) {
+	if report == nil {
+		return 0, ""
+	}
+	name := filepath.Base(relPath)
+
+	if strings.HasPrefix(relPath, "druckdateien/") {
+		if prefix, _, found := strings.Cut(name, "_"); found {
+			if index, err := strconv.Atoi(prefix); err == nil {
+				for _, song := range report.Songs {
+					if song.Index == index {
+						return song.Index, song.Title
+					}
+				}
+				return 0, ""
+			}
+		}
+	}
+
+	// The longest matching file name wins, "song" must not claim "song_two"
+	var match *SongReport
+	for i := range report.Songs {
+		song := &report.Songs[i]
+		base := strings.TrimSuffix(song.Filename, ".abc")
+		if !strings.HasPrefix(name, base) || len(name) == len(base) {
+			continue
+		}
+		if next := name[len(base)]; next != '_' && next != '.' {
+			continue
+		}
+		if match == nil || len(song.Filename) > len(match.Filename) {
+			match = song
+		}
+	}
+	if match == nil {
+		return 0, ""
+	}
+	return match.Index, match.Title
+}
+
+// manifestFolder determines the druckdateien folder a file belongs to. PDFs
+// that are copied into several folders belong to the first of them.
+func manifestFolder(relPath, shortName string, routing *FolderRouting) string {
+	parts := strings.Split(relPath, "/")
+	name := parts[len(parts)-1]
+
+	switch parts[0] {
+	case "druckdateien":
+		if len(parts) > 2 {
+			return parts[1]
+		}
+		// Merged PDFs are named <short name>_<folder>.pdf, <short name>_<folder>_nachtrag.pdf in supplements
+		return strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(name, shortName+"_"), ".pdf"), SupplementSuffix)
+
+	case "pdf":
+		if folders := pdfFolders(name, routing).Folders; len(folders) > 0 {
+			return folders[0]
+		}
+	}
+
+	return ""
+}
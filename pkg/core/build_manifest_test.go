@@ -0,0 +1,110 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// minimalPDF returns a valid PDF document with the given number of empty pages
+func minimalPDF(pages int) []byte {
+	var kids []string
+	for i := 0; i < pages; i++ {
+		kids = append(kids, fmt.Sprintf("%d 0 R", i+3))
+	}
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), pages),
+	}
+	for i := 0; i < pages; i++ {
+		objects = append(objects, "<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] >>")
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+func TestCreateManifest(t *testing.T) {
+	outputDir := t.TempDir()
+	samplePDF := minimalPDF(2)
+
+	files := map[string][]byte{
+		"pdf/amazing_grace_-A1_a3.pdf":                   samplePDF,
+		"pdf/amazing_grace_two_-A1_a3.pdf":               samplePDF,
+		"druckdateien/klein/02_amazing_grace_-A1_a3.pdf": samplePDF,
+		"druckdateien/TEST_klein.pdf":                    samplePDF,
+		"abc/amazing_grace.abc":                          []byte("X:1\nT:Amazing Grace\n"),
+		"log/amazing_grace_two.abc.err.log":              []byte("warning\n"),
+		"html/00_inhaltsverzeichnis.html":                []byte("<html></html>"),
+	}
+	for path, content := range files {
+		fullPath := filepath.Join(outputDir, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(fullPath), 0755))
+		require.NoError(t, os.WriteFile(fullPath, content, 0644))
+	}
+
+	project := &ent.Project{ShortName: "TEST", Config: map[string]interface{}{}}
+	report := &BuildReport{Songs: []SongReport{
+		{Index: 1, Title: "Amazing Grace Two", Filename: "amazing_grace_two.abc"},
+		{Index: 2, Title: "Amazing Grace", Filename: "amazing_grace.abc"},
+	}}
+
+	service := &projectService{}
+	manifest, err := service.createManifest(project, outputDir, report)
+	require.NoError(t, err)
+	assert.Equal(t, len(files), manifest.FileCount)
+
+	byPath := make(map[string]ManifestFile)
+	var totalSize int64
+	for _, file := range manifest.Files {
+		byPath[file.Path] = file
+		totalSize += file.Size
+	}
+	assert.Equal(t, totalSize, manifest.TotalSize)
+
+	pdf := byPath["pdf/amazing_grace_-A1_a3.pdf"]
+	assert.Equal(t, int64(len(samplePDF)), pdf.Size)
+	assert.Len(t, pdf.SHA256, 64)
+	assert.Equal(t, 2, pdf.Pages)
+	assert.Equal(t, "Amazing Grace", pdf.Song)
+	assert.Equal(t, "klein", pdf.Folder)
+
+	// The longest file name wins over a shorter prefix
+	assert.Equal(t, 1, byPath["pdf/amazing_grace_two_-A1_a3.pdf"].SongIndex)
+	assert.Equal(t, 1, byPath["log/amazing_grace_two.abc.err.log"].SongIndex)
+	assert.Equal(t, 2, byPath["abc/amazing_grace.abc"].SongIndex)
+
+	// Files in druckdateien are assigned by their index prefix
+	assert.Equal(t, 2, byPath["druckdateien/klein/02_amazing_grace_-A1_a3.pdf"].SongIndex)
+	assert.Equal(t, "klein", byPath["druckdateien/klein/02_amazing_grace_-A1_a3.pdf"].Folder)
+	assert.Equal(t, "klein", byPath["druckdateien/TEST_klein.pdf"].Folder)
+	assert.Equal(t, 0, byPath["druckdateien/TEST_klein.pdf"].SongIndex)
+	assert.Equal(t, 0, byPath["html/00_inhaltsverzeichnis.html"].SongIndex)
+
+	// The manifest is written into the output directory as well
+	data, err := os.ReadFile(filepath.Join(outputDir, ManifestFilename))
+	require.NoError(t, err)
+	var written BuildManifest
+	require.NoError(t, json.Unmarshal(data, &written))
+	assert.Equal(t, manifest.Files, written.Files)
+}
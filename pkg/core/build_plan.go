@@ -0,0 +1,479 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/templatevars"
+	"github.com/bwl21/zupfmanager/internal/zupfnoter"
+)
+
+// BuildStatusDryRun is the status of a build result that only carries a plan
+const BuildStatusDryRun = "dry_run"
+
+// BuiltInTemplate marks a table of contents that uses the built-in template
+const BuiltInTemplate = "built-in"
+
+// BuildPlan describes what a build would do, without running zupfnoter or Chrome
+type BuildPlan struct {
+	ProjectID         int                `json:"project_id"`
+	ShortName         string             `json:"short_name"`
+	OutputDir         string             `json:"output_dir"`
+	AbcFileDir        string             `json:"abc_file_dir"`
+	PriorityThreshold int                `json:"priority_threshold"`
+	SampleID          string             `json:"sample_id,omitempty"`
+	SongOrder         string             `json:"song_order"`
+	SupplementSince   string             `json:"supplement_since,omitempty"`
+	Profile           string             `json:"profile,omitempty"`
+	Final             bool               `json:"final,omitempty"`
+	ZupfnoterVersion  string             `json:"zupfnoter_version"`
+	FolderRouting     *FolderRouting     `json:"folder_routing"`
+	Songs             []PlannedSong      `json:"songs"`
+	TOC               PlannedTOC         `json:"toc"`
+	MergedFiles       []PlannedMerge     `json:"merged_files"`
+	Watermarks        []PlannedWatermark `json:"watermarks,omitempty"`
+	Editions          []PlannedEdition   `json:"editions,omitempty"`
+	Warnings          []string           `json:"warnings,omitempty"`
+}
+
+// PlannedEdition is an edition of a build plan. The paths of its songs, table
+// of contents and merged files are relative to the directory of the edition.
+// Plans of projects with editions only list songs in their editions.
+type PlannedEdition struct {
+	Name          string             `json:"name"`
+	FolderRouting *FolderRouting     `json:"folder_routing"`
+	Songs         []PlannedSong      `json:"songs"`
+	TOC           PlannedTOC         `json:"toc"`
+	MergedFiles   []PlannedMerge     `json:"merged_files"`
+	Watermarks    []PlannedWatermark `json:"watermarks,omitempty"`
+}
+
+// PlannedWatermark is a watermark a build stamps on the print files of its
+// folders, on all folders if it names none
+type PlannedWatermark struct {
+	Name    string   `json:"name"`
+	Text    string   `json:"text,omitempty"`
+	Image   string   `json:"image,omitempty"`
+	Folders []string `json:"folders,omitempty"`
+}
+
+// PlannedSong is a song of a build plan in build order
+type PlannedSong struct {
+	Index         int            `json:"index"`
+	ProjectSongID int            `json:"project_song_id"`
+	SongID        int            `json:"song_id"`
+	Title         string         `json:"title"`
+	Filename      string         `json:"filename"`
+	Priority      int            `json:"priority"`
+	Copyright     string         `json:"copyright,omitempty"`
+	ABCPath       string         `json:"abc_path"`
+	ABCExists     bool           `json:"abc_exists"`
+	Config        map[string]any `json:"config,omitempty"`
+	PDFs          []PlannedPDF   `json:"pdfs"`
+	Warnings      []string       `json:"warnings,omitempty"`
+}
+
+// PlannedPDF is a PDF a build is expected to write, with the copies made of it
+type PlannedPDF struct {
+	Path      string   `json:"path"`
+	Extract   string   `json:"extract,omitempty"`
+	Folder    string   `json:"folder,omitempty"` // the first of Folders
+	Target    string   `json:"target,omitempty"` // the first of Targets
+	Folders   []string `json:"folders,omitempty"`
+	Targets   []string `json:"targets,omitempty"`
+	Excluded  bool     `json:"excluded,omitempty"`
+	Reference string   `json:"reference,omitempty"`
+}
+
+// PlannedTOC describes the table of contents of a build and the registers
+// rendered with its templates. The templates are named by their path,
+// "shared:<name>" or BuiltInTemplate.
+type PlannedTOC struct {
+	Template     string       `json:"template"`
+	HTMLTemplate string       `json:"html_template"`
+	PageNumbers  bool         `json:"page_numbers"`
+	Registers    []string     `json:"registers,omitempty"`
+	PDFs         []PlannedPDF `json:"pdfs"`
+	Warnings     []string     `json:"warnings,omitempty"`
+}
+
+// PlannedMerge is a merged PDF of a druckdateien folder with its parts in order
+type PlannedMerge struct {
+	Path   string   `json:"path"`
+	Folder string   `json:"folder"`
+	Files  []string `json:"files"`
+}
+
+// zupfnoterDefaultExtractTitles are the extract titles of the zupfnoter
+// defaults, they apply when the config does not name an extract
+var zupfnoterDefaultExtractTitles = map[string]string{
+	"0": "alle Stimmen",
+	"1": "Sopran, Alt",
+	"2": "Tenor, Bass",
+	"3": "Melodie",
+}
+
+var (
+	abcFilenamePattern   = regexp.MustCompile(`(?m)^F:[ \t]*(.*?)[ \t]*\r?$`)
+	filenamePartReplacer = regexp.MustCompile(`[^a-zA-Z0-9\-_]`)
+)
+
+// PlanProjectBuild resolves everything a build of the request would do and
+// returns it as a plan. Nothing is written and no build record is created.
+func (s *projectService) PlanProjectBuild(ctx context.Context, req BuildProjectRequest) (*BuildPlan, error) {
+	req, err := s.prepareBuildRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.loadBuildProject(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	editions, err := projectEditions(project)
+	if err != nil {
+		return nil, err
+	}
+
+	routing, err := projectFolderRouting(project)
+	if err != nil {
+		return nil, err
+	}
+	plan := &BuildPlan{
+		ProjectID:         project.ID,
+		ShortName:         project.ShortName,
+		OutputDir:         req.OutputDir,
+		AbcFileDir:        req.AbcFileDir,
+		PriorityThreshold: req.PriorityThreshold,
+		SampleID:          req.SampleID,
+		SongOrder:         req.SongOrder,
+		SupplementSince:   req.SupplementSince,
+		Profile:           req.Profile,
+		Final:             req.Final,
+		ZupfnoterVersion:  zupfnoter.Version(),
+		FolderRouting:     routing,
+		Songs:             make([]PlannedSong, 0, len(project.Edges.ProjectSongs)),
+	}
+
+	vars := projectVariables(project, req.SampleID, time.Now())
+	if len(editions) == 0 {
+		edition, err := s.planEdition(ctx, project, req, vars)
+		if err != nil {
+			return nil, err
+		}
+		plan.Songs, plan.TOC, plan.MergedFiles, plan.Watermarks = edition.Songs, edition.TOC, edition.MergedFiles, edition.Watermarks
+		plan.Warnings = planWarnings(edition, "")
+		return plan, nil
+	}
+
+	defer useBuildConfig(project, project.Config)
+	plan.TOC = PlannedTOC{PDFs: []PlannedPDF{}}
+	plan.MergedFiles = []PlannedMerge{}
+	for _, edition := range editions {
+		useBuildConfig(project, edition.Config)
+		planned, err := s.planEdition(ctx, project, req, vars)
+		if err != nil {
+			return nil, fmt.Errorf("edition %s: %w", edition.Name, err)
+		}
+		planned.Name = edition.Name
+		plan.Editions = append(plan.Editions, planned)
+		plan.Warnings = append(plan.Warnings, planWarnings(planned, edition.Name+": ")...)
+	}
+	return plan, nil
+}
+
+// planEdition plans the songs, table of contents, merged files and
+// watermarks of a build with the current config of the project
+func (s *projectService) planEdition(ctx context.Context, project *ent.Project, req BuildProjectRequest, vars *templatevars.Variables) (PlannedEdition, error) {
+	watermarks, err := projectWatermarks(project, vars, req.Final)
+	if err != nil {
+		return PlannedEdition{}, err
+	}
+
+	routing, err := projectFolderRouting(project)
+	if err != nil {
+		return PlannedEdition{}, err
+	}
+	edition := PlannedEdition{
+		FolderRouting: routing,
+		Songs:         make([]PlannedSong, 0, len(project.Edges.ProjectSongs)),
+	}
+	for _, ps := range project.Edges.ProjectSongs {
+		edition.Songs = append(edition.Songs, s.planSong(project, ps, ps.Number, req, vars, routing))
+	}
+	edition.TOC = s.planToc(ctx, project, vars, routing)
+	edition.MergedFiles = planMerges(project, edition.Songs, edition.TOC, req.SupplementSince != "", routing)
+	for _, watermark := range watermarks {
+		edition.Watermarks = append(edition.Watermarks, PlannedWatermark{
+			Name:    watermark.Name,
+			Text:    watermark.Text,
+			Image:   watermark.Image,
+			Folders: watermark.Folders,
+		})
+	}
+	return edition, nil
+}
+
+// planWarnings lists the missing ABC files and empty merged files of a
+// planned edition, each with the given prefix
+func planWarnings(edition PlannedEdition, prefix string) []string {
+	var warnings []string
+	for _, song := range edition.Songs {
+		if !song.ABCExists {
+			warnings = append(warnings, fmt.Sprintf("%s%02d %s: ABC file %s not found", prefix, song.Index, song.Title, song.ABCPath))
+		}
+	}
+	for _, merge := range edition.MergedFiles {
+		if len(merge.Files) == 0 {
+			warnings = append(warnings, fmt.Sprintf("%sno PDFs for folder %s, %s is not written", prefix, merge.Folder, merge.Path))
+		}
+	}
+	return warnings
+}
+
+// planSong resolves the config and the expected output of a single song
+func (s *projectService) planSong(project *ent.Project, ps *ent.ProjectSong, songIndex int, req BuildProjectRequest, vars *templatevars.Variables, routing *FolderRouting) PlannedSong {
+	song := ps.Edges.Song
+	planned := PlannedSong{
+		Index:         songIndex,
+		ProjectSongID: ps.ID,
+		SongID:        song.ID,
+		Title:         song.Title,
+		Filename:      song.Filename,
+		Priority:      ps.Priority,
+		Copyright:     song.Copyright,
+		ABCPath:       filepath.Join(req.AbcFileDir, song.Filename),
+		PDFs:          []PlannedPDF{},
+	}
+
+	abcFile, err := os.ReadFile(planned.ABCPath)
+	planned.ABCExists = err == nil
+
+	// Without the ABC file the project config is all that is known
+	config, err := s.resolveSongConfig(ps, songIndex, vars, abcFile)
+	if err != nil {
+		planned.Warnings = append(planned.Warnings, err.Error())
+		return planned
+	}
+	planned.Config = config
+	if !planned.ABCExists {
+		return planned
+	}
+
+	baseName := strings.TrimSuffix(song.Filename, ".abc")
+	names, extracts, warnings := zupfnoterPDFs(abcFile, config)
+	planned.Warnings = append(planned.Warnings, warnings...)
+	for i, name := range names {
+		planned.PDFs = append(planned.PDFs, planPDF(project, name, extracts[i], baseName, songIndex, routing, &planned.Warnings))
+	}
+
+	// HTML files next to the ABC file are converted with Chrome
+	htmlPath := filepath.Join(req.AbcFileDir, baseName+".html")
+	if _, err := os.Stat(htmlPath); err == nil {
+		planned.PDFs = append(planned.PDFs, planPDF(project, baseName+"_noten.pdf", "", baseName, songIndex, routing, &planned.Warnings))
+	}
+
+	if song.Copyright != "" {
+		for i := range planned.PDFs {
+			if planned.PDFs[i].Target != "" {
+				planned.PDFs[i].Reference = filepath.ToSlash(filepath.Join("referenz", song.Copyright, filepath.Base(planned.PDFs[i].Path)))
+			}
+		}
+	}
+
+	return planned
+}
+
+// planToc resolves the templates of the table of contents and the expected
+// PDFs of the table of contents and the registers
+func (s *projectService) planToc(ctx context.Context, project *ent.Project, vars *templatevars.Variables, routing *FolderRouting) PlannedTOC {
+	toc := PlannedTOC{PDFs: []PlannedPDF{}}
+	docs, err := projectTocDocuments(project)
+	if err != nil {
+		toc.Warnings = append(toc.Warnings, err.Error())
+	}
+	for _, doc := range docs[1:] {
+		toc.Registers = append(toc.Registers, doc.Title)
+	}
+
+	tocTemplate, err := s.resolveTemplate(ctx, project, TemplateKindTOC)
+	if err != nil {
+		toc.Warnings = append(toc.Warnings, err.Error())
+		tocTemplate = builtInTemplate(TemplateKindTOC)
+	}
+	toc.Template = tocTemplate.Origin()
+	template := []byte(tocTemplate.Content)
+
+	// The build fails on unknown variables in the templates
+	if expanded, err := vars.Expand(string(template)); err != nil {
+		toc.Warnings = append(toc.Warnings, err.Error())
+	} else {
+		template = []byte(expanded)
+	}
+
+	// Zupfnoter gets no config for the table of contents, only the one in the template
+	config, err := s.extractConfigFromABCFile(template)
+	if err != nil {
+		toc.Warnings = append(toc.Warnings, err.Error())
+	} else {
+		for _, doc := range docs {
+			abcFile := template
+			if doc.Register != "" {
+				abcFile = []byte(registerABCFilename(string(template), doc.BaseName))
+			}
+			// The registers share the template, its warnings are listed once
+			names, extracts, warnings := zupfnoterPDFs(abcFile, config)
+			if doc.Register == "" {
+				toc.Warnings = append(toc.Warnings, warnings...)
+			}
+			for i, name := range names {
+				toc.PDFs = append(toc.PDFs, planPDF(project, name, extracts[i], doc.BaseName, 0, routing, &toc.Warnings))
+			}
+		}
+	}
+
+	htmlTemplate, err := s.resolveTemplate(ctx, project, TemplateKindTOCHTML)
+	if err != nil {
+		toc.Warnings = append(toc.Warnings, err.Error())
+		htmlTemplate = builtInTemplate(TemplateKindTOCHTML)
+	}
+	toc.HTMLTemplate = htmlTemplate.Origin()
+
+	pageNumbers, err := tocPageNumbers(project)
+	if err != nil {
+		toc.Warnings = append(toc.Warnings, err.Error())
+	}
+	toc.PageNumbers = pageNumbers
+	if _, err := parseHTMLTocTemplate(htmlTemplate.Content, vars); err != nil {
+		toc.Warnings = append(toc.Warnings, err.Error())
+	}
+	for _, doc := range docs {
+		toc.PDFs = append(toc.PDFs, planPDF(project, doc.htmlPDF(), "", doc.BaseName, 0, routing, &toc.Warnings))
+	}
+
+	return toc
+}
+
+// planPDF determines where a PDF written to pdf/ is distributed to
+func planPDF(project *ent.Project, name, extract, baseName string, songIndex int, routing *FolderRouting, warnings *[]string) PlannedPDF {
+	pdf := PlannedPDF{
+		Path:    "pdf/" + name,
+		Extract: extract,
+	}
+
+	// Only PDFs named after the ABC file are picked up for distribution
+	if !strings.HasPrefix(name, baseName) {
+		*warnings = append(*warnings, fmt.Sprintf("%s does not start with %s and is not distributed", name, baseName))
+		return pdf
+	}
+
+	match := pdfFolders(name, routing)
+	if match.Excluded {
+		pdf.Excluded = true
+		return pdf
+	}
+	if len(match.Folders) == 0 {
+		*warnings = append(*warnings, fmt.Sprintf("%s matches no folder rule", name))
+		return pdf
+	}
+	for _, folder := range match.Folders {
+		pdf.Folders = append(pdf.Folders, folder)
+		pdf.Targets = append(pdf.Targets, filepath.ToSlash(filepath.Join("druckdateien", folder, fmt.Sprintf("%02d_%s", songIndex, name))))
+	}
+	pdf.Folder, pdf.Target = pdf.Folders[0], pdf.Targets[0]
+	return pdf
+}
+
+// planMerges lists the merged PDF of every druckdateien folder with its parts
+// in the order they are merged
+func planMerges(project *ent.Project, songs []PlannedSong, toc PlannedTOC, supplement bool, routing *FolderRouting) []PlannedMerge {
+	folders := routing.Folders()
+
+	files := make(map[string][]string)
+	addFiles := func(pdfs []PlannedPDF) {
+		for _, pdf := range pdfs {
+			for i, target := range pdf.Targets {
+				files[pdf.Folders[i]] = append(files[pdf.Folders[i]], target)
+			}
+		}
+	}
+	for _, song := range songs {
+		addFiles(song.PDFs)
+	}
+	addFiles(toc.PDFs)
+
+	merges := make([]PlannedMerge, 0, len(folders))
+	for _, folder := range folders {
+		parts := files[folder]
+		if parts == nil {
+			parts = []string{}
+		}
+		sort.Strings(parts)
+		merges = append(merges, PlannedMerge{
+			Path:   filepath.ToSlash(filepath.Join("druckdateien", mergedPDFName(project, folder, supplement))),
+			Folder: folder,
+			Files:  parts,
+		})
+	}
+	return merges
+}
+
+// zupfnoterPDFs predicts the PDFs zupfnoter writes for an ABC file. Zupfnoter
+// names them <F: field>_<filenamepart>_a3.pdf for every extract in produce;
+// the filename part defaults to the title of the extract.
+func zupfnoterPDFs(abcFile []byte, config map[string]any) (names, extracts, warnings []string) {
+	match := abcFilenamePattern.FindSubmatch(abcFile)
+	if match == nil || len(match[1]) == 0 {
+		return nil, nil, []string{"the ABC file has no F: line, zupfnoter does not render it"}
+	}
+	filename := string(match[1])
+	if strings.Contains(filename, "{{") {
+		return nil, nil, []string{"the ABC file is a template, zupfnoter does not render it"}
+	}
+
+	produce := []string{"0"}
+	if values, ok := config["produce"].([]any); ok {
+		produce = produce[:0]
+		for _, value := range values {
+			switch v := value.(type) {
+			case float64:
+				produce = append(produce, strconv.Itoa(int(v)))
+			case int:
+				produce = append(produce, strconv.Itoa(v))
+			case string:
+				produce = append(produce, v)
+			}
+		}
+	}
+
+	extractConfigs, _ := config["extract"].(map[string]any)
+	for _, id := range produce {
+		extract, _ := extractConfigs[id].(map[string]any)
+		title, _ := extract["title"].(string)
+		if title == "" {
+			title = zupfnoterDefaultExtractTitles[id]
+		}
+		if title == "" {
+			warnings = append(warnings, fmt.Sprintf("extract %s has no title, zupfnoter skips it", id))
+			continue
+		}
+
+		part, _ := extract["filenamepart"].(string)
+		if part == "" {
+			part = title
+		}
+		part = filenamePartReplacer.ReplaceAllString(strings.TrimSpace(part), "_")
+
+		names = append(names, fmt.Sprintf("%s_%s_a3.pdf", filename, part))
+		extracts = append(extracts, id)
+	}
+	return names, extracts, warnings
+}
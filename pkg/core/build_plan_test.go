@@ -0,0 +1,151 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZupfnoterPDFs(t *testing.T) {
+	// Without produce zupfnoter renders extract 0 with its default title
+	names, extracts, warnings := zupfnoterPDFs([]byte("X:1\nF:01_song\nT:Song\n"), map[string]any{})
+	assert.Equal(t, []string{"01_song_alle_Stimmen_a3.pdf"}, names)
+	assert.Equal(t, []string{"0"}, extracts)
+	assert.Empty(t, warnings)
+
+	config := map[string]any{
+		"produce": []any{float64(1), float64(3), float64(7)},
+		"extract": map[string]any{
+			"1": map[string]any{"title": "Sopran, Alt", "filenamepart": "-A1"},
+			"3": map[string]any{"title": "Melodie"},
+		},
+	}
+	names, extracts, warnings = zupfnoterPDFs([]byte("X:1\nF: 01_song \r\n"), config)
+	assert.Equal(t, []string{"01_song_-A1_a3.pdf", "01_song_Melodie_a3.pdf"}, names)
+	assert.Equal(t, []string{"1", "3"}, extracts)
+	assert.Equal(t, []string{"extract 7 has no title, zupfnoter skips it"}, warnings)
+
+	names, _, warnings = zupfnoterPDFs([]byte("X:1\nT:Song\n"), config)
+	assert.Empty(t, names)
+	assert.Len(t, warnings, 1)
+}
+
+func TestProjectService_PlanProjectBuild(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Plan Project",
+		ShortName: "PLAN",
+		Config: map[string]interface{}{
+			"produce": []interface{}{1},
+			"extract": map[string]interface{}{
+				"1": map[string]interface{}{
+					"title":        "#{PREFIX} #{the_index}",
+					"filenamepart": "-A1",
+				},
+			},
+			"folderPatterns": map[string]interface{}{
+				"*_-A*_a3.pdf": "klein",
+				"*_noten.pdf":  "noten",
+			},
+			"numbering": map[string]interface{}{"mode": "sequential"},
+		},
+	})
+	require.NoError(t, err)
+
+	abcDir := t.TempDir()
+	songs := []struct {
+		title    string
+		filename string
+		priority int
+		write    bool
+	}{
+		{"Zebra", "zebra.abc", 1, true},
+		{"alpha", "alpha.abc", 2, true},
+		{"Missing", "missing.abc", 1, false},
+		{"Optional", "optional.abc", 3, true},
+	}
+	for _, s := range songs {
+		if s.write {
+			content := "X:1\nF:" + s.filename[:len(s.filename)-4] + "\nT:" + s.title + "\n"
+			require.NoError(t, os.WriteFile(filepath.Join(abcDir, s.filename), []byte(content), 0644))
+		}
+		song, err := services.DB().Song.Create().SetTitle(s.title).SetFilename(s.filename).Save(ctx)
+		require.NoError(t, err)
+		priority := s.priority
+		_, err = services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+			ProjectID: project.ID,
+			SongID:    song.ID,
+			Priority:  &priority,
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(abcDir, "alpha.html"), []byte("<p>alpha</p>"), 0644))
+
+	outputDir := filepath.Join(t.TempDir(), "PLAN")
+	result, err := services.Project.RunProjectBuild(ctx, BuildProjectRequest{
+		ProjectID:         project.ID,
+		AbcFileDir:        abcDir,
+		OutputDir:         outputDir,
+		PriorityThreshold: 2,
+		DryRun:            true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, BuildStatusDryRun, result.Status)
+	require.NotNil(t, result.Plan)
+	plan := result.Plan
+
+	// Songs are sorted by title regardless of case, optional songs are left out
+	require.Len(t, plan.Songs, 3)
+	assert.Equal(t, "alpha", plan.Songs[0].Title)
+	assert.Equal(t, "Missing", plan.Songs[1].Title)
+	assert.Equal(t, "Zebra", plan.Songs[2].Title)
+	for i, song := range plan.Songs {
+		assert.Equal(t, i+1, song.Index)
+	}
+
+	alpha := plan.Songs[0]
+	assert.True(t, alpha.ABCExists)
+	assert.Equal(t, filepath.Join(abcDir, "alpha.abc"), alpha.ABCPath)
+	extract := alpha.Config["extract"].(map[string]any)["1"].(map[string]any)
+	assert.Equal(t, "PLAN 01", extract["title"])
+	assert.Equal(t, []PlannedPDF{
+		{Path: "pdf/alpha_-A1_a3.pdf", Extract: "1", Folder: "klein", Target: "druckdateien/klein/01_alpha_-A1_a3.pdf",
+			Folders: []string{"klein"}, Targets: []string{"druckdateien/klein/01_alpha_-A1_a3.pdf"}},
+		{Path: "pdf/alpha_noten.pdf", Folder: "noten", Target: "druckdateien/noten/01_alpha_noten.pdf",
+			Folders: []string{"noten"}, Targets: []string{"druckdateien/noten/01_alpha_noten.pdf"}},
+	}, alpha.PDFs)
+
+	missing := plan.Songs[1]
+	assert.False(t, missing.ABCExists)
+	assert.Empty(t, missing.PDFs)
+	assert.Contains(t, plan.Warnings, "02 Missing: ABC file "+missing.ABCPath+" not found")
+
+	// Without templates of its own the project uses the built-in ones
+	assert.Equal(t, BuiltInTemplate, plan.TOC.Template)
+	assert.Equal(t, BuiltInTemplate, plan.TOC.HTMLTemplate)
+
+	require.Len(t, plan.MergedFiles, 2)
+	assert.Equal(t, PlannedMerge{
+		Path:   "druckdateien/PLAN_klein.pdf",
+		Folder: "klein",
+		Files:  []string{"druckdateien/klein/01_alpha_-A1_a3.pdf", "druckdateien/klein/03_zebra_-A1_a3.pdf"},
+	}, plan.MergedFiles[0])
+	assert.Equal(t, PlannedMerge{
+		Path:   "druckdateien/PLAN_noten.pdf",
+		Folder: "noten",
+		Files:  []string{"druckdateien/noten/00_00_inhaltsverzeichnis_noten.pdf", "druckdateien/noten/01_alpha_noten.pdf"},
+	}, plan.MergedFiles[1])
+
+	// A dry run neither records a build nor writes anything
+	builds, err := services.Project.ListBuilds(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Empty(t, builds)
+	assert.NoDirExists(t, outputDir)
+}
@@ -0,0 +1,319 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/ent/buildprofile"
+	"github.com/bwl21/zupfmanager/internal/ent/project"
+)
+
+// ErrBuildProfileNotFound is returned for a profile that does not belong to the project
+var ErrBuildProfileNotFound = errors.New("build profile not found")
+
+// DefaultPriorityThreshold is the priority threshold of builds that neither
+// set one nor use a profile that does: all songs are built
+const DefaultPriorityThreshold = 4
+
+// ValidateBuildProfileRequest validates a create or update build profile request
+func ValidateBuildProfileRequest(req BuildProfileRequest) error {
+	var errors ValidationErrors
+
+	if strings.TrimSpace(req.Name) == "" {
+		errors = append(errors, ValidationError{
+			Field:   "name",
+			Message: "name is required",
+		})
+	}
+	if req.PriorityThreshold != 0 && (req.PriorityThreshold < 1 || req.PriorityThreshold > 4) {
+		errors = append(errors, ValidationError{
+			Field:   "priority_threshold",
+			Message: "priority_threshold must be between 1 and 4",
+		})
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+	return nil
+}
+
+// ListBuildProfiles returns the build profiles of a project ordered by name
+func (s *projectService) ListBuildProfiles(ctx context.Context, projectID int) ([]*BuildProfile, error) {
+	if err := s.checkProjectExists(ctx, projectID); err != nil {
+		return nil, err
+	}
+	entProfiles, err := s.db.BuildProfile.Query().
+		Where(buildprofile.ProjectID(projectID)).
+		Order(ent.Asc(buildprofile.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return BuildProfilesFromEnt(entProfiles), nil
+}
+
+// GetBuildProfile returns a build profile of a project
+func (s *projectService) GetBuildProfile(ctx context.Context, projectID, profileID int) (*BuildProfile, error) {
+	entProfile, err := s.getBuildProfile(ctx, projectID, profileID)
+	if err != nil {
+		return nil, err
+	}
+	return BuildProfileFromEnt(entProfile), nil
+}
+
+// CreateBuildProfile adds a build profile to a project. A new default
+// profile replaces the previous one.
+func (s *projectService) CreateBuildProfile(ctx context.Context, req BuildProfileRequest) (*BuildProfile, error) {
+	if err := ValidateBuildProfileRequest(req); err != nil {
+		return nil, err
+	}
+	if err := s.checkProjectExists(ctx, req.ProjectID); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.IsDefault {
+		if err := clearDefaultBuildProfile(ctx, tx, req.ProjectID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	entProfile, err := tx.BuildProfile.Create().
+		SetProjectID(req.ProjectID).
+		SetName(strings.TrimSpace(req.Name)).
+		SetOutputDir(req.OutputDir).
+		SetAbcFileDir(req.AbcFileDir).
+		SetPriorityThreshold(req.PriorityThreshold).
+		SetSampleID(req.SampleID).
+		SetConfig(req.Config).
+		SetIsDefault(req.IsDefault).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, buildProfileSaveError(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return BuildProfileFromEnt(entProfile), nil
+}
+
+// UpdateBuildProfile replaces the settings of a build profile
+func (s *projectService) UpdateBuildProfile(ctx context.Context, profileID int, req BuildProfileRequest) (*BuildProfile, error) {
+	if err := ValidateBuildProfileRequest(req); err != nil {
+		return nil, err
+	}
+	entProfile, err := s.getBuildProfile(ctx, req.ProjectID, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.IsDefault {
+		if err := clearDefaultBuildProfile(ctx, tx, req.ProjectID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	update := tx.BuildProfile.UpdateOne(entProfile).
+		SetName(strings.TrimSpace(req.Name)).
+		SetOutputDir(req.OutputDir).
+		SetAbcFileDir(req.AbcFileDir).
+		SetPriorityThreshold(req.PriorityThreshold).
+		SetSampleID(req.SampleID).
+		SetIsDefault(req.IsDefault)
+	if req.Config != nil {
+		update.SetConfig(req.Config)
+	} else {
+		update.ClearConfig()
+	}
+	entProfile, err = update.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, buildProfileSaveError(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return BuildProfileFromEnt(entProfile), nil
+}
+
+// DeleteBuildProfile removes a build profile. Builds made with it keep its name.
+func (s *projectService) DeleteBuildProfile(ctx context.Context, projectID, profileID int) error {
+	entProfile, err := s.getBuildProfile(ctx, projectID, profileID)
+	if err != nil {
+		return err
+	}
+	return s.db.BuildProfile.DeleteOne(entProfile).Exec(ctx)
+}
+
+// GetBuildDefaults returns the parameters a build of the project uses when
+// the build request leaves them out: those of the default profile, or the
+// project defaults where the profile sets nothing
+func (s *projectService) GetBuildDefaults(ctx context.Context, projectID int) (*BuildDefaults, error) {
+	entProject, err := s.db.Project.Get(ctx, projectID)
+	if ent.IsNotFound(err) {
+		return nil, ErrProjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.applyBuildProfile(ctx, BuildProjectRequest{ProjectID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	defaults := &BuildDefaults{
+		Profile:           req.Profile,
+		OutputDir:         req.OutputDir,
+		AbcFileDir:        req.AbcFileDir,
+		PriorityThreshold: req.PriorityThreshold,
+		SampleID:          req.SampleID,
+	}
+	if defaults.OutputDir == "" {
+		defaults.OutputDir = defaultOutputDir(entProject, false)
+	}
+	if defaults.AbcFileDir == "" {
+		defaults.AbcFileDir = defaultAbcFileDir(entProject)
+	}
+	if defaults.PriorityThreshold == 0 {
+		defaults.PriorityThreshold = DefaultPriorityThreshold
+	}
+	return defaults, nil
+}
+
+// applyBuildProfile fills the parameters a build request leaves out from the
+// profile it names, or from the default profile of the project if it names
+// none. The name of the applied profile is recorded in the request.
+func (s *projectService) applyBuildProfile(ctx context.Context, req BuildProjectRequest) (BuildProjectRequest, error) {
+	query := s.db.BuildProfile.Query().Where(buildprofile.ProjectID(req.ProjectID))
+	if req.Profile != "" {
+		query.Where(buildprofile.Name(req.Profile))
+	} else {
+		query.Where(buildprofile.IsDefault(true))
+	}
+	entProfile, err := query.First(ctx)
+	if ent.IsNotFound(err) {
+		if req.Profile != "" {
+			return req, ValidationErrors{{
+				Field:   "profile",
+				Message: fmt.Sprintf("build profile %q does not exist", req.Profile),
+			}}
+		}
+		return req, nil
+	}
+	if err != nil {
+		return req, err
+	}
+
+	req.Profile = entProfile.Name
+	if req.OutputDir == "" && entProfile.OutputDir != "" {
+		req.OutputDir = entProfile.OutputDir
+		// Supplements must not replace the latest build of the profile
+		if req.SupplementSince != "" {
+			req.OutputDir = filepath.Join(entProfile.OutputDir, supplementOutputDir)
+		}
+	}
+	if req.AbcFileDir == "" {
+		req.AbcFileDir = entProfile.AbcFileDir
+	}
+	if req.PriorityThreshold == 0 {
+		req.PriorityThreshold = entProfile.PriorityThreshold
+	}
+	if req.SampleID == "" {
+		req.SampleID = entProfile.SampleID
+	}
+	return req, nil
+}
+
+// applyProfileConfig merges the config overlay of the build profile over the
+// config of a loaded build project and its songs
+func (s *projectService) applyProfileConfig(ctx context.Context, project *ent.Project, profileName string) error {
+	if profileName == "" {
+		return nil
+	}
+	entProfile, err := s.db.BuildProfile.Query().
+		Where(buildprofile.ProjectID(project.ID), buildprofile.Name(profileName)).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get build profile %q: %w", profileName, err)
+	}
+	if len(entProfile.Config) == 0 {
+		return nil
+	}
+
+	useBuildConfig(project, overlayConfig(project.Config, entProfile.Config))
+	return nil
+}
+
+// overlayConfig returns a copy of base with the values of overlay. Nested
+// objects are merged, every other value of overlay replaces the one in base.
+func overlayConfig(base, overlay map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base)+len(overlay))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range overlay {
+		baseMap, baseIsMap := merged[key].(map[string]interface{})
+		overlayMap, overlayIsMap := value.(map[string]interface{})
+		if baseIsMap && overlayIsMap {
+			merged[key] = overlayConfig(baseMap, overlayMap)
+		} else {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+// getBuildProfile returns a build profile of a project
+func (s *projectService) getBuildProfile(ctx context.Context, projectID, profileID int) (*ent.BuildProfile, error) {
+	entProfile, err := s.db.BuildProfile.Query().
+		Where(buildprofile.ID(profileID), buildprofile.ProjectID(projectID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrBuildProfileNotFound
+	}
+	return entProfile, err
+}
+
+// checkProjectExists returns ErrProjectNotFound for unknown projects
+func (s *projectService) checkProjectExists(ctx context.Context, projectID int) error {
+	exists, err := s.db.Project.Query().Where(project.ID(projectID)).Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrProjectNotFound
+	}
+	return nil
+}
+
+// clearDefaultBuildProfile removes the default flag from all profiles of a project
+func clearDefaultBuildProfile(ctx context.Context, tx *ent.Tx, projectID int) error {
+	return tx.BuildProfile.Update().
+		Where(buildprofile.ProjectID(projectID), buildprofile.IsDefault(true)).
+		SetIsDefault(false).
+		Exec(ctx)
+}
+
+// buildProfileSaveError reports a duplicate profile name as validation error
+func buildProfileSaveError(err error) error {
+	if ent.IsConstraintError(err) {
+		return ValidationErrors{{
+			Field:   "name",
+			Message: "a build profile with this name already exists in the project",
+		}}
+	}
+	return fmt.Errorf("failed to save build profile: %w", err)
+}
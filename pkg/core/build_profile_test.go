@@ -0,0 +1,156 @@
+package core
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverlayConfig(t *testing.T) {
+	base := map[string]interface{}{
+		"produce": []interface{}{1, 2},
+		"extract": map[string]interface{}{
+			"0": map[string]interface{}{"title": "alle Stimmen", "filenamepart": "-A0"},
+		},
+	}
+	merged := overlayConfig(base, map[string]interface{}{
+		"produce": []interface{}{1},
+		"extract": map[string]interface{}{
+			"0": map[string]interface{}{"title": "Probedruck"},
+		},
+	})
+
+	assert.Equal(t, []interface{}{1}, merged["produce"])
+	assert.Equal(t, map[string]interface{}{"title": "Probedruck", "filenamepart": "-A0"}, merged["extract"].(map[string]interface{})["0"])
+	// The base config is left unchanged
+	assert.Equal(t, "alle Stimmen", base["extract"].(map[string]interface{})["0"].(map[string]interface{})["title"])
+}
+
+func TestProjectService_BuildProfiles(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Profile Project",
+		ShortName: "PRO",
+		Config: map[string]interface{}{
+			"produce": []interface{}{1},
+			"extract": map[string]interface{}{
+				"1": map[string]interface{}{"title": "#{sampleId}", "filenamepart": "-A1"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	abcDir := t.TempDir()
+	song, err := services.DB().Song.Create().SetTitle("Alpha").SetFilename("alpha.abc").Save(ctx)
+	require.NoError(t, err)
+	priority := 3
+	_, err = services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+		ProjectID: project.ID,
+		SongID:    song.ID,
+		Priority:  &priority,
+	})
+	require.NoError(t, err)
+
+	// Without profiles the project defaults apply
+	defaults, err := services.Project.GetBuildDefaults(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &BuildDefaults{OutputDir: "PRO", AbcFileDir: defaults.AbcFileDir, PriorityThreshold: DefaultPriorityThreshold}, defaults)
+
+	probedruck, err := services.Project.CreateBuildProfile(ctx, BuildProfileRequest{
+		ProjectID:         project.ID,
+		Name:              "Probedruck",
+		AbcFileDir:        abcDir,
+		PriorityThreshold: 1,
+		SampleID:          "draft",
+		IsDefault:         true,
+	})
+	require.NoError(t, err)
+	teilnehmer, err := services.Project.CreateBuildProfile(ctx, BuildProfileRequest{
+		ProjectID:         project.ID,
+		Name:              "Teilnehmer",
+		OutputDir:         filepath.Join("PRO", "teilnehmer"),
+		PriorityThreshold: 3,
+		Config: map[string]interface{}{
+			"extract": map[string]interface{}{
+				"1": map[string]interface{}{"title": "Teilnehmer"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = services.Project.CreateBuildProfile(ctx, BuildProfileRequest{ProjectID: project.ID, Name: "Probedruck"})
+	assert.IsType(t, ValidationErrors{}, err)
+	_, err = services.Project.CreateBuildProfile(ctx, BuildProfileRequest{ProjectID: project.ID, Name: " ", PriorityThreshold: 5})
+	require.IsType(t, ValidationErrors{}, err)
+	assert.Len(t, err.(ValidationErrors), 2)
+	_, err = services.Project.CreateBuildProfile(ctx, BuildProfileRequest{ProjectID: 999, Name: "Referent"})
+	assert.Equal(t, ErrProjectNotFound, err)
+
+	defaults, err = services.Project.GetBuildDefaults(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &BuildDefaults{Profile: "Probedruck", OutputDir: "PRO", AbcFileDir: abcDir, PriorityThreshold: 1, SampleID: "draft"}, defaults)
+
+	// Builds without a profile use the default profile
+	plan, err := services.Project.PlanProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "Probedruck", plan.Profile)
+	assert.Equal(t, abcDir, plan.AbcFileDir)
+	assert.Empty(t, plan.Songs)
+
+	// Request parameters win over the profile, its config overlay is merged over the project config
+	plan, err = services.Project.PlanProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID, Profile: "Teilnehmer", AbcFileDir: abcDir, SampleID: "final"})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("PRO", "teilnehmer"), plan.OutputDir)
+	assert.Equal(t, 3, plan.PriorityThreshold)
+	assert.Equal(t, "final", plan.SampleID)
+	require.Len(t, plan.Songs, 1)
+	assert.Equal(t, "Teilnehmer", plan.Songs[0].Config["extract"].(map[string]any)["1"].(map[string]any)["title"])
+	assert.Equal(t, "-A1", plan.Songs[0].Config["extract"].(map[string]any)["1"].(map[string]any)["filenamepart"])
+
+	_, err = services.Project.PlanProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID, Profile: "Referent"})
+	assert.IsType(t, ValidationErrors{}, err)
+
+	// A new default profile replaces the previous one
+	_, err = services.Project.UpdateBuildProfile(ctx, teilnehmer.ID, BuildProfileRequest{
+		ProjectID: project.ID,
+		Name:      "Teilnehmer",
+		IsDefault: true,
+	})
+	require.NoError(t, err)
+	probedruckNow, err := services.Project.GetBuildProfile(ctx, project.ID, probedruck.ID)
+	require.NoError(t, err)
+	assert.False(t, probedruckNow.IsDefault)
+
+	profiles, err := services.Project.ListBuildProfiles(ctx, project.ID)
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+	assert.Equal(t, "Probedruck", profiles[0].Name)
+	assert.True(t, profiles[1].IsDefault)
+	assert.Empty(t, profiles[1].Config)
+
+	require.NoError(t, services.Project.DeleteBuildProfile(ctx, project.ID, probedruck.ID))
+	assert.Equal(t, ErrBuildProfileNotFound, services.Project.DeleteBuildProfile(ctx, project.ID, probedruck.ID))
+	_, err = services.Project.GetBuildProfile(ctx, 999, teilnehmer.ID)
+	assert.Equal(t, ErrBuildProfileNotFound, err)
+
+	// The fallback threshold only applies if no profile sets one
+	plan, err = services.Project.PlanProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID, FallbackPriorityThreshold: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, plan.PriorityThreshold)
+	_, err = services.Project.UpdateBuildProfile(ctx, teilnehmer.ID, BuildProfileRequest{
+		ProjectID:         project.ID,
+		Name:              "Teilnehmer",
+		PriorityThreshold: 3,
+		IsDefault:         true,
+	})
+	require.NoError(t, err)
+	plan, err = services.Project.PlanProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID, FallbackPriorityThreshold: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 3, plan.PriorityThreshold)
+}
@@ -0,0 +1,189 @@
+package core
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultMaxConcurrentBuilds is the number of builds that may run at the same time
+// unless configured otherwise. Every build starts several node and Chrome processes,
+// so this is kept deliberately low.
+const DefaultMaxConcurrentBuilds = 2
+
+// BuildJob is the work function executed by the build queue
+type BuildJob func(ctx context.Context)
+
+// queuedBuild is a build waiting in or running from the build queue
+type queuedBuild struct {
+	buildID   string
+	projectID int
+	job       BuildJob
+	cancel    context.CancelCauseFunc // set once the build is running
+}
+
+// BuildQueue schedules project builds with a server-wide limit of concurrent builds.
+//
+// Builds are started in FIFO order. A queued build is passed over as long as another
+// build of the same project is running, so two builds never write into the same
+// output directory at the same time. All methods are safe for concurrent use.
+type BuildQueue struct {
+	ctx        context.Context
+	mu         sync.Mutex
+	maxWorkers int
+	pending    []*queuedBuild
+	running    map[string]*queuedBuild
+	busy       map[int]bool // projects with a running build
+	wg         sync.WaitGroup
+}
+
+// NewBuildQueue creates a new build queue. Jobs receive a context derived from ctx.
+func NewBuildQueue(ctx context.Context, maxWorkers int) *BuildQueue {
+	if maxWorkers < 1 {
+		maxWorkers = DefaultMaxConcurrentBuilds
+	}
+	return &BuildQueue{
+		ctx:        ctx,
+		maxWorkers: maxWorkers,
+		running:    make(map[string]*queuedBuild),
+		busy:       make(map[int]bool),
+	}
+}
+
+// Enqueue adds a build to the queue and starts it as soon as a worker is free.
+// It returns the queue position of the build, or 0 if it was started right away.
+func (q *BuildQueue) Enqueue(buildID string, projectID int, job BuildJob) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.pending = append(q.pending, &queuedBuild{
+		buildID:   buildID,
+		projectID: projectID,
+		job:       job,
+	})
+	q.dispatchLocked()
+
+	return q.positionLocked(buildID)
+}
+
+// Position returns the 1-based position of a build in the queue.
+// It returns 0 if the build is running or unknown to the queue.
+func (q *BuildQueue) Position(buildID string) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.positionLocked(buildID)
+}
+
+// IsRunning reports whether the given build is currently executed by a worker
+func (q *BuildQueue) IsRunning(buildID string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	_, ok := q.running[buildID]
+	return ok
+}
+
+// Len returns the number of queued and running builds
+func (q *BuildQueue) Len() (queued, running int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending), len(q.running)
+}
+
+// MaxWorkers returns the maximum number of concurrent builds
+func (q *BuildQueue) MaxWorkers() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.maxWorkers
+}
+
+// SetMaxWorkers changes the maximum number of concurrent builds.
+// Running builds are not affected if the limit is lowered.
+func (q *BuildQueue) SetMaxWorkers(maxWorkers int) {
+	if maxWorkers < 1 {
+		maxWorkers = 1
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.maxWorkers = maxWorkers
+	q.dispatchLocked()
+}
+
+// Cancel stops a build. A queued build is removed from the queue and will never
+// run, a running build gets its context cancelled with ErrBuildCancelled as cause.
+// dequeued reports whether the build was still waiting, found whether the queue
+// knew the build at all.
+func (q *BuildQueue) Cancel(buildID string) (dequeued bool, found bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, qb := range q.pending {
+		if qb.buildID == buildID {
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			return true, true
+		}
+	}
+
+	if qb, ok := q.running[buildID]; ok {
+		qb.cancel(ErrBuildCancelled)
+		return false, true
+	}
+
+	return false, false
+}
+
+// Wait blocks until all started builds have finished
+func (q *BuildQueue) Wait() {
+	q.wg.Wait()
+}
+
+// positionLocked returns the queue position of a build; q.mu must be held
+func (q *BuildQueue) positionLocked(buildID string) int {
+	for i, qb := range q.pending {
+		if qb.buildID == buildID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// dispatchLocked starts queued builds while workers are available; q.mu must be held
+func (q *BuildQueue) dispatchLocked() {
+	for len(q.running) < q.maxWorkers {
+		next := -1
+		for i, qb := range q.pending {
+			if !q.busy[qb.projectID] {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			return
+		}
+
+		qb := q.pending[next]
+		q.pending = append(q.pending[:next], q.pending[next+1:]...)
+		q.running[qb.buildID] = qb
+		q.busy[qb.projectID] = true
+
+		ctx, cancel := context.WithCancelCause(q.ctx)
+		qb.cancel = cancel
+
+		q.wg.Add(1)
+		go q.run(ctx, qb)
+	}
+}
+
+// run executes a build job and frees its worker afterwards
+func (q *BuildQueue) run(ctx context.Context, qb *queuedBuild) {
+	defer q.wg.Done()
+	defer func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		qb.cancel(nil)
+		delete(q.running, qb.buildID)
+		delete(q.busy, qb.projectID)
+		q.dispatchLocked()
+	}()
+
+	qb.job(ctx)
+}
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingJob returns a job that signals its start and blocks until released
+func blockingJob(started chan<- string, release <-chan struct{}, id string) BuildJob {
+	return func(ctx context.Context) {
+		started <- id
+		<-release
+	}
+}
+
+func TestBuildQueue_RespectsWorkerLimit(t *testing.T) {
+	queue := NewBuildQueue(context.Background(), 2)
+
+	var current, peak int32
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		queue.Enqueue(string(rune('a'+i)), i+1, func(ctx context.Context) {
+			defer wg.Done()
+			n := atomic.AddInt32(&current, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&current, -1)
+		})
+	}
+	wg.Wait()
+	queue.Wait()
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(2))
+	queued, running := queue.Len()
+	assert.Equal(t, 0, queued)
+	assert.Equal(t, 0, running)
+}
+
+func TestBuildQueue_FIFOAndPositions(t *testing.T) {
+	queue := NewBuildQueue(context.Background(), 1)
+	started := make(chan string, 3)
+	release := make(chan struct{})
+
+	assert.Equal(t, 0, queue.Enqueue("first", 1, blockingJob(started, release, "first")))
+	assert.Equal(t, "first", <-started)
+
+	assert.Equal(t, 1, queue.Enqueue("second", 2, blockingJob(started, release, "second")))
+	assert.Equal(t, 2, queue.Enqueue("third", 3, blockingJob(started, release, "third")))
+	assert.True(t, queue.IsRunning("first"))
+	assert.Equal(t, 2, queue.Position("third"))
+
+	release <- struct{}{}
+	assert.Equal(t, "second", <-started)
+	assert.Equal(t, 1, queue.Position("third"))
+
+	release <- struct{}{}
+	assert.Equal(t, "third", <-started)
+	assert.Equal(t, 0, queue.Position("third"))
+
+	release <- struct{}{}
+	queue.Wait()
+}
+
+func TestBuildQueue_SerializesBuildsOfSameProject(t *testing.T) {
+	queue := NewBuildQueue(context.Background(), 3)
+	started := make(chan string, 3)
+	release := make(chan struct{})
+
+	queue.Enqueue("p1-a", 1, blockingJob(started, release, "p1-a"))
+	require.Equal(t, "p1-a", <-started)
+
+	// The second build of project 1 has to wait although workers are free
+	assert.Equal(t, 1, queue.Enqueue("p1-b", 1, blockingJob(started, release, "p1-b")))
+
+	// A build of another project overtakes it
+	assert.Equal(t, 0, queue.Enqueue("p2-a", 2, blockingJob(started, release, "p2-a")))
+	require.Equal(t, "p2-a", <-started)
+	assert.Equal(t, 1, queue.Position("p1-b"))
+
+	release <- struct{}{}
+	release <- struct{}{}
+	require.Equal(t, "p1-b", <-started)
+
+	release <- struct{}{}
+	queue.Wait()
+}
+
+func TestBuildQueue_Cancel(t *testing.T) {
+	queue := NewBuildQueue(context.Background(), 1)
+	started := make(chan string, 2)
+	cancelled := make(chan error, 1)
+
+	queue.Enqueue("running", 1, func(ctx context.Context) {
+		started <- "running"
+		<-ctx.Done()
+		cancelled <- context.Cause(ctx)
+	})
+	require.Equal(t, "running", <-started)
+
+	queue.Enqueue("waiting", 2, func(ctx context.Context) {
+		started <- "waiting"
+	})
+
+	dequeued, found := queue.Cancel("waiting")
+	assert.True(t, dequeued)
+	assert.True(t, found)
+	assert.Equal(t, 0, queue.Position("waiting"))
+
+	dequeued, found = queue.Cancel("running")
+	assert.False(t, dequeued)
+	assert.True(t, found)
+	assert.ErrorIs(t, <-cancelled, ErrBuildCancelled)
+
+	queue.Wait()
+	assert.Empty(t, started, "cancelled queued build must not run")
+
+	_, found = queue.Cancel("unknown")
+	assert.False(t, found)
+}
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"time"
+
 	"github.com/bwl21/zupfmanager/internal/ent"
 )
 
@@ -16,6 +18,8 @@ func ProjectFromEnt(entProject *ent.Project) *Project {
 		ShortName:            entProject.ShortName,
 		Config:               entProject.Config,
 		AbcFileDirPreference: entProject.AbcFileDirPreference,
+		Variables:            entProject.Variables,
+		ReservedNumbers:      entProject.ReservedNumbers,
 	}
 }
 
@@ -65,6 +69,8 @@ func ProjectSongFromEnt(entProjectSong *ent.ProjectSong) *ProjectSong {
 		SongID:     entProjectSong.SongID,
 		Difficulty: string(entProjectSong.Difficulty),
 		Priority:   entProjectSong.Priority,
+		Position:   entProjectSong.Position,
+		Number:     entProjectSong.Number,
 		Comment:    &entProjectSong.Comment,
 	}
 	
@@ -87,3 +93,86 @@ func ProjectSongsFromEnt(entProjectSongs []*ent.ProjectSong) []*ProjectSong {
 	}
 	return projectSongs
 }
+
+// BuildStatusFromEnt converts an ent.Build to a domain BuildStatus
+func BuildStatusFromEnt(entBuild *ent.Build) *BuildStatus {
+	if entBuild == nil {
+		return nil
+	}
+
+	status := &BuildStatus{
+		Status:    string(entBuild.Status),
+		Progress:  entBuild.Progress,
+		Message:   entBuild.Message,
+		StartedAt: entBuild.StartedAt.Format(time.RFC3339),
+		Error:     entBuild.Error,
+	}
+	if entBuild.CompletedAt != nil {
+		status.CompletedAt = entBuild.CompletedAt.Format(time.RFC3339)
+	}
+
+	return status
+}
+
+// BuildResultFromEnt converts an ent.Build to a domain BuildResult
+func BuildResultFromEnt(entBuild *ent.Build) *BuildResult {
+	if entBuild == nil {
+		return nil
+	}
+
+	result := &BuildResult{
+		BuildID:        entBuild.BuildID,
+		ProjectID:      entBuild.ProjectID,
+		Status:         string(entBuild.Status),
+		OutputDir:      entBuild.OutputDir,
+		BuildDir:       entBuild.BuildDir,
+		Profile:        entBuild.Profile,
+		GeneratedFiles: entBuild.GeneratedFiles,
+		StartedAt:      entBuild.StartedAt.Format(time.RFC3339),
+		Error:          entBuild.Error,
+		Report:         entBuild.Report,
+		Manifest:       entBuild.Manifest,
+	}
+	if entBuild.CompletedAt != nil {
+		result.CompletedAt = entBuild.CompletedAt.Format(time.RFC3339)
+	}
+
+	return result
+}
+
+// BuildResultsFromEnt converts a slice of ent.Build to domain BuildResults
+func BuildResultsFromEnt(entBuilds []*ent.Build) []*BuildResult {
+	results := make([]*BuildResult, len(entBuilds))
+	for i, entBuild := range entBuilds {
+		results[i] = BuildResultFromEnt(entBuild)
+	}
+	return results
+}
+
+// BuildProfileFromEnt converts an ent.BuildProfile to a domain BuildProfile
+func BuildProfileFromEnt(entProfile *ent.BuildProfile) *BuildProfile {
+	if entProfile == nil {
+		return nil
+	}
+
+	return &BuildProfile{
+		ID:                entProfile.ID,
+		ProjectID:         entProfile.ProjectID,
+		Name:              entProfile.Name,
+		OutputDir:         entProfile.OutputDir,
+		AbcFileDir:        entProfile.AbcFileDir,
+		PriorityThreshold: entProfile.PriorityThreshold,
+		SampleID:          entProfile.SampleID,
+		Config:            entProfile.Config,
+		IsDefault:         entProfile.IsDefault,
+	}
+}
+
+// BuildProfilesFromEnt converts a slice of ent.BuildProfile to domain BuildProfiles
+func BuildProfilesFromEnt(entProfiles []*ent.BuildProfile) []*BuildProfile {
+	profiles := make([]*BuildProfile, len(entProfiles))
+	for i, entProfile := range entProfiles {
+		profiles[i] = BuildProfileFromEnt(entProfile)
+	}
+	return profiles
+}
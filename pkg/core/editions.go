@@ -0,0 +1,101 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+)
+
+// editionsConfigKey is the key of the project config that holds the editions
+// of a project, e.g. {"teilnehmer": {...}, "lehrer": {...}}. Every edition is
+// a config overlay, merged over the project config like that of a build profile.
+const editionsConfigKey = "editions"
+
+// editionNamePattern restricts edition names to names usable as directory
+var editionNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// buildEdition is a variant of a project that a build writes into a
+// directory of its own, named after the edition
+type buildEdition struct {
+	Name   string
+	Config map[string]any // project config with the overlay of the edition
+}
+
+// projectEditions returns the editions of a project in name order with their
+// resolved configs, nil for projects without editions
+func projectEditions(project *ent.Project) ([]buildEdition, error) {
+	value, ok := project.Config[editionsConfigKey]
+	if !ok || value == nil {
+		return nil, nil
+	}
+	overlays, ok := value.(map[string]any)
+	if !ok {
+		return nil, ValidationErrors{{
+			Field:   "config." + editionsConfigKey,
+			Message: "editions must be an object of edition names and config overlays",
+		}}
+	}
+
+	// The editions themselves are not part of the config of an edition
+	base := make(map[string]any, len(project.Config))
+	for key, value := range project.Config {
+		if key != editionsConfigKey {
+			base[key] = value
+		}
+	}
+
+	var errors ValidationErrors
+	editions := make([]buildEdition, 0, len(overlays))
+	for name, value := range overlays {
+		field := fmt.Sprintf("config.%s.%s", editionsConfigKey, name)
+		overlay, ok := value.(map[string]any)
+		if !ok {
+			errors = append(errors, ValidationError{Field: field, Message: "the edition must be a config overlay object"})
+			continue
+		}
+		if !editionNamePattern.MatchString(name) {
+			errors = append(errors, ValidationError{Field: field, Message: "edition names may only contain letters, digits, '-' and '_'"})
+			continue
+		}
+		editions = append(editions, buildEdition{Name: name, Config: overlayConfig(base, overlay)})
+	}
+	if errors.HasErrors() {
+		sort.Slice(errors, func(i, j int) bool { return errors[i].Field < errors[j].Field })
+		return nil, errors
+	}
+
+	sort.Slice(editions, func(i, j int) bool { return editions[i].Name < editions[j].Name })
+	return editions, nil
+}
+
+// useBuildConfig replaces the config of a loaded build project and its songs
+func useBuildConfig(project *ent.Project, config map[string]any) {
+	project.Config = config
+	for _, ps := range project.Edges.ProjectSongs {
+		if ps.Edges.Project != nil {
+			ps.Edges.Project.Config = config
+		}
+	}
+}
+
+// editionEvents passes the events of building an edition on. Song events name
+// the edition, progress is scaled to the share of the edition in the build.
+func editionEvents(onEvent BuildEventCallback, edition string, index, count int) BuildEventCallback {
+	if onEvent == nil {
+		return nil
+	}
+	return func(event BuildEvent) {
+		if event.Type == BuildEventProgress {
+			event.Progress = 10 + (index*100+event.Progress)*80/(count*100)
+			event.Message = fmt.Sprintf("%s: %s", edition, event.Message)
+		}
+		if event.Song != nil {
+			song := *event.Song
+			song.Edition = edition
+			event.Song = &song
+		}
+		onEvent(event)
+	}
+}
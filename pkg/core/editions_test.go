@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectEditions(t *testing.T) {
+	editions, err := projectEditions(&ent.Project{Config: map[string]any{"produce": []any{1}}})
+	require.NoError(t, err)
+	assert.Nil(t, editions)
+
+	editions, err = projectEditions(&ent.Project{Config: map[string]any{
+		"produce": []any{1},
+		"editions": map[string]any{
+			"lehrer":     map[string]any{"produce": []any{0, 1}},
+			"grossdruck": map[string]any{},
+		},
+	}})
+	require.NoError(t, err)
+	require.Len(t, editions, 2)
+	assert.Equal(t, "grossdruck", editions[0].Name)
+	assert.Equal(t, map[string]any{"produce": []any{1}}, editions[0].Config)
+	assert.Equal(t, map[string]any{"produce": []any{0, 1}}, editions[1].Config)
+
+	_, err = projectEditions(&ent.Project{Config: map[string]any{"editions": []any{"lehrer"}}})
+	assert.IsType(t, ValidationErrors{}, err)
+	_, err = projectEditions(&ent.Project{Config: map[string]any{"editions": map[string]any{
+		"../lehrer":  map[string]any{},
+		"teilnehmer": "klein",
+	}}})
+	require.IsType(t, ValidationErrors{}, err)
+	assert.Len(t, err.(ValidationErrors), 2)
+}
+
+func TestProjectService_BuildEditions(t *testing.T) {
+	// Zupfnoter does not write anything in debug mode, the test fills the cache itself
+	t.Setenv("ZUPFNOTER_DEBUG", "1")
+
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Editions Project",
+		ShortName: "EDI",
+		Config: map[string]interface{}{
+			"produce": []interface{}{1},
+			"editions": map[string]interface{}{
+				"teilnehmer": map[string]interface{}{},
+				// Other folders do not change what zupfnoter renders
+				"grossdruck": map[string]interface{}{"folderPatterns": map[string]interface{}{"*_a3.pdf": "gross"}},
+				"lehrer":     map[string]interface{}{"produce": []interface{}{0, 1}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	abcDir := t.TempDir()
+	outputDir := filepath.Join(t.TempDir(), "EDI")
+	require.NoError(t, os.WriteFile(filepath.Join(abcDir, "alpha.abc"), []byte("X:1\nT:alpha\n"), 0644))
+	song, err := services.DB().Song.Create().SetTitle("alpha").SetFilename("alpha.abc").Save(ctx)
+	require.NoError(t, err)
+	priority := 1
+	_, err = services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+		ProjectID: project.ID,
+		SongID:    song.ID,
+		Priority:  &priority,
+	})
+	require.NoError(t, err)
+
+	req := BuildProjectRequest{ProjectID: project.ID, AbcFileDir: abcDir, OutputDir: outputDir}
+	plan, err := services.Project.PlanProjectBuild(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Songs)
+	require.Len(t, plan.Editions, 3)
+	assert.Equal(t, "grossdruck", plan.Editions[0].Name)
+	assert.Equal(t, []FolderRule{{Match: "*_a3.pdf", Folders: []string{"gross"}}}, plan.Editions[0].FolderRouting.Rules)
+	assert.Equal(t, []any{0.0, 1.0}, plan.Editions[1].Songs[0].Config["produce"])
+	assert.NotContains(t, plan.Editions[1].Songs[0].Config, editionsConfigKey)
+
+	result, err := services.Project.RunProjectBuild(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, "completed", result.Status, result.Error)
+
+	// Every edition is built, songs with the same zupfnoter config are rendered once
+	report := result.Report
+	require.Len(t, report.Songs, 3)
+	assert.Equal(t, []string{"grossdruck", "lehrer", "teilnehmer"}, []string{report.Songs[0].Edition, report.Songs[1].Edition, report.Songs[2].Edition})
+	assert.False(t, report.Songs[0].Cached)
+	assert.False(t, report.Songs[1].Cached)
+	assert.True(t, report.Songs[2].Cached)
+	assert.Equal(t, report.Songs[0].CacheKey, report.Songs[2].CacheKey)
+	assert.Equal(t, 1, report.Cached)
+
+	for _, edition := range []string{"grossdruck", "lehrer", "teilnehmer"} {
+		assert.FileExists(t, filepath.Join(outputDir, LatestBuildLink, edition, "abc", "alpha.abc"))
+		assert.DirExists(t, filepath.Join(outputDir, LatestBuildLink, edition, "druckdateien"))
+	}
+	assert.DirExists(t, filepath.Join(outputDir, LatestBuildLink, "grossdruck", "druckdateien", "gross"))
+	assert.NoDirExists(t, filepath.Join(outputDir, LatestBuildLink, "grossdruck", "druckdateien", "klein"))
+
+	// The files of an edition are listed and served with the directory of the edition
+	artifacts, err := services.Project.GetBuildArtifacts(ctx, project.ID, result.BuildID)
+	require.NoError(t, err)
+	var abcFile *ManifestFile
+	for i, file := range result.Manifest.Files {
+		if file.Path == "lehrer/abc/alpha.abc" {
+			abcFile = &result.Manifest.Files[i]
+		}
+	}
+	require.NotNil(t, abcFile)
+	assert.Equal(t, "lehrer", abcFile.Edition)
+	assert.Equal(t, 1, abcFile.SongIndex)
+	path, err := artifacts.FilePath("lehrer/abc/alpha.abc")
+	require.NoError(t, err)
+	assert.FileExists(t, path)
+	_, err = artifacts.FilePath("lehrer/log/alpha.err.log")
+	assert.Equal(t, ErrInvalidArtifactPath, err)
+}
@@ -27,19 +27,7 @@ func (f *fileSystemService) CreateProjectDirectories(shortName string) error {
 		return fmt.Errorf("failed to create project directories for %s: %w", shortName, err)
 	}
 
-	// Create default TOC template
-	tocTemplateFile := filepath.Join(tplDir, "999_inhaltsverzeichnis_template.abc")
-	tocTemplateContent := `X:1
-T:Inhaltsverzeichnis
-M:4/4
-L:1/4
-K:C
-W:{{TOC}}
-`
-	
-	if err := os.WriteFile(tocTemplateFile, []byte(tocTemplateContent), 0644); err != nil {
-		return fmt.Errorf("failed to create TOC template for %s: %w", shortName, err)
-	}
-
+	// Projects start without templates of their own, the template registry
+	// falls back to shared, user and built-in templates until they override them
 	return nil
 }
@@ -0,0 +1,368 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+)
+
+// Project config keys of the folder routing. folderRules is an ordered list
+// of rules, folderPatterns the older map of glob patterns to folders that is
+// used when a project has no rules.
+const (
+	folderRulesConfigKey    = "folderRules"
+	folderPatternsConfigKey = "folderPatterns"
+	folderRoutingConfigKey  = "folderRouting"
+)
+
+// Modes of the folder routing: a file goes to the folders of the first rule
+// it matches, or to the folders of all rules it matches
+const (
+	FolderRoutingFirst = "first"
+	FolderRoutingAll   = "all"
+)
+
+// FolderRoutingModes are the valid modes of the folder routing
+var FolderRoutingModes = []string{FolderRoutingFirst, FolderRoutingAll}
+
+// defaultFolderPatterns route the PDFs of projects without folder rules or
+// patterns
+var defaultFolderPatterns = map[string]interface{}{
+	"*_-A*_a3.pdf": "klein",
+	"*_-M*_a3.pdf": "klein",
+	"*_-O*_a3.pdf": "klein",
+	"*_-B*_a3.pdf": "gross",
+	"*_-X*_a3.pdf": "gross",
+	"*_noten.pdf":  "noten",
+}
+
+// FolderRule routes the PDFs written to pdf/ into druckdateien folders. A
+// rule matches the file name with a glob pattern or a regular expression.
+// Files matching an exclusion rule are not distributed at all.
+type FolderRule struct {
+	Match   string   `json:"match,omitempty"`
+	Regex   string   `json:"regex,omitempty"`
+	Folders []string `json:"folders,omitempty"`
+	Exclude bool     `json:"exclude,omitempty"`
+
+	regex *regexp.Regexp
+}
+
+// matches reports whether the rule matches a file name
+func (r FolderRule) matches(name string) bool {
+	if r.regex != nil {
+		return r.regex.MatchString(name)
+	}
+	matched, _ := filepath.Match(r.Match, name)
+	return matched
+}
+
+// FolderRouting is the ordered list of folder rules of a project
+type FolderRouting struct {
+	Mode  string       `json:"mode"`
+	Rules []FolderRule `json:"rules"`
+}
+
+// FolderMatch is where a file is routed to. Rules are the indices of the
+// rules that decided, an exclusion rule if the file is excluded.
+type FolderMatch struct {
+	Rules    []int    `json:"rules"`
+	Folders  []string `json:"folders"`
+	Excluded bool     `json:"excluded,omitempty"`
+}
+
+// Route returns the folders a file is distributed to. In first mode the
+// first matching rule decides, in all mode the file is copied into the
+// folders of every matching rule. A matching exclusion rule keeps the file
+// out of all folders in both modes, rules after it are not considered.
+func (r *FolderRouting) Route(name string) FolderMatch {
+	match := FolderMatch{Rules: []int{}, Folders: []string{}}
+	for i, rule := range r.Rules {
+		if !rule.matches(name) {
+			continue
+		}
+		if rule.Exclude {
+			return FolderMatch{Rules: []int{i}, Folders: []string{}, Excluded: true}
+		}
+		match.Rules = append(match.Rules, i)
+		for _, folder := range rule.Folders {
+			if !containsString(match.Folders, folder) {
+				match.Folders = append(match.Folders, folder)
+			}
+		}
+		if r.Mode == FolderRoutingFirst {
+			break
+		}
+	}
+	return match
+}
+
+// Folders returns every folder a rule routes to in name order
+func (r *FolderRouting) Folders() []string {
+	var folders []string
+	for _, rule := range r.Rules {
+		for _, folder := range rule.Folders {
+			if !containsString(folders, folder) {
+				folders = append(folders, folder)
+			}
+		}
+	}
+	sort.Strings(folders)
+	return folders
+}
+
+// pdfFolders routes a PDF written to pdf/
+func pdfFolders(name string, routing *FolderRouting) FolderMatch {
+	if routing == nil {
+		return FolderMatch{Rules: []int{}, Folders: []string{}}
+	}
+	return routing.Route(name)
+}
+
+// projectFolderRouting returns the folder routing of a project
+func projectFolderRouting(project *ent.Project) (*FolderRouting, error) {
+	return FolderRoutingFromConfig(project.Config)
+}
+
+// FolderRoutingFromConfig returns the folder routing of a project config.
+// Configs without folderRules get their folderPatterns, or the default
+// patterns, as first match rules with the most specific patterns first.
+func FolderRoutingFromConfig(config map[string]interface{}) (*FolderRouting, error) {
+	routing := &FolderRouting{Mode: FolderRoutingFirst}
+
+	var errors ValidationErrors
+	if mode, _ := config[folderRoutingConfigKey].(string); mode != "" {
+		if containsString(FolderRoutingModes, mode) {
+			routing.Mode = mode
+		} else {
+			errors = append(errors, ValidationError{
+				Field:   "config." + folderRoutingConfigKey,
+				Message: fmt.Sprintf("%s must be one of %s", folderRoutingConfigKey, strings.Join(FolderRoutingModes, ", ")),
+			})
+		}
+	}
+
+	value, ok := config[folderRulesConfigKey]
+	if !ok || value == nil {
+		patterns, ok := config[folderPatternsConfigKey].(map[string]interface{})
+		if !ok {
+			patterns = defaultFolderPatterns
+		}
+		routing.Rules = folderPatternRules(patterns)
+		for _, rule := range routing.Rules {
+			if _, err := filepath.Match(rule.Match, ""); err != nil {
+				errors = append(errors, ValidationError{
+					Field:   "config." + folderPatternsConfigKey,
+					Message: fmt.Sprintf("invalid pattern %q: %v", rule.Match, err),
+				})
+			}
+		}
+	} else if entries, ok := value.([]interface{}); ok {
+		for i, entry := range entries {
+			rule, ruleErrors := parseFolderRule(entry, fmt.Sprintf("config.%s[%d]", folderRulesConfigKey, i))
+			errors = append(errors, ruleErrors...)
+			routing.Rules = append(routing.Rules, rule)
+		}
+	} else {
+		errors = append(errors, ValidationError{
+			Field:   "config." + folderRulesConfigKey,
+			Message: "folderRules must be a list of rules",
+		})
+	}
+
+	if errors.HasErrors() {
+		return nil, errors
+	}
+	return routing, nil
+}
+
+// parseFolderRule reads a rule of the folderRules list, e.g.
+//
+//	{"match": "*_-A*_a3.pdf", "folders": ["klein", "archiv"]}
+//	{"regex": "_-[BX]\\d+_a3\\.pdf$", "folder": "gross"}
+//	{"match": "*_entwurf*", "exclude": true}
+func parseFolderRule(entry interface{}, field string) (FolderRule, ValidationErrors) {
+	settings, ok := entry.(map[string]interface{})
+	if !ok {
+		return FolderRule{}, ValidationErrors{{Field: field, Message: "a folder rule must be an object"}}
+	}
+
+	var rule FolderRule
+	var errors ValidationErrors
+	rule.Match, _ = settings["match"].(string)
+	rule.Regex, _ = settings["regex"].(string)
+	rule.Exclude, _ = settings["exclude"].(bool)
+	if folder, ok := settings["folder"].(string); ok {
+		rule.Folders = append(rule.Folders, folder)
+	}
+	if folders, ok := settings["folders"].([]interface{}); ok {
+		for _, folder := range folders {
+			if folder, ok := folder.(string); ok {
+				rule.Folders = append(rule.Folders, folder)
+			}
+		}
+	}
+
+	switch {
+	case (rule.Match == "") == (rule.Regex == ""):
+		errors = append(errors, ValidationError{Field: field, Message: "a folder rule needs either match or regex"})
+	case rule.Match != "":
+		if _, err := filepath.Match(rule.Match, ""); err != nil {
+			errors = append(errors, ValidationError{Field: field + ".match", Message: fmt.Sprintf("invalid pattern: %v", err)})
+		}
+	default:
+		regex, err := regexp.Compile(rule.Regex)
+		if err != nil {
+			errors = append(errors, ValidationError{Field: field + ".regex", Message: fmt.Sprintf("invalid regular expression: %v", err)})
+		}
+		rule.regex = regex
+	}
+
+	switch {
+	case rule.Exclude && len(rule.Folders) > 0:
+		errors = append(errors, ValidationError{Field: field + ".folders", Message: "exclusion rules have no folders"})
+	case !rule.Exclude && len(rule.Folders) == 0:
+		errors = append(errors, ValidationError{Field: field + ".folders", Message: "a folder rule needs at least one folder"})
+	}
+	for _, folder := range rule.Folders {
+		if folder == "" || folder == "." || folder == ".." || strings.ContainsAny(folder, `/\`) {
+			errors = append(errors, ValidationError{Field: field + ".folders", Message: fmt.Sprintf("invalid folder name %q", folder)})
+		}
+	}
+	return rule, errors
+}
+
+// folderPatternRules converts folderPatterns to first match rules. JSON
+// objects have no order, patterns without wildcards come first, then the
+// patterns with the most literal characters, so that catch-all patterns such
+// as *.pdf come last.
+func folderPatternRules(patterns map[string]interface{}) []FolderRule {
+	keys := make([]string, 0, len(patterns))
+	for pattern, folder := range patterns {
+		if _, ok := folder.(string); ok {
+			keys = append(keys, pattern)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		wildI, wildJ := strings.ContainsAny(keys[i], "*?["), strings.ContainsAny(keys[j], "*?[")
+		if wildI != wildJ {
+			return !wildI
+		}
+		literalI, literalJ := literalLength(keys[i]), literalLength(keys[j])
+		if literalI != literalJ {
+			return literalI > literalJ
+		}
+		return keys[i] < keys[j]
+	})
+
+	rules := make([]FolderRule, len(keys))
+	for i, pattern := range keys {
+		rules[i] = FolderRule{Match: pattern, Folders: []string{patterns[pattern].(string)}}
+	}
+	return rules
+}
+
+// literalLength counts the characters of a glob pattern that are not wildcards
+func literalLength(pattern string) int {
+	return len(pattern) - strings.Count(pattern, "*") - strings.Count(pattern, "?")
+}
+
+// FolderRuleCheck shows where the current folder rules of a project route
+// the PDFs written to pdf/ by a build. Rule indices of files refer to the
+// routing of their edition.
+type FolderRuleCheck struct {
+	ProjectID int                   `json:"project_id"`
+	Build     BuildDiffBuild        `json:"build"`
+	Routing   *FolderRouting        `json:"routing,omitempty"`
+	Editions  []FolderRuleEdition   `json:"editions,omitempty"`
+	Files     []FolderRuleCheckFile `json:"files"`
+	Unmatched int                   `json:"unmatched"`
+	Excluded  int                   `json:"excluded"`
+}
+
+// FolderRuleEdition is the folder routing of an edition
+type FolderRuleEdition struct {
+	Name    string         `json:"name"`
+	Routing *FolderRouting `json:"routing"`
+}
+
+// FolderRuleCheckFile is a PDF of a build with the rules it hits and the
+// folder it was distributed to by the build
+type FolderRuleCheckFile struct {
+	Path        string `json:"path"`
+	Edition     string `json:"edition,omitempty"`
+	BuildFolder string `json:"build_folder,omitempty"`
+	FolderMatch
+}
+
+// CheckFolderRules routes the PDFs of a finished build with the current
+// folder rules of the project, the latest build without buildID
+func (s *projectService) CheckFolderRules(ctx context.Context, projectID int, buildID string) (*FolderRuleCheck, error) {
+	entProject, err := s.db.Project.Get(ctx, projectID)
+	if ent.IsNotFound(err) {
+		return nil, ErrProjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	entBuild, err := s.getComparableBuild(ctx, projectID, buildID)
+	if err != nil {
+		return nil, err
+	}
+
+	check := &FolderRuleCheck{
+		ProjectID: projectID,
+		Build:     buildDiffBuild(entBuild),
+		Files:     []FolderRuleCheckFile{},
+	}
+	routings := make(map[string]*FolderRouting)
+	editions, err := projectEditions(entProject)
+	if err != nil {
+		return nil, err
+	}
+	if len(editions) == 0 {
+		if check.Routing, err = projectFolderRouting(entProject); err != nil {
+			return nil, err
+		}
+		routings[""] = check.Routing
+	}
+	for _, edition := range editions {
+		routing, err := FolderRoutingFromConfig(edition.Config)
+		if err != nil {
+			return nil, err
+		}
+		check.Editions = append(check.Editions, FolderRuleEdition{Name: edition.Name, Routing: routing})
+		routings[edition.Name] = routing
+	}
+
+	for _, file := range entBuild.Manifest.Files {
+		relPath := strings.TrimPrefix(file.Path, file.Edition+"/")
+		if !strings.HasPrefix(relPath, "pdf/") || !strings.HasSuffix(relPath, ".pdf") {
+			continue
+		}
+		routing, ok := routings[file.Edition]
+		if !ok {
+			// The edition was removed from the project since the build
+			continue
+		}
+		match := pdfFolders(strings.TrimPrefix(relPath, "pdf/"), routing)
+		check.Files = append(check.Files, FolderRuleCheckFile{
+			Path:        file.Path,
+			Edition:     file.Edition,
+			BuildFolder: file.Folder,
+			FolderMatch: match,
+		})
+		switch {
+		case match.Excluded:
+			check.Excluded++
+		case len(match.Folders) == 0:
+			check.Unmatched++
+		}
+	}
+	return check, nil
+}
@@ -0,0 +1,149 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFolderRoutingFromConfig_Patterns(t *testing.T) {
+	// The catch-all pattern must lose against the more specific one on every build
+	routing, err := FolderRoutingFromConfig(map[string]interface{}{
+		"folderPatterns": map[string]interface{}{
+			"*.pdf":        "alle_anderen",
+			"*_-*_a3.pdf":  "klein",
+			"cover.pdf":    "umschlag",
+			"*_-B*_a3.pdf": "gross",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, FolderRoutingFirst, routing.Mode)
+	assert.Equal(t, []FolderRule{
+		{Match: "cover.pdf", Folders: []string{"umschlag"}},
+		{Match: "*_-B*_a3.pdf", Folders: []string{"gross"}},
+		{Match: "*_-*_a3.pdf", Folders: []string{"klein"}},
+		{Match: "*.pdf", Folders: []string{"alle_anderen"}},
+	}, routing.Rules)
+
+	assert.Equal(t, []string{"klein"}, routing.Route("alpha_-A1_a3.pdf").Folders)
+	assert.Equal(t, []string{"gross"}, routing.Route("alpha_-B1_a3.pdf").Folders)
+	assert.Equal(t, []string{"alle_anderen"}, routing.Route("alpha_-A1_a4.pdf").Folders)
+	assert.Equal(t, []string{"alle_anderen", "gross", "klein", "umschlag"}, routing.Folders())
+
+	routing, err = FolderRoutingFromConfig(map[string]interface{}{})
+	require.NoError(t, err)
+	assert.Len(t, routing.Rules, len(defaultFolderPatterns))
+	assert.Equal(t, []string{"klein"}, routing.Route("alpha_-M2_a3.pdf").Folders)
+	assert.Equal(t, []string{"noten"}, pdfFolders("alpha_noten.pdf", routing).Folders)
+}
+
+func TestFolderRouting_Route(t *testing.T) {
+	config := map[string]interface{}{
+		"folderRules": []interface{}{
+			map[string]interface{}{"match": "*_entwurf*", "exclude": true},
+			map[string]interface{}{"match": "*_-A*_a3.pdf", "folders": []interface{}{"klein", "archiv"}},
+			map[string]interface{}{"regex": `_-[AB]\d+_a3\.pdf$`, "folder": "gross"},
+		},
+	}
+	routing, err := FolderRoutingFromConfig(config)
+	require.NoError(t, err)
+
+	assert.Equal(t, FolderMatch{Rules: []int{1}, Folders: []string{"klein", "archiv"}}, routing.Route("alpha_-A1_a3.pdf"))
+	assert.Equal(t, FolderMatch{Rules: []int{2}, Folders: []string{"gross"}}, routing.Route("alpha_-B1_a3.pdf"))
+	assert.Equal(t, FolderMatch{Rules: []int{0}, Folders: []string{}, Excluded: true}, routing.Route("alpha_entwurf_-A1_a3.pdf"))
+	assert.Empty(t, routing.Route("alpha_-X1_a3.pdf").Folders)
+	assert.Equal(t, []string{"archiv", "gross", "klein"}, routing.Folders())
+
+	config["folderRouting"] = FolderRoutingAll
+	routing, err = FolderRoutingFromConfig(config)
+	require.NoError(t, err)
+	assert.Equal(t, FolderMatch{Rules: []int{1, 2}, Folders: []string{"klein", "archiv", "gross"}}, routing.Route("alpha_-A1_a3.pdf"))
+	assert.True(t, routing.Route("alpha_entwurf_-A1_a3.pdf").Excluded)
+
+	// HTML PDFs are routed by the rules like every other PDF
+	assert.True(t, pdfFolders("alpha_entwurf_noten.pdf", routing).Excluded)
+	assert.Empty(t, pdfFolders("alpha_noten.pdf", routing).Folders)
+}
+
+func TestFolderRoutingFromConfig_Invalid(t *testing.T) {
+	_, err := FolderRoutingFromConfig(map[string]interface{}{
+		"folderRouting": "random",
+		"folderRules": []interface{}{
+			map[string]interface{}{"match": "*.pdf", "regex": ".*", "folder": "a"},
+			map[string]interface{}{"regex": "(", "folder": "a"},
+			map[string]interface{}{"match": "*.pdf"},
+			map[string]interface{}{"match": "*.pdf", "exclude": true, "folder": "a"},
+			map[string]interface{}{"match": "*.pdf", "folder": "../a"},
+			"*.pdf",
+		},
+	})
+	var errors ValidationErrors
+	require.ErrorAs(t, err, &errors)
+	fields := make([]string, len(errors))
+	for i, e := range errors {
+		fields[i] = e.Field
+	}
+	assert.Equal(t, []string{
+		"config.folderRouting",
+		"config.folderRules[0]",
+		"config.folderRules[1].regex",
+		"config.folderRules[2].folders",
+		"config.folderRules[3].folders",
+		"config.folderRules[4].folders",
+		"config.folderRules[5]",
+	}, fields)
+}
+
+func TestProjectService_CheckFolderRules(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Rules Project",
+		ShortName: "RUL",
+		Config: map[string]interface{}{
+			"folderRules": []interface{}{
+				map[string]interface{}{"match": "*_-A*_a3.pdf", "folder": "klein"},
+				map[string]interface{}{"match": "*.pdf", "folder": "alle_anderen"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = services.DB().Build.Create().
+		SetBuildID("rules-build").
+		SetProjectID(project.ID).
+		SetStatus("completed").
+		SetProgress(100).
+		SetOutputDir(t.TempDir()).
+		SetManifest(&BuildManifest{Files: []ManifestFile{
+			{Path: "abc/alpha.abc"},
+			{Path: "pdf/alpha_-A1_a3.pdf", Folder: "klein"},
+			{Path: "pdf/alpha_-B1_a3.pdf", Folder: "gross"},
+			{Path: "pdf/alpha_noten.pdf", Folder: "noten"},
+		}}).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	check, err := services.Project.CheckFolderRules(ctx, project.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, "rules-build", check.Build.BuildID)
+	require.NotNil(t, check.Routing)
+	assert.Len(t, check.Routing.Rules, 2)
+	assert.Equal(t, []FolderRuleCheckFile{
+		{Path: "pdf/alpha_-A1_a3.pdf", BuildFolder: "klein", FolderMatch: FolderMatch{Rules: []int{0}, Folders: []string{"klein"}}},
+		{Path: "pdf/alpha_-B1_a3.pdf", BuildFolder: "gross", FolderMatch: FolderMatch{Rules: []int{1}, Folders: []string{"alle_anderen"}}},
+		{Path: "pdf/alpha_noten.pdf", BuildFolder: "noten", FolderMatch: FolderMatch{Rules: []int{1}, Folders: []string{"alle_anderen"}}},
+	}, check.Files)
+	assert.Zero(t, check.Unmatched)
+
+	_, err = services.Project.CheckFolderRules(ctx, project.ID, "unknown")
+	assert.Equal(t, ErrBuildNotFound, err)
+	_, err = services.Project.CheckFolderRules(ctx, project.ID+1, "")
+	assert.Equal(t, ErrProjectNotFound, err)
+}
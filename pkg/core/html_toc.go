@@ -0,0 +1,209 @@
+package core
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"sort"
+	"strings"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/templatevars"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// HTMLTocData is the data HTML templates of the table of contents are
+// rendered with by html/template, e.g.
+//
+//	{{range .Sections}}<h2>{{.Title}}</h2>
+//	  {{range .Entries}}<p>{{printf "%02d" .Number}} {{.Title}}</p>{{end}}
+//	{{end}}
+//
+// All values are escaped where they are inserted. Project variables such as
+// #{build_date} are expanded in the template before it is parsed.
+type HTMLTocData struct {
+	Title    string            // Inhaltsverzeichnis or the title of a register
+	Project  HTMLTocProject    // the project that is built
+	Vars     map[string]string // the project variables, dates in DefaultDateFormat
+	Entries  []HTMLTocEntry    // all songs in build order, registers in their order
+	Sections []HTMLTocSection  // the songs by the sections of the project or of the register
+	Genres   []HTMLTocSection  // the songs by genre, genres in alphabetical order
+	HasPages bool              // whether the entries know their page
+}
+
+// HTMLTocProject is the project of a table of contents
+type HTMLTocProject struct {
+	Title     string
+	ShortName string
+}
+
+// HTMLTocEntry is a song of the table of contents. Page is the page the song
+// starts on in the merged PDF of the noten folder, 0 while it is not known.
+// Registers list songs under their first line as well, these entries carry
+// the first line as Title and the title of the song as SongTitle.
+type HTMLTocEntry struct {
+	Number      int
+	Title       string
+	SongTitle   string
+	FirstLine   string
+	IsFirstLine bool
+	Tocinfo     string
+	Genre       string
+	Difficulty  string
+	Priority    int
+	Comment     string
+	Copyright   string
+	Page        int
+}
+
+// HTMLTocSection is a group of songs of the table of contents. Songs before
+// the first section of a project, or without genre, are in a section
+// without title.
+type HTMLTocSection struct {
+	Title   string
+	Entries []HTMLTocEntry
+}
+
+// htmlTocData returns the data of the HTML table of contents of the songs
+// in build order. pages maps song numbers to their first page, it may be nil.
+func htmlTocData(project *ent.Project, projectSongs []*ent.ProjectSong, vars *templatevars.Variables, pages map[int]int) (*HTMLTocData, error) {
+	sections, err := projectSections(project)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &HTMLTocData{
+		Title:    tableOfContents.Title,
+		Project:  HTMLTocProject{Title: project.Title, ShortName: project.ShortName},
+		Vars:     vars.Values(),
+		Entries:  make([]HTMLTocEntry, 0, len(projectSongs)),
+		Sections: []HTMLTocSection{},
+		Genres:   []HTMLTocSection{},
+		HasPages: len(pages) > 0,
+	}
+	genres := make(map[string]int) // genre -> index in data.Genres
+	for _, ps := range projectSongs {
+		song := ps.Edges.Song
+		entry := HTMLTocEntry{
+			Number:     ps.Number,
+			Title:      song.Title,
+			SongTitle:  song.Title,
+			Tocinfo:    song.Tocinfo,
+			Genre:      strings.TrimSpace(song.Genre),
+			Difficulty: string(ps.Difficulty),
+			Priority:   ps.Priority,
+			Comment:    ps.Comment,
+			Copyright:  song.Copyright,
+			Page:       pages[ps.Number],
+		}
+		data.Entries = append(data.Entries, entry)
+
+		section := sectionTitle(sections, ps.Number)
+		if last := len(data.Sections) - 1; last < 0 || data.Sections[last].Title != section {
+			data.Sections = append(data.Sections, HTMLTocSection{Title: section})
+		}
+		data.Sections[len(data.Sections)-1].Entries = append(data.Sections[len(data.Sections)-1].Entries, entry)
+
+		index, ok := genres[entry.Genre]
+		if !ok {
+			index = len(data.Genres)
+			genres[entry.Genre] = index
+			data.Genres = append(data.Genres, HTMLTocSection{Title: entry.Genre})
+		}
+		data.Genres[index].Entries = append(data.Genres[index].Entries, entry)
+	}
+
+	sortSections(data.Genres)
+	return data, nil
+}
+
+// sortSections sorts sections alphabetically by title, the section without
+// title comes last
+func sortSections(sections []HTMLTocSection) {
+	collator := collate.New(language.German, collate.IgnoreCase)
+	sort.SliceStable(sections, func(i, j int) bool {
+		a, b := sections[i].Title, sections[j].Title
+		if (a == "") != (b == "") {
+			return b == ""
+		}
+		return collator.CompareString(a, b) < 0
+	})
+}
+
+// htmlTocVariableEscaper escapes the values of variables expanded in HTML
+// TOC templates, braces must not turn a value into template actions
+var htmlTocVariableEscaper = strings.NewReplacer("{", "&#123;", "}", "&#125;")
+
+// parseHTMLTocTemplate expands the variables of an HTML TOC template and
+// parses it. Templates written for the placeholders {{TOC_ENTRIES}},
+// {{PROJECT_TITLE}} and {{PROJECT_SHORT_NAME}} keep working, the
+// placeholders are functions of the template.
+func parseHTMLTocTemplate(content string, vars *templatevars.Variables) (*template.Template, error) {
+	expanded, err := vars.ExpandEscaped(content, func(value string) string {
+		return htmlTocVariableEscaper.Replace(html.EscapeString(value))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to substitute variables in HTML TOC template: %w", err)
+	}
+
+	// The placeholder functions are bound to the data when the template is rendered
+	tmpl, err := template.New("toc").Funcs(htmlTocFuncs(&HTMLTocData{})).Parse(expanded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML TOC template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// htmlTocFuncs returns the functions of HTML TOC templates for the data
+func htmlTocFuncs(data *HTMLTocData) template.FuncMap {
+	return template.FuncMap{
+		"TOC_ENTRIES":        func() template.HTML { return legacyHTMLTocEntries(data.Entries) },
+		"PROJECT_TITLE":      func() string { return data.Project.Title },
+		"PROJECT_SHORT_NAME": func() string { return data.Project.ShortName },
+	}
+}
+
+// legacyHTMLTocEntries renders the entries for {{TOC_ENTRIES}} the way
+// templates without the data model expect them
+func legacyHTMLTocEntries(entries []HTMLTocEntry) template.HTML {
+	var b strings.Builder
+	b.WriteString(`<div class="toc-entries">`)
+	for _, entry := range entries {
+		songinfo := ""
+		if entry.Tocinfo != "" {
+			songinfo = " - " + entry.Tocinfo
+		}
+		fmt.Fprintf(&b, `
+        <p class="toc-entry">
+            <span class="toc-number">%02d</span>
+            <span class="toc-title">%s</span>
+        </p>`, entry.Number, html.EscapeString(entry.Title+songinfo))
+	}
+	b.WriteString(`</div>`)
+	return template.HTML(b.String())
+}
+
+// renderHTMLToc renders a parsed HTML TOC template with the data
+func renderHTMLToc(tmpl *template.Template, data *HTMLTocData) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Funcs(htmlTocFuncs(data)).Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to render HTML TOC template: %w", err)
+	}
+	return b.String(), nil
+}
+
+// renderHTMLTocTemplate renders the content of an HTML TOC template for the
+// table of contents or a register with the songs and their pages, if known
+func renderHTMLTocTemplate(content string, doc tocDocument, project *ent.Project, projectSongs []*ent.ProjectSong, vars *templatevars.Variables, pages map[int]int) (string, error) {
+	vars = doc.variables(vars)
+	tmpl, err := parseHTMLTocTemplate(content, vars)
+	if err != nil {
+		return "", err
+	}
+	data, err := doc.data(project, projectSongs, vars, pages)
+	if err != nil {
+		return "", err
+	}
+	return renderHTMLToc(tmpl, data)
+}
@@ -6,8 +6,12 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/templatevars"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateHTMLToc(t *testing.T) {
@@ -20,6 +24,7 @@ func TestCreateHTMLToc(t *testing.T) {
 	// Create test songs
 	projectSongs := []*ent.ProjectSong{
 		{
+			Number: 1,
 			Edges: ent.ProjectSongEdges{
 				Song: &ent.Song{
 					Title:    "Test Song 1",
@@ -29,6 +34,7 @@ func TestCreateHTMLToc(t *testing.T) {
 			},
 		},
 		{
+			Number: 2,
 			Edges: ent.ProjectSongEdges{
 				Song: &ent.Song{
 					Title:    "Test Song 2",
@@ -38,6 +44,7 @@ func TestCreateHTMLToc(t *testing.T) {
 			},
 		},
 		{
+			Number: 3,
 			Edges: ent.ProjectSongEdges{
 				Song: &ent.Song{
 					Title:    "Test Song 3",
@@ -55,11 +62,16 @@ func TestCreateHTMLToc(t *testing.T) {
 	}
 	defer os.RemoveAll(outputDir)
 
+	routing, err := projectFolderRouting(project)
+	if err != nil {
+		t.Fatalf("Failed to parse folder routing: %v", err)
+	}
+
 	// Create service
 	service := &projectService{}
 
 	// Test HTML TOC creation
-	err = service.createHTMLToc(context.Background(), project, projectSongs, outputDir)
+	err = service.createHTMLToc(context.Background(), tableOfContents, project, projectSongs, projectVariables(project, "", time.Now()), outputDir, routing)
 	if err != nil {
 		t.Fatalf("Failed to create HTML TOC: %v", err)
 	}
@@ -148,4 +160,73 @@ func TestCreateHTMLToc(t *testing.T) {
 	}
 
 	t.Logf("✅ HTML TOC created successfully at: %s", htmlFile)
-}
\ No newline at end of file
+}
+
+func TestGenerateHTMLTocContent_Escaping(t *testing.T) {
+	project := &ent.Project{ShortName: "ESC", Title: "Lieder & <Tänze>", Config: map[string]interface{}{}}
+	projectSongs := []*ent.ProjectSong{
+		{Number: 1, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Rock & Roll", Tocinfo: "<b>trad.</b>"}}},
+	}
+
+	content, err := (&projectService{}).generateHTMLTocContent(context.Background(), tableOfContents, project, projectSongs, projectVariables(project, "", time.Now()), nil)
+	require.NoError(t, err)
+	assert.Contains(t, content, `<td class="toc-title">Rock &amp; Roll</td>`)
+	assert.Contains(t, content, `<td class="toc-info">&lt;b&gt;trad.&lt;/b&gt;</td>`)
+	assert.Contains(t, content, "Notensammlung ESC - Lieder &amp; &lt;Tänze&gt;")
+	assert.NotContains(t, content, `<th class="toc-page">`)
+}
+
+func TestParseHTMLTocTemplate(t *testing.T) {
+	project := &ent.Project{
+		ShortName: "TPL",
+		Title:     "Templates",
+		Config: map[string]interface{}{
+			"sections": []interface{}{map[string]interface{}{"title": "Advent", "from": 2.0}},
+		},
+	}
+	projectSongs := []*ent.ProjectSong{
+		{Number: 1, Priority: 1, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Alpha", Genre: "Volkslied"}}},
+		{Number: 2, Priority: 2, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Beta", Genre: "Choral"}}},
+		{Number: 3, Priority: 3, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Gamma & Delta", Tocinfo: "T&M"}}},
+	}
+	vars := projectVariables(project, "", time.Now())
+	vars.Set("motto", "{{.Project}} & mehr")
+
+	data, err := htmlTocData(project, projectSongs, vars, map[int]int{1: 2, 3: 5})
+	require.NoError(t, err)
+	assert.True(t, data.HasPages)
+	require.Len(t, data.Sections, 2)
+	assert.Equal(t, "", data.Sections[0].Title)
+	assert.Equal(t, "Advent", data.Sections[1].Title)
+	assert.Len(t, data.Sections[1].Entries, 2)
+	require.Len(t, data.Genres, 3)
+	assert.Equal(t, []string{"Choral", "Volkslied", ""}, []string{data.Genres[0].Title, data.Genres[1].Title, data.Genres[2].Title})
+	assert.Equal(t, 5, data.Genres[2].Entries[0].Page)
+
+	t.Run("data model", func(t *testing.T) {
+		tmpl, err := parseHTMLTocTemplate(`<h1>#{motto}</h1>{{range .Genres}}<h2>{{.Title}}</h2>{{range .Entries}}<p>{{.Number}} {{.Title}} {{.Page}}</p>{{end}}{{end}}`, vars)
+		require.NoError(t, err)
+		content, err := renderHTMLToc(tmpl, data)
+		require.NoError(t, err)
+		assert.Equal(t, `<h1>&#123;&#123;.Project&#125;&#125; &amp; mehr</h1>`+
+			`<h2>Choral</h2><p>2 Beta 0</p>`+
+			`<h2>Volkslied</h2><p>1 Alpha 2</p>`+
+			`<h2></h2><p>3 Gamma &amp; Delta 5</p>`, content)
+	})
+
+	t.Run("placeholders", func(t *testing.T) {
+		tmpl, err := parseHTMLTocTemplate(`<title>{{PROJECT_SHORT_NAME}} - {{PROJECT_TITLE}}</title>{{TOC_ENTRIES}}`, vars)
+		require.NoError(t, err)
+		content, err := renderHTMLToc(tmpl, data)
+		require.NoError(t, err)
+		assert.Contains(t, content, "<title>TPL - Templates</title>")
+		assert.Contains(t, content, `<span class="toc-title">Gamma &amp; Delta - T&amp;M</span>`)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := parseHTMLTocTemplate(`{{range .Entries}}`, vars)
+		assert.Error(t, err)
+		_, err = parseHTMLTocTemplate(`#{unknown}`, vars)
+		assert.ErrorIs(t, err, templatevars.ErrUnknownVariable)
+	})
+}
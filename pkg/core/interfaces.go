@@ -2,6 +2,8 @@ package core
 
 import (
 	"context"
+
+	"github.com/bwl21/zupfmanager/internal/buildreport"
 )
 
 // Project represents a project domain entity
@@ -11,6 +13,8 @@ type Project struct {
 	ShortName            string                 `json:"short_name"`
 	Config               map[string]interface{} `json:"config"`
 	AbcFileDirPreference string                 `json:"abc_file_dir_preference,omitempty"`
+	Variables            map[string]string      `json:"variables,omitempty"`
+	ReservedNumbers      []int                  `json:"reserved_numbers,omitempty"`
 }
 
 // Song represents a song domain entity
@@ -31,6 +35,8 @@ type ProjectSong struct {
 	SongID     int     `json:"song_id"`
 	Difficulty string  `json:"difficulty"`
 	Priority   int     `json:"priority"`
+	Position   int     `json:"position"`
+	Number     int     `json:"number"`
 	Comment    *string `json:"comment,omitempty"`
 	Song       *Song   `json:"song,omitempty"`
 	Project    *Project `json:"project,omitempty"`
@@ -80,13 +86,60 @@ type BuildProjectRequest struct {
 	AbcFileDir        string `json:"abc_file_dir,omitempty"`
 	PriorityThreshold int    `json:"priority_threshold,omitempty" validate:"omitempty,min=1,max=4"`
 	SampleID          string `json:"sample_id,omitempty"`
+	Incremental       bool   `json:"incremental,omitempty"`
+	DryRun            bool   `json:"dry_run,omitempty"`
+	SongOrder         string `json:"song_order,omitempty"` // one of SongOrders, defaults to the project config
+	SupplementSince   string `json:"supplement_since,omitempty"` // build ID or date, only songs added or changed since then are built
+	Profile           string `json:"profile,omitempty"` // name of a build profile, defaults to the default profile of the project
+	Final             bool   `json:"final,omitempty"` // final builds get only the watermarks marked final, no draft marks
+
+	// FallbackPriorityThreshold replaces DefaultPriorityThreshold if neither
+	// the request nor the profile sets a threshold, e.g. 1 for the CLI
+	FallbackPriorityThreshold int `json:"-"`
+}
+
+// BuildProfile is a named set of build parameters of a project, e.g.
+// "Probedruck", "Teilnehmer" or "Referent". Empty parameters are left to the
+// build request or the project defaults.
+type BuildProfile struct {
+	ID                int                    `json:"id"`
+	ProjectID         int                    `json:"project_id"`
+	Name              string                 `json:"name"`
+	OutputDir         string                 `json:"output_dir,omitempty"`
+	AbcFileDir        string                 `json:"abc_file_dir,omitempty"`
+	PriorityThreshold int                    `json:"priority_threshold,omitempty"`
+	SampleID          string                 `json:"sample_id,omitempty"`
+	Config            map[string]interface{} `json:"config,omitempty"` // merged over the project config
+	IsDefault         bool                   `json:"is_default"`
+}
+
+// BuildProfileRequest represents the data needed to create or update a build profile
+type BuildProfileRequest struct {
+	ProjectID         int                    `json:"project_id" validate:"required,min=1"`
+	Name              string                 `json:"name" validate:"required,min=1"`
+	OutputDir         string                 `json:"output_dir,omitempty"`
+	AbcFileDir        string                 `json:"abc_file_dir,omitempty"`
+	PriorityThreshold int                    `json:"priority_threshold,omitempty" validate:"omitempty,min=1,max=4"`
+	SampleID          string                 `json:"sample_id,omitempty"`
+	Config            map[string]interface{} `json:"config,omitempty"`
+	IsDefault         bool                   `json:"is_default,omitempty"`
+}
+
+// BuildDefaults are the parameters of a build that leaves them out
+type BuildDefaults struct {
+	Profile           string `json:"profile,omitempty"` // the default profile of the project, if any
+	OutputDir         string `json:"output_dir"`
+	AbcFileDir        string `json:"abc_file_dir"`
+	PriorityThreshold int    `json:"priority_threshold"`
+	SampleID          string `json:"sample_id"`
 }
 
 // BuildStatus represents the status of a build operation
 type BuildStatus struct {
-	Status      string `json:"status"` // "pending", "running", "completed", "failed"
-	Progress    int    `json:"progress"` // 0-100
-	Message     string `json:"message,omitempty"`
+	Status        string `json:"status"` // "queued", "running", "completed", "completed_with_errors", "failed", "cancelled", "interrupted"
+	Progress      int    `json:"progress"` // 0-100
+	QueuePosition int    `json:"queue_position,omitempty"` // 1-based, only set while queued
+	Message       string `json:"message,omitempty"`
 	StartedAt   string `json:"started_at,omitempty"`
 	CompletedAt string `json:"completed_at,omitempty"`
 	Error       string `json:"error,omitempty"`
@@ -98,12 +151,23 @@ type BuildResult struct {
 	ProjectID   int      `json:"project_id"`
 	Status      string   `json:"status"`
 	OutputDir   string   `json:"output_dir"`
+	BuildDir    string   `json:"build_dir,omitempty"` // relative to the output directory
+	Profile     string   `json:"profile,omitempty"`
 	GeneratedFiles []string `json:"generated_files,omitempty"`
 	StartedAt   string   `json:"started_at"`
 	CompletedAt string   `json:"completed_at,omitempty"`
 	Error       string   `json:"error,omitempty"`
+	Report      *BuildReport `json:"report,omitempty"`
+	Manifest    *BuildManifest `json:"manifest,omitempty"`
+	Plan        *BuildPlan `json:"plan,omitempty"` // only set for dry runs
 }
 
+// BuildReport holds the per-song results of a build
+type BuildReport = buildreport.Report
+
+// SongReport holds the result of building a single project song
+type SongReport = buildreport.SongReport
+
 // ImportResult represents the result of an import operation
 type ImportResult struct {
 	Filename string   `json:"filename"`
@@ -127,19 +191,53 @@ type ProjectService interface {
 	List(ctx context.Context) ([]*Project, error)
 	Get(ctx context.Context, id int) (*Project, error)
 	Delete(ctx context.Context, id int) error
+	SetProjectVariables(ctx context.Context, projectID int, variables map[string]string) (*Project, error)
 	
 	// Project-Song relationship operations
 	AddSongToProject(ctx context.Context, req AddSongToProjectRequest) (*ProjectSong, error)
 	RemoveSongFromProject(ctx context.Context, projectID, songID int) error
 	UpdateProjectSong(ctx context.Context, req UpdateProjectSongRequest) (*ProjectSong, error)
 	ListProjectSongs(ctx context.Context, projectID int) ([]*ProjectSong, error)
+	ReorderProjectSongs(ctx context.Context, projectID int, songIDs []int) ([]*ProjectSong, error)
+	RenumberProjectSongs(ctx context.Context, projectID int, songOrder string) ([]*ProjectSong, error)
+	ResolveSongConfig(ctx context.Context, req ResolveSongConfigRequest) (*ResolvedSongConfig, error)
+	
+	// Build profile operations
+	ListBuildProfiles(ctx context.Context, projectID int) ([]*BuildProfile, error)
+	GetBuildProfile(ctx context.Context, projectID, profileID int) (*BuildProfile, error)
+	CreateBuildProfile(ctx context.Context, req BuildProfileRequest) (*BuildProfile, error)
+	UpdateBuildProfile(ctx context.Context, profileID int, req BuildProfileRequest) (*BuildProfile, error)
+	DeleteBuildProfile(ctx context.Context, projectID, profileID int) error
+	GetBuildDefaults(ctx context.Context, projectID int) (*BuildDefaults, error)
 	
 	// Project build operations
 	BuildProject(ctx context.Context, req BuildProjectRequest) (*BuildResult, error)
+	RunProjectBuild(ctx context.Context, req BuildProjectRequest) (*BuildResult, error)
+	PlanProjectBuild(ctx context.Context, req BuildProjectRequest) (*BuildPlan, error)
+	CancelBuild(ctx context.Context, projectID int, buildID string) error
+	StreamBuildEvents(ctx context.Context, projectID int, buildID string, lastEventID int) (<-chan BuildEvent, error)
 	ExecuteProjectBuild(ctx context.Context, req BuildProjectRequest) error
 	GetBuildStatus(ctx context.Context, buildID string) (*BuildStatus, error)
+	GetBuild(ctx context.Context, projectID int, buildID string) (*BuildResult, error)
+	GetBuildArtifacts(ctx context.Context, projectID int, buildID string) (*BuildArtifacts, error)
+	CompareBuilds(ctx context.Context, projectID int, fromBuildID, toBuildID string) (*BuildDiff, error)
+	WriteChangedPagesPDF(ctx context.Context, diff *BuildDiff, folders []string, dest string) (int, error)
+	CheckFolderRules(ctx context.Context, projectID int, buildID string) (*FolderRuleCheck, error)
 	ListBuilds(ctx context.Context, projectID int) ([]*BuildResult, error)
 	ClearBuildHistory(ctx context.Context, projectID int) error
+	MarkInterruptedBuilds(ctx context.Context) (int, error)
+
+	// Template operations
+	ListTemplates(ctx context.Context, projectID int) ([]*Template, error)
+	GetTemplate(ctx context.Context, ref TemplateRef) (*Template, error)
+	CreateSharedTemplate(ctx context.Context, req TemplateRequest) (*Template, error)
+	UpdateSharedTemplate(ctx context.Context, templateID int, req TemplateRequest) (*Template, error)
+	DeleteSharedTemplate(ctx context.Context, templateID int) error
+	SetProjectTemplate(ctx context.Context, projectID int, kind, content string) (*Template, error)
+	CopyTemplateToProject(ctx context.Context, templateID, projectID int) (*Template, error)
+	PreviewTemplate(ctx context.Context, req TemplatePreviewRequest) (*TemplatePreview, error)
+	GetTemplateOrder(ctx context.Context, projectID int) ([]string, error)
+	SetTemplateOrder(ctx context.Context, order []string) error
 }
 
 // PreviewPDF represents a generated preview PDF
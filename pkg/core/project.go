@@ -9,12 +9,16 @@ import (
 
 	"github.com/bwl21/zupfmanager/internal/database"
 	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/ent/build"
 	"github.com/bwl21/zupfmanager/internal/ent/project"
 	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
 	"github.com/bwl21/zupfmanager/internal/ent/song"
 	"github.com/google/uuid"
 )
 
+// activeBuildStatuses are the states of builds that have not finished yet
+var activeBuildStatuses = []build.Status{build.StatusPending, build.StatusQueued, build.StatusRunning}
+
 // Common errors
 var (
 	ErrProjectNotFound        = errors.New("project not found")
@@ -23,12 +27,8 @@ var (
 	ErrProjectSongNotFound   = errors.New("project-song relationship not found")
 	ErrBuildNotFound         = errors.New("build not found")
 	ErrBuildInProgress       = errors.New("build already in progress")
-)
-
-// In-memory build tracking (in production, this would be in database or cache)
-var (
-	buildStatuses = make(map[string]*BuildStatus)
-	buildResults  = make(map[string]*BuildResult)
+	ErrBuildNotActive        = errors.New("build is not queued or running")
+	ErrBuildCancelled        = errors.New("build cancelled")
 )
 
 // projectService implements ProjectService interface
@@ -36,14 +36,24 @@ type projectService struct {
 	db         *database.Client
 	config     ConfigService
 	fileSystem FileSystemService
+	queue      *BuildQueue
+	events     *BuildEventHub
 }
 
 // NewProjectServiceWithDeps creates a new project service with dependencies
 func NewProjectServiceWithDeps(db *database.Client, config ConfigService, fileSystem FileSystemService) ProjectService {
+	return NewProjectServiceWithQueue(db, config, fileSystem, NewBuildQueue(context.Background(), DefaultMaxConcurrentBuilds), NewBuildEventHub())
+}
+
+// NewProjectServiceWithQueue creates a new project service that schedules builds on the given queue
+// and publishes their events to the given hub
+func NewProjectServiceWithQueue(db *database.Client, config ConfigService, fileSystem FileSystemService, queue *BuildQueue, events *BuildEventHub) ProjectService {
 	return &projectService{
 		db:         db,
 		config:     config,
 		fileSystem: fileSystem,
+		queue:      queue,
+		events:     events,
 	}
 }
 
@@ -187,10 +197,17 @@ func (s *projectService) AddSongToProject(ctx context.Context, req AddSongToProj
 		return nil, ErrSongAlreadyInProject
 	}
 
+	// New songs go to the end of the manual order
+	position, err := s.nextSongPosition(ctx, req.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create project-song relationship
 	builder := s.db.ProjectSong.Create().
 		SetProjectID(req.ProjectID).
-		SetSongID(req.SongID)
+		SetSongID(req.SongID).
+		SetPosition(position)
 
 	if req.Difficulty != nil {
 		builder = builder.SetDifficulty(projectsong.Difficulty(*req.Difficulty))
@@ -207,24 +224,39 @@ func (s *projectService) AddSongToProject(ctx context.Context, req AddSongToProj
 		return nil, err
 	}
 
+	if err := s.assignSongNumbers(ctx, req.ProjectID); err != nil {
+		return nil, fmt.Errorf("failed to number song: %w", err)
+	}
+	entProjectSong, err = s.db.ProjectSong.Get(ctx, entProjectSong.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	return ProjectSongFromEnt(entProjectSong), nil
 }
 
 // RemoveSongFromProject removes a song from a project
 func (s *projectService) RemoveSongFromProject(ctx context.Context, projectID, songID int) error {
-	deleted, err := s.db.ProjectSong.Delete().
+	entProjectSong, err := s.db.ProjectSong.Query().
 		Where(
 			projectsong.ProjectID(projectID),
 			projectsong.SongID(songID),
 		).
-		Exec(ctx)
-
+		Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrProjectSongNotFound
+		}
 		return err
 	}
 
-	if deleted == 0 {
-		return ErrProjectSongNotFound
+	if err := s.db.ProjectSong.DeleteOne(entProjectSong).Exec(ctx); err != nil {
+		return err
+	}
+
+	// Depending on the project the number of the song is given to a later song or kept free
+	if err := s.releaseSongNumber(ctx, projectID, entProjectSong.Number); err != nil {
+		return fmt.Errorf("failed to release song number: %w", err)
 	}
 
 	return nil
@@ -252,8 +284,8 @@ func (s *projectService) UpdateProjectSong(ctx context.Context, req UpdateProjec
 		return nil, err
 	}
 
-	// Update the relationship
-	builder := entProjectSong.Update()
+	// Update the relationship, supplement builds pick up changed songs
+	builder := entProjectSong.Update().SetUpdatedAt(time.Now())
 	if req.Difficulty != nil {
 		builder = builder.SetDifficulty(projectsong.Difficulty(*req.Difficulty))
 	}
@@ -288,6 +320,7 @@ func (s *projectService) ListProjectSongs(ctx context.Context, projectID int) ([
 		Where(projectsong.ProjectID(projectID)).
 		WithSong().
 		WithProject().
+		Order(ent.Asc(projectsong.FieldPosition), ent.Asc(projectsong.FieldID)).
 		All(ctx)
 
 	if err != nil {
@@ -358,146 +391,528 @@ func (s *projectService) ListProjectSongs(ctx context.Context, projectID int) ([
 	return projectSongs, nil
 }
 
-// BuildProject starts a project build operation
+// BuildProject starts a project build operation in the background. A dry run
+// returns the build plan right away instead.
 func (s *projectService) BuildProject(ctx context.Context, req BuildProjectRequest) (*BuildResult, error) {
+	if req.DryRun {
+		return s.dryRunResult(ctx, req)
+	}
+
+	entBuild, req, err := s.createBuild(ctx, req, build.StatusQueued, build.OriginServer, "Build queued")
+	if err != nil {
+		return nil, err
+	}
+
+	// Hand the build over to the queue, it is started as soon as a worker is free
+	buildID, buildDir := entBuild.BuildID, entBuild.BuildDir
+	s.events.Publish(buildID, BuildEvent{Type: BuildEventStatus, Status: string(build.StatusQueued), Message: "Build queued"})
+	s.queue.Enqueue(buildID, req.ProjectID, func(ctx context.Context) {
+		s.executeBuild(ctx, buildID, buildDir, req)
+	})
+
+	return BuildResultFromEnt(entBuild), nil
+}
+
+// RunProjectBuild runs a project build in the foreground and records it in the
+// build history. Cancelling ctx stops the build and marks it as cancelled.
+// A dry run returns the build plan without recording a build.
+func (s *projectService) RunProjectBuild(ctx context.Context, req BuildProjectRequest) (*BuildResult, error) {
+	if req.DryRun {
+		return s.dryRunResult(ctx, req)
+	}
+
+	entBuild, req, err := s.createBuild(ctx, req, build.StatusRunning, build.OriginCli, "Starting build process")
+	if err != nil {
+		return nil, err
+	}
+
+	// Cancellation of ctx is a user request here, mark it like a cancel via the queue
+	runCtx, cancel := context.WithCancelCause(context.WithoutCancel(ctx))
+	defer cancel(nil)
+	stop := context.AfterFunc(ctx, func() { cancel(ErrBuildCancelled) })
+	defer stop()
+
+	buildErr := s.executeBuild(runCtx, entBuild.BuildID, entBuild.BuildDir, req)
+
+	// The build record is read with a fresh context, ctx may be cancelled by now
+	entBuild, err = s.getBuild(context.Background(), entBuild.BuildID)
+	if err != nil {
+		return nil, err
+	}
+	return BuildResultFromEnt(entBuild), buildErr
+}
+
+// dryRunResult plans a build and wraps the plan in a build result
+func (s *projectService) dryRunResult(ctx context.Context, req BuildProjectRequest) (*BuildResult, error) {
+	plan, err := s.PlanProjectBuild(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &BuildResult{
+		ProjectID: plan.ProjectID,
+		Status:    BuildStatusDryRun,
+		OutputDir: plan.OutputDir,
+		Plan:      plan,
+	}, nil
+}
+
+// CancelBuild stops a queued or running build
+func (s *projectService) CancelBuild(ctx context.Context, projectID int, buildID string) error {
+	entBuild, err := s.getProjectBuild(ctx, projectID, buildID)
+	if err != nil {
+		return err
+	}
+
+	dequeued, found := s.queue.Cancel(buildID)
+	if !found {
+		return ErrBuildNotActive
+	}
+
+	// A dequeued build never started, so nobody else will record its end
+	if dequeued {
+		s.updateBuild(entBuild.BuildID, func(update *ent.BuildUpdate) {
+			update.SetStatus(build.StatusCancelled).
+				SetMessage("Build cancelled").
+				SetCompletedAt(time.Now())
+		})
+		s.publishBuildResult(entBuild.BuildID)
+	}
+
+	slog.Info("Build cancellation requested", "buildID", buildID, "queued", dequeued)
+	return nil
+}
+
+// prepareBuildRequest validates a build request and applies its defaults
+func (s *projectService) prepareBuildRequest(ctx context.Context, req BuildProjectRequest) (BuildProjectRequest, error) {
 	// Validate input
 	if err := ValidateBuildProjectRequest(req); err != nil {
-		return nil, err
+		return req, err
 	}
 
 	// Check if project exists
 	projectExists, err := s.db.Project.Query().Where(project.ID(req.ProjectID)).Exist(ctx)
 	if err != nil {
-		return nil, err
+		return req, err
 	}
 	if !projectExists {
-		return nil, ErrProjectNotFound
+		return req, ErrProjectNotFound
+	}
+
+	// Parameters left out come from the build profile first
+	req, err = s.applyBuildProfile(ctx, req)
+	if err != nil {
+		return req, err
 	}
 
-	// Generate unique build ID
-	buildID := uuid.New().String()
-	
 	// Set defaults
 	if req.PriorityThreshold == 0 {
-		req.PriorityThreshold = 4 // Include all priorities by default
+		req.PriorityThreshold = req.FallbackPriorityThreshold
 	}
-	if req.OutputDir == "" {
-		// Get project to determine output directory
+	if req.PriorityThreshold == 0 {
+		req.PriorityThreshold = DefaultPriorityThreshold // Include all priorities by default
+	}
+	if req.OutputDir == "" || req.AbcFileDir == "" || req.SongOrder == "" {
+		// Get project to determine output directory, ABC file directory and song order
 		entProject, err := s.db.Project.Get(ctx, req.ProjectID)
 		if err != nil {
-			return nil, err
+			return req, err
+		}
+		if err := s.applyProfileConfig(ctx, entProject, req.Profile); err != nil {
+			return req, err
+		}
+		if req.OutputDir == "" {
+			req.OutputDir = defaultOutputDir(entProject, req.SupplementSince != "")
+		}
+		if req.AbcFileDir == "" {
+			req.AbcFileDir = defaultAbcFileDir(entProject)
+		}
+		if req.SongOrder == "" {
+			req.SongOrder, err = projectSongOrder(entProject)
+			if err != nil {
+				return req, err
+			}
 		}
-		req.OutputDir = entProject.ShortName
 	}
 
-	// Initialize build status
-	now := time.Now().Format(time.RFC3339)
-	buildStatus := &BuildStatus{
-		Status:    "pending",
-		Progress:  0,
-		Message:   "Build queued",
-		StartedAt: now,
+	if req.SupplementSince != "" {
+		return s.prepareSupplement(ctx, req)
 	}
-	buildStatuses[buildID] = buildStatus
 
-	// Initialize build result
-	buildResult := &BuildResult{
-		BuildID:   buildID,
-		ProjectID: req.ProjectID,
-		Status:    "pending",
-		OutputDir: req.OutputDir,
-		StartedAt: now,
+	return req, nil
+}
+
+// createBuild validates a build request, applies its defaults and persists a
+// new build record with the given initial status and the process that runs it
+func (s *projectService) createBuild(ctx context.Context, req BuildProjectRequest, status build.Status, origin build.Origin, message string) (*ent.Build, BuildProjectRequest, error) {
+	req, err := s.prepareBuildRequest(ctx, req)
+	if err != nil {
+		return nil, req, err
+	}
+	// Songs added before the project had numbers get them with their first build
+	if err := s.assignSongNumbers(ctx, req.ProjectID); err != nil {
+		return nil, req, err
 	}
-	buildResults[buildID] = buildResult
 
-	// Start build in background (simplified version using CLI command)
-	go s.executeBuild(buildID, req)
+	// Generate unique build ID, every build is written into its own directory
+	buildID := uuid.New().String()
+	startedAt := time.Now()
 
-	return buildResult, nil
+	// Persist the build so that its history survives server restarts
+	entBuild, err := s.db.Build.Create().
+		SetBuildID(buildID).
+		SetProjectID(req.ProjectID).
+		SetStatus(status).
+		SetOrigin(origin).
+		SetProgress(0).
+		SetMessage(message).
+		SetOutputDir(req.OutputDir).
+		SetBuildDir(buildDirName(startedAt, buildID)).
+		SetStartedAt(startedAt).
+		SetAbcFileDir(req.AbcFileDir).
+		SetPriorityThreshold(req.PriorityThreshold).
+		SetSampleID(req.SampleID).
+		SetIncremental(req.Incremental).
+		SetSongOrder(req.SongOrder).
+		SetSupplementSince(req.SupplementSince).
+		SetProfile(req.Profile).
+		SetFinal(req.Final).
+		Save(ctx)
+	if err != nil {
+		return nil, req, fmt.Errorf("failed to create build record: %w", err)
+	}
+
+	return entBuild, req, nil
 }
 
 // GetBuildStatus returns the current status of a build
 func (s *projectService) GetBuildStatus(ctx context.Context, buildID string) (*BuildStatus, error) {
-	status, exists := buildStatuses[buildID]
-	if !exists {
-		return nil, ErrBuildNotFound
+	entBuild, err := s.getBuild(ctx, buildID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := BuildStatusFromEnt(entBuild)
+	if entBuild.Status == build.StatusQueued {
+		status.QueuePosition = s.queue.Position(buildID)
 	}
 	return status, nil
 }
 
-// ListBuilds returns all builds for a project
+// GetBuild returns a build of a project including its report
+func (s *projectService) GetBuild(ctx context.Context, projectID int, buildID string) (*BuildResult, error) {
+	entBuild, err := s.getProjectBuild(ctx, projectID, buildID)
+	if err != nil {
+		return nil, err
+	}
+	return BuildResultFromEnt(entBuild), nil
+}
+
+// ListBuilds returns all builds for a project, newest first
 func (s *projectService) ListBuilds(ctx context.Context, projectID int) ([]*BuildResult, error) {
-	var results []*BuildResult
-	for _, result := range buildResults {
-		if result.ProjectID == projectID {
-			results = append(results, result)
-		}
+	entBuilds, err := s.db.Build.Query().
+		Where(build.ProjectID(projectID)).
+		Order(ent.Desc(build.FieldStartedAt), ent.Desc(build.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return results, nil
+	return BuildResultsFromEnt(entBuilds), nil
 }
 
-// ClearBuildHistory removes all build history for a project
+// ClearBuildHistory removes all finished builds of a project.
+// Builds that are still queued or running are kept.
 func (s *projectService) ClearBuildHistory(ctx context.Context, projectID int) error {
-	// Remove all builds for this project from buildResults
-	for buildID, result := range buildResults {
-		if result.ProjectID == projectID {
-			delete(buildResults, buildID)
-			delete(buildStatuses, buildID)
+	_, err := s.db.Build.Delete().
+		Where(
+			build.ProjectID(projectID),
+			build.StatusNotIn(activeBuildStatuses...),
+		).
+		Exec(ctx)
+	return err
+}
+
+// MarkInterruptedBuilds marks all server builds that are still queued or
+// running as interrupted. It is meant to be called on server startup, when no
+// server build can be active anymore. Builds run in the foreground, e.g. by
+// the CLI, may still be running in another process and are left alone.
+func (s *projectService) MarkInterruptedBuilds(ctx context.Context) (int, error) {
+	count, err := s.db.Build.Update().
+		Where(
+			build.StatusIn(activeBuildStatuses...),
+			build.OriginEQ(build.OriginServer),
+		).
+		SetStatus(build.StatusInterrupted).
+		SetMessage("Build interrupted").
+		SetError("build was interrupted because the server stopped").
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark interrupted builds: %w", err)
+	}
+	return count, nil
+}
+
+// StreamBuildEvents returns the events of a build of a project after
+// lastEventID followed by all live events. The channel is closed after the
+// final result event or when ctx is done. Builds whose events are no longer in
+// memory get a single result event built from the build history. Active builds
+// without events in memory, e.g. those run by the CLI in another process, are
+// followed in the build history until they end.
+func (s *projectService) StreamBuildEvents(ctx context.Context, projectID int, buildID string, lastEventID int) (<-chan BuildEvent, error) {
+	entBuild, err := s.getProjectBuild(ctx, projectID, buildID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.events.Has(buildID) {
+		if !isActiveBuildStatus(entBuild.Status) {
+			events := make(chan BuildEvent, 1)
+			events <- buildResultEvent(entBuild)
+			close(events)
+			return events, nil
+		}
+		return s.pollBuildEvents(ctx, entBuild, lastEventID), nil
+	}
+
+	events, unsubscribe := s.events.Subscribe(buildID, lastEventID)
+	stop := context.AfterFunc(ctx, unsubscribe)
+
+	// Forward the events so that the stream ends with ctx as well
+	out := make(chan BuildEvent)
+	go func() {
+		defer close(out)
+		defer stop()
+		for event := range events {
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				unsubscribe()
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// pollBuildEvents follows a build record that no event hub publishes for. It
+// sends the current status first, a status or progress event for every change
+// and the result event once the build has ended. Event IDs continue after
+// lastEventID.
+func (s *projectService) pollBuildEvents(ctx context.Context, entBuild *ent.Build, lastEventID int) <-chan BuildEvent {
+	out := make(chan BuildEvent)
+	go func() {
+		defer close(out)
+		id := lastEventID
+		send := func(event BuildEvent) bool {
+			id++
+			event.ID = id
+			event.BuildID = entBuild.BuildID
+			event.Time = time.Now().Format(time.RFC3339)
+			select {
+			case out <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		last := entBuild
+		if !send(BuildEvent{Type: BuildEventStatus, Status: string(last.Status), Progress: last.Progress, Message: last.Message}) {
+			return
+		}
+
+		ticker := time.NewTicker(buildEventPollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+
+			current, err := s.getBuild(ctx, last.BuildID)
+			if err != nil {
+				// The build has been removed from the history, e.g. by clearing it
+				if ctx.Err() == nil {
+					slog.Warn("failed to poll build", "buildID", last.BuildID, "error", err)
+				}
+				return
+			}
+			if !isActiveBuildStatus(current.Status) {
+				send(buildResultEvent(current))
+				return
+			}
+			switch {
+			case current.Status != last.Status:
+				if !send(BuildEvent{Type: BuildEventStatus, Status: string(current.Status), Progress: current.Progress, Message: current.Message}) {
+					return
+				}
+			case current.Progress != last.Progress || current.Message != last.Message:
+				if !send(BuildEvent{Type: BuildEventProgress, Progress: current.Progress, Message: current.Message}) {
+					return
+				}
+			}
+			last = current
 		}
+	}()
+	return out
+}
+
+// publishBuildResult publishes the persisted outcome of a build as its final event
+func (s *projectService) publishBuildResult(buildID string) {
+	entBuild, err := s.getBuild(context.Background(), buildID)
+	if err != nil {
+		slog.Warn("failed to load build result", "buildID", buildID, "error", err)
+		return
 	}
-	return nil
+	s.events.Publish(buildID, buildResultEvent(entBuild))
 }
 
-// executeBuild runs the actual build process in background
-func (s *projectService) executeBuild(buildID string, req BuildProjectRequest) {
-	status := buildStatuses[buildID]
-	result := buildResults[buildID]
+// buildResultEvent creates the result event of a build record
+func buildResultEvent(entBuild *ent.Build) BuildEvent {
+	result := BuildResultFromEnt(entBuild)
+	return BuildEvent{
+		BuildID:  entBuild.BuildID,
+		Type:     BuildEventResult,
+		Status:   result.Status,
+		Progress: entBuild.Progress,
+		Message:  entBuild.Message,
+		Error:    result.Error,
+		Result:   result,
+	}
+}
 
-	// Update status to running
-	status.Status = "running"
-	status.Progress = 10
-	status.Message = "Starting build process"
-	result.Status = "running"
+// isActiveBuildStatus reports whether a build with the given status is queued or running
+func isActiveBuildStatus(status build.Status) bool {
+	for _, active := range activeBuildStatuses {
+		if status == active {
+			return true
+		}
+	}
+	return false
+}
+
+// getBuild loads a build record by its public build ID
+func (s *projectService) getBuild(ctx context.Context, buildID string) (*ent.Build, error) {
+	entBuild, err := s.db.Build.Query().Where(build.BuildID(buildID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBuildNotFound
+		}
+		return nil, err
+	}
+	return entBuild, nil
+}
+
+// getProjectBuild loads a build record of a project. Builds of other projects
+// are reported as not found.
+func (s *projectService) getProjectBuild(ctx context.Context, projectID int, buildID string) (*ent.Build, error) {
+	entBuild, err := s.getBuild(ctx, buildID)
+	if err != nil {
+		return nil, err
+	}
+	if entBuild.ProjectID != projectID {
+		return nil, ErrBuildNotFound
+	}
+	return entBuild, nil
+}
 
-	// Create progress callback
-	progressCallback := func(progress int, message string) {
-		status.Progress = progress
-		status.Message = message
-		slog.Info("Build progress", "buildID", buildID, "progress", progress, "message", message)
+// updateBuild applies changes to a persisted build record. Failures are only
+// logged, a status write must never abort the build itself.
+func (s *projectService) updateBuild(buildID string, apply func(update *ent.BuildUpdate)) {
+	update := s.db.Build.Update().Where(build.BuildID(buildID))
+	apply(update)
+	if _, err := update.Save(context.Background()); err != nil {
+		slog.Warn("failed to persist build status", "buildID", buildID, "error", err)
+	}
+}
+
+// executeBuild runs the actual build process and records its outcome.
+// It is called by a build queue worker or directly by RunProjectBuild.
+func (s *projectService) executeBuild(ctx context.Context, buildID, buildDir string, req BuildProjectRequest) error {
+	// Update status to running
+	s.updateBuild(buildID, func(update *ent.BuildUpdate) {
+		update.SetStatus(build.StatusRunning).
+			SetProgress(10).
+			SetMessage("Starting build process")
+	})
+	s.events.Publish(buildID, BuildEvent{Type: BuildEventStatus, Status: string(build.StatusRunning), Progress: 10, Message: "Starting build process"})
+
+	// The final outcome is published once it has been persisted
+	defer s.publishBuildResult(buildID)
+
+	// Persist progress and pass every event on to the subscribers
+	eventCallback := func(event BuildEvent) {
+		if event.Type == BuildEventProgress {
+			s.updateBuild(buildID, func(update *ent.BuildUpdate) {
+				update.SetProgress(event.Progress).SetMessage(event.Message)
+			})
+			slog.Info("Build progress", "buildID", buildID, "progress", event.Progress, "message", event.Message)
+		}
+		s.events.Publish(buildID, event)
 	}
 
 	// Execute the build directly using core logic
-	ctx := context.Background()
-	err := s.ExecuteProjectBuildWithProgress(ctx, req, progressCallback)
+	report, manifest, err := s.executeProjectBuild(ctx, req, buildDir, eventCallback)
 
-	now := time.Now().Format(time.RFC3339)
-	result.CompletedAt = now
-	status.CompletedAt = now
+	// Keep the results of the songs that were built before the build stopped
+	setReport := func(update *ent.BuildUpdate) {
+		if report != nil {
+			update.SetReport(report)
+		}
+	}
 
-	if err != nil {
-		status.Status = "failed"
-		status.Progress = 100
-		status.Message = "Build failed"
-		result.Status = "failed"
-		result.Error = fmt.Sprintf("Build failed: %v", err)
+	switch {
+	case err != nil && errors.Is(context.Cause(ctx), ErrBuildCancelled):
+		s.updateBuild(buildID, func(update *ent.BuildUpdate) {
+			update.SetStatus(build.StatusCancelled).
+				SetMessage("Build cancelled").
+				SetCompletedAt(time.Now())
+			setReport(update)
+		})
+		slog.Info("Build cancelled", "buildID", buildID)
+		return ErrBuildCancelled
+
+	case err != nil && ctx.Err() != nil:
+		s.updateBuild(buildID, func(update *ent.BuildUpdate) {
+			update.SetStatus(build.StatusInterrupted).
+				SetMessage("Build interrupted").
+				SetError(fmt.Sprintf("Build interrupted: %v", ctx.Err())).
+				SetCompletedAt(time.Now())
+			setReport(update)
+		})
+		slog.Warn("Build interrupted", "buildID", buildID, "error", err)
+		return err
+
+	case err != nil:
+		s.updateBuild(buildID, func(update *ent.BuildUpdate) {
+			update.SetStatus(build.StatusFailed).
+				SetProgress(100).
+				SetMessage("Build failed").
+				SetError(fmt.Sprintf("Build failed: %v", err)).
+				SetCompletedAt(time.Now())
+			setReport(update)
+		})
 		slog.Error("Build failed", "buildID", buildID, "error", err)
-	} else {
-		status.Status = "completed"
-		status.Progress = 100
-		status.Message = "Build completed successfully"
-		result.Status = "completed"
-		
-		// TODO: Scan output directory to get generated files
-		result.GeneratedFiles = []string{} // Placeholder
-		
-		slog.Info("Build completed successfully", "buildID", buildID)
-		result.Status = "completed"
-		
-		// TODO: Parse output to extract generated files
-		result.GeneratedFiles = []string{
-			fmt.Sprintf("%s/druckdateien", req.OutputDir),
-			fmt.Sprintf("%s/pdf", req.OutputDir),
-			fmt.Sprintf("%s/abc", req.OutputDir),
-		}
+		return err
+	}
+
+	// Failed songs do not fail the build, the report tells which songs are missing
+	status, message := build.StatusCompleted, "Build completed successfully"
+	if report.Failed > 0 {
+		status = build.StatusCompletedWithErrors
+		message = fmt.Sprintf("Build completed, %d of %d songs failed", report.Failed, report.Total)
 	}
+
+	s.updateBuild(buildID, func(update *ent.BuildUpdate) {
+		update.SetStatus(status).
+			SetProgress(100).
+			SetMessage(message).
+			SetGeneratedFiles(manifest.Paths()).
+			SetReport(report).
+			SetManifest(manifest).
+			SetCompletedAt(time.Now())
+	})
+	slog.Info("Build completed", "buildID", buildID, "status", status, "failedSongs", report.Failed)
+	return nil
 }
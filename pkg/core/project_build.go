@@ -9,14 +9,16 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
-	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"dario.cat/mergo"
+	"github.com/bwl21/zupfmanager/internal/buildreport"
 	"github.com/bwl21/zupfmanager/internal/ent"
 	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
 	"github.com/bwl21/zupfmanager/internal/htmlpdf"
+	"github.com/bwl21/zupfmanager/internal/templatevars"
 	"github.com/bwl21/zupfmanager/internal/zupfnoter"
 	"github.com/pdfcpu/pdfcpu/pkg/api"
 	pdfcpuModel "github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
@@ -37,10 +39,83 @@ func (s *projectService) ExecuteProjectBuild(ctx context.Context, req BuildProje
 
 // ExecuteProjectBuildWithProgress performs the actual project build logic with progress updates
 func (s *projectService) ExecuteProjectBuildWithProgress(ctx context.Context, req BuildProjectRequest, progressCallback ProgressCallback) error {
+	_, _, err := s.executeProjectBuild(ctx, req, newBuildDirName(), func(event BuildEvent) {
+		if event.Type == BuildEventProgress && progressCallback != nil {
+			progressCallback(event.Progress, event.Message)
+		}
+	})
+	return err
+}
+
+// executeProjectBuild performs the actual project build logic and reports
+// progress and per-song results as build events. The build is written into
+// buildDir below the output directory, which becomes the latest build once
+// the build succeeded. A build that does not complete removes its directory.
+// Projects with editions get a directory per edition in the build directory.
+// The returned report holds the outcome of every song, it is also returned if
+// the build failed later on. The manifest of the generated files is only
+// created for finished builds.
+func (s *projectService) executeProjectBuild(ctx context.Context, req BuildProjectRequest, buildDir string, onEvent BuildEventCallback) (report *BuildReport, manifest *BuildManifest, err error) {
+	project, err := s.loadBuildProject(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	retention, err := projectBuildRetention(project)
+	if err != nil {
+		return nil, nil, err
+	}
+	editions, err := projectEditions(project)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Zupfnoter output survives in the render cache, incremental builds reuse it.
+	// The cache is shared by all builds of the output directory.
+	cache := newSongCache(req.OutputDir, req.Incremental)
+
+	buildPath := filepath.Join(req.OutputDir, buildDir)
+	defer func() {
+		// A failed or cancelled build leaves half written output behind, remove it
+		if err != nil {
+			if err := os.RemoveAll(buildPath); err != nil {
+				slog.Warn("Failed to remove build directory", "directory", buildPath, "error", err)
+			}
+		}
+	}()
+	report, err = s.buildEditions(ctx, req, project, editions, buildPath, cache, onEvent)
+	if err != nil {
+		return report, nil, err
+	}
+
+	// Entries of songs that changed or left the project long ago are not needed anymore
+	cache.prune()
+
+	if onEvent != nil {
+		onEvent(BuildEvent{Type: BuildEventProgress, Progress: 95, Message: "Creating manifest"})
+	}
+	manifest, err = s.createManifest(project, buildPath, report)
+	if err != nil {
+		return report, nil, fmt.Errorf("failed to create manifest: %w", err)
+	}
+
+	// The build is complete without the link, only latest keeps pointing to an older one
+	if err := publishLatestBuild(req.OutputDir, buildDir); err != nil {
+		slog.Warn("Failed to publish build as latest", "outputDir", req.OutputDir, "buildDir", buildDir, "error", err)
+	}
+	// Old builds are only cleaned up, failing to remove them does not fail the build
+	if err := pruneBuildDirs(req.OutputDir, retention); err != nil {
+		slog.Warn("Failed to remove old builds", "outputDir", req.OutputDir, "error", err)
+	}
+	return report, manifest, nil
+}
+
+// loadBuildProject loads a project with the songs selected by a build request
+// attached in build order. The Number of a song is its index in the build.
+func (s *projectService) loadBuildProject(ctx context.Context, req BuildProjectRequest) (*ent.Project, error) {
 	// Get the project first
 	project, err := s.db.Project.Get(ctx, req.ProjectID)
 	if err != nil {
-		return fmt.Errorf("failed to get project: %w", err)
+		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
 
 	// Then query the project songs separately with the priority filter (like the working version)
@@ -56,31 +131,123 @@ func (s *projectService) ExecuteProjectBuildWithProgress(ctx context.Context, re
 		Order(ent.Asc("priority")).
 		All(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to query project songs: %w", err)
+		return nil, fmt.Errorf("failed to query project songs: %w", err)
 	}
 
 	// Attach the songs to the project for compatibility with the rest of the code
 	project.Edges.ProjectSongs = projectSongs
 
-	return s.buildProject(ctx, req.AbcFileDir, req.OutputDir, project, req.SampleID, progressCallback)
-}
+	// Ensure all songs are loaded
+	for _, ps := range projectSongs {
+		if ps.Edges.Song == nil {
+			return nil, fmt.Errorf("song not loaded for project song %d", ps.ID)
+		}
+	}
 
-func (s *projectService) buildProject(ctx context.Context, abcFileDir, outputDir string, project *ent.Project, sampleId string, progressCallback ProgressCallback) error {
-	updateProgress := func(progress int, message string) {
-		if progressCallback != nil {
-			progressCallback(progress, message)
+	// Builds have numbered all songs, plans and previews number them in memory
+	if err := s.previewSongNumbers(ctx, project, projectSongs); err != nil {
+		return nil, err
+	}
+
+	// The config overlay of the build profile applies to the project and every song
+	if err := s.applyProfileConfig(ctx, project, req.Profile); err != nil {
+		return nil, err
+	}
+
+	sortProjectSongs(projectSongs, req.SongOrder)
+
+	numbering, err := projectNumbering(project)
+	if err != nil {
+		return nil, err
+	}
+	if req.SupplementSince != "" {
+		since, err := time.Parse(time.RFC3339Nano, req.SupplementSince)
+		if err != nil {
+			return nil, fmt.Errorf("invalid supplement date: %w", err)
 		}
+		project.Edges.ProjectSongs = supplementSongs(projectSongs, since, numbering)
+	} else {
+		numberBuildSongs(projectSongs, numbering)
 	}
 
-	updateProgress(15, "Preparing directories")
+	return project, nil
+}
 
-	// Remove existing directories
-	for _, dir := range []string{"pdf", "abc", "log", "druckdateien", "referenz"} {
-		if err := os.RemoveAll(filepath.Join(outputDir, dir)); err != nil {
-			slog.Error("Failed to remove directory", "directory", dir, "error", err)
-			return fmt.Errorf("failed to remove directory %s: %w", dir, err)
+// buildOutputDirs are the directories written by a build below the build
+// directory, or below the directory of every edition
+var buildOutputDirs = []string{"pdf", "abc", "log", "druckdateien", "referenz", "html"}
+
+// buildEditions writes every edition of a project into a directory of its own
+// below outputDir, one after the other. Editions share the render cache, a
+// song whose zupfnoter config is the same in several editions is rendered
+// once. Projects without editions are written into outputDir itself.
+func (s *projectService) buildEditions(ctx context.Context, req BuildProjectRequest, project *ent.Project, editions []buildEdition, outputDir string, cache *songCache, onEvent BuildEventCallback) (*BuildReport, error) {
+	if len(editions) == 0 {
+		return s.buildProject(ctx, req, project, outputDir, cache, onEvent)
+	}
+
+	report := &BuildReport{ZupfnoterVersion: cache.version}
+	defer useBuildConfig(project, project.Config)
+	for i, edition := range editions {
+		slog.Info("Building edition", "edition", edition.Name)
+		useBuildConfig(project, edition.Config)
+
+		editionReport, err := s.buildProject(ctx, req, project, filepath.Join(outputDir, edition.Name), cache, editionEvents(onEvent, edition.Name, i, len(editions)))
+		for _, song := range editionReport.Songs {
+			song.Edition = edition.Name
+			report.Songs = append(report.Songs, song)
+		}
+		if err != nil {
+			report.Count()
+			return report, fmt.Errorf("edition %s: %w", edition.Name, err)
 		}
 	}
+	report.Count()
+	return report, nil
+}
+
+// buildProject writes a build into outputDir, a new build directory below the
+// output directory of the request or the directory of an edition in it
+func (s *projectService) buildProject(ctx context.Context, req BuildProjectRequest, project *ent.Project, outputDir string, cache *songCache, onEvent BuildEventCallback) (*BuildReport, error) {
+	abcFileDir := req.AbcFileDir
+	emit := func(event BuildEvent) {
+		if onEvent != nil {
+			onEvent(event)
+		}
+	}
+	updateProgress := func(progress int, message string) {
+		emit(BuildEvent{Type: BuildEventProgress, Progress: progress, Message: message})
+	}
+
+	report := &BuildReport{ZupfnoterVersion: cache.version}
+
+	// All songs and the table of contents see the same build date
+	vars := projectVariables(project, req.SampleID, time.Now())
+
+	// Editions may configure folders, bookmarks and watermarks of their own,
+	// check them before rendering
+	routing, err := projectFolderRouting(project)
+	if err != nil {
+		return report, err
+	}
+	bookmarks, err := projectBookmarks(project)
+	if err != nil {
+		return report, err
+	}
+	watermarks, err := projectWatermarks(project, vars, req.Final)
+	if err != nil {
+		return report, err
+	}
+	pageNumbers, err := tocPageNumbers(project)
+	if err != nil {
+		return report, err
+	}
+	docs, err := projectTocDocuments(project)
+	if err != nil {
+		return report, err
+	}
+
+	updateProgress(15, "Preparing directories")
 
 	// Create base directories
 	dirs := []string{
@@ -93,7 +260,7 @@ func (s *projectService) buildProject(ctx context.Context, abcFileDir, outputDir
 	for _, dir := range dirs {
 		if err := os.MkdirAll(dir, 0755); err != nil {
 			slog.Error("Failed to create directory", "directory", dir, "error", err)
-			return fmt.Errorf("failed to create directory %s: %w", dir, err)
+			return report, fmt.Errorf("failed to create directory %s: %w", dir, err)
 		}
 	}
 
@@ -101,58 +268,85 @@ func (s *projectService) buildProject(ctx context.Context, abcFileDir, outputDir
 	druckdateienDir := filepath.Join(outputDir, "druckdateien")
 	_ = os.MkdirAll(druckdateienDir, 0755)
 
-	// Create target directories based on folder patterns
-	folderPatterns := s.getFolderPatterns(project)
-	folderSet := make(map[string]bool)
-	for _, folder := range folderPatterns {
-		if !folderSet[folder] {
-			targetDir := filepath.Join(druckdateienDir, folder)
-			_ = os.MkdirAll(targetDir, 0755)
-			folderSet[folder] = true
-		}
+	// Create the target directories of the folder rules
+	for _, folder := range routing.Folders() {
+		_ = os.MkdirAll(filepath.Join(druckdateienDir, folder), 0755)
 	}
 
-	eg, ctx := errgroup.WithContext(ctx)
+	// Songs are built independently, a failing song must not cancel the others
+	var eg errgroup.Group
 	eg.SetLimit(5)
 
 	projectSongs := project.Edges.ProjectSongs
 	slog.Info("Project songs loaded", "count", len(projectSongs))
 	updateProgress(25, fmt.Sprintf("Building %d songs", len(projectSongs)))
 
-	// Ensure all songs are loaded
-	for _, ps := range projectSongs {
-		if ps.Edges.Song == nil {
-			return fmt.Errorf("song not loaded for project song %d", ps.ID)
-		}
-	}
-
-	// Sort the songs by title
-	sort.Slice(projectSongs, func(i, j int) bool {
-		return strings.ToLower(projectSongs[i].Edges.Song.Title) < strings.ToLower(projectSongs[j].Edges.Song.Title)
-	})
-
-	// Track completed songs for progress
+	// Track completed songs for progress, songs are built concurrently
+	var progressMu sync.Mutex
 	completedSongs := 0
 	totalSongs := len(projectSongs)
 
+	// Every song writes only its own report entry
+	report.Songs = make([]SongReport, totalSongs)
+
 	for id, song := range projectSongs {
 		song := song
 		songIndex := id
+		songReport := &report.Songs[songIndex]
+		*songReport = SongReport{
+			Index:         song.Number,
+			ProjectSongID: song.ID,
+			SongID:        song.SongID,
+			Title:         song.Edges.Song.Title,
+			Filename:      song.Edges.Song.Filename,
+			Status:        buildreport.SongSkipped,
+		}
 		eg.Go(func() error {
-			err := s.buildSong(ctx, abcFileDir, outputDir, songIndex+1, song, sampleId, project)
-			if err == nil {
-				completedSongs++
-				// Progress from 25% to 70% based on song completion
-				progress := 25 + (completedSongs * 45 / totalSongs)
-				updateProgress(progress, fmt.Sprintf("Built song %d/%d: %s", completedSongs, totalSongs, song.Edges.Song.Title))
+			// Songs that did not start before a cancellation stay skipped
+			if ctx.Err() != nil {
+				return nil
 			}
-			return err
+
+			eventSong := &BuildEventSong{
+				Index:    songReport.Index,
+				Title:    songReport.Title,
+				Filename: songReport.Filename,
+			}
+			emit(BuildEvent{Type: BuildEventSongStarted, Song: eventSong})
+
+			started := time.Now()
+			songReport.StartedAt = started.Format(time.RFC3339)
+			err := s.buildSong(ctx, abcFileDir, outputDir, song.Number, song, vars, project, routing, cache, songReport)
+			songReport.DurationMs = time.Since(started).Milliseconds()
+
+			if err != nil {
+				songReport.Status = buildreport.SongFailed
+				songReport.Error = err.Error()
+				emit(BuildEvent{Type: BuildEventSongFailed, Song: eventSong, Error: err.Error()})
+				return nil
+			}
+
+			songReport.Status = buildreport.SongCompleted
+			eventSong.Cached = songReport.Cached
+			emit(BuildEvent{Type: BuildEventSongCompleted, Song: eventSong})
+			progressMu.Lock()
+			completedSongs++
+			// Progress from 25% to 70% based on song completion
+			progress := 25 + (completedSongs * 45 / totalSongs)
+			updateProgress(progress, fmt.Sprintf("Built song %d/%d: %s", completedSongs, totalSongs, song.Edges.Song.Title))
+			progressMu.Unlock()
+			return nil
 		})
 	}
-	err := eg.Wait()
-	if err != nil {
-		slog.Warn("Some songs failed to build, but continuing with TOC creation", "error", err)
-		// Don't return here - continue with TOC creation even if some songs failed
+	eg.Wait()
+	report.Count()
+	if report.Failed > 0 {
+		slog.Warn("Some songs failed to build, but continuing with TOC creation", "failed", report.Failed)
+	}
+
+	// Stop here if the build was cancelled, the remaining steps would fail anyway
+	if err := ctx.Err(); err != nil {
+		return report, fmt.Errorf("build aborted: %w", err)
 	}
 
 	updateProgress(75, "Processing copyright information")
@@ -162,60 +356,81 @@ func (s *projectService) buildProject(ctx context.Context, abcFileDir, outputDir
 
 	err = s.createCopyrightDirectories(outputDir, copyrightNames)
 	if err != nil {
-		return fmt.Errorf("failed to create copyright directories: %w", err)
+		return report, fmt.Errorf("failed to create copyright directories: %w", err)
 	}
 
 	err = s.copyPdfsToCopyrightDirectories(project, outputDir)
 	if err != nil {
-		return fmt.Errorf("failed to copy PDFs to copyright directories: %w", err)
+		return report, fmt.Errorf("failed to copy PDFs to copyright directories: %w", err)
 	}
 
+	// Registers go the same way as the table of contents
+	docs = withFirstLines(docs, abcFileDir, projectSongs)
+
 	updateProgress(80, "Creating table of contents")
-	if err := s.createToc(context.Background(), project, projectSongs, outputDir); err != nil {
-		return fmt.Errorf("failed to create table of contents: %w", err)
+	for _, doc := range docs {
+		if err := s.createToc(ctx, doc, project, projectSongs, vars, outputDir, routing); err != nil {
+			return report, fmt.Errorf("failed to create %s: %w", doc.Title, err)
+		}
 	}
 
 	updateProgress(82, "Creating HTML table of contents")
 	slog.Info("Starting HTML table of contents creation", "project", project.ShortName, "songs", len(projectSongs))
-	if err := s.createHTMLToc(context.Background(), project, projectSongs, outputDir); err != nil {
-		slog.Error("Failed to create HTML table of contents", "error", err)
-		return fmt.Errorf("failed to create HTML table of contents: %w", err)
+	for _, doc := range docs {
+		if err := s.createHTMLToc(ctx, doc, project, projectSongs, vars, outputDir, routing); err != nil {
+			slog.Error("Failed to create HTML table of contents", "document", doc.Title, "error", err)
+			return report, fmt.Errorf("failed to create HTML %s: %w", doc.Title, err)
+		}
 	}
 	slog.Info("HTML table of contents creation completed")
 
-	updateProgress(85, "Merging PDF files")
-	// Get folder patterns from project config or use defaults
-	folderPatterns = s.getFolderPatterns(project)
+	if err := ctx.Err(); err != nil {
+		return report, fmt.Errorf("build aborted: %w", err)
+	}
+
+	folders := routing.Folders()
 
-	// Get unique target folders
-	folderSet = make(map[string]bool)
-	for _, targetFolder := range folderPatterns {
-		folderSet[targetFolder] = true
+	// Second pass: the pages of the songs are known once all PDFs are distributed
+	if pageNumbers {
+		updateProgress(84, "Numbering the pages of the table of contents")
+		if err := s.numberTocPages(ctx, docs, project, projectSongs, vars, outputDir, routing); err != nil {
+			return report, err
+		}
 	}
 
-	// Always include 'noten' folder for HTML PDFs (including TOC)
-	folderSet["noten"] = true
+	updateProgress(85, "Merging PDF files")
+
+	// Watermarks are stamped before merging, the merged PDFs carry them as well
+	if len(watermarks) > 0 {
+		if err := s.stampWatermarks(outputDir, folders, watermarks); err != nil {
+			return report, fmt.Errorf("failed to stamp watermarks: %w", err)
+		}
+	}
 
 	// Merge PDFs for each target folder
-	for folder := range folderSet {
+	for _, folder := range folders {
 		sourceDir := filepath.Join(outputDir, "druckdateien", folder)
 		// Add project short name to the output filename
-		destFile := filepath.Join(outputDir, "druckdateien", fmt.Sprintf("%s_%s.pdf", project.ShortName, folder))
+		destFile := filepath.Join(outputDir, "druckdateien", mergedPDFName(project, folder, req.SupplementSince != ""))
 
 		slog.Info("Merging PDFs for folder", "folder", folder, "source", sourceDir, "dest", destFile)
 
-		err = s.mergePDFs(sourceDir, destFile)
+		files, err := s.mergePDFs(sourceDir, destFile)
 		if err != nil {
-			return fmt.Errorf("failed to merge PDFs in %s directory: %w", folder, err)
+			return report, fmt.Errorf("failed to merge PDFs in %s directory: %w", folder, err)
+		}
+
+		// Bookmarks only help to navigate, the merged PDF is usable without them
+		if len(files) > 0 && bookmarks.Enabled {
+			if err := s.addMergedPDFBookmarks(destFile, files, projectSongs, bookmarks); err != nil {
+				slog.Warn("failed to add bookmarks to merged PDF", "file", destFile, "error", err)
+			}
 		}
 	}
 
-	return nil
+	return report, nil
 }
 
-// Rest of the helper functions...
-// (I'll add them in the next step to keep this manageable)
-
 // getCopyrightNames returns a slice of copyright names used in the project.
 func (s *projectService) getCopyrightNames(project *ent.Project) []string {
 	copyrightNames := make([]string, 0)
@@ -227,41 +442,36 @@ func (s *projectService) getCopyrightNames(project *ent.Project) []string {
 	return copyrightNames
 }
 
-func (s *projectService) createToc(ctx context.Context, project *ent.Project, projectSongs []*ent.ProjectSong, outputDir string) error {
-	tocabc := ""
-	for id, song := range projectSongs {
-		tocinfo := ""
-		if song.Edges.Song.Tocinfo != "" {
-			tocinfo = " - " + song.Edges.Song.Tocinfo
-		}
+func (s *projectService) createToc(ctx context.Context, doc tocDocument, project *ent.Project, projectSongs []*ent.ProjectSong, vars *templatevars.Variables, outputDir string, routing *FolderRouting) error {
+	if err := s.renderTocPDFs(ctx, doc, project, projectSongs, vars, nil, outputDir); err != nil {
+		return err
+	}
 
-		tocabc += fmt.Sprintf("W:%02d %s%s\n", id+1, song.Edges.Song.Title, tocinfo)
+	// Distribute the table of contents PDF to the print files directories.
+	err := s.distributeZupfnoterOutput(routing, doc.abcFilename(), outputDir, 0)
+	if err != nil {
+		return fmt.Errorf("failed to distribute Zupfnoter output: %w", err)
 	}
 
-	templateFile := filepath.Join(project.ShortName, "tpl", "999_inhaltsverzeichnis_template.abc")
-	toctemplateBytes, err := os.ReadFile(templateFile)
+	return nil
+}
+
+// renderTocPDFs writes the ABC table of contents or register to abc/ below
+// dir and renders it with zupfnoter to pdf/ below dir. pages maps song
+// numbers to the page they start on, it may be nil.
+func (s *projectService) renderTocPDFs(ctx context.Context, doc tocDocument, project *ent.Project, projectSongs []*ent.ProjectSong, vars *templatevars.Variables, pages map[int]int, dir string) error {
+	template, err := s.resolveTemplate(ctx, project, TemplateKindTOC)
 	if err != nil {
-		slog.Warn("failed to read template file, using default", "path", templateFile, "error", err)
-		defaultTemplateFile := "x/MBT-2025/999_inhaltsverzeichnis_template.abc"
-		toctemplateBytes, err = os.ReadFile(defaultTemplateFile)
-		if err != nil {
-			slog.Warn("failed to read default template file, using built-in template", "path", defaultTemplateFile, "error", err)
-			// Use built-in template as last resort
-			toctemplateBytes = []byte(`X:1
- sollte T:Inhaltsverzeichnis
-M:4/4
-L:1/4
-K:C
-W:{{TOC}}
-`)
-		} else {
-			slog.Warn("using default template file", "path", defaultTemplateFile)
-		}
+		return err
+	}
+	slog.Info("using TOC template", "source", template.Source, "template", template.Origin())
+	toctemplate, err := renderTocABC(template.Content, doc, project, projectSongs, vars, pages)
+	if err != nil {
+		return err
 	}
-	toctemplate := strings.Replace(string(toctemplateBytes), "W:{{TOC}}", tocabc, 1)
 
-	tocSongFilename := "00_inhaltsverzeichnis.abc"
-	err = os.WriteFile(filepath.Join(outputDir, "abc", tocSongFilename), []byte(toctemplate), 0644)
+	tocABCFilename := doc.abcFilename()
+	err = os.WriteFile(filepath.Join(dir, "abc", tocABCFilename), []byte(toctemplate), 0644)
 	if err != nil {
 		return fmt.Errorf("failed to write toc file: %w", err)
 	}
@@ -270,78 +480,97 @@ W:{{TOC}}
 	if err != nil {
 		return fmt.Errorf("failed to create temp file: %w", err)
 	}
+	defer os.Remove(tempFile.Name())
 	json.NewEncoder(tempFile).Encode("{}")
 	tempFile.Close()
 
-	stdOutBuf, stdErrBuf, err := zupfnoter.Run(ctx, filepath.Join(outputDir, "abc", tocSongFilename), filepath.Join(outputDir, "pdf"))
+	stdOutBuf, stdErrBuf, err := zupfnoter.Run(ctx, filepath.Join(dir, "abc", tocABCFilename), filepath.Join(dir, "pdf"))
 	if err != nil {
-		errorMsg := fmt.Sprintf("Zupfnoter failed for TOC %s", tocSongFilename)
+		errorMsg := fmt.Sprintf("Zupfnoter failed for TOC %s", tocABCFilename)
 		if stdOutBuf != "" {
 			errorMsg += fmt.Sprintf("\nStdout: %s", stdOutBuf)
 		}
 		if stdErrBuf != "" {
 			errorMsg += fmt.Sprintf("\nStderr: %s", stdErrBuf)
 		}
-		slog.Error("zupfnoter failed for TOC", "output", stdOutBuf, "stderr", stdErrBuf, "file", tocSongFilename)
+		slog.Error("zupfnoter failed for TOC", "output", stdOutBuf, "stderr", stdErrBuf, "file", tocABCFilename)
 		return fmt.Errorf("%s: %w", errorMsg, err)
 	}
+	return nil
+}
 
-	// Distribute the table of contents PDF to the print files directories.
-	err = s.distributeZupfnoterOutput(project, tocSongFilename, outputDir, 0)
+func (s *projectService) createHTMLToc(ctx context.Context, doc tocDocument, project *ent.Project, projectSongs []*ent.ProjectSong, vars *templatevars.Variables, outputDir string, routing *FolderRouting) error {
+	slog.Info("createHTMLToc called", "project", project.ShortName, "document", doc.Title, "outputDir", outputDir, "songCount", len(projectSongs))
+
+	htmlTocFilename := doc.htmlFilename()
+	converted, err := s.renderHTMLTocPDF(ctx, doc, project, projectSongs, vars, nil, outputDir, filepath.Join(outputDir, "pdf"))
 	if err != nil {
-		return fmt.Errorf("failed to distribute Zupfnoter output: %w", err)
+		return err
+	}
+	if converted {
+		// Distribute the HTML TOC PDF only if conversion succeeded
+		err = s.distributeHTMLPDF(routing, htmlTocFilename, outputDir, 0)
+		if err != nil {
+			return fmt.Errorf("failed to distribute HTML TOC output: %w", err)
+		}
 	}
 
+	slog.Info("created HTML table of contents", "file", htmlTocFilename)
 	return nil
 }
 
-func (s *projectService) createHTMLToc(ctx context.Context, project *ent.Project, projectSongs []*ent.ProjectSong, outputDir string) error {
-	slog.Info("createHTMLToc called", "project", project.ShortName, "outputDir", outputDir, "songCount", len(projectSongs))
-
-	// Create HTML table of contents using built-in template
-	htmlContent := s.generateHTMLTocContent(project, projectSongs)
+// renderHTMLTocPDF writes the HTML table of contents or register to html/ of
+// the output directory and converts it to e.g. 00_inhaltsverzeichnis_noten.pdf
+// in pdfDir. pages maps song numbers to the page they start on, it may be
+// nil. It returns false if the HTML could not be converted.
+func (s *projectService) renderHTMLTocPDF(ctx context.Context, doc tocDocument, project *ent.Project, projectSongs []*ent.ProjectSong, vars *templatevars.Variables, pages map[int]int, outputDir, pdfDir string) (bool, error) {
+	// Create HTML table of contents using the template of the project
+	htmlContent, err := s.generateHTMLTocContent(ctx, doc, project, projectSongs, vars, pages)
+	if err != nil {
+		return false, err
+	}
 
 	// Write HTML file
-	htmlTocFilename := "00_inhaltsverzeichnis.html"
-	htmlTocPath := filepath.Join(outputDir, "html", htmlTocFilename)
+	htmlTocPath := filepath.Join(outputDir, "html", doc.htmlFilename())
 	htmlDir := filepath.Join(outputDir, "html")
 
 	slog.Info("Creating HTML directory", "path", htmlDir)
-	err := os.MkdirAll(htmlDir, 0755)
+	err = os.MkdirAll(htmlDir, 0755)
 	if err != nil {
 		slog.Error("Failed to create HTML directory", "path", htmlDir, "error", err)
-		return fmt.Errorf("failed to create HTML directory: %w", err)
+		return false, fmt.Errorf("failed to create HTML directory: %w", err)
 	}
 
 	slog.Info("Writing HTML TOC file", "path", htmlTocPath, "size", len(htmlContent))
 	err = os.WriteFile(htmlTocPath, []byte(htmlContent), 0644)
 	if err != nil {
 		slog.Error("Failed to write HTML TOC file", "path", htmlTocPath, "error", err)
-		return fmt.Errorf("failed to write HTML TOC file: %w", err)
+		return false, fmt.Errorf("failed to write HTML TOC file: %w", err)
 	}
 
 	// Verify file was created
 	if _, err := os.Stat(htmlTocPath); err != nil {
 		slog.Error("HTML TOC file verification failed", "path", htmlTocPath, "error", err)
-		return fmt.Errorf("HTML TOC file verification failed: %w", err)
+		return false, fmt.Errorf("HTML TOC file verification failed: %w", err)
 	}
 	slog.Info("HTML TOC file created successfully", "path", htmlTocPath)
 
 	// Convert HTML to PDF using the HTML to PDF converter (if available)
 	converter := htmlpdf.NewChromeDPConverter()
+	defer converter.Close()
 
 	// Ensure we have an absolute path for the HTML file
 	absHTMLPath, err := filepath.Abs(htmlTocPath)
 	if err != nil {
 		slog.Error("Failed to get absolute path for HTML TOC", "path", htmlTocPath, "error", err)
-		return fmt.Errorf("failed to get absolute path for HTML TOC: %w", err)
+		return false, fmt.Errorf("failed to get absolute path for HTML TOC: %w", err)
 	}
 
 	// Ensure we have an absolute path for the output PDF
-	absOutputPath, err := filepath.Abs(filepath.Join(outputDir, "pdf", "00_inhaltsverzeichnis_noten.pdf"))
+	absOutputPath, err := filepath.Abs(filepath.Join(pdfDir, doc.htmlPDF()))
 	if err != nil {
 		slog.Error("Failed to get absolute path for PDF output", "error", err)
-		return fmt.Errorf("failed to get absolute path for PDF output: %w", err)
+		return false, fmt.Errorf("failed to get absolute path for PDF output: %w", err)
 	}
 
 	request := &htmlpdf.ConversionRequest{
@@ -361,181 +590,60 @@ func (s *projectService) createHTMLToc(ctx context.Context, project *ent.Project
 	if err != nil {
 		slog.Warn("failed to convert HTML TOC to PDF (Chrome not available?)", "error", err)
 		// Continue without PDF conversion - HTML file is still created
-	} else {
-		// Distribute the HTML TOC PDF to the noten directory only if conversion succeeded
-		err = s.distributeHTMLPDF(project, "00_inhaltsverzeichnis.html", outputDir, 0)
+		return false, nil
+	}
+	return true, nil
+}
+
+// generateHTMLTocContent renders the HTML template of the table of contents
+// or a register with the songs of the project
+func (s *projectService) generateHTMLTocContent(ctx context.Context, doc tocDocument, project *ent.Project, projectSongs []*ent.ProjectSong, vars *templatevars.Variables, pages map[int]int) (string, error) {
+	template, err := s.resolveTemplate(ctx, project, TemplateKindTOCHTML)
+	if err != nil {
+		return "", err
+	}
+	slog.Info("using HTML TOC template", "source", template.Source, "template", template.Origin())
+	return renderHTMLTocTemplate(template.Content, doc, project, projectSongs, vars, pages)
+}
+
+// renderTocABC renders the ABC template of the table of contents or a
+// register, the entries replace the line W:{{TOC}}. Entries of songs in pages
+// end with the page the song starts on.
+func renderTocABC(content string, doc tocDocument, project *ent.Project, projectSongs []*ent.ProjectSong, vars *templatevars.Variables, pages map[int]int) (string, error) {
+	tocabc := ""
+	if doc.Register != "" {
+		data, err := doc.data(project, projectSongs, vars, pages)
 		if err != nil {
-			return fmt.Errorf("failed to distribute HTML TOC output: %w", err)
+			return "", err
+		}
+		tocabc = registerABC(data)
+	} else {
+		for _, song := range projectSongs {
+			tocinfo := ""
+			if song.Edges.Song.Tocinfo != "" {
+				tocinfo = " - " + song.Edges.Song.Tocinfo
+			}
+			page := ""
+			if pages[song.Number] > 0 {
+				page = fmt.Sprintf(", S. %d", pages[song.Number])
+			}
+
+			tocabc += fmt.Sprintf("W:%02d %s%s%s\n", song.Number, song.Edges.Song.Title, tocinfo, page)
 		}
 	}
 
-	slog.Info("created HTML table of contents", "file", htmlTocFilename)
-	return nil
+	// Variables are expanded before the entries go in, song titles stay as they are
+	toctemplate, err := doc.variables(vars).Expand(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to substitute variables in TOC template: %w", err)
+	}
+	if doc.Register != "" {
+		toctemplate = registerABCFilename(toctemplate, doc.BaseName)
+	}
+	return strings.Replace(toctemplate, "W:{{TOC}}", tocabc, 1), nil
 }
 
-// generateHTMLTocContent creates the HTML content for the table of contents
-// This uses a built-in template that can later be made configurable
-func (s *projectService) generateHTMLTocContent(project *ent.Project, projectSongs []*ent.ProjectSong) string {
-	// Try to load custom template first, fall back to built-in template
-	templateContent := s.getHTMLTocTemplate(project)
-
-	// Generate table of contents entries
-	var tocEntries strings.Builder
-	tocEntries.WriteString(`<div class="toc-entries">`)
-	for id, song := range projectSongs {
-		songinfo := ""
-		if song.Edges.Song.Tocinfo != "" {
-			songinfo = " - " + song.Edges.Song.Tocinfo
-		}
-		tocEntries.WriteString(fmt.Sprintf(`
-        <p class="toc-entry">
-            <span class="toc-number">%02d</span>
-            <span class="toc-title">%s%s</span>
-        </p>`, id+1, song.Edges.Song.Title, songinfo))
-	}
-
-	// Replace placeholders in template
-	htmlContent := strings.Replace(templateContent, "{{TOC_ENTRIES}}", tocEntries.String(), 1)
-	htmlContent = strings.ReplaceAll(htmlContent, "{{PROJECT_TITLE}}", project.Title)
-	htmlContent = strings.ReplaceAll(htmlContent, "{{PROJECT_SHORT_NAME}}", project.ShortName)
-
-	return htmlContent
-}
-
-// getHTMLTocTemplate returns the HTML template for table of contents
-// First tries to load from project-specific file, then falls back to built-in template
-func (s *projectService) getHTMLTocTemplate(project *ent.Project) string {
-	// Try project-specific template
-	templateFile := filepath.Join(project.ShortName, "tpl", "999_inhaltsverzeichnis_template.html")
-	if templateBytes, err := os.ReadFile(templateFile); err == nil {
-		slog.Info("using project-specific HTML TOC template", "path", templateFile)
-		return string(templateBytes)
-	}
-
-	// Try default template
-	defaultTemplateFile := "x/MBT-2025/999_inhaltsverzeichnis_template.html"
-	if templateBytes, err := os.ReadFile(defaultTemplateFile); err == nil {
-		slog.Info("using default HTML TOC template", "path", defaultTemplateFile)
-		return string(templateBytes)
-	}
-
-	// Use built-in template as fallback
-	slog.Info("using built-in HTML TOC template")
-	return s.getBuiltInHTMLTocTemplate()
-}
-
-// getBuiltInHTMLTocTemplate returns the built-in HTML template for _noten table of contents
-func (s *projectService) getBuiltInHTMLTocTemplate() string {
-	return `<!DOCTYPE html>
-<html lang="de">
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Inhaltsverzeichnis - {{PROJECT_TITLE}}</title>
-    <style>
-        @page {
-            size: A4;
-            margin: 2cm;
-        }
-        
-        body {
-            font-family: "Arial", sans-serif;
-            font-size: 12pt;
-            line-height: 1.4;
-            margin: 0;
-            padding: 0;
-            color: #000;
-        }
-        
-        .header {
-            text-align: center;
-            margin-bottom: 1cm;
-            padding-bottom: 1cm;
-        }
-        
-        .header h1 {
-            font-size: 24pt;
-            font-family: "Arial", sans-serif;
-            font-weight: bold;
-            margin: 0 0 0.5cm 0;
-        }
-        
-        .header .subtitle {
-            font-size: 14pt;
-            font-style: italic;
-            font-family: "Arial", sans-serif;
-            margin: 0;
-        }
-        
-        .toc-entries {
-            margin: 1cm 0;
-            font-size: 11pt;
-            line-height: 1.6;
-        }
-        
-        .toc-entry {
-            margin: 0.3em 0;
-            page-break-inside: avoid;
-        }
-        
-        .toc-number {
-            display: inline-block;
-            width: 2em;
-            margin-right: 0.5em;
-            font-variant-numeric: tabular-nums;
-            text-align: center;
-            font-weight: bold;
-        }
-        
-        .toc-title {
-            font-weight: bold;
-            min-width: 200pt;
-        }
-        
-        .toc-info {
-            font-style: italic;
-            color: #666;
-            width: 150pt;
-        }
-        
-        .footer {
-            margin-top: 2cm;
-            padding-top: 1cm;
-            font-family: arial sans-serif;
-            text-align: center;
-            font-size: 10pt;
-        }
-        
-        /* Print-specific styles */
-        @media print {
-            body {
-                -webkit-print-color-adjust: exact;
-                print-color-adjust: exact;
-            }
-            
-            .toc-table {
-                page-break-inside: avoid;
-            }
-            
-            .toc-entry {
-                page-break-inside: avoid;
-            }
-        }
-    </style>
-</head>
-<body>
-    <div class="header">
-        <h1>{{PROJECT_SHORT_NAME}} - {{PROJECT_TITLE}}</h1>
-    </div>
-    
-    <div class="toc-entries">
-{{TOC_ENTRIES}}
-    </div>
-</body>
-</html>`
-}
-
-func (s *projectService) buildSong(ctx context.Context, abcFileDir, outputDir string, songIndex int, song *ent.ProjectSong, projectSampleId string, project *ent.Project) error {
+func (s *projectService) buildSong(ctx context.Context, abcFileDir, outputDir string, songIndex int, song *ent.ProjectSong, vars *templatevars.Variables, project *ent.Project, routing *FolderRouting, cache *songCache, report *SongReport) error {
 	slog.Info("building song", "song", song.Edges.Song.Title)
 
 	abcFile, err := os.ReadFile(filepath.Join(abcFileDir, song.Edges.Song.Filename))
@@ -543,91 +651,198 @@ func (s *projectService) buildSong(ctx context.Context, abcFileDir, outputDir st
 		return fmt.Errorf("failed to read ABC file: %w", err)
 	}
 
+	finalConfig, err := s.resolveSongConfig(song, songIndex, vars, abcFile)
+	if err != nil {
+		return err
+	}
+
+	report.ABCHash, report.ConfigHash, report.CacheKey, err = cache.songCacheKey(abcFile, finalConfig)
+	if err != nil {
+		return err
+	}
+
+	entry, cached := cache.lookup(report.CacheKey)
+	if cached {
+		slog.Info("reusing cached zupfnoter output", "song", song.Edges.Song.Title, "key", report.CacheKey)
+		report.Cached = true
+	} else {
+		entry, err = s.renderSong(ctx, abcFileDir, song, finalConfig, cache, report)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.restoreSongOutput(entry, outputDir)
+	if err != nil {
+		return fmt.Errorf("failed to copy Zupfnoter output: %w", err)
+	}
+
+	err = s.distributeZupfnoterOutput(routing, song.Edges.Song.Filename, outputDir, songIndex)
+	if err != nil {
+		return fmt.Errorf("failed to distribute Zupfnoter output: %w", err)
+	}
+
+	err = os.WriteFile(
+		filepath.Join(outputDir, "abc", song.Edges.Song.Filename),
+		abcFile,
+		0644,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to copy ABC file to output dir: %w", err)
+	}
+
+	// HTML-zu-PDF Konvertierung (optional)
+	err = s.buildSongHTML(ctx, abcFileDir, outputDir, songIndex, song, project, routing)
+	if err != nil {
+		// Log error but don't fail the whole build for HTML conversion
+		slog.Warn("HTML to PDF conversion failed", "song", song.Edges.Song.Title, "error", err)
+		report.HTMLWarnings = append(report.HTMLWarnings, err.Error())
+	}
+
+	report.PDFs, err = s.songPDFs(outputDir, song.Edges.Song.Filename)
+	if err != nil {
+		return fmt.Errorf("failed to list PDF files: %w", err)
+	}
+
+	return nil
+}
+
+// resolveSongConfig returns the zupfnoter config of a song: the project config
+// with its variables substituted, merged with the config of the ABC file and
+// without the keys zupfmanager evaluates itself
+func (s *projectService) resolveSongConfig(song *ent.ProjectSong, songIndex int, vars *templatevars.Variables, abcFile []byte) (map[string]any, error) {
+	layers, err := s.songConfigLayers(song, songIndex, vars, abcFile)
+	if err != nil {
+		return nil, err
+	}
+	return layers.final, nil
+}
+
+// zupfmanagerConfigKeys are the keys of the project config that zupfmanager
+// evaluates itself. Zupfnoter does not get them, they must not make songs of
+// editions that only differ in them render again.
+var zupfmanagerConfigKeys = []string{folderPatternsConfigKey, folderRulesConfigKey, folderRoutingConfigKey, buildRetentionConfigKey, numberingConfigKey, songOrderConfigKey, editionsConfigKey, bookmarksConfigKey, sectionsConfigKey, watermarksConfigKey, templatesConfigKey, templateOrderConfigKey, tocPageNumbersConfigKey, registersConfigKey}
+
+// zupfnoterConfig returns the config of a song without the keys only
+// zupfmanager evaluates
+func zupfnoterConfig(config map[string]any) map[string]any {
+	result := make(map[string]any, len(config))
+	for key, value := range config {
+		if !containsString(zupfmanagerConfigKeys, key) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// songConfigLayers keeps the configs that make up the zupfnoter config of a song
+type songConfigLayers struct {
+	project     map[string]any // project config as stored
+	substituted map[string]any // project config with variables substituted
+	file        map[string]any // config block of the ABC file
+	variables   map[string]string
+	final       map[string]any // the config zupfnoter gets
+}
+
+// songConfigLayers resolves the zupfnoter config of a song and keeps the
+// configs it was merged from
+func (s *projectService) songConfigLayers(song *ent.ProjectSong, songIndex int, vars *templatevars.Variables, abcFile []byte) (*songConfigLayers, error) {
 	fileConfig, err := s.extractConfigFromABCFile(abcFile)
 	if err != nil {
-		return fmt.Errorf("failed to extract config from ABC file: %w", err)
+		return nil, fmt.Errorf("failed to extract config from ABC file: %w", err)
 	}
 
 	projectConfigBytes, err := json.Marshal(song.Edges.Project.Config)
 	if err != nil {
-		return fmt.Errorf("failed to marshal project config: %w", err)
+		return nil, fmt.Errorf("failed to marshal project config: %w", err)
+	}
+	vars = songVariables(vars, song, songIndex)
+
+	layers := &songConfigLayers{file: fileConfig, variables: vars.Values()}
+	if err := json.Unmarshal(projectConfigBytes, &layers.project); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal project config: %w", err)
 	}
-	fc := bytes.ReplaceAll(projectConfigBytes, []byte("#{PREFIX}"), []byte(song.Edges.Project.ShortName))
-	fc = bytes.ReplaceAll(fc, []byte("#{the_index}"), []byte(fmt.Sprintf("%02d", songIndex)))
-	fc = bytes.ReplaceAll(fc, []byte("#{sampleId}"), []byte(projectSampleId))
 
-	var finalConfig map[string]any
-	err = json.Unmarshal(fc, &finalConfig)
+	// Variables are substituted in the decoded config, their values cannot
+	// break the JSON. Every call returns a copy, mergo changes the final one.
+	substituted, err := vars.ExpandJSON(layers.project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to substitute variables in project config: %w", err)
+	}
+	layers.substituted = substituted.(map[string]any)
+	expanded, err := vars.ExpandJSON(layers.project)
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal project config: %w", err)
+		return nil, fmt.Errorf("failed to substitute variables in project config: %w", err)
 	}
+	finalConfig := expanded.(map[string]any)
 
 	err = mergo.Merge(&finalConfig, fileConfig)
 	if err != nil {
-		return fmt.Errorf("failed to merge config: %w", err)
+		return nil, fmt.Errorf("failed to merge config: %w", err)
 	}
+	// The build plan and the inspector show the config exactly as zupfnoter gets it
+	layers.final = zupfnoterConfig(finalConfig)
 
+	return layers, nil
+}
+
+// renderSong runs zupfnoter for a song and stores its output in the render cache
+func (s *projectService) renderSong(ctx context.Context, abcFileDir string, song *ent.ProjectSong, finalConfig map[string]any, cache *songCache, report *SongReport) (string, error) {
 	tempConfigFile, err := os.CreateTemp("", "zupfnoter-*.json")
 	if err != nil {
-		return fmt.Errorf("failed to create temp file: %w", err)
+		return "", fmt.Errorf("failed to create temp file: %w", err)
 	}
+	defer os.Remove(tempConfigFile.Name())
 	json.NewEncoder(tempConfigFile).Encode(finalConfig)
 	tempConfigFile.Close()
 
+	// Zupfnoter writes into a directory of its own, so that the output of
+	// songs built at the same time does not mix
+	staging, err := cache.stage(report.CacheKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
 	stdOutBuf, stdErrBuf, err := zupfnoter.Run(
 		ctx,
 		filepath.Join(abcFileDir, song.Edges.Song.Filename),
-		filepath.Join(outputDir, "pdf"),
+		staging,
 		tempConfigFile.Name(),
 	)
+	report.Stdout = buildreport.LimitOutput(stdOutBuf)
+	report.Stderr = buildreport.LimitOutput(stdErrBuf)
 	if err != nil {
-		errorMsg := fmt.Sprintf("Zupfnoter failed for %s", song.Edges.Song.Filename)
-		if stdOutBuf != "" {
-			errorMsg += fmt.Sprintf("\nStdout: %s", stdOutBuf)
-		}
-		if stdErrBuf != "" {
-			errorMsg += fmt.Sprintf("\nStderr: %s", stdErrBuf)
-		}
+		os.RemoveAll(staging)
+		// The output of zupfnoter is kept in the song report
 		slog.Error("zupfnoter failed", "output", stdOutBuf, "stderr", stdErrBuf, "file", song.Edges.Song.Filename)
-		return fmt.Errorf("%s: %w", errorMsg, err)
+		return "", fmt.Errorf("Zupfnoter failed for %s: %w", song.Edges.Song.Filename, err)
 	}
-	os.Remove(tempConfigFile.Name())
 
-	err = s.distributeZupfnoterOutput(project, song.Edges.Song.Filename, outputDir, songIndex)
+	entry, err := cache.commit(report.CacheKey, staging)
 	if err != nil {
-		return fmt.Errorf("failed to distribute Zupfnoter output: %w", err)
-	}
-
-	err = os.WriteFile(
-		filepath.Join(outputDir, "abc", song.Edges.Song.Filename),
-		abcFile,
-		0644,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to copy ABC file to output dir: %w", err)
+		os.RemoveAll(staging)
+		return "", fmt.Errorf("failed to store Zupfnoter output in cache: %w", err)
 	}
+	return entry, nil
+}
 
-	logFN := fmt.Sprintf("%s.err.log", song.Edges.Song.Filename)
-	err = os.Rename(
-		filepath.Join(outputDir, "pdf", logFN),
-		filepath.Join(outputDir, "log", logFN),
-	)
+// songPDFs returns the PDFs generated for a song, relative to the output directory
+func (s *projectService) songPDFs(outputDir, filename string) ([]string, error) {
+	baseFilenameWithoutExt := strings.TrimSuffix(filename, ".abc")
+	files, err := filepath.Glob(filepath.Join(outputDir, "pdf", filepath.Base(baseFilenameWithoutExt)+"*.pdf"))
 	if err != nil {
-		return fmt.Errorf("failed to rename log file: %w", err)
+		return nil, err
 	}
 
-	// HTML-zu-PDF Konvertierung (optional)
-	err = s.buildSongHTML(ctx, abcFileDir, outputDir, songIndex, song, project)
-	if err != nil {
-		// Log error but don't fail the whole build for HTML conversion
-		slog.Warn("HTML to PDF conversion failed", "song", song.Edges.Song.Title, "error", err)
+	pdfs := make([]string, len(files))
+	for i, file := range files {
+		pdfs[i] = filepath.ToSlash(filepath.Join("pdf", filepath.Base(file)))
 	}
-
-	return nil
+	return pdfs, nil
 }
 
 // buildSongHTML handles the HTML to PDF conversion (new functionality)
-func (s *projectService) buildSongHTML(ctx context.Context, abcFileDir, outputDir string, songIndex int, song *ent.ProjectSong, project *ent.Project) error {
+func (s *projectService) buildSongHTML(ctx context.Context, abcFileDir, outputDir string, songIndex int, song *ent.ProjectSong, project *ent.Project, routing *FolderRouting) error {
 	// 1. Check if HTML file exists
 	htmlFilename := strings.TrimSuffix(song.Edges.Song.Filename, ".abc") + ".html"
 	htmlPath := filepath.Join(abcFileDir, htmlFilename)
@@ -671,11 +886,12 @@ func (s *projectService) buildSongHTML(ctx context.Context, abcFileDir, outputDi
 		"size", result.FileSize)
 
 	// 5. Distribute PDF to print directories (analogous to ABC PDFs)
-	return s.distributeHTMLPDF(project, htmlFilename, outputDir, songIndex)
+	return s.distributeHTMLPDF(routing, htmlFilename, outputDir, songIndex)
 }
 
-// distributeHTMLPDF distributes HTML-generated PDFs to appropriate directories
-func (s *projectService) distributeHTMLPDF(project *ent.Project, htmlFilename string, outputDir string, songIndex int) error {
+// distributeHTMLPDF distributes HTML-generated PDFs to the folders of the
+// folder routing, the default patterns route them to noten
+func (s *projectService) distributeHTMLPDF(routing *FolderRouting, htmlFilename string, outputDir string, songIndex int) error {
 	pdfDir := filepath.Join(outputDir, "pdf")
 	// HTML filename corresponds to ABC filename, so we use the same basename
 	baseFilenameWithoutExt := strings.TrimSuffix(htmlFilename, ".html")
@@ -693,22 +909,31 @@ func (s *projectService) distributeHTMLPDF(project *ent.Project, htmlFilename st
 		filename := filepath.Base(pdfFile)
 		newFilename := fmt.Sprintf("%02d_%s", songIndex, filename)
 
-		// HTML PDFs (including TOC) always go to "noten" directory
-		// All HTML PDFs go to "noten" directory
-		targetDir := filepath.Join(outputDir, "druckdateien", "noten")
-
-		err := os.MkdirAll(targetDir, 0755)
-		if err != nil {
-			return fmt.Errorf("failed to create HTML target directory: %w", err)
+		match := pdfFolders(filename, routing)
+		if match.Excluded {
+			slog.Info("file excluded by folder rule", "filename", filename, "rule", match.Rules[0])
+			continue
 		}
-
-		targetFile := filepath.Join(targetDir, newFilename)
-		err = s.copyFile(pdfFile, targetFile)
-		if err != nil {
-			return fmt.Errorf("failed to copy HTML PDF: %w", err)
+		if len(match.Folders) == 0 {
+			slog.Error("no target folder found", "filename", filename)
+			continue
 		}
 
-		slog.Info("distributed HTML PDF", "source", pdfFile, "target", targetFile)
+		for _, folder := range match.Folders {
+			targetDir := filepath.Join(outputDir, "druckdateien", folder)
+			err := os.MkdirAll(targetDir, 0755)
+			if err != nil {
+				return fmt.Errorf("failed to create HTML target directory: %w", err)
+			}
+
+			targetFile := filepath.Join(targetDir, newFilename)
+			err = s.copyFile(pdfFile, targetFile)
+			if err != nil {
+				return fmt.Errorf("failed to copy HTML PDF: %w", err)
+			}
+
+			slog.Info("distributed HTML PDF", "source", pdfFile, "target", targetFile)
+		}
 	}
 
 	return nil
@@ -731,29 +956,7 @@ func (s *projectService) extractConfigFromABCFile(abcFile []byte) (map[string]an
 
 // Add remaining helper functions...
 
-func (s *projectService) getFolderPatterns(project *ent.Project) map[string]string {
-	folderPatterns := map[string]string{
-		"*_-A*_a3.pdf": "klein",
-		"*_-M*_a3.pdf": "klein",
-		"*_-O*_a3.pdf": "klein",
-		"*_-B*_a3.pdf": "gross",
-		"*_-X*_a3.pdf": "gross",
-		"*_noten.pdf":  "noten",
-	}
-
-	if configPatterns, ok := project.Config["folderPatterns"].(map[string]interface{}); ok {
-		folderPatterns = make(map[string]string)
-		for pattern, folder := range configPatterns {
-			if folderStr, ok := folder.(string); ok {
-				folderPatterns[pattern] = folderStr
-			}
-		}
-	}
-
-	return folderPatterns
-}
-
-func (s *projectService) distributeZupfnoterOutput(project *ent.Project, baseFilename string, outputDir string, songIndex int) error {
+func (s *projectService) distributeZupfnoterOutput(routing *FolderRouting, baseFilename string, outputDir string, songIndex int) error {
 	pdfDir := filepath.Join(outputDir, "pdf")
 	baseFilenameWithoutExt := strings.TrimSuffix(baseFilename, ".abc")
 	pattern := filepath.Join(pdfDir, filepath.Base(baseFilenameWithoutExt)+"*.pdf")
@@ -762,38 +965,32 @@ func (s *projectService) distributeZupfnoterOutput(project *ent.Project, baseFil
 		return fmt.Errorf("failed to glob PDF files: %w", err)
 	}
 
-	folderPatterns := s.getFolderPatterns(project)
-
 	for _, pdfFile := range files {
 		filename := filepath.Base(pdfFile)
 		newFilename := fmt.Sprintf("%02d_%s", songIndex, filename)
-		var targetDir string
 
-		for pattern, folder := range folderPatterns {
-			matched, err := filepath.Match(pattern, filename)
-			if err != nil {
-				return fmt.Errorf("failed to match pattern: %w", err)
-			}
-			if matched {
-				targetDir = filepath.Join(outputDir, "druckdateien", folder)
-				break
-			}
+		match := pdfFolders(filename, routing)
+		if match.Excluded {
+			slog.Info("file excluded by folder rule", "filename", filename, "rule", match.Rules[0])
+			continue
 		}
-
-		if targetDir == "" {
+		if len(match.Folders) == 0 {
 			slog.Error("no target folder found", "filename", filename)
 			continue
 		}
 
-		err := os.MkdirAll(targetDir, 0755)
-		if err != nil {
-			return fmt.Errorf("failed to create target directory: %w", err)
-		}
+		for _, folder := range match.Folders {
+			targetDir := filepath.Join(outputDir, "druckdateien", folder)
+			err := os.MkdirAll(targetDir, 0755)
+			if err != nil {
+				return fmt.Errorf("failed to create target directory: %w", err)
+			}
 
-		targetFile := filepath.Join(targetDir, newFilename)
-		err = s.copyFile(pdfFile, targetFile)
-		if err != nil {
-			return fmt.Errorf("failed to copy file: %w", err)
+			targetFile := filepath.Join(targetDir, newFilename)
+			err = s.copyFile(pdfFile, targetFile)
+			if err != nil {
+				return fmt.Errorf("failed to copy file: %w", err)
+			}
 		}
 	}
 
@@ -878,12 +1075,14 @@ func getFileSize(path string) string {
 	return fmt.Sprintf("%.2f KB", float64(fileInfo.Size())/1024)
 }
 
-func (s *projectService) mergePDFs(dir, dest string) error {
+// mergePDFs merges the PDF files below dir in path order into dest and
+// returns the merged files
+func (s *projectService) mergePDFs(dir, dest string) ([]string, error) {
 	slog.Info("merging pdf files", "dir", dir, "dest", dest)
 
 	if _, err := os.Stat(dir); os.IsNotExist(err) {
 		slog.Warn("directory does not exist, skipping merge", "dir", dir)
-		return nil
+		return nil, nil
 	}
 
 	// Special logging for the problematic TOC file
@@ -899,6 +1098,24 @@ func (s *projectService) mergePDFs(dir, dest string) error {
 		}
 	}
 
+	files, err := mergeOrder(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		slog.Warn("no PDF files found to merge", "dir", dir)
+		return nil, nil
+	}
+
+	if err := s.mergePDFFiles(files, dest); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// mergeOrder returns the PDF files below dir in the order they are merged in
+func mergeOrder(dir string) ([]string, error) {
 	var files []string
 
 	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
@@ -911,14 +1128,14 @@ func (s *projectService) mergePDFs(dir, dest string) error {
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("failed to walk directory: %w", err)
-	}
-
-	if len(files) == 0 {
-		slog.Warn("no PDF files found to merge", "dir", dir)
-		return nil
+		return nil, fmt.Errorf("failed to walk directory: %w", err)
 	}
+	return files, nil
+}
 
+// mergePDFFiles merges PDF files in the given order into dest. Every file is
+// sanitized first, files that cannot be sanitized are merged as they are.
+func (s *projectService) mergePDFFiles(files []string, dest string) error {
 	// Create temporary directory for sanitized PDFs
 	tempDir, err := os.MkdirTemp("", "pdfclean")
 	if err != nil {
@@ -938,8 +1155,11 @@ func (s *projectService) mergePDFs(dir, dest string) error {
 		}
 	}
 
-	slog.Info("merging PDF files", "count", len(sanitizedFiles), "from", dir, "to", dest, "files", sanitizedFiles)
-	err = api.MergeCreateFile(sanitizedFiles, dest, false, nil)
+	slog.Info("merging PDF files", "count", len(sanitizedFiles), "to", dest, "files", sanitizedFiles)
+	// The bookmarks pdfcpu creates would be named after the sanitized files
+	conf := pdfcpuModel.NewDefaultConfiguration()
+	conf.CreateBookmarks = false
+	err = api.MergeCreateFile(sanitizedFiles, dest, false, conf)
 	if err != nil {
 		return fmt.Errorf("failed to merge pdf files: %w", err)
 	}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func setupProjectTest(t *testing.T) (*Services, func()) {
@@ -227,3 +228,226 @@ func TestProjectService_Delete(t *testing.T) {
 		t.Error("Expected error when getting deleted project, got none")
 	}
 }
+
+func TestProjectService_BuildHistory(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Build History Project",
+		ShortName: "build-history",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test project: %v", err)
+	}
+
+	// Simulate a finished build and a build that was running when the server stopped
+	db := services.DB()
+	_, err = db.Build.Create().
+		SetBuildID("finished-build").
+		SetProjectID(project.ID).
+		SetStatus("completed").
+		SetProgress(100).
+		SetStartedAt(time.Now().Add(-time.Hour)).
+		SetCompletedAt(time.Now().Add(-50 * time.Minute)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create finished build: %v", err)
+	}
+	_, err = db.Build.Create().
+		SetBuildID("running-build").
+		SetProjectID(project.ID).
+		SetStatus("running").
+		SetProgress(40).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create running build: %v", err)
+	}
+	// A build of the CLI may still be running in another process
+	_, err = db.Build.Create().
+		SetBuildID("cli-build").
+		SetProjectID(project.ID).
+		SetStatus("running").
+		SetOrigin("cli").
+		SetStartedAt(time.Now().Add(-time.Minute)).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create CLI build: %v", err)
+	}
+
+	builds, err := services.Project.ListBuilds(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("ListBuilds() error = %v", err)
+	}
+	if len(builds) != 3 {
+		t.Fatalf("Expected 3 builds, got %d", len(builds))
+	}
+	if builds[0].BuildID != "running-build" {
+		t.Errorf("Expected newest build first, got %s", builds[0].BuildID)
+	}
+
+	count, err := services.Project.MarkInterruptedBuilds(ctx)
+	if err != nil {
+		t.Fatalf("MarkInterruptedBuilds() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 interrupted build, got %d", count)
+	}
+
+	status, err := services.Project.GetBuildStatus(ctx, "running-build")
+	if err != nil {
+		t.Fatalf("GetBuildStatus() error = %v", err)
+	}
+	if status.Status != "interrupted" {
+		t.Errorf("Expected status interrupted, got %s", status.Status)
+	}
+	if status.CompletedAt == "" {
+		t.Error("Expected interrupted build to have a completion time")
+	}
+	status, err = services.Project.GetBuildStatus(ctx, "cli-build")
+	if err != nil {
+		t.Fatalf("GetBuildStatus() error = %v", err)
+	}
+	if status.Status != "running" {
+		t.Errorf("Expected the CLI build to keep running, got %s", status.Status)
+	}
+
+	if err := services.Project.ClearBuildHistory(ctx, project.ID); err != nil {
+		t.Fatalf("ClearBuildHistory() error = %v", err)
+	}
+	if _, err := services.Project.GetBuildStatus(ctx, "finished-build"); err != ErrBuildNotFound {
+		t.Errorf("Expected ErrBuildNotFound after clearing history, got %v", err)
+	}
+}
+
+func TestProjectService_CancelBuild(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Cancel Build Project",
+		ShortName: "cancel-build",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test project: %v", err)
+	}
+
+	// Keep the project busy so that the next build stays in the queue
+	release := make(chan struct{})
+	defer close(release)
+	services.BuildQueue.Enqueue("blocker", project.ID, func(ctx context.Context) {
+		<-release
+	})
+
+	result, err := services.Project.BuildProject(ctx, BuildProjectRequest{
+		ProjectID:         project.ID,
+		PriorityThreshold: 1,
+	})
+	if err != nil {
+		t.Fatalf("BuildProject() error = %v", err)
+	}
+	if result.Status != "queued" {
+		t.Fatalf("Expected build to be queued, got %s", result.Status)
+	}
+
+	if err := services.Project.CancelBuild(ctx, project.ID+1, result.BuildID); err != ErrBuildNotFound {
+		t.Errorf("Expected ErrBuildNotFound for the build of another project, got %v", err)
+	}
+	if err := services.Project.CancelBuild(ctx, project.ID, result.BuildID); err != nil {
+		t.Fatalf("CancelBuild() error = %v", err)
+	}
+
+	status, err := services.Project.GetBuildStatus(ctx, result.BuildID)
+	if err != nil {
+		t.Fatalf("GetBuildStatus() error = %v", err)
+	}
+	if status.Status != "cancelled" {
+		t.Errorf("Expected status cancelled, got %s", status.Status)
+	}
+	if status.CompletedAt == "" {
+		t.Error("Expected cancelled build to have a completion time")
+	}
+
+	if err := services.Project.CancelBuild(ctx, project.ID, result.BuildID); err != ErrBuildNotActive {
+		t.Errorf("Expected ErrBuildNotActive for a finished build, got %v", err)
+	}
+	if err := services.Project.CancelBuild(ctx, project.ID, "unknown-build"); err != ErrBuildNotFound {
+		t.Errorf("Expected ErrBuildNotFound for an unknown build, got %v", err)
+	}
+}
+
+func TestProjectService_BuildReport(t *testing.T) {
+	// Skip the zupfnoter runs, the songs fail before zupfnoter would be needed
+	t.Setenv("ZUPFNOTER_DEBUG", "1")
+
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Build Report Project",
+		ShortName: "build-report",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test project: %v", err)
+	}
+
+	priority := 1
+	for _, filename := range []string{"missing_a.abc", "missing_b.abc"} {
+		song, err := services.DB().Song.Create().
+			SetTitle(filename).
+			SetFilename(filename).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("Failed to create song: %v", err)
+		}
+		_, err = services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+			ProjectID: project.ID,
+			SongID:    song.ID,
+			Priority:  &priority,
+		})
+		if err != nil {
+			t.Fatalf("Failed to add song to project: %v", err)
+		}
+	}
+
+	result, err := services.Project.RunProjectBuild(ctx, BuildProjectRequest{
+		ProjectID:         project.ID,
+		AbcFileDir:        t.TempDir(),
+		OutputDir:         filepath.Join(t.TempDir(), "out"),
+		PriorityThreshold: 4,
+	})
+	if err != nil {
+		t.Fatalf("RunProjectBuild() error = %v", err)
+	}
+	if result.Status != "completed_with_errors" {
+		t.Errorf("Expected status completed_with_errors, got %s", result.Status)
+	}
+	if result.Report == nil {
+		t.Fatal("Expected the build result to contain a report")
+	}
+
+	// The first failing song must not stop the other one
+	report := result.Report
+	if report.Total != 2 || report.Failed != 2 {
+		t.Errorf("Expected 2 failed of 2 songs, got %d of %d", report.Failed, report.Total)
+	}
+	for _, song := range report.Songs {
+		if song.Status != "failed" || song.Error == "" || song.StartedAt == "" {
+			t.Errorf("Expected song %s to be reported as failed with an error, got %+v", song.Filename, song)
+		}
+	}
+
+	stored, err := services.Project.GetBuild(ctx, project.ID, result.BuildID)
+	if err != nil {
+		t.Fatalf("GetBuild() error = %v", err)
+	}
+	if stored.Report == nil || len(stored.Report.Songs) != 2 {
+		t.Fatalf("Expected the report to be stored with the build, got %+v", stored.Report)
+	}
+	if _, err := services.Project.GetBuild(ctx, project.ID+1, result.BuildID); err != ErrBuildNotFound {
+		t.Errorf("Expected ErrBuildNotFound for the build of another project, got %v", err)
+	}
+}
@@ -0,0 +1,379 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/templatevars"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Project config key of the registers next to the table of contents, e.g.
+//
+//	"registers": {"alphabetical": true, "grouped": "genre"}
+const registersConfigKey = "registers"
+
+// Register kinds of a tocDocument
+const (
+	registerAlphabetical = "alphabetical"
+	registerGrouped      = "grouped"
+)
+
+// RegisterGroups are the values of "grouped" in the registers config. "tag"
+// reads the genre of a song as tags separated by commas or semicolons, a
+// song is listed under each of them.
+var RegisterGroups = []string{"genre", "tag"}
+
+// tocDocument is a document rendered with the templates of the table of
+// contents: the table of contents itself or a register. Its files are named
+// after BaseName, which sorts them in front of the songs.
+type tocDocument struct {
+	BaseName   string
+	Title      string         // #{toc_title} of the ABC template, .Title of the HTML template
+	Register   string         // empty for the table of contents
+	GroupBy    string         // genre or tag, for grouped registers
+	FirstLines map[int]string // first lines of the songs by number, for registers
+}
+
+var (
+	tableOfContents      = tocDocument{BaseName: "00_inhaltsverzeichnis", Title: "Inhaltsverzeichnis"}
+	alphabeticalRegister = tocDocument{BaseName: "00_register", Title: "Register", Register: registerAlphabetical}
+	groupedRegister      = tocDocument{BaseName: "00_themenregister", Title: "Themenregister", Register: registerGrouped}
+)
+
+// abcFilename returns the ABC file of the document in abc/
+func (doc tocDocument) abcFilename() string {
+	return doc.BaseName + ".abc"
+}
+
+// htmlFilename returns the HTML file of the document in html/
+func (doc tocDocument) htmlFilename() string {
+	return doc.BaseName + ".html"
+}
+
+// htmlPDF returns the PDF of the HTML document in pdf/, it is distributed
+// to the noten folder with the prefix 00_
+func (doc tocDocument) htmlPDF() string {
+	return doc.BaseName + "_noten.pdf"
+}
+
+// ownsFile reports whether a file of a druckdateien folder belongs to the
+// document
+func (doc tocDocument) ownsFile(name string) bool {
+	return strings.HasPrefix(name, "00_"+doc.BaseName)
+}
+
+// variables returns the variables of a build with #{toc_title} set to the
+// title of the document
+func (doc tocDocument) variables(vars *templatevars.Variables) *templatevars.Variables {
+	vars = vars.Clone()
+	vars.Set("toc_title", doc.Title)
+	return vars
+}
+
+// data returns the data the HTML template renders the document with.
+// Registers replace the entries and sections of the table of contents.
+func (doc tocDocument) data(project *ent.Project, projectSongs []*ent.ProjectSong, vars *templatevars.Variables, pages map[int]int) (*HTMLTocData, error) {
+	data, err := htmlTocData(project, projectSongs, vars, pages)
+	if err != nil {
+		return nil, err
+	}
+	data.Title = doc.Title
+	for i := range data.Entries {
+		data.Entries[i].FirstLine = doc.FirstLines[data.Entries[i].Number]
+	}
+
+	switch doc.Register {
+	case registerAlphabetical:
+		data.Sections = alphabeticalSections(data.Entries)
+	case registerGrouped:
+		data.Sections = groupedSections(data.Entries, doc.GroupBy)
+	default:
+		return data, nil
+	}
+	data.Entries = []HTMLTocEntry{}
+	for _, section := range data.Sections {
+		data.Entries = append(data.Entries, section.Entries...)
+	}
+	return data, nil
+}
+
+// projectTocDocuments returns the table of contents and the registers the
+// project config turns on
+func projectTocDocuments(project *ent.Project) ([]tocDocument, error) {
+	docs := []tocDocument{tableOfContents}
+	value, ok := project.Config[registersConfigKey]
+	if !ok || value == nil {
+		return docs, nil
+	}
+	config, ok := value.(map[string]interface{})
+	if !ok {
+		return docs, ValidationErrors{{
+			Field:   "config." + registersConfigKey,
+			Message: "registers must be an object with alphabetical and grouped",
+		}}
+	}
+
+	var errors ValidationErrors
+	switch alphabetical := config["alphabetical"].(type) {
+	case nil:
+	case bool:
+		if alphabetical {
+			docs = append(docs, alphabeticalRegister)
+		}
+	default:
+		errors = append(errors, ValidationError{
+			Field:   "config." + registersConfigKey + ".alphabetical",
+			Message: "alphabetical must be true or false",
+		})
+	}
+	switch grouped := config["grouped"].(type) {
+	case nil:
+	case string:
+		if grouped == "" {
+			break
+		}
+		if !containsString(RegisterGroups, grouped) {
+			errors = append(errors, registerGroupedError())
+			break
+		}
+		doc := groupedRegister
+		doc.GroupBy = grouped
+		docs = append(docs, doc)
+	default:
+		errors = append(errors, registerGroupedError())
+	}
+
+	if errors.HasErrors() {
+		return docs, errors
+	}
+	return docs, nil
+}
+
+// registerGroupedError is the validation error of an unknown grouped value
+func registerGroupedError() ValidationError {
+	return ValidationError{
+		Field:   "config." + registersConfigKey + ".grouped",
+		Message: fmt.Sprintf("grouped must be one of %s", strings.Join(RegisterGroups, ", ")),
+	}
+}
+
+// withFirstLines passes the first lines of the songs to the registers among
+// the documents. Songs whose ABC file can not be read have no first line.
+func withFirstLines(docs []tocDocument, abcFileDir string, projectSongs []*ent.ProjectSong) []tocDocument {
+	var firstLines map[int]string
+	for i := range docs {
+		if docs[i].Register == "" {
+			continue
+		}
+		if firstLines == nil {
+			firstLines = make(map[int]string)
+			for _, ps := range projectSongs {
+				abcFile, err := os.ReadFile(filepath.Join(abcFileDir, ps.Edges.Song.Filename))
+				if err != nil {
+					slog.Warn("no first line for the registers", "file", ps.Edges.Song.Filename, "error", err)
+					continue
+				}
+				if line := abcFirstLine(abcFile); line != "" {
+					firstLines[ps.Number] = line
+				}
+			}
+		}
+		docs[i].FirstLines = firstLines
+	}
+	return docs
+}
+
+var (
+	abcLyricsPattern  = regexp.MustCompile(`^([wW]):(.*)$`)
+	verseNumberPrefix = regexp.MustCompile(`^\d+[.)]\s*`)
+	abcLyricsReplacer = strings.NewReplacer(`\-`, "-", "-", "", "_", "", "*", "", "|", "", "~", " ")
+)
+
+// abcFirstLine returns the first line of the lyrics of an ABC file: the
+// first w: line aligned to the notes or, without one, the first W: line.
+// Syllable separators and verse numbers are removed.
+func abcFirstLine(abcFile []byte) string {
+	if index := bytes.Index(abcFile, []byte(zupfnoterConfigString)); index >= 0 {
+		abcFile = abcFile[:index]
+	}
+
+	var verse string
+	scanner := bufio.NewScanner(bytes.NewReader(abcFile))
+	for scanner.Scan() {
+		match := abcLyricsPattern.FindStringSubmatch(strings.TrimRight(scanner.Text(), "\r"))
+		if match == nil {
+			continue
+		}
+		aligned := match[1] == "w"
+		line := cleanLyrics(match[2], aligned)
+		if line == "" {
+			continue
+		}
+		if aligned {
+			return line
+		}
+		if verse == "" {
+			verse = line
+		}
+	}
+	return verse
+}
+
+// cleanLyrics turns ABC lyrics into plain text. Lyrics aligned to the notes
+// lose their syllable separators, \- stays as hyphen.
+func cleanLyrics(lyrics string, aligned bool) string {
+	text := lyrics
+	if aligned {
+		text = abcLyricsReplacer.Replace(text)
+	}
+	text = strings.Join(strings.Fields(text), " ")
+	text = verseNumberPrefix.ReplaceAllString(text, "")
+	return strings.TrimRight(text, ",;: ")
+}
+
+// registerSortKey returns the text a register entry is sorted by, leading
+// quotes and other punctuation do not count
+func registerSortKey(title string) string {
+	return strings.TrimLeftFunc(title, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// registerInitial returns the letter a register entry is filed under,
+// umlauts under their vowel and digits under #
+func registerInitial(title string) string {
+	for _, r := range norm.NFD.String(registerSortKey(title)) {
+		if unicode.IsLetter(r) {
+			return string(unicode.ToUpper(r))
+		}
+		return "#"
+	}
+	return ""
+}
+
+// sortRegisterEntries sorts register entries alphabetically, entries with
+// the same text by number
+func sortRegisterEntries(entries []HTMLTocEntry) {
+	collator := collate.New(language.German, collate.IgnoreCase)
+	sort.SliceStable(entries, func(i, j int) bool {
+		if c := collator.CompareString(registerSortKey(entries[i].Title), registerSortKey(entries[j].Title)); c != 0 {
+			return c < 0
+		}
+		return entries[i].Number < entries[j].Number
+	})
+}
+
+// alphabeticalSections lists the songs by title and by first line, if it
+// differs from the title, in sections by initial letter
+func alphabeticalSections(entries []HTMLTocEntry) []HTMLTocSection {
+	register := make([]HTMLTocEntry, 0, 2*len(entries))
+	for _, entry := range entries {
+		register = append(register, entry)
+		if entry.FirstLine != "" && !strings.EqualFold(registerSortKey(entry.FirstLine), registerSortKey(entry.Title)) {
+			firstLine := entry
+			firstLine.Title = entry.FirstLine
+			firstLine.IsFirstLine = true
+			register = append(register, firstLine)
+		}
+	}
+	sortRegisterEntries(register)
+
+	sections := []HTMLTocSection{}
+	for _, entry := range register {
+		initial := registerInitial(entry.Title)
+		if last := len(sections) - 1; last < 0 || sections[last].Title != initial {
+			sections = append(sections, HTMLTocSection{Title: initial})
+		}
+		sections[len(sections)-1].Entries = append(sections[len(sections)-1].Entries, entry)
+	}
+	return sections
+}
+
+// registerGroups returns the groups of a song in a grouped register
+func registerGroups(entry HTMLTocEntry, groupBy string) []string {
+	if groupBy != "tag" {
+		return []string{entry.Genre}
+	}
+	var tags []string
+	for _, tag := range strings.FieldsFunc(entry.Genre, func(r rune) bool { return r == ',' || r == ';' }) {
+		if tag = strings.TrimSpace(tag); tag != "" && !containsString(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	if len(tags) == 0 {
+		return []string{""}
+	}
+	return tags
+}
+
+// groupedSections lists the songs by genre or tag, groups and songs in
+// alphabetical order. Songs without genre come last in a group without title.
+func groupedSections(entries []HTMLTocEntry, groupBy string) []HTMLTocSection {
+	sections := []HTMLTocSection{}
+	groups := make(map[string]int) // group -> index in sections
+	for _, entry := range entries {
+		for _, group := range registerGroups(entry, groupBy) {
+			index, ok := groups[group]
+			if !ok {
+				index = len(sections)
+				groups[group] = index
+				sections = append(sections, HTMLTocSection{Title: group})
+			}
+			sections[index].Entries = append(sections[index].Entries, entry)
+		}
+	}
+	sortSections(sections)
+	for _, section := range sections {
+		sortRegisterEntries(section.Entries)
+	}
+	return sections
+}
+
+// registerABC returns the W: lines of a register for the ABC template, the
+// sections as headings and the entries followed by the number of their song
+// and the page it starts on, if known
+func registerABC(data *HTMLTocData) string {
+	var b strings.Builder
+	for i, section := range data.Sections {
+		if section.Title != "" {
+			if i > 0 {
+				b.WriteString("W:\n")
+			}
+			fmt.Fprintf(&b, "W:%s\n", section.Title)
+		}
+		for _, entry := range section.Entries {
+			page := ""
+			if entry.Page > 0 {
+				page = fmt.Sprintf(", S. %d", entry.Page)
+			}
+			fmt.Fprintf(&b, "W:%s - %02d%s\n", entry.Title, entry.Number, page)
+		}
+	}
+	return b.String()
+}
+
+// registerABCFilename names the PDFs of a register after its base name: the
+// F: line of the template, written for the table of contents, keeps only
+// what follows 00_inhaltsverzeichnis
+func registerABCFilename(abc, baseName string) string {
+	return abcFilenamePattern.ReplaceAllStringFunc(abc, func(line string) string {
+		name := abcFilenamePattern.FindStringSubmatch(line)[1]
+		suffix, ok := strings.CutPrefix(name, tableOfContents.BaseName)
+		if !ok {
+			suffix = ""
+		}
+		return "F:" + baseName + suffix
+	})
+}
@@ -0,0 +1,132 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectTocDocuments(t *testing.T) {
+	docs, err := projectTocDocuments(&ent.Project{})
+	require.NoError(t, err)
+	assert.Equal(t, []tocDocument{tableOfContents}, docs)
+
+	docs, err = projectTocDocuments(&ent.Project{Config: map[string]interface{}{
+		"registers": map[string]interface{}{"alphabetical": true, "grouped": "tag"},
+	}})
+	require.NoError(t, err)
+	require.Len(t, docs, 3)
+	assert.Equal(t, "00_register", docs[1].BaseName)
+	assert.Equal(t, "tag", docs[2].GroupBy)
+
+	_, err = projectTocDocuments(&ent.Project{Config: map[string]interface{}{
+		"registers": map[string]interface{}{"alphabetical": "ja", "grouped": "stimmung"},
+	}})
+	var validationErrors ValidationErrors
+	require.ErrorAs(t, err, &validationErrors)
+	require.Len(t, validationErrors, 2)
+	assert.Equal(t, "config.registers.alphabetical", validationErrors[0].Field)
+	assert.Equal(t, "config.registers.grouped", validationErrors[1].Field)
+}
+
+func TestABCFirstLine(t *testing.T) {
+	tests := []struct {
+		name string
+		abc  string
+		want string
+	}{
+		{"aligned lyrics", "X:1\nT:Grace\nK:G\nD2 G2|\nw:1.~A-maz-ing grace, how sweet_ the sound,\nW:Through many dangers\n", "Amazing grace, how sweet the sound"},
+		{"verses only", "X:1\nT:Lied\nK:C\nW:\nW:2. Geh aus, mein Herz, und su-che Freud\n", "Geh aus, mein Herz, und su-che Freud"},
+		{"escaped hyphen", "X:1\nw:Hal-le\\-lu-ja *\n", "Halle-luja"},
+		{"config is no lyrics", "X:1\nK:C\n%%%%zupfnoter.config\n\nw:nothing\n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, abcFirstLine([]byte(tt.abc)))
+		})
+	}
+}
+
+func TestRegisterData(t *testing.T) {
+	project := &ent.Project{Title: "Register", ShortName: "REG"}
+	songs := []*ent.ProjectSong{
+		{Number: 1, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Zion hört die Wächter singen", Genre: "Choral; Advent"}}},
+		{Number: 2, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Amazing Grace", Genre: "Gospel"}}},
+		{Number: 3, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Öffne meine Augen", Genre: "Advent"}}},
+	}
+	vars := projectVariables(project, "", time.Now())
+
+	doc := alphabeticalRegister
+	doc.FirstLines = map[int]string{1: "Wachet auf, ruft uns die Stimme", 2: "Amazing grace"}
+	data, err := doc.data(project, songs, vars, map[int]int{3: 4})
+	require.NoError(t, err)
+	assert.Equal(t, "Register", data.Title)
+	var titles []string
+	for _, section := range data.Sections {
+		titles = append(titles, section.Title)
+	}
+	assert.Equal(t, []string{"A", "O", "W", "Z"}, titles)
+	require.Len(t, data.Entries, 4)
+	assert.Equal(t, "Wachet auf, ruft uns die Stimme", data.Entries[2].Title)
+	assert.True(t, data.Entries[2].IsFirstLine)
+	assert.Equal(t, "Zion hört die Wächter singen", data.Entries[2].SongTitle)
+	assert.Equal(t, "W:A\nW:Amazing Grace - 02\nW:\nW:O\nW:Öffne meine Augen - 03, S. 4\nW:\nW:W\nW:Wachet auf, ruft uns die Stimme - 01\nW:\nW:Z\nW:Zion hört die Wächter singen - 01\n", registerABC(data))
+
+	doc = groupedRegister
+	doc.GroupBy = "tag"
+	data, err = doc.data(project, songs, vars, nil)
+	require.NoError(t, err)
+	require.Len(t, data.Sections, 3)
+	assert.Equal(t, "Advent", data.Sections[0].Title)
+	assert.Equal(t, "Öffne meine Augen", data.Sections[0].Entries[0].Title)
+	assert.Equal(t, "Zion hört die Wächter singen", data.Sections[0].Entries[1].Title)
+	assert.Equal(t, "Choral", data.Sections[1].Title)
+	assert.Equal(t, "Gospel", data.Sections[2].Title)
+}
+
+func TestRenderRegisterABC(t *testing.T) {
+	project := &ent.Project{Title: "Register", ShortName: "REG"}
+	songs := []*ent.ProjectSong{
+		{Number: 1, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Alpha"}}},
+	}
+
+	abc, err := renderTocABC("X:1\nF:00_inhaltsverzeichnis_gross\nT:#{toc_title}\nW:{{TOC}}\n", alphabeticalRegister, project, songs, projectVariables(project, "", time.Now()), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "X:1\nF:00_register_gross\nT:Register\nW:A\nW:Alpha - 01\n\n", abc)
+}
+
+func TestProjectService_NumberRegisterPages(t *testing.T) {
+	t.Setenv(UserTemplateDirEnv, t.TempDir())
+	outputDir := t.TempDir()
+	noten := filepath.Join(outputDir, "druckdateien", "noten")
+	require.NoError(t, os.MkdirAll(noten, 0755))
+	for name, pages := range map[string]int{
+		"00_00_register_noten.pdf": 1,
+		"01_alpha_noten.pdf":       2,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(noten, name), minimalPDF(pages), 0644))
+	}
+
+	project := &ent.Project{Title: "Pages", ShortName: "REGPAGES"}
+	songs := []*ent.ProjectSong{
+		{Number: 1, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Alpha"}}},
+	}
+	docs := []tocDocument{tableOfContents, alphabeticalRegister}
+	routing, err := projectFolderRouting(project)
+	require.NoError(t, err)
+	err = (&projectService{}).numberTocPages(context.Background(), docs, project, songs, projectVariables(project, "", time.Now()), outputDir, routing)
+	require.NoError(t, err)
+
+	// Only the register is in the folder, the table of contents is not rendered
+	html, err := os.ReadFile(filepath.Join(outputDir, "html", alphabeticalRegister.htmlFilename()))
+	require.NoError(t, err)
+	assert.Contains(t, string(html), "<h1>Register</h1>")
+	assert.Contains(t, string(html), `<td class="toc-page">2</td>`)
+	assert.NoFileExists(t, filepath.Join(outputDir, "html", tableOfContents.htmlFilename()))
+}
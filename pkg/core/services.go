@@ -11,13 +11,15 @@ import (
 
 // Services container holds all service instances with shared dependencies
 type Services struct {
-	db         *database.Client
-	Project    ProjectService
-	Song       SongService
-	Import     ImportService
-	Config     ConfigService
-	Settings   SettingsService
-	FileSystem FileSystemService
+	db          *database.Client
+	Project     ProjectService
+	Song        SongService
+	Import      ImportService
+	Config      ConfigService
+	Settings    SettingsService
+	FileSystem  FileSystemService
+	BuildQueue  *BuildQueue
+	BuildEvents *BuildEventHub
 
 	// Resource management
 	ctx    context.Context
@@ -56,18 +58,24 @@ func NewServicesWithEmbedded(ctx context.Context, embeddedConfigFS fs.FS) (*Serv
 	serviceCtx, cancel := context.WithCancel(ctx)
 
 	settings := NewSettingsService(db)
+
+	// Builds run in the background and stop when the services are closed
+	buildQueue := NewBuildQueue(serviceCtx, DefaultMaxConcurrentBuilds)
+	buildEvents := NewBuildEventHub()
 	
 	return &Services{
-		db:         db,
-		Project:    NewProjectServiceWithDeps(db, config, fileSystem),
-		Song:       NewSongServiceWithDeps(db),
-		Import:     NewImportServiceWithDeps(db, settings),
-		Config:     config,
-		Settings:   settings,
-		FileSystem: fileSystem,
-		ctx:        serviceCtx,
-		cancel:     cancel,
-		closed:     false,
+		db:          db,
+		Project:     NewProjectServiceWithQueue(db, config, fileSystem, buildQueue, buildEvents),
+		Song:        NewSongServiceWithDeps(db),
+		Import:      NewImportServiceWithDeps(db, settings),
+		Config:      config,
+		Settings:    settings,
+		FileSystem:  fileSystem,
+		BuildQueue:  buildQueue,
+		BuildEvents: buildEvents,
+		ctx:         serviceCtx,
+		cancel:      cancel,
+		closed:      false,
 	}, nil
 }
 
@@ -93,6 +101,11 @@ func (s *Services) Close() error {
 	if s.cancel != nil {
 		s.cancel()
 	}
+
+	// Let running builds finish their cleanup before the database goes away
+	if s.BuildQueue != nil {
+		s.BuildQueue.Wait()
+	}
 	
 	// Close database connection
 	if s.db != nil {
@@ -0,0 +1,220 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
+)
+
+// ErrSongNotInBuild is returned for a song that a build with the given
+// priority threshold leaves out
+var ErrSongNotInBuild = errors.New("song is not part of the build")
+
+// Origins of the values of a resolved song config
+const (
+	ConfigOriginProject     = "project"
+	ConfigOriginABCFile     = "abc_file"
+	ConfigOriginPlaceholder = "placeholder"
+)
+
+// ResolveSongConfigRequest selects a song of a project and the build settings
+// that affect its config
+type ResolveSongConfigRequest struct {
+	ProjectID         int    `json:"project_id"`
+	SongID            int    `json:"song_id"`
+	AbcFileDir        string `json:"abc_file_dir,omitempty"`
+	PriorityThreshold int    `json:"priority_threshold,omitempty"`
+	SampleID          string `json:"sample_id,omitempty"`
+	SongOrder         string `json:"song_order,omitempty"`
+	Profile           string `json:"profile,omitempty"`
+
+	FallbackPriorityThreshold int `json:"-"` // see BuildProjectRequest
+}
+
+// ResolvedSongConfig is the zupfnoter config a build passes for a song.
+// Origins maps the JSON pointer of every value in Config to where it came from.
+type ResolvedSongConfig struct {
+	ProjectID     int               `json:"project_id"`
+	SongID        int               `json:"song_id"`
+	ProjectSongID int               `json:"project_song_id"`
+	Title         string            `json:"title"`
+	Filename      string            `json:"filename"`
+	Index         int               `json:"index"`
+	ABCPath       string            `json:"abc_path"`
+	ABCExists     bool              `json:"abc_exists"`
+	Variables     map[string]string `json:"variables"`
+	Config        map[string]any    `json:"config"`
+	Origins       map[string]string `json:"origins"`
+}
+
+// ResolveSongConfig returns the config of a song in a project exactly as a
+// build with the same settings would pass it to zupfnoter
+func (s *projectService) ResolveSongConfig(ctx context.Context, req ResolveSongConfigRequest) (*ResolvedSongConfig, error) {
+	if req.ProjectID <= 0 || req.SongID <= 0 {
+		return nil, ErrProjectSongNotFound
+	}
+
+	buildReq, err := s.prepareBuildRequest(ctx, BuildProjectRequest{
+		ProjectID:         req.ProjectID,
+		AbcFileDir:        req.AbcFileDir,
+		PriorityThreshold: req.PriorityThreshold,
+		SampleID:          req.SampleID,
+		SongOrder:         req.SongOrder,
+		Profile:           req.Profile,
+
+		FallbackPriorityThreshold: req.FallbackPriorityThreshold,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.loadBuildProject(ctx, buildReq)
+	if err != nil {
+		return nil, err
+	}
+	if buildReq.AbcFileDir == "" {
+		buildReq.AbcFileDir = defaultAbcFileDir(project)
+	}
+
+	// The index is the number of the song in the build
+	var ps *ent.ProjectSong
+	songIndex := 0
+	for _, candidate := range project.Edges.ProjectSongs {
+		if candidate.SongID == req.SongID {
+			ps, songIndex = candidate, candidate.Number
+			break
+		}
+	}
+	if ps == nil {
+		inProject, err := s.db.ProjectSong.Query().Where(projectsong.ProjectID(req.ProjectID), projectsong.SongID(req.SongID)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if inProject {
+			return nil, ErrSongNotInBuild
+		}
+		return nil, ErrProjectSongNotFound
+	}
+
+	song := ps.Edges.Song
+	resolved := &ResolvedSongConfig{
+		ProjectID:     project.ID,
+		SongID:        song.ID,
+		ProjectSongID: ps.ID,
+		Title:         song.Title,
+		Filename:      song.Filename,
+		Index:         songIndex,
+		ABCPath:       filepath.Join(buildReq.AbcFileDir, song.Filename),
+	}
+
+	// Without the ABC file the config is the one of the project alone
+	abcFile, err := os.ReadFile(resolved.ABCPath)
+	resolved.ABCExists = err == nil
+
+	vars := projectVariables(project, buildReq.SampleID, time.Now())
+	layers, err := s.songConfigLayers(ps, songIndex, vars, abcFile)
+	if err != nil {
+		return nil, err
+	}
+	resolved.Variables = layers.variables
+	resolved.Config = layers.final
+	resolved.Origins = make(map[string]string)
+	annotateConfigOrigins("", layers.final, layers, resolved.Origins)
+
+	return resolved, nil
+}
+
+// annotateConfigOrigins records the origin of every value below a path of the
+// final config. Maps are descended into, everything else counts as one value.
+func annotateConfigOrigins(path string, value any, layers *songConfigLayers, origins map[string]string) {
+	// Empty maps are values of their own, except for the config itself
+	if m, ok := value.(map[string]any); ok && (len(m) > 0 || path == "") {
+		for key, child := range m {
+			annotateConfigOrigins(path+"/"+escapeJSONPointer(key), child, layers, origins)
+		}
+		return
+	}
+
+	// The project config wins over the ABC file, values that differ from it
+	// were filled in from the ABC file
+	substituted, ok := lookupJSONPointer(layers.substituted, path)
+	if !ok || !reflect.DeepEqual(substituted, value) {
+		origins[path] = ConfigOriginABCFile
+		return
+	}
+	if raw, _ := lookupJSONPointer(layers.project, path); !reflect.DeepEqual(raw, substituted) {
+		origins[path] = ConfigOriginPlaceholder
+		return
+	}
+	origins[path] = ConfigOriginProject
+}
+
+// lookupJSONPointer returns the value at a JSON pointer such as /extract/0/title
+func lookupJSONPointer(config map[string]any, pointer string) (any, bool) {
+	var value any = config
+	for _, key := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = m[unescapeJSONPointer(key)]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+var (
+	jsonPointerEscaper   = strings.NewReplacer("~", "~0", "/", "~1")
+	jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// escapeJSONPointer escapes a map key for use in a JSON pointer
+func escapeJSONPointer(key string) string {
+	return jsonPointerEscaper.Replace(key)
+}
+
+// unescapeJSONPointer reverts escapeJSONPointer
+func unescapeJSONPointer(key string) string {
+	return jsonPointerUnescaper.Replace(key)
+}
+
+// SortedConfigPaths returns the JSON pointers of resolved config values in order
+func (c *ResolvedSongConfig) SortedConfigPaths() []string {
+	paths := make([]string, 0, len(c.Origins))
+	for path := range c.Origins {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// ConfigValue returns the value of the resolved config at a JSON pointer
+func (c *ResolvedSongConfig) ConfigValue(path string) any {
+	value, _ := lookupJSONPointer(c.Config, path)
+	return value
+}
+
+// defaultAbcFileDir returns the directory builds of a project read the ABC
+// files from when none is given: the preference of the project, the
+// abc_file_dir of its config or the most recent import directory
+func defaultAbcFileDir(project *ent.Project) string {
+	if project.AbcFileDirPreference != "" {
+		return project.AbcFileDirPreference
+	}
+	if abcFileDir, ok := project.Config["abc_file_dir"].(string); ok && abcFileDir != "" {
+		return abcFileDir
+	}
+	if lastImportDir, err := GetLastImportDir(); err == nil {
+		return lastImportDir
+	}
+	return ""
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectService_ResolveSongConfig(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Config Project",
+		ShortName: "CFG",
+		Config: map[string]interface{}{
+			"extract": map[string]interface{}{
+				"0": map[string]interface{}{
+					"title": "#{PREFIX} #{the_index}",
+					"notes": map[string]interface{}{"T01": "fixed"},
+				},
+			},
+			"folderPatterns": map[string]interface{}{"*_noten/x.pdf": "noten"},
+			"numbering":      map[string]interface{}{"mode": "sequential"},
+		},
+	})
+	require.NoError(t, err)
+
+	abcDir := t.TempDir()
+	abc := "X:1\nF:beta\nT:beta\n\n%%%%zupfnoter.config\n\n" +
+		`{"extract": {"0": {"title": "ignored", "filenamepart": "-A1"}}, "produce": [0]}` + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(abcDir, "beta.abc"), []byte(abc), 0644))
+
+	var songIDs []int
+	for i, name := range []string{"beta", "alpha", "gamma"} {
+		song, err := services.DB().Song.Create().SetTitle(name).SetFilename(name + ".abc").Save(ctx)
+		require.NoError(t, err)
+		priority := i + 1
+		_, err = services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+			ProjectID: project.ID,
+			SongID:    song.ID,
+			Priority:  &priority,
+		})
+		require.NoError(t, err)
+		songIDs = append(songIDs, song.ID)
+	}
+
+	resolved, err := services.Project.ResolveSongConfig(ctx, ResolveSongConfigRequest{
+		ProjectID:  project.ID,
+		SongID:     songIDs[0],
+		AbcFileDir: abcDir,
+		SampleID:   "S1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, resolved.Index)
+	assert.True(t, resolved.ABCExists)
+	assert.Equal(t, "S1", resolved.Variables["sampleId"])
+
+	extract := resolved.Config["extract"].(map[string]any)["0"].(map[string]any)
+	assert.Equal(t, "CFG 02", extract["title"])
+	assert.Equal(t, "-A1", extract["filenamepart"])
+	assert.Equal(t, map[string]string{
+		"/extract/0/title":        ConfigOriginPlaceholder,
+		"/extract/0/notes/T01":    ConfigOriginProject,
+		"/extract/0/filenamepart": ConfigOriginABCFile,
+		"/produce":                ConfigOriginABCFile,
+	}, resolved.Origins)
+	// Zupfnoter does not get the keys zupfmanager evaluates itself
+	assert.NotContains(t, resolved.Config, "folderPatterns")
+	assert.NotContains(t, resolved.Config, "numbering")
+
+	// The index follows the songs of the build
+	resolved, err = services.Project.ResolveSongConfig(ctx, ResolveSongConfigRequest{
+		ProjectID:         project.ID,
+		SongID:            songIDs[0],
+		AbcFileDir:        abcDir,
+		PriorityThreshold: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, resolved.Index)
+
+	// Without the ABC file only the project config is known
+	resolved, err = services.Project.ResolveSongConfig(ctx, ResolveSongConfigRequest{
+		ProjectID:  project.ID,
+		SongID:     songIDs[1],
+		AbcFileDir: abcDir,
+	})
+	require.NoError(t, err)
+	assert.False(t, resolved.ABCExists)
+	assert.NotContains(t, resolved.Origins, "/produce")
+
+	_, err = services.Project.ResolveSongConfig(ctx, ResolveSongConfigRequest{
+		ProjectID:         project.ID,
+		SongID:            songIDs[2],
+		PriorityThreshold: 2,
+	})
+	assert.Equal(t, ErrSongNotInBuild, err)
+
+	_, err = services.Project.ResolveSongConfig(ctx, ResolveSongConfigRequest{ProjectID: project.ID, SongID: 999})
+	assert.Equal(t, ErrProjectSongNotFound, err)
+	_, err = services.Project.ResolveSongConfig(ctx, ResolveSongConfigRequest{ProjectID: 999, SongID: songIDs[0]})
+	assert.Equal(t, ErrProjectNotFound, err)
+}
@@ -0,0 +1,314 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/ent/project"
+	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
+)
+
+// Numbering modes of a project. Sequential numbering counts the songs of
+// every build in song order, with stable numbering every song keeps the
+// number assigned to it when it was added.
+const (
+	NumberingStable     = "stable"
+	NumberingSequential = "sequential"
+)
+
+// Strategies to number new songs: after the highest number ever given, or
+// in the lowest gap left by removed songs
+const (
+	NumberAssignNext = "next"
+	NumberAssignFill = "fill"
+)
+
+// What happens to the number of a removed song: it is given to a later song
+// again, or it stays reserved until the project is renumbered
+const (
+	NumberRemoveRelease = "release"
+	NumberRemoveReserve = "reserve"
+)
+
+// numberingConfigKey is the project config key of the numbering settings
+const numberingConfigKey = "numbering"
+
+// songNumbering are the numbering settings of a project, e.g.
+//
+//	"numbering": {"mode": "stable", "assign": "fill", "onRemove": "reserve"}
+type songNumbering struct {
+	Mode     string
+	Assign   string
+	OnRemove string
+}
+
+// projectNumbering returns the numbering settings of the project config with
+// their defaults applied
+func projectNumbering(project *ent.Project) (songNumbering, error) {
+	numbering := songNumbering{
+		Mode:     NumberingSequential,
+		Assign:   NumberAssignNext,
+		OnRemove: NumberRemoveRelease,
+	}
+	settings, _ := project.Config[numberingConfigKey].(map[string]interface{})
+
+	var errors ValidationErrors
+	for _, setting := range []struct {
+		key    string
+		target *string
+		values []string
+	}{
+		{"mode", &numbering.Mode, []string{NumberingStable, NumberingSequential}},
+		{"assign", &numbering.Assign, []string{NumberAssignNext, NumberAssignFill}},
+		{"onRemove", &numbering.OnRemove, []string{NumberRemoveRelease, NumberRemoveReserve}},
+	} {
+		value, _ := settings[setting.key].(string)
+		if value == "" {
+			continue
+		}
+		if !containsString(setting.values, value) {
+			errors = append(errors, ValidationError{
+				Field:   "config." + numberingConfigKey + "." + setting.key,
+				Message: fmt.Sprintf("%s must be one of %s", setting.key, strings.Join(setting.values, ", ")),
+			})
+			continue
+		}
+		*setting.target = value
+	}
+
+	if errors.HasErrors() {
+		return numbering, errors
+	}
+	return numbering, nil
+}
+
+// numberBuildSongs sets the number of every song of a build, the songs are
+// in build order. Sequential numbering replaces the stored numbers in memory
+// only. Stable numbering keeps them and orders the songs by number instead,
+// so that the table of contents follows the merged PDFs.
+func numberBuildSongs(projectSongs []*ent.ProjectSong, numbering songNumbering) {
+	if numbering.Mode != NumberingSequential {
+		sort.SliceStable(projectSongs, func(i, j int) bool {
+			return projectSongs[i].Number < projectSongs[j].Number
+		})
+		return
+	}
+	for i, ps := range projectSongs {
+		ps.Number = i + 1
+	}
+}
+
+// nextSongNumbers returns count numbers for new songs that are neither taken
+// nor reserved
+func nextSongNumbers(taken map[int]bool, assign string, count int) []int {
+	numbers := make([]int, 0, count)
+	candidate := 1
+	if assign != NumberAssignFill {
+		for number := range taken {
+			if number >= candidate {
+				candidate = number + 1
+			}
+		}
+	}
+	for len(numbers) < count {
+		if !taken[candidate] {
+			numbers = append(numbers, candidate)
+		}
+		candidate++
+	}
+	return numbers
+}
+
+// newSongNumbers returns the songs of a project that have no number, e.g.
+// songs added before the project had numbers, in the song order of the
+// project and the numbers they get. projectSongs are all songs of the project.
+func newSongNumbers(entProject *ent.Project, projectSongs []*ent.ProjectSong) ([]*ent.ProjectSong, []int, error) {
+	numbering, err := projectNumbering(entProject)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	taken := make(map[int]bool)
+	for _, number := range entProject.ReservedNumbers {
+		taken[number] = true
+	}
+	var unnumbered []*ent.ProjectSong
+	for _, ps := range projectSongs {
+		if ps.Number > 0 {
+			taken[ps.Number] = true
+		} else {
+			unnumbered = append(unnumbered, ps)
+		}
+	}
+	if len(unnumbered) == 0 {
+		return nil, nil, nil
+	}
+
+	order, err := projectSongOrder(entProject)
+	if err != nil {
+		return nil, nil, err
+	}
+	sortProjectSongs(unnumbered, order)
+	return unnumbered, nextSongNumbers(taken, numbering.Assign, len(unnumbered)), nil
+}
+
+// assignSongNumbers gives a number to every song of a project that has none.
+// The numbers are read and assigned in one transaction, so that a song added
+// during a build does not get a number twice.
+func (s *projectService) assignSongNumbers(ctx context.Context, projectID int) error {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	entProject, err := tx.Project.Get(ctx, projectID)
+	if ent.IsNotFound(err) {
+		tx.Rollback()
+		return ErrProjectNotFound
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	projectSongs, err := tx.ProjectSong.Query().
+		Where(projectsong.ProjectID(projectID)).
+		WithSong().
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	unnumbered, numbers, err := newSongNumbers(entProject, projectSongs)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	for i, ps := range unnumbered {
+		if err := tx.ProjectSong.UpdateOne(ps).SetNumber(numbers[i]).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to assign number: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// previewSongNumbers numbers the songs of a build that have no number yet
+// the way the next build will, in memory only. Plans, the config inspector
+// and template previews must not write to the database.
+func (s *projectService) previewSongNumbers(ctx context.Context, entProject *ent.Project, buildSongs []*ent.ProjectSong) error {
+	missing := false
+	for _, ps := range buildSongs {
+		missing = missing || ps.Number == 0
+	}
+	if !missing {
+		return nil
+	}
+
+	projectSongs, err := s.db.ProjectSong.Query().
+		Where(projectsong.ProjectID(entProject.ID)).
+		WithSong().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	unnumbered, numbers, err := newSongNumbers(entProject, projectSongs)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int]int, len(unnumbered))
+	for i, ps := range unnumbered {
+		byID[ps.ID] = numbers[i]
+	}
+	for _, ps := range buildSongs {
+		if ps.Number == 0 {
+			ps.Number = byID[ps.ID]
+		}
+	}
+	return nil
+}
+
+// releaseSongNumber reserves the number of a removed song if the project
+// keeps the numbers of removed songs
+func (s *projectService) releaseSongNumber(ctx context.Context, projectID, number int) error {
+	if number <= 0 {
+		return nil
+	}
+	entProject, err := s.db.Project.Get(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	numbering, err := projectNumbering(entProject)
+	if err != nil {
+		return err
+	}
+	if numbering.OnRemove != NumberRemoveReserve {
+		return nil
+	}
+
+	reserved := append([]int{number}, entProject.ReservedNumbers...)
+	sort.Ints(reserved)
+	return s.db.Project.UpdateOne(entProject).SetReservedNumbers(reserved).Exec(ctx)
+}
+
+// RenumberProjectSongs numbers all songs of a project from 1 in the given
+// song order, the song order of the project if empty. Reserved numbers are
+// released.
+func (s *projectService) RenumberProjectSongs(ctx context.Context, projectID int, songOrder string) ([]*ProjectSong, error) {
+	entProject, err := s.db.Project.Query().Where(project.ID(projectID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrProjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if songOrder == "" {
+		songOrder, err = projectSongOrder(entProject)
+		if err != nil {
+			return nil, err
+		}
+	} else if !containsString(SongOrders, songOrder) {
+		return nil, ValidationErrors{{
+			Field:   "song_order",
+			Message: fmt.Sprintf("song_order must be one of %s", strings.Join(SongOrders, ", ")),
+		}}
+	}
+
+	projectSongs, err := s.db.ProjectSong.Query().
+		Where(projectsong.ProjectID(projectID)).
+		WithSong().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sortProjectSongs(projectSongs, songOrder)
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Numbers are unique within a project, the songs give them up before they swap them
+	if err := tx.ProjectSong.Update().Where(projectsong.ProjectID(projectID)).SetNumber(0).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to update number: %w", err)
+	}
+	for i, ps := range projectSongs {
+		if err := tx.ProjectSong.UpdateOne(ps).SetNumber(i + 1).Exec(ctx); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to update number: %w", err)
+		}
+	}
+	if err := tx.Project.UpdateOne(entProject).ClearReservedNumbers().Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to release reserved numbers: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.ListProjectSongs(ctx, projectID)
+}
@@ -0,0 +1,193 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextSongNumbers(t *testing.T) {
+	taken := map[int]bool{1: true, 2: true, 4: true, 7: true}
+	assert.Equal(t, []int{8, 9}, nextSongNumbers(taken, NumberAssignNext, 2))
+	assert.Equal(t, []int{3, 5, 6, 8}, nextSongNumbers(taken, NumberAssignFill, 4))
+	assert.Equal(t, []int{1}, nextSongNumbers(map[int]bool{}, NumberAssignNext, 1))
+}
+
+func TestProjectNumbering(t *testing.T) {
+	numbering, err := projectNumbering(&ent.Project{})
+	require.NoError(t, err)
+	assert.Equal(t, songNumbering{Mode: NumberingSequential, Assign: NumberAssignNext, OnRemove: NumberRemoveRelease}, numbering)
+
+	_, err = projectNumbering(&ent.Project{Config: map[string]interface{}{
+		"numbering": map[string]interface{}{"mode": "random", "onRemove": "reserve"},
+	}})
+	require.Error(t, err)
+	errs := err.(ValidationErrors)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "config.numbering.mode", errs[0].Field)
+}
+
+func TestProjectService_StableSongNumbers(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Numbers Project",
+		ShortName: "NUM",
+		Config: map[string]interface{}{
+			"numbering": map[string]interface{}{"mode": "stable", "assign": "fill", "onRemove": "reserve"},
+		},
+	})
+	require.NoError(t, err)
+
+	songIDs := make(map[string]int)
+	addSong := func(title string) *ProjectSong {
+		song, err := services.DB().Song.Create().SetTitle(title).SetFilename(title + ".abc").Save(ctx)
+		require.NoError(t, err)
+		songIDs[title] = song.ID
+		priority := 1
+		ps, err := services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+			ProjectID: project.ID,
+			SongID:    song.ID,
+			Priority:  &priority,
+		})
+		require.NoError(t, err)
+		return ps
+	}
+	plannedNumbers := func() map[string]int {
+		plan, err := services.Project.PlanProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID, AbcFileDir: t.TempDir()})
+		require.NoError(t, err)
+		numbers := make(map[string]int)
+		for _, song := range plan.Songs {
+			numbers[song.Title] = song.Index
+		}
+		return numbers
+	}
+
+	assert.Equal(t, 1, addSong("Zion").Number)
+	assert.Equal(t, 2, addSong("Morgenstern").Number)
+	assert.Equal(t, 3, addSong("Abend").Number)
+
+	// Songs keep their numbers although the song order is by title
+	assert.Equal(t, map[string]int{"Abend": 3, "Morgenstern": 2, "Zion": 1}, plannedNumbers())
+
+	// The number of a removed song is reserved, the next song fills no gap with it
+	require.NoError(t, services.Project.RemoveSongFromProject(ctx, project.ID, songIDs["Morgenstern"]))
+	stored, err := services.Project.Get(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, stored.ReservedNumbers)
+	assert.Equal(t, 4, addSong("Bach").Number)
+
+	// Songs added without the core service get a number with the next build
+	song, err := services.DB().Song.Create().SetTitle("Choral").SetFilename("choral.abc").Save(ctx)
+	require.NoError(t, err)
+	choral, err := services.DB().ProjectSong.Create().SetProjectID(project.ID).SetSongID(song.ID).SetPriority(1).Save(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"Abend": 3, "Bach": 4, "Choral": 5, "Zion": 1}, plannedNumbers())
+
+	// The plan numbers it in memory only
+	choral, err = services.DB().ProjectSong.Get(ctx, choral.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, choral.Number)
+	require.NoError(t, services.Project.(*projectService).assignSongNumbers(ctx, project.ID))
+	choral, err = services.DB().ProjectSong.Get(ctx, choral.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 5, choral.Number)
+
+	// A number is given only once within a project
+	song, err = services.DB().Song.Create().SetTitle("Doppelt").SetFilename("doppelt.abc").Save(ctx)
+	require.NoError(t, err)
+	_, err = services.DB().ProjectSong.Create().SetProjectID(project.ID).SetSongID(song.ID).SetPriority(1).SetNumber(5).Save(ctx)
+	assert.True(t, ent.IsConstraintError(err))
+
+	renumbered, err := services.Project.RenumberProjectSongs(ctx, project.ID, "")
+	require.NoError(t, err)
+	numbers := make(map[string]int)
+	for _, ps := range renumbered {
+		numbers[ps.Song.Title] = ps.Number
+	}
+	assert.Equal(t, map[string]int{"Abend": 1, "Bach": 2, "Choral": 3, "Zion": 4}, numbers)
+	stored, err = services.Project.Get(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Empty(t, stored.ReservedNumbers)
+
+	_, err = services.Project.RenumberProjectSongs(ctx, project.ID, "random")
+	assert.IsType(t, ValidationErrors{}, err)
+	_, err = services.Project.RenumberProjectSongs(ctx, 999, "")
+	assert.Equal(t, ErrProjectNotFound, err)
+}
+
+func TestProjectService_StableNumbersOrderTheBuild(t *testing.T) {
+	// Zupfnoter does not write anything in debug mode
+	t.Setenv("ZUPFNOTER_DEBUG", "1")
+
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Stable Order Project",
+		ShortName: "ORD",
+		Config: map[string]interface{}{
+			"produce":        []interface{}{1},
+			"extract":        map[string]interface{}{"1": map[string]interface{}{"filenamepart": "-A1"}},
+			"folderPatterns": map[string]interface{}{"*_-A*_a3.pdf": "klein"},
+			"numbering":      map[string]interface{}{"mode": "stable"},
+		},
+	})
+	require.NoError(t, err)
+
+	abcDir := t.TempDir()
+	addSong := func(title string) {
+		require.NoError(t, os.WriteFile(filepath.Join(abcDir, title+".abc"), []byte("X:1\nF:"+title+"\nT:"+title+"\n"), 0644))
+		song, err := services.DB().Song.Create().SetTitle(title).SetFilename(title + ".abc").Save(ctx)
+		require.NoError(t, err)
+		priority := 1
+		_, err = services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+			ProjectID: project.ID,
+			SongID:    song.ID,
+			Priority:  &priority,
+		})
+		require.NoError(t, err)
+	}
+
+	req := BuildProjectRequest{ProjectID: project.ID, AbcFileDir: abcDir, OutputDir: filepath.Join(t.TempDir(), "ORD")}
+	addSong("zion")
+	addSong("morgenstern")
+	result, err := services.Project.RunProjectBuild(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, "completed", result.Status, result.Error)
+
+	// A song added after the build gets the next number and comes last
+	addSong("abend")
+	plan, err := services.Project.PlanProjectBuild(ctx, req)
+	require.NoError(t, err)
+	var tocOrder []string
+	for _, song := range plan.Songs {
+		tocOrder = append(tocOrder, fmt.Sprintf("%02d_%s", song.Index, song.Title))
+	}
+	assert.Equal(t, []string{"01_zion", "02_morgenstern", "03_abend"}, tocOrder)
+
+	// The merged PDFs take the songs in the order of their numbers, the same as the table of contents
+	var merge *PlannedMerge
+	for i := range plan.MergedFiles {
+		if plan.MergedFiles[i].Folder == "klein" {
+			merge = &plan.MergedFiles[i]
+		}
+	}
+	require.NotNil(t, merge)
+	var mergeOrder []string
+	for _, file := range merge.Files {
+		name := filepath.Base(file)
+		mergeOrder = append(mergeOrder, name[:strings.Index(name, "_-")])
+	}
+	assert.Equal(t, tocOrder, mergeOrder)
+}
@@ -0,0 +1,199 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/ent/project"
+	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// Orders of the songs in a build. The order determines the table of contents
+// and, with sequential numbering, the index of every song.
+const (
+	SongOrderTitle      = "title"
+	SongOrderManual     = "manual"
+	SongOrderPriority   = "priority"
+	SongOrderDifficulty = "difficulty"
+	SongOrderGenre      = "genre"
+)
+
+// SongOrders are all supported song orders, SongOrderTitle is the default
+var SongOrders = []string{SongOrderTitle, SongOrderManual, SongOrderPriority, SongOrderDifficulty, SongOrderGenre}
+
+// songOrderConfigKey is the project config key of the song order
+const songOrderConfigKey = "songOrder"
+
+// difficultyRanks orders the difficulties from easy to expert
+var difficultyRanks = map[projectsong.Difficulty]int{
+	projectsong.DifficultyEasy:   1,
+	projectsong.DifficultyMedium: 2,
+	projectsong.DifficultyHard:   3,
+	projectsong.DifficultyExpert: 4,
+}
+
+// projectSongOrder returns the song order configured in the project config,
+// SongOrderTitle if there is none
+func projectSongOrder(project *ent.Project) (string, error) {
+	order, _ := project.Config[songOrderConfigKey].(string)
+	if order == "" {
+		return SongOrderTitle, nil
+	}
+	if !containsString(SongOrders, order) {
+		return "", ValidationErrors{{
+			Field:   "config." + songOrderConfigKey,
+			Message: fmt.Sprintf("%s must be one of %s", songOrderConfigKey, strings.Join(SongOrders, ", ")),
+		}}
+	}
+	return order, nil
+}
+
+// sortProjectSongs sorts the songs of a project in place. Texts are compared
+// with German collation, so that umlauts sort next to their base letters.
+// Songs that are equal in the chosen order are sorted by title.
+func sortProjectSongs(projectSongs []*ent.ProjectSong, order string) {
+	collator := collate.New(language.German, collate.IgnoreCase)
+	compareTitle := func(a, b *ent.ProjectSong) int {
+		if c := collator.CompareString(a.Edges.Song.Title, b.Edges.Song.Title); c != 0 {
+			return c
+		}
+		return a.SongID - b.SongID
+	}
+
+	compare := func(a, b *ent.ProjectSong) int {
+		switch order {
+		case SongOrderManual:
+			if a.Position != b.Position {
+				return a.Position - b.Position
+			}
+		case SongOrderPriority:
+			if a.Priority != b.Priority {
+				return a.Priority - b.Priority
+			}
+		case SongOrderDifficulty:
+			if a.Difficulty != b.Difficulty {
+				return difficultyRanks[a.Difficulty] - difficultyRanks[b.Difficulty]
+			}
+		case SongOrderGenre:
+			// Songs without genre come last
+			genreA, genreB := a.Edges.Song.Genre, b.Edges.Song.Genre
+			if (genreA == "") != (genreB == "") {
+				if genreA == "" {
+					return 1
+				}
+				return -1
+			}
+			if c := collator.CompareString(genreA, genreB); c != 0 {
+				return c
+			}
+		}
+		return compareTitle(a, b)
+	}
+
+	sort.SliceStable(projectSongs, func(i, j int) bool {
+		return compare(projectSongs[i], projectSongs[j]) < 0
+	})
+}
+
+// ReorderProjectSongs stores the manual order of the songs of a project. The
+// list must contain every song of the project exactly once.
+func (s *projectService) ReorderProjectSongs(ctx context.Context, projectID int, songIDs []int) ([]*ProjectSong, error) {
+	exists, err := s.db.Project.Query().Where(project.ID(projectID)).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrProjectNotFound
+	}
+
+	projectSongs, err := s.db.ProjectSong.Query().
+		Where(projectsong.ProjectID(projectID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSongOrder(projectSongs, songIDs); err != nil {
+		return nil, err
+	}
+
+	positions := make(map[int]int, len(songIDs))
+	for i, songID := range songIDs {
+		positions[songID] = i + 1
+	}
+
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ps := range projectSongs {
+		if err := tx.ProjectSong.UpdateOne(ps).SetPosition(positions[ps.SongID]).Exec(ctx); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to update position: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.ListProjectSongs(ctx, projectID)
+}
+
+// validateSongOrder checks that songIDs lists the songs of a project exactly once
+func validateSongOrder(projectSongs []*ent.ProjectSong, songIDs []int) error {
+	var errors ValidationErrors
+
+	inProject := make(map[int]bool, len(projectSongs))
+	for _, ps := range projectSongs {
+		inProject[ps.SongID] = true
+	}
+
+	seen := make(map[int]bool, len(songIDs))
+	for _, songID := range songIDs {
+		switch {
+		case !inProject[songID]:
+			errors = append(errors, ValidationError{
+				Field:   "song_ids",
+				Message: fmt.Sprintf("song %d is not in the project", songID),
+			})
+		case seen[songID]:
+			errors = append(errors, ValidationError{
+				Field:   "song_ids",
+				Message: fmt.Sprintf("song %d is listed more than once", songID),
+			})
+		}
+		seen[songID] = true
+	}
+	for _, ps := range projectSongs {
+		if !seen[ps.SongID] {
+			errors = append(errors, ValidationError{
+				Field:   "song_ids",
+				Message: fmt.Sprintf("song %d of the project is missing", ps.SongID),
+			})
+		}
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+	return nil
+}
+
+// nextSongPosition returns the position after the last song of a project
+func (s *projectService) nextSongPosition(ctx context.Context, projectID int) (int, error) {
+	last, err := s.db.ProjectSong.Query().
+		Where(projectsong.ProjectID(projectID)).
+		Order(ent.Desc(projectsong.FieldPosition)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return last.Position + 1, nil
+}
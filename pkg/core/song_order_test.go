@@ -0,0 +1,128 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortProjectSongs(t *testing.T) {
+	newSong := func(id int, title, genre string, priority, position int, difficulty projectsong.Difficulty) *ent.ProjectSong {
+		return &ent.ProjectSong{
+			SongID:     id,
+			Priority:   priority,
+			Position:   position,
+			Difficulty: difficulty,
+			Edges:      ent.ProjectSongEdges{Song: &ent.Song{ID: id, Title: title, Genre: genre}},
+		}
+	}
+	songs := []*ent.ProjectSong{
+		newSong(1, "Zu Bethlehem geboren", "Weihnachten", 2, 1, projectsong.DifficultyEasy),
+		newSong(2, "Über den Wolken", "", 1, 4, projectsong.DifficultyExpert),
+		newSong(3, "alle Vögel sind schon da", "Volkslied", 3, 2, projectsong.DifficultyMedium),
+		newSong(4, "Ode an die Freude", "Klassik", 1, 3, projectsong.DifficultyEasy),
+		newSong(5, "Öffne meine Augen", "Lobpreis", 2, 5, projectsong.DifficultyHard),
+	}
+	order := func(songOrder string) []int {
+		sortProjectSongs(songs, songOrder)
+		ids := make([]int, len(songs))
+		for i, ps := range songs {
+			ids[i] = ps.SongID
+		}
+		return ids
+	}
+
+	// Umlauts sort next to their base letters, case is ignored
+	assert.Equal(t, []int{3, 4, 5, 2, 1}, order(SongOrderTitle))
+	assert.Equal(t, []int{1, 3, 4, 2, 5}, order(SongOrderManual))
+	assert.Equal(t, []int{4, 2, 5, 1, 3}, order(SongOrderPriority))
+	assert.Equal(t, []int{4, 1, 3, 5, 2}, order(SongOrderDifficulty))
+	// Songs without genre come last
+	assert.Equal(t, []int{4, 5, 3, 1, 2}, order(SongOrderGenre))
+}
+
+func TestProjectSongOrder(t *testing.T) {
+	order, err := projectSongOrder(&ent.Project{})
+	require.NoError(t, err)
+	assert.Equal(t, SongOrderTitle, order)
+
+	order, err = projectSongOrder(&ent.Project{Config: map[string]interface{}{"songOrder": "genre"}})
+	require.NoError(t, err)
+	assert.Equal(t, SongOrderGenre, order)
+
+	_, err = projectSongOrder(&ent.Project{Config: map[string]interface{}{"songOrder": "random"}})
+	assert.IsType(t, ValidationErrors{}, err)
+
+	err = ValidateBuildProjectRequest(BuildProjectRequest{ProjectID: 1, SongOrder: "random"})
+	assert.IsType(t, ValidationErrors{}, err)
+}
+
+func TestProjectService_ReorderProjectSongs(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Order Project",
+		ShortName: "ORD",
+		Config:    map[string]interface{}{"songOrder": "manual"},
+	})
+	require.NoError(t, err)
+
+	var songIDs []int
+	for _, title := range []string{"Ährenfeld", "Abendlied", "Morgenlied"} {
+		song, err := services.DB().Song.Create().SetTitle(title).SetFilename(title + ".abc").Save(ctx)
+		require.NoError(t, err)
+		priority := 1
+		ps, err := services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+			ProjectID: project.ID,
+			SongID:    song.ID,
+			Priority:  &priority,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, len(songIDs)+1, ps.Position)
+		songIDs = append(songIDs, song.ID)
+	}
+
+	reordered, err := services.Project.ReorderProjectSongs(ctx, project.ID, []int{songIDs[2], songIDs[0], songIDs[1]})
+	require.NoError(t, err)
+	require.Len(t, reordered, 3)
+	assert.Equal(t, songIDs[2], reordered[0].SongID)
+	assert.Equal(t, songIDs[0], reordered[1].SongID)
+	assert.Equal(t, 3, reordered[2].Position)
+
+	// Builds follow the manual order of the project config unless the request overrides it
+	plan, err := services.Project.PlanProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID, AbcFileDir: t.TempDir()})
+	require.NoError(t, err)
+	assert.Equal(t, SongOrderManual, plan.SongOrder)
+	assert.Equal(t, []string{"Morgenlied", "Ährenfeld", "Abendlied"}, plannedTitles(plan))
+
+	plan, err = services.Project.PlanProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID, AbcFileDir: t.TempDir(), SongOrder: SongOrderTitle})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Abendlied", "Ährenfeld", "Morgenlied"}, plannedTitles(plan))
+
+	// Every song of the project must be listed exactly once
+	for _, ids := range [][]int{
+		{songIDs[0], songIDs[1]},
+		{songIDs[0], songIDs[1], songIDs[1]},
+		{songIDs[0], songIDs[1], songIDs[2], 999},
+	} {
+		_, err = services.Project.ReorderProjectSongs(ctx, project.ID, ids)
+		assert.IsType(t, ValidationErrors{}, err, ids)
+	}
+
+	_, err = services.Project.ReorderProjectSongs(ctx, 999, songIDs)
+	assert.Equal(t, ErrProjectNotFound, err)
+}
+
+func plannedTitles(plan *BuildPlan) []string {
+	titles := make([]string, len(plan.Songs))
+	for i, song := range plan.Songs {
+		titles[i] = song.Title
+	}
+	return titles
+}
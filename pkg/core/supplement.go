@@ -0,0 +1,120 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/ent/build"
+	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
+)
+
+// SupplementSuffix is appended to the merged PDFs of a supplement build
+const SupplementSuffix = "_nachtrag"
+
+// supplementOutputDir is the default output directory of supplement builds
+// below the project directory, so that they leave the songbook in place
+const supplementOutputDir = "nachtrag"
+
+// ErrEmptySupplement is returned for a supplement build without songs
+var ErrEmptySupplement = errors.New("no songs were added or changed")
+
+// supplementDateLayouts are the accepted layouts of a supplement date
+var supplementDateLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04", "2006-01-02"}
+
+// resolveSupplementSince returns the time a supplement build starts from.
+// since is a date or the ID of an earlier build of the project.
+func (s *projectService) resolveSupplementSince(ctx context.Context, projectID int, since string) (time.Time, error) {
+	for _, layout := range supplementDateLayouts {
+		if date, err := time.ParseInLocation(layout, since, time.Local); err == nil {
+			return date, nil
+		}
+	}
+
+	reference, err := s.db.Build.Query().
+		Where(build.BuildID(since), build.ProjectID(projectID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return time.Time{}, ValidationErrors{{
+			Field:   "supplement_since",
+			Message: "supplement_since must be a date (YYYY-MM-DD) or the ID of a build of the project",
+		}}
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return reference.StartedAt, nil
+}
+
+// prepareSupplement checks a supplement build request and replaces its
+// reference with the time it stands for
+func (s *projectService) prepareSupplement(ctx context.Context, req BuildProjectRequest) (BuildProjectRequest, error) {
+	since, err := s.resolveSupplementSince(ctx, req.ProjectID, req.SupplementSince)
+	if err != nil {
+		return req, err
+	}
+	req.SupplementSince = since.Format(time.RFC3339Nano)
+
+	exists, err := s.db.ProjectSong.Query().
+		Where(
+			projectsong.ProjectID(req.ProjectID),
+			projectsong.PriorityLTE(req.PriorityThreshold),
+			projectsong.Or(
+				projectsong.CreatedAtGT(since),
+				projectsong.UpdatedAtGT(since),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return req, err
+	}
+	if !exists {
+		return req, fmt.Errorf("%w since %s", ErrEmptySupplement, since.Format("2006-01-02 15:04"))
+	}
+	return req, nil
+}
+
+// isSupplementSong reports whether a song was added or changed after since
+func isSupplementSong(ps *ent.ProjectSong, since time.Time) bool {
+	return ps.CreatedAt.After(since) || ps.UpdatedAt.After(since)
+}
+
+// supplementSongs returns the songs of a supplement build in build order.
+// Sequential numbers continue after the songs of the songbook, with stable
+// numbers the supplement is ordered by number.
+func supplementSongs(projectSongs []*ent.ProjectSong, since time.Time, numbering songNumbering) []*ent.ProjectSong {
+	var songbook, supplement []*ent.ProjectSong
+	for _, ps := range projectSongs {
+		if isSupplementSong(ps, since) {
+			supplement = append(supplement, ps)
+		} else {
+			songbook = append(songbook, ps)
+		}
+	}
+	if numbering.Mode == NumberingSequential {
+		numberBuildSongs(append(songbook, supplement...), numbering)
+	} else {
+		numberBuildSongs(supplement, numbering)
+	}
+	return supplement
+}
+
+// mergedPDFName returns the file name of the merged PDF of a folder
+func mergedPDFName(project *ent.Project, folder string, supplement bool) string {
+	name := fmt.Sprintf("%s_%s", project.ShortName, folder)
+	if supplement {
+		name += SupplementSuffix
+	}
+	return name + ".pdf"
+}
+
+// defaultOutputDir returns the output directory of a build of the project
+func defaultOutputDir(project *ent.Project, supplement bool) string {
+	if supplement {
+		return filepath.Join(project.ShortName, supplementOutputDir)
+	}
+	return project.ShortName
+}
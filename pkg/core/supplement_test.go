@@ -0,0 +1,108 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupplementSongs(t *testing.T) {
+	since := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	before, after := since.Add(-time.Hour), since.Add(time.Hour)
+	songs := []*ent.ProjectSong{
+		{SongID: 1, Number: 7, CreatedAt: before, UpdatedAt: before},
+		{SongID: 2, Number: 3, CreatedAt: after, UpdatedAt: after},
+		{SongID: 3, Number: 1, CreatedAt: before, UpdatedAt: before},
+		{SongID: 4, Number: 9, CreatedAt: before, UpdatedAt: after},
+		{SongID: 5, Number: 2},
+	}
+
+	supplement := supplementSongs(songs, since, songNumbering{Mode: NumberingStable})
+	require.Len(t, supplement, 2)
+	assert.Equal(t, 2, supplement[0].SongID)
+	assert.Equal(t, 3, supplement[0].Number)
+	assert.Equal(t, 9, supplement[1].Number)
+
+	// Sequential numbers continue after the songs of the songbook
+	supplement = supplementSongs(songs, since, songNumbering{Mode: NumberingSequential})
+	assert.Equal(t, 4, supplement[0].Number)
+	assert.Equal(t, 5, supplement[1].Number)
+
+	assert.Equal(t, "MBT_klein_nachtrag.pdf", mergedPDFName(&ent.Project{ShortName: "MBT"}, "klein", true))
+	assert.Equal(t, "klein", manifestFolder("druckdateien/MBT_klein_nachtrag.pdf", "MBT", nil))
+}
+
+func TestProjectService_PlanSupplementBuild(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Supplement Project",
+		ShortName: "SUP",
+		Config: map[string]interface{}{
+			"folderPatterns": map[string]interface{}{"*_noten.pdf": "noten"},
+		},
+	})
+	require.NoError(t, err)
+
+	addSong := func(title string) int {
+		song, err := services.DB().Song.Create().SetTitle(title).SetFilename(title + ".abc").Save(ctx)
+		require.NoError(t, err)
+		priority := 1
+		_, err = services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+			ProjectID: project.ID,
+			SongID:    song.ID,
+			Priority:  &priority,
+		})
+		require.NoError(t, err)
+		return song.ID
+	}
+	addSong("Alpha")
+	bravo := addSong("Bravo")
+
+	// The songbook build is the reference of the supplement
+	reference, err := services.DB().Build.Create().
+		SetBuildID("songbook").
+		SetProjectID(project.ID).
+		SetStartedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	abcDir := t.TempDir()
+	_, err = services.Project.PlanProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID, AbcFileDir: abcDir, SupplementSince: reference.BuildID})
+	assert.True(t, errors.Is(err, ErrEmptySupplement))
+
+	time.Sleep(10 * time.Millisecond)
+	addSong("Charlie")
+	comment := "neue Strophe"
+	_, err = services.Project.UpdateProjectSong(ctx, UpdateProjectSongRequest{ProjectID: project.ID, SongID: bravo, Comment: &comment})
+	require.NoError(t, err)
+
+	plan, err := services.Project.PlanProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID, AbcFileDir: abcDir, SupplementSince: reference.BuildID})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("SUP", "nachtrag"), plan.OutputDir)
+	assert.Equal(t, reference.StartedAt.Format(time.RFC3339Nano), plan.SupplementSince)
+	require.Len(t, plan.Songs, 2)
+	assert.Equal(t, "Bravo", plan.Songs[0].Title)
+	assert.Equal(t, 2, plan.Songs[0].Index)
+	assert.Equal(t, "Charlie", plan.Songs[1].Title)
+	assert.Equal(t, 3, plan.Songs[1].Index)
+	for _, merge := range plan.MergedFiles {
+		assert.Contains(t, merge.Path, SupplementSuffix+".pdf")
+	}
+
+	// A date works as well, builds of other projects do not
+	plan, err = services.Project.PlanProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID, AbcFileDir: abcDir, SupplementSince: "2000-01-01"})
+	require.NoError(t, err)
+	assert.Len(t, plan.Songs, 3)
+
+	_, err = services.Project.PlanProjectBuild(ctx, BuildProjectRequest{ProjectID: project.ID, AbcFileDir: abcDir, SupplementSince: "unknown"})
+	assert.IsType(t, ValidationErrors{}, err)
+}
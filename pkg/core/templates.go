@@ -0,0 +1,647 @@
+package core
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	entsetting "github.com/bwl21/zupfmanager/internal/ent/setting"
+	enttemplate "github.com/bwl21/zupfmanager/internal/ent/template"
+)
+
+// ErrTemplateNotFound is returned for templates that do not exist in the
+// requested source
+var ErrTemplateNotFound = errors.New("template not found")
+
+// Kinds of templates: the ABC and the HTML template of the table of contents
+const (
+	TemplateKindTOC     = "toc"
+	TemplateKindTOCHTML = "toc-html"
+)
+
+// TemplateKinds are the kinds of templates in the registry
+var TemplateKinds = []string{TemplateKindTOC, TemplateKindTOCHTML}
+
+// templateFiles are the file names of the template kinds in template directories
+var templateFiles = map[string]string{
+	TemplateKindTOC:     "999_inhaltsverzeichnis_template.abc",
+	TemplateKindTOCHTML: "999_inhaltsverzeichnis_template.html",
+}
+
+// Sources of templates. Project templates are files in <short>/tpl, shared
+// templates are stored in the database and selected by name in the project
+// config, user templates are files in the user template directory and the
+// built-in templates are compiled into zupfmanager.
+const (
+	TemplateSourceProject = "project"
+	TemplateSourceShared  = "shared"
+	TemplateSourceUser    = "user"
+	TemplateSourceBuiltIn = BuiltInTemplate
+)
+
+// TemplateSources are the sources of templates, built-in comes last in every
+// resolution order
+var TemplateSources = []string{TemplateSourceProject, TemplateSourceShared, TemplateSourceUser, TemplateSourceBuiltIn}
+
+// DefaultTemplateOrder is the order templates are looked up in unless the
+// project config or the server setting configure another one
+var DefaultTemplateOrder = []string{TemplateSourceProject, TemplateSourceShared, TemplateSourceUser}
+
+// Project config keys of the template registry, e.g.
+//
+//	"templates": {"toc-html": "zweispaltig"},
+//	"templateOrder": ["shared", "project"]
+const (
+	templatesConfigKey     = "templates"
+	templateOrderConfigKey = "templateOrder"
+)
+
+// TemplateOrderSetting is the setting with the server-wide template order,
+// the sources separated by commas
+const TemplateOrderSetting = "template_order"
+
+// UserTemplateDirEnv overrides the user template directory
+const UserTemplateDirEnv = "ZUPFMANAGER_TEMPLATE_DIR"
+
+//go:embed templates
+var builtInTemplates embed.FS
+
+// Template is a template of the registry. ID is only set for shared
+// templates, Path for templates stored in files. Active marks the template
+// a project uses in listings for a project.
+type Template struct {
+	ID          int    `json:"id,omitempty"`
+	Kind        string `json:"kind"`
+	Source      string `json:"source"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Path        string `json:"path,omitempty"`
+	Content     string `json:"content,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+	Active      bool   `json:"active,omitempty"`
+}
+
+// Origin names where a template comes from, e.g. in build plans
+func (t *Template) Origin() string {
+	switch t.Source {
+	case TemplateSourceShared:
+		return TemplateSourceShared + ":" + t.Name
+	case TemplateSourceBuiltIn:
+		return BuiltInTemplate
+	}
+	return t.Path
+}
+
+// TemplateRef selects a template. Without source the template a project, or
+// without project the default order, resolves to is selected. Shared
+// templates are selected by name, project templates by project.
+type TemplateRef struct {
+	Kind      string `json:"kind"`
+	Source    string `json:"source,omitempty"`
+	Name      string `json:"name,omitempty"`
+	ProjectID int    `json:"project_id,omitempty"`
+}
+
+// TemplateRequest represents the data needed to create or update a shared template
+type TemplateRequest struct {
+	Name        string `json:"name"`
+	Kind        string `json:"kind"`
+	Description string `json:"description,omitempty"`
+	Content     string `json:"content"`
+}
+
+// TemplatePreviewRequest renders a template with the songs of a project.
+// Content replaces the content of the selected template, e.g. to preview
+// changes before they are saved.
+type TemplatePreviewRequest struct {
+	TemplateRef
+	Content string `json:"content,omitempty"`
+}
+
+// TemplatePreview is a template rendered with the songs of a project
+type TemplatePreview struct {
+	Template    *Template `json:"template"`
+	ContentType string    `json:"content_type"`
+	Content     string    `json:"content"`
+}
+
+// ValidateTemplateRequest validates a create or update template request
+func ValidateTemplateRequest(req TemplateRequest) error {
+	var errors ValidationErrors
+
+	if strings.TrimSpace(req.Name) == "" {
+		errors = append(errors, ValidationError{Field: "name", Message: "name is required"})
+	}
+	if !containsString(TemplateKinds, req.Kind) {
+		errors = append(errors, ValidationError{
+			Field:   "kind",
+			Message: fmt.Sprintf("kind must be one of %s", strings.Join(TemplateKinds, ", ")),
+		})
+	} else if err := checkTemplateSyntax(req.Kind, req.Content); err != nil {
+		errors = append(errors, ValidationError{Field: "content", Message: err.Error()})
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+	return nil
+}
+
+// validateTemplateKind returns a validation error for unknown kinds
+func validateTemplateKind(kind string) error {
+	if containsString(TemplateKinds, kind) {
+		return nil
+	}
+	return ValidationErrors{{
+		Field:   "kind",
+		Message: fmt.Sprintf("kind must be one of %s", strings.Join(TemplateKinds, ", ")),
+	}}
+}
+
+// checkTemplateSyntax parses HTML templates, variables are not checked
+func checkTemplateSyntax(kind, content string) error {
+	if kind != TemplateKindTOCHTML {
+		return nil
+	}
+	_, err := parseHTMLTocTemplate(content, nil)
+	return err
+}
+
+// userTemplateDir returns the user template directory, "" if there is none
+func userTemplateDir() string {
+	if dir := os.Getenv(UserTemplateDirEnv); dir != "" {
+		return dir
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(configDir, "zupfmanager", "templates")
+}
+
+// projectTemplatePath returns the file of a project template
+func projectTemplatePath(project *ent.Project, kind string) string {
+	return filepath.Join(project.ShortName, "tpl", templateFiles[kind])
+}
+
+// builtInTemplate returns the built-in template of a kind
+func builtInTemplate(kind string) *Template {
+	content, err := builtInTemplates.ReadFile("templates/" + templateFiles[kind])
+	if err != nil {
+		panic(fmt.Sprintf("built-in template %s missing: %v", kind, err))
+	}
+	return &Template{Kind: kind, Source: TemplateSourceBuiltIn, Name: kind, Content: string(content)}
+}
+
+// fileTemplate reads a template file, nil if it does not exist
+func fileTemplate(kind, source, path string) (*Template, error) {
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template %s: %w", path, err)
+	}
+	template := &Template{Kind: kind, Source: source, Name: filepath.Base(path), Path: path, Content: string(content)}
+	if info, err := os.Stat(path); err == nil {
+		template.UpdatedAt = info.ModTime().Format(time.RFC3339)
+	}
+	return template, nil
+}
+
+// templateOrder returns the order the templates of a project are looked up
+// in: that of the project config, the server setting or the default
+func (s *projectService) templateOrder(ctx context.Context, project *ent.Project) ([]string, error) {
+	var order []string
+	field := "config." + templateOrderConfigKey
+	var value interface{}
+	if project != nil {
+		value = project.Config[templateOrderConfigKey]
+	}
+	if value != nil {
+		entries, ok := value.([]interface{})
+		if !ok {
+			return nil, ValidationErrors{{Field: field, Message: "templateOrder must be a list of template sources"}}
+		}
+		for _, entry := range entries {
+			source, _ := entry.(string)
+			order = append(order, source)
+		}
+	} else if s.db != nil {
+		// Services without database, as in tests of the rendering, only know files and built-ins
+		setting, err := s.db.Setting.Query().Where(entsetting.KeyEQ(TemplateOrderSetting)).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if setting != nil && strings.TrimSpace(setting.Value) != "" {
+			field = TemplateOrderSetting
+			for _, source := range strings.Split(setting.Value, ",") {
+				order = append(order, strings.TrimSpace(source))
+			}
+		}
+	}
+	if order == nil {
+		order = append([]string(nil), DefaultTemplateOrder...)
+	}
+	if err := validateTemplateOrder(order, field); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// validateTemplateOrder checks the sources of a template order
+func validateTemplateOrder(order []string, field string) error {
+	for _, source := range order {
+		if !containsString(TemplateSources, source) {
+			return ValidationErrors{{
+				Field:   field,
+				Message: fmt.Sprintf("unknown template source %q, sources are %s", source, strings.Join(TemplateSources, ", ")),
+			}}
+		}
+	}
+	return nil
+}
+
+// sharedTemplateName returns the name of the shared template the project
+// config selects for a kind, "" if it selects none
+func sharedTemplateName(project *ent.Project, kind string) string {
+	names, _ := project.Config[templatesConfigKey].(map[string]interface{})
+	name, _ := names[kind].(string)
+	return name
+}
+
+// resolveTemplate returns the template of a kind a project uses: the first
+// one found in the template order, the built-in template if none is found.
+// Without project the server-wide order is used.
+func (s *projectService) resolveTemplate(ctx context.Context, project *ent.Project, kind string) (*Template, error) {
+	order, err := s.templateOrder(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	for _, source := range order {
+		template, err := s.sourceTemplate(ctx, project, kind, source, "")
+		if err != nil {
+			return nil, err
+		}
+		if template != nil {
+			return template, nil
+		}
+	}
+	return builtInTemplate(kind), nil
+}
+
+// sourceTemplate returns the template of a kind in a source, nil if the
+// source has none. Shared templates are looked up by name or, without name,
+// by the name the project config selects.
+func (s *projectService) sourceTemplate(ctx context.Context, project *ent.Project, kind, source, name string) (*Template, error) {
+	switch source {
+	case TemplateSourceProject:
+		if project == nil {
+			return nil, nil
+		}
+		return fileTemplate(kind, source, projectTemplatePath(project, kind))
+
+	case TemplateSourceShared:
+		if name == "" && project != nil {
+			name = sharedTemplateName(project, kind)
+		}
+		if name == "" || s.db == nil {
+			return nil, nil
+		}
+		entTemplate, err := s.db.Template.Query().
+			Where(enttemplate.Kind(kind), enttemplate.Name(name)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			if project != nil {
+				return nil, fmt.Errorf("shared %s template %q selected by project %s: %w", kind, name, project.ShortName, ErrTemplateNotFound)
+			}
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return TemplateFromEnt(entTemplate), nil
+
+	case TemplateSourceUser:
+		dir := userTemplateDir()
+		if dir == "" {
+			return nil, nil
+		}
+		return fileTemplate(kind, source, filepath.Join(dir, templateFiles[kind]))
+
+	case TemplateSourceBuiltIn:
+		return builtInTemplate(kind), nil
+	}
+	return nil, validateTemplateOrder([]string{source}, "source")
+}
+
+// ListTemplates returns the templates of all sources by kind. With a
+// project its templates are listed as well and the ones it uses are marked
+// active. Contents are left out.
+func (s *projectService) ListTemplates(ctx context.Context, projectID int) ([]*Template, error) {
+	var project *ent.Project
+	if projectID != 0 {
+		var err error
+		project, err = s.db.Project.Get(ctx, projectID)
+		if ent.IsNotFound(err) {
+			return nil, ErrProjectNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	entTemplates, err := s.db.Template.Query().
+		Order(ent.Asc(enttemplate.FieldKind), ent.Asc(enttemplate.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := []*Template{}
+	for _, kind := range TemplateKinds {
+		var active *Template
+		if project != nil {
+			if active, err = s.resolveTemplate(ctx, project, kind); err != nil {
+				return nil, err
+			}
+		}
+
+		candidates := []*Template{}
+		if project != nil {
+			template, err := fileTemplate(kind, TemplateSourceProject, projectTemplatePath(project, kind))
+			if err != nil {
+				return nil, err
+			}
+			if template != nil {
+				candidates = append(candidates, template)
+			}
+		}
+		for _, entTemplate := range entTemplates {
+			if entTemplate.Kind == kind {
+				candidates = append(candidates, TemplateFromEnt(entTemplate))
+			}
+		}
+		if dir := userTemplateDir(); dir != "" {
+			template, err := fileTemplate(kind, TemplateSourceUser, filepath.Join(dir, templateFiles[kind]))
+			if err != nil {
+				return nil, err
+			}
+			if template != nil {
+				candidates = append(candidates, template)
+			}
+		}
+		candidates = append(candidates, builtInTemplate(kind))
+
+		for _, template := range candidates {
+			template.Active = active != nil && template.Source == active.Source && template.Name == active.Name
+			template.Content = ""
+			templates = append(templates, template)
+		}
+	}
+	return templates, nil
+}
+
+// GetTemplate returns a template with its content
+func (s *projectService) GetTemplate(ctx context.Context, ref TemplateRef) (*Template, error) {
+	if err := validateTemplateKind(ref.Kind); err != nil {
+		return nil, err
+	}
+	var project *ent.Project
+	if ref.ProjectID != 0 {
+		var err error
+		project, err = s.db.Project.Get(ctx, ref.ProjectID)
+		if ent.IsNotFound(err) {
+			return nil, ErrProjectNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if ref.Source == "" {
+		template, err := s.resolveTemplate(ctx, project, ref.Kind)
+		if err != nil {
+			return nil, err
+		}
+		template.Active = ref.ProjectID != 0
+		return template, nil
+	}
+
+	if ref.Source == TemplateSourceProject && project == nil {
+		return nil, ValidationErrors{{Field: "project_id", Message: "project templates need a project"}}
+	}
+	if ref.Source == TemplateSourceShared && ref.Name == "" {
+		return nil, ValidationErrors{{Field: "name", Message: "shared templates are selected by name"}}
+	}
+	// Only project templates depend on the project, shared ones are selected by name
+	if ref.Source != TemplateSourceProject {
+		project = nil
+	}
+	template, err := s.sourceTemplate(ctx, project, ref.Kind, ref.Source, ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return nil, ErrTemplateNotFound
+	}
+	return template, nil
+}
+
+// CreateSharedTemplate stores a shared template
+func (s *projectService) CreateSharedTemplate(ctx context.Context, req TemplateRequest) (*Template, error) {
+	if err := ValidateTemplateRequest(req); err != nil {
+		return nil, err
+	}
+	entTemplate, err := s.db.Template.Create().
+		SetName(strings.TrimSpace(req.Name)).
+		SetKind(req.Kind).
+		SetDescription(req.Description).
+		SetContent(req.Content).
+		Save(ctx)
+	if err != nil {
+		return nil, templateSaveError(err)
+	}
+	return TemplateFromEnt(entTemplate), nil
+}
+
+// UpdateSharedTemplate replaces name, description and content of a shared template
+func (s *projectService) UpdateSharedTemplate(ctx context.Context, templateID int, req TemplateRequest) (*Template, error) {
+	entTemplate, err := s.getSharedTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	req.Kind = entTemplate.Kind
+	if err := ValidateTemplateRequest(req); err != nil {
+		return nil, err
+	}
+	entTemplate, err = entTemplate.Update().
+		SetName(strings.TrimSpace(req.Name)).
+		SetDescription(req.Description).
+		SetContent(req.Content).
+		Save(ctx)
+	if err != nil {
+		return nil, templateSaveError(err)
+	}
+	return TemplateFromEnt(entTemplate), nil
+}
+
+// DeleteSharedTemplate removes a shared template. Projects that still
+// select it fail to build until they select another one.
+func (s *projectService) DeleteSharedTemplate(ctx context.Context, templateID int) error {
+	entTemplate, err := s.getSharedTemplate(ctx, templateID)
+	if err != nil {
+		return err
+	}
+	return s.db.Template.DeleteOne(entTemplate).Exec(ctx)
+}
+
+// SetProjectTemplate writes the template of a kind into the template
+// directory of a project, where it overrides shared and user templates in
+// the default order
+func (s *projectService) SetProjectTemplate(ctx context.Context, projectID int, kind, content string) (*Template, error) {
+	if err := validateTemplateKind(kind); err != nil {
+		return nil, err
+	}
+	if err := checkTemplateSyntax(kind, content); err != nil {
+		return nil, ValidationErrors{{Field: "content", Message: err.Error()}}
+	}
+	project, err := s.db.Project.Get(ctx, projectID)
+	if ent.IsNotFound(err) {
+		return nil, ErrProjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	path := projectTemplatePath(project, kind)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create template directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write template: %w", err)
+	}
+	slog.Info("wrote project template", "project", project.ShortName, "kind", kind, "path", path)
+
+	template, err := fileTemplate(kind, TemplateSourceProject, path)
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// CopyTemplateToProject copies a shared template into the template
+// directory of a project, so that the project can change it on its own
+func (s *projectService) CopyTemplateToProject(ctx context.Context, templateID, projectID int) (*Template, error) {
+	entTemplate, err := s.getSharedTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	return s.SetProjectTemplate(ctx, projectID, entTemplate.Kind, entTemplate.Content)
+}
+
+// PreviewTemplate renders a template with the songs of a project the way a
+// build with the default parameters would. The ABC table of contents is
+// not passed to zupfnoter.
+func (s *projectService) PreviewTemplate(ctx context.Context, req TemplatePreviewRequest) (*TemplatePreview, error) {
+	if req.ProjectID == 0 {
+		return nil, ValidationErrors{{Field: "project_id", Message: "previews need a project"}}
+	}
+	template, err := s.GetTemplate(ctx, req.TemplateRef)
+	if err != nil {
+		return nil, err
+	}
+	if req.Content != "" {
+		template.Content = req.Content
+	}
+
+	buildReq, err := s.prepareBuildRequest(ctx, BuildProjectRequest{ProjectID: req.ProjectID})
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.loadBuildProject(ctx, buildReq)
+	if err != nil {
+		return nil, err
+	}
+	vars := projectVariables(project, buildReq.SampleID, time.Now())
+
+	preview := &TemplatePreview{Template: template}
+	switch template.Kind {
+	case TemplateKindTOC:
+		preview.ContentType = "text/vnd.abc"
+		preview.Content, err = renderTocABC(template.Content, tableOfContents, project, project.Edges.ProjectSongs, vars, nil)
+	case TemplateKindTOCHTML:
+		preview.ContentType = "text/html"
+		preview.Content, err = renderHTMLTocTemplate(template.Content, tableOfContents, project, project.Edges.ProjectSongs, vars, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return preview, nil
+}
+
+// GetTemplateOrder returns the order the templates of a project, or without
+// project the templates of all projects without own order, are looked up in
+func (s *projectService) GetTemplateOrder(ctx context.Context, projectID int) ([]string, error) {
+	var project *ent.Project
+	if projectID != 0 {
+		var err error
+		project, err = s.db.Project.Get(ctx, projectID)
+		if ent.IsNotFound(err) {
+			return nil, ErrProjectNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.templateOrder(ctx, project)
+}
+
+// SetTemplateOrder sets the server-wide template order, an empty order
+// restores the default order
+func (s *projectService) SetTemplateOrder(ctx context.Context, order []string) error {
+	if err := validateTemplateOrder(order, "order"); err != nil {
+		return err
+	}
+	return NewSettingsService(s.db).SetSetting(ctx, TemplateOrderSetting, strings.Join(order, ","))
+}
+
+// getSharedTemplate returns a shared template by ID
+func (s *projectService) getSharedTemplate(ctx context.Context, templateID int) (*ent.Template, error) {
+	entTemplate, err := s.db.Template.Get(ctx, templateID)
+	if ent.IsNotFound(err) {
+		return nil, ErrTemplateNotFound
+	}
+	return entTemplate, err
+}
+
+// templateSaveError turns the unique constraint on kind and name into a validation error
+func templateSaveError(err error) error {
+	if ent.IsConstraintError(err) {
+		return ValidationErrors{{Field: "name", Message: "a shared template of this kind with this name already exists"}}
+	}
+	return err
+}
+
+// TemplateFromEnt converts an ent.Template to a shared domain Template
+func TemplateFromEnt(entTemplate *ent.Template) *Template {
+	if entTemplate == nil {
+		return nil
+	}
+	return &Template{
+		ID:          entTemplate.ID,
+		Kind:        entTemplate.Kind,
+		Source:      TemplateSourceShared,
+		Name:        entTemplate.Name,
+		Description: entTemplate.Description,
+		Content:     entTemplate.Content,
+		UpdatedAt:   entTemplate.UpdatedAt.Format(time.RFC3339),
+	}
+}
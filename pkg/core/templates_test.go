@@ -0,0 +1,146 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectService_TemplateResolution(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+	userDir := t.TempDir()
+	t.Setenv(UserTemplateDirEnv, userDir)
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{Title: "Template Project", ShortName: "TPLRES"})
+	require.NoError(t, err)
+	defer os.RemoveAll("TPLRES")
+
+	get := func() *Template {
+		template, err := services.Project.GetTemplate(ctx, TemplateRef{Kind: TemplateKindTOC, ProjectID: project.ID})
+		require.NoError(t, err)
+		return template
+	}
+
+	// New projects use the built-in template
+	assert.Equal(t, TemplateSourceBuiltIn, get().Source)
+	assert.Contains(t, get().Content, "W:{{TOC}}")
+
+	require.NoError(t, os.WriteFile(filepath.Join(userDir, "999_inhaltsverzeichnis_template.abc"), []byte("X:1\nT:User\nW:{{TOC}}\n"), 0644))
+	assert.Equal(t, TemplateSourceUser, get().Source)
+
+	// Shared templates apply once the project selects them
+	shared, err := services.Project.CreateSharedTemplate(ctx, TemplateRequest{Name: "gross", Kind: TemplateKindTOC, Content: "X:1\nT:Shared\nW:{{TOC}}\n"})
+	require.NoError(t, err)
+	assert.Equal(t, TemplateSourceUser, get().Source)
+	_, err = services.Project.Update(ctx, UpdateProjectRequest{
+		ID: project.ID, Title: project.Title, ShortName: project.ShortName,
+		Config: map[string]interface{}{"templates": map[string]interface{}{"toc": "gross"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "shared:gross", get().Origin())
+
+	// Copying the shared template makes it a project override
+	copied, err := services.Project.CopyTemplateToProject(ctx, shared.ID, project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("TPLRES", "tpl", "999_inhaltsverzeichnis_template.abc"), copied.Path)
+	assert.Equal(t, TemplateSourceProject, get().Source)
+	assert.Equal(t, "X:1\nT:Shared\nW:{{TOC}}\n", get().Content)
+
+	// The server-wide order puts shared templates first
+	require.NoError(t, services.Project.SetTemplateOrder(ctx, []string{TemplateSourceShared, TemplateSourceProject}))
+	assert.Equal(t, TemplateSourceShared, get().Source)
+	order, err := services.Project.GetTemplateOrder(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{TemplateSourceShared, TemplateSourceProject}, order)
+
+	err = services.Project.SetTemplateOrder(ctx, []string{"database"})
+	var validationErrors ValidationErrors
+	require.ErrorAs(t, err, &validationErrors)
+
+	templates, err := services.Project.ListTemplates(ctx, project.ID)
+	require.NoError(t, err)
+	var active []string
+	for _, template := range templates {
+		assert.Empty(t, template.Content)
+		if template.Active {
+			active = append(active, template.Kind+" "+template.Origin())
+		}
+	}
+	assert.Equal(t, []string{"toc shared:gross", "toc-html built-in"}, active)
+
+	// Projects selecting a deleted shared template fail to resolve it
+	require.NoError(t, services.Project.DeleteSharedTemplate(ctx, shared.ID))
+	_, err = services.Project.GetTemplate(ctx, TemplateRef{Kind: TemplateKindTOC, ProjectID: project.ID})
+	assert.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+func TestProjectService_SharedTemplates(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	_, err := services.Project.CreateSharedTemplate(ctx, TemplateRequest{Name: "kaputt", Kind: TemplateKindTOCHTML, Content: "{{range .Entries}}"})
+	var validationErrors ValidationErrors
+	require.ErrorAs(t, err, &validationErrors)
+	assert.Equal(t, "content", validationErrors[0].Field)
+
+	_, err = services.Project.CreateSharedTemplate(ctx, TemplateRequest{Name: "x", Kind: "cover", Content: ""})
+	require.ErrorAs(t, err, &validationErrors)
+	assert.Equal(t, "kind", validationErrors[0].Field)
+
+	template, err := services.Project.CreateSharedTemplate(ctx, TemplateRequest{Name: "liste", Kind: TemplateKindTOCHTML, Content: "<ul></ul>"})
+	require.NoError(t, err)
+	_, err = services.Project.CreateSharedTemplate(ctx, TemplateRequest{Name: "liste", Kind: TemplateKindTOCHTML, Content: "<ol></ol>"})
+	require.ErrorAs(t, err, &validationErrors)
+	assert.Equal(t, "name", validationErrors[0].Field)
+
+	updated, err := services.Project.UpdateSharedTemplate(ctx, template.ID, TemplateRequest{Name: "liste", Description: "Liste", Content: "<ol></ol>"})
+	require.NoError(t, err)
+	assert.Equal(t, TemplateKindTOCHTML, updated.Kind)
+	assert.Equal(t, "<ol></ol>", updated.Content)
+
+	_, err = services.Project.UpdateSharedTemplate(ctx, template.ID+1, TemplateRequest{Name: "liste"})
+	assert.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+func TestProjectService_PreviewTemplate(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+	t.Setenv(UserTemplateDirEnv, t.TempDir())
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{Title: "Preview Project", ShortName: "TPLPRE"})
+	require.NoError(t, err)
+	defer os.RemoveAll("TPLPRE")
+	song, err := services.DB().Song.Create().SetTitle("Amazing <Grace>").SetFilename("grace.abc").SetTocinfo("trad.").Save(ctx)
+	require.NoError(t, err)
+	priority := 1
+	_, err = services.Project.AddSongToProject(ctx, AddSongToProjectRequest{ProjectID: project.ID, SongID: song.ID, Priority: &priority})
+	require.NoError(t, err)
+
+	preview, err := services.Project.PreviewTemplate(ctx, TemplatePreviewRequest{
+		TemplateRef: TemplateRef{Kind: TemplateKindTOC, ProjectID: project.ID},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, TemplateSourceBuiltIn, preview.Template.Source)
+	assert.Contains(t, preview.Content, "W:01 Amazing <Grace> - trad.\n")
+
+	// Unsaved content is previewed in place of the template
+	preview, err = services.Project.PreviewTemplate(ctx, TemplatePreviewRequest{
+		TemplateRef: TemplateRef{Kind: TemplateKindTOCHTML, ProjectID: project.ID},
+		Content:     "<h1>#{project_title}</h1>{{range .Entries}}<p>{{.Title}}</p>{{end}}",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "text/html", preview.ContentType)
+	assert.Equal(t, "<h1>Preview Project</h1><p>Amazing &lt;Grace&gt;</p>", preview.Content)
+
+	_, err = services.Project.PreviewTemplate(ctx, TemplatePreviewRequest{TemplateRef: TemplateRef{Kind: TemplateKindTOC}})
+	var validationErrors ValidationErrors
+	require.ErrorAs(t, err, &validationErrors)
+}
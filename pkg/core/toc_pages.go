@@ -0,0 +1,216 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/templatevars"
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+)
+
+// Project config key of the page numbers of the table of contents, e.g.
+//
+//	"tocPageNumbers": false
+const tocPageNumbersConfigKey = "tocPageNumbers"
+
+// maxTocPagePasses limits how often the table of contents of a folder is
+// rendered with page numbers. A table of contents that gets longer or
+// shorter with its page numbers moves the songs and needs another pass.
+const maxTocPagePasses = 3
+
+// tocPageNumbers returns whether the table of contents shows the pages the
+// songs start on, it does unless the project config turns it off
+func tocPageNumbers(project *ent.Project) (bool, error) {
+	value, ok := project.Config[tocPageNumbersConfigKey]
+	if !ok || value == nil {
+		return true, nil
+	}
+	enabled, ok := value.(bool)
+	if !ok {
+		return false, ValidationErrors{{
+			Field:   "config." + tocPageNumbersConfigKey,
+			Message: "tocPageNumbers must be true or false",
+		}}
+	}
+	return enabled, nil
+}
+
+// songPages returns the page every song starts on in a PDF merged from the
+// files with the given page counts. Files carry the number of their song as
+// prefix, the first file of a song decides.
+func songPages(files []string, counts []int) map[int]int {
+	pages := make(map[int]int)
+	page := 1
+	for i, file := range files {
+		prefix, _, found := strings.Cut(filepath.Base(file), "_")
+		number, err := strconv.Atoi(prefix)
+		if found && err == nil && number > 0 && counts[i] > 0 {
+			if _, ok := pages[number]; !ok {
+				pages[number] = page
+			}
+		}
+		page += counts[i]
+	}
+	return pages
+}
+
+// folderTocFiles tells which files of a document of the table of contents
+// a druckdateien folder has
+type folderTocFiles struct {
+	doc       tocDocument
+	abc, html bool
+}
+
+// findTocFiles returns the documents with files among the files of a folder
+func findTocFiles(docs []tocDocument, folder string, files []string) []folderTocFiles {
+	var found []folderTocFiles
+	for _, doc := range docs {
+		tocFiles := folderTocFiles{doc: doc}
+		for _, file := range files {
+			name := filepath.Base(file)
+			switch {
+			case name == "00_"+doc.htmlPDF():
+				tocFiles.html = true
+			case doc.ownsFile(name):
+				tocFiles.abc = true
+			}
+		}
+		if tocFiles.abc || tocFiles.html {
+			found = append(found, tocFiles)
+		}
+	}
+	return found
+}
+
+// countFolderPages returns the PDFs of a druckdateien folder in merge order
+// and their page counts
+func countFolderPages(dir string) ([]string, []int, error) {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil, nil, nil
+	}
+	files, err := mergeOrder(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	counts := make([]int, len(files))
+	for i, file := range files {
+		counts[i], err = api.PageCountFile(file)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to count pages of %s: %w", filepath.Base(file), err)
+		}
+	}
+	return files, counts, nil
+}
+
+// numberTocPages renders the table of contents and the registers of every
+// druckdateien folder again with the pages the songs start on in the merged
+// PDF of the folder. This is the second pass of a build, the first pass
+// rendered them without pages.
+func (s *projectService) numberTocPages(ctx context.Context, docs []tocDocument, project *ent.Project, projectSongs []*ent.ProjectSong, vars *templatevars.Variables, outputDir string, routing *FolderRouting) error {
+	for _, folder := range routing.Folders() {
+		if err := s.numberFolderTocPages(ctx, docs, project, projectSongs, vars, outputDir, folder, routing); err != nil {
+			return fmt.Errorf("failed to number the pages of the table of contents in %s: %w", folder, err)
+		}
+	}
+	return nil
+}
+
+// numberFolderTocPages renders the table of contents of a folder with page
+// numbers until the pages of the songs no longer change
+func (s *projectService) numberFolderTocPages(ctx context.Context, docs []tocDocument, project *ent.Project, projectSongs []*ent.ProjectSong, vars *templatevars.Variables, outputDir, folder string, routing *FolderRouting) error {
+	dir := filepath.Join(outputDir, "druckdateien", folder)
+	var rendered map[int]int
+	for pass := 1; ; pass++ {
+		files, counts, err := countFolderPages(dir)
+		if err != nil {
+			return err
+		}
+
+		found := findTocFiles(docs, folder, files)
+		if len(found) == 0 {
+			return nil
+		}
+
+		pages := songPages(files, counts)
+		if maps.Equal(pages, rendered) {
+			return nil
+		}
+		if pass > maxTocPagePasses {
+			slog.Warn("page numbers of the table of contents did not settle", "folder", folder, "passes", maxTocPagePasses)
+			return nil
+		}
+
+		slog.Info("numbering the pages of the table of contents", "folder", folder, "pass", pass)
+		for _, tocFiles := range found {
+			if err := s.renderFolderToc(ctx, tocFiles, project, projectSongs, vars, pages, outputDir, folder, routing); err != nil {
+				return err
+			}
+		}
+		rendered = pages
+	}
+}
+
+// routesToFolder tells whether the folder routing distributes a PDF written
+// to pdf/ into folder
+func routesToFolder(name string, routing *FolderRouting, folder string) bool {
+	match := pdfFolders(name, routing)
+	return !match.Excluded && containsString(match.Folders, folder)
+}
+
+// renderFolderToc renders a document of the table of contents with the pages
+// of a folder and replaces its files in the folder
+func (s *projectService) renderFolderToc(ctx context.Context, tocFiles folderTocFiles, project *ent.Project, projectSongs []*ent.ProjectSong, vars *templatevars.Variables, pages map[int]int, outputDir, folder string, routing *FolderRouting) error {
+	doc := tocFiles.doc
+	tempDir, err := os.MkdirTemp("", "zupfmanager-toc-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+	pdfDir := filepath.Join(tempDir, "pdf")
+	for _, dir := range []string{filepath.Join(tempDir, "abc"), pdfDir} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create directory %s: %w", dir, err)
+		}
+	}
+	dir := filepath.Join(outputDir, "druckdateien", folder)
+
+	if tocFiles.abc {
+		if err := s.renderTocPDFs(ctx, doc, project, projectSongs, vars, pages, tempDir); err != nil {
+			return err
+		}
+		files, err := filepath.Glob(filepath.Join(pdfDir, doc.BaseName+"*.pdf"))
+		if err != nil {
+			return fmt.Errorf("failed to glob PDF files: %w", err)
+		}
+		// Only the PDFs the folder rules route to this folder replace files
+		for _, file := range files {
+			name := filepath.Base(file)
+			if !routesToFolder(name, routing, folder) {
+				continue
+			}
+			if err := s.copyFile(file, filepath.Join(dir, "00_"+name)); err != nil {
+				return fmt.Errorf("failed to copy file: %w", err)
+			}
+		}
+	}
+
+	if tocFiles.html {
+		converted, err := s.renderHTMLTocPDF(ctx, doc, project, projectSongs, vars, pages, outputDir, pdfDir)
+		if err != nil {
+			return err
+		}
+		if converted && routesToFolder(doc.htmlPDF(), routing, folder) {
+			if err := s.copyFile(filepath.Join(pdfDir, doc.htmlPDF()), filepath.Join(dir, "00_"+doc.htmlPDF())); err != nil {
+				return fmt.Errorf("failed to copy HTML TOC PDF: %w", err)
+			}
+		}
+	}
+	return nil
+}
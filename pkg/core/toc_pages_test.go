@@ -0,0 +1,105 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTocPageNumbers(t *testing.T) {
+	enabled, err := tocPageNumbers(&ent.Project{})
+	require.NoError(t, err)
+	assert.True(t, enabled)
+
+	enabled, err = tocPageNumbers(&ent.Project{Config: map[string]interface{}{"tocPageNumbers": false}})
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, err = tocPageNumbers(&ent.Project{Config: map[string]interface{}{"tocPageNumbers": "ja"}})
+	var validationErrors ValidationErrors
+	require.ErrorAs(t, err, &validationErrors)
+	assert.Equal(t, "config.tocPageNumbers", validationErrors[0].Field)
+}
+
+func TestSongPages(t *testing.T) {
+	files := []string{
+		"klein/00_00_inhaltsverzeichnis_a3.pdf",
+		"klein/01_alpha_-A1_a3.pdf",
+		"klein/01_alpha_-A2_a3.pdf",
+		"klein/02_beta_-A1_a3.pdf",
+		"klein/03_empty_-A1_a3.pdf",
+		"klein/04_gamma_-A1_a3.pdf",
+	}
+	// The first file of a song decides, empty files start no song
+	pages := songPages(files, []int{2, 1, 3, 2, 0, 1})
+	assert.Equal(t, map[int]int{1: 3, 2: 7, 4: 9}, pages)
+}
+
+func TestRoutesToFolder(t *testing.T) {
+	routing, err := FolderRoutingFromConfig(map[string]interface{}{
+		"folderRules": []interface{}{
+			map[string]interface{}{"match": "00_inhaltsverzeichnis_noten.pdf", "exclude": true},
+			map[string]interface{}{"match": "*_noten.pdf", "folder": "noten"},
+			map[string]interface{}{"match": "*_-A*_a3.pdf", "folder": "klein"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, routesToFolder("00_inhaltsverzeichnis_-A1_a3.pdf", routing, "klein"))
+	assert.False(t, routesToFolder("00_inhaltsverzeichnis_-A1_a3.pdf", routing, "noten"))
+	assert.False(t, routesToFolder(tableOfContents.htmlPDF(), routing, "noten"))
+	assert.True(t, routesToFolder(alphabeticalRegister.htmlPDF(), routing, "noten"))
+}
+
+func TestRenderTocABCWithPages(t *testing.T) {
+	songs := []*ent.ProjectSong{
+		{Number: 1, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Alpha", Tocinfo: "trad."}}},
+		{Number: 2, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Beta"}}},
+	}
+	project := &ent.Project{Title: "Pages", ShortName: "PG"}
+
+	toc, err := renderTocABC("X:1\nW:{{TOC}}\n", tableOfContents, project, songs, projectVariables(project, "", time.Now()), map[int]int{1: 3})
+	require.NoError(t, err)
+	assert.Equal(t, "X:1\nW:01 Alpha - trad., S. 3\nW:02 Beta\n\n", toc)
+}
+
+func TestProjectService_NumberTocPages(t *testing.T) {
+	t.Setenv(UserTemplateDirEnv, t.TempDir())
+	outputDir := t.TempDir()
+	noten := filepath.Join(outputDir, "druckdateien", "noten")
+	klein := filepath.Join(outputDir, "druckdateien", "klein")
+	require.NoError(t, os.MkdirAll(noten, 0755))
+	require.NoError(t, os.MkdirAll(klein, 0755))
+	for name, pages := range map[string]int{
+		"00_00_inhaltsverzeichnis_noten.pdf": 1,
+		"01_alpha_noten.pdf":                 2,
+		"02_beta_noten.pdf":                  1,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(noten, name), minimalPDF(pages), 0644))
+	}
+	// Folders without table of contents are left alone
+	require.NoError(t, os.WriteFile(filepath.Join(klein, "01_alpha_-A1_a3.pdf"), minimalPDF(1), 0644))
+
+	project := &ent.Project{Title: "Pages", ShortName: "TOCPAGES"}
+	songs := []*ent.ProjectSong{
+		{Number: 1, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Alpha"}}},
+		{Number: 2, Edges: ent.ProjectSongEdges{Song: &ent.Song{Title: "Beta"}}},
+	}
+	routing, err := projectFolderRouting(project)
+	require.NoError(t, err)
+	s := &projectService{}
+	err = s.numberTocPages(context.Background(), []tocDocument{tableOfContents}, project, songs, projectVariables(project, "", time.Now()), outputDir, routing)
+	require.NoError(t, err)
+
+	// Without Chrome the PDF stays as it is, the HTML shows the pages of the noten folder
+	html, err := os.ReadFile(filepath.Join(outputDir, "html", tableOfContents.htmlFilename()))
+	require.NoError(t, err)
+	assert.Contains(t, string(html), `<td class="toc-page">2</td>`)
+	assert.Contains(t, string(html), `<td class="toc-page">4</td>`)
+}
@@ -98,6 +98,14 @@ func ValidateBuildProjectRequest(req BuildProjectRequest) error {
 		})
 	}
 
+	// Validate SongOrder if provided
+	if req.SongOrder != "" && !containsString(SongOrders, req.SongOrder) {
+		errors = append(errors, ValidationError{
+			Field:   "song_order",
+			Message: fmt.Sprintf("song_order must be one of %s", strings.Join(SongOrders, ", ")),
+		})
+	}
+
 	if errors.HasErrors() {
 		return errors
 	}
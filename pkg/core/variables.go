@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/templatevars"
+)
+
+// ProjectVariables are the variables every project config and table of
+// contents template can refer to
+var ProjectVariables = []string{
+	"PREFIX",
+	"project_title",
+	"short_name",
+	"sampleId",
+	"build_date",
+	"toc_title",
+}
+
+// SongVariables are the variables the project config can refer to for
+// every song in addition to ProjectVariables
+var SongVariables = []string{
+	"the_index",
+	"song_title",
+	"filename",
+	"genre",
+	"copyright",
+	"tocinfo",
+	"difficulty",
+	"priority",
+	"comment",
+}
+
+// projectVariables returns the variables of a build of the project. The
+// user-defined variables of the project come first, built-in ones can not be
+// overridden.
+func projectVariables(project *ent.Project, sampleID string, buildDate time.Time) *templatevars.Variables {
+	vars := templatevars.New()
+	for name, value := range project.Variables {
+		vars.Set(name, value)
+	}
+	vars.Set("PREFIX", project.ShortName)
+	vars.Set("project_title", project.Title)
+	vars.Set("short_name", project.ShortName)
+	vars.Set("sampleId", sampleID)
+	vars.SetDate("build_date", buildDate)
+	vars.Set("toc_title", tableOfContents.Title)
+	return vars
+}
+
+// songVariables extends the variables of a build with those of a song at
+// the given index
+func songVariables(vars *templatevars.Variables, ps *ent.ProjectSong, songIndex int) *templatevars.Variables {
+	vars = vars.Clone()
+	vars.Set("the_index", fmt.Sprintf("%02d", songIndex))
+	vars.Set("difficulty", string(ps.Difficulty))
+	vars.Set("priority", strconv.Itoa(ps.Priority))
+	vars.Set("comment", ps.Comment)
+	if song := ps.Edges.Song; song != nil {
+		vars.Set("song_title", song.Title)
+		vars.Set("filename", song.Filename)
+		vars.Set("genre", song.Genre)
+		vars.Set("copyright", song.Copyright)
+		vars.Set("tocinfo", song.Tocinfo)
+	}
+	return vars
+}
+
+// ValidateProjectVariables checks the names of user-defined variables
+func ValidateProjectVariables(variables map[string]string) error {
+	var errors ValidationErrors
+
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		switch {
+		case !templatevars.ValidName(name):
+			errors = append(errors, ValidationError{
+				Field:   "variables." + name,
+				Message: "variable names must start with a letter or underscore and contain only letters, digits and underscores",
+			})
+		case containsString(ProjectVariables, name) || containsString(SongVariables, name):
+			errors = append(errors, ValidationError{
+				Field:   "variables." + name,
+				Message: fmt.Sprintf("%s is a built-in variable", name),
+			})
+		}
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+	return nil
+}
+
+// SetProjectVariables replaces the user-defined variables of a project
+func (s *projectService) SetProjectVariables(ctx context.Context, projectID int, variables map[string]string) (*Project, error) {
+	if err := ValidateProjectVariables(variables); err != nil {
+		return nil, err
+	}
+
+	update := s.db.Project.UpdateOneID(projectID)
+	if len(variables) == 0 {
+		update.ClearVariables()
+	} else {
+		update.SetVariables(variables)
+	}
+	entProject, err := update.Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrProjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return ProjectFromEnt(entProject), nil
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/ent/projectsong"
+	"github.com/bwl21/zupfmanager/internal/templatevars"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSongConfigVariables(t *testing.T) {
+	project := &ent.Project{
+		Title:     "Herbstseminar",
+		ShortName: "HS",
+		Variables: map[string]string{"ort": "Bad Boll", "PREFIX": "ignored"},
+		Config: map[string]interface{}{
+			"extract": map[string]interface{}{
+				"0": map[string]interface{}{
+					"title": "#{PREFIX}-#{the_index} #{song_title}",
+					"notes": map[string]interface{}{
+						"T01": map[string]interface{}{"text": "#{ort}, #{build_date:02.01.2006} #{genre|ohne Genre}"},
+						"T02": map[string]interface{}{"text": "#{difficulty}/#{priority} #{comment}"},
+					},
+				},
+			},
+		},
+	}
+	ps := &ent.ProjectSong{
+		Priority:   2,
+		Difficulty: projectsong.DifficultyHard,
+		Comment:    "langsam",
+		Edges: ent.ProjectSongEdges{
+			Project: project,
+			Song:    &ent.Song{Title: `Der "Mond"`, Filename: "mond.abc"},
+		},
+	}
+
+	vars := projectVariables(project, "", time.Date(2025, 10, 3, 0, 0, 0, 0, time.UTC))
+	config, err := (&projectService{}).resolveSongConfig(ps, 4, vars, []byte("X:1\n"))
+	require.NoError(t, err)
+
+	extract := config["extract"].(map[string]any)["0"].(map[string]any)
+	notes := extract["notes"].(map[string]any)
+	assert.Equal(t, `HS-04 Der "Mond"`, extract["title"])
+	assert.Equal(t, "Bad Boll, 03.10.2025 ohne Genre", notes["T01"].(map[string]any)["text"])
+	assert.Equal(t, "hard/2 langsam", notes["T02"].(map[string]any)["text"])
+
+	// The stored project config is not touched
+	assert.Equal(t, "#{PREFIX}-#{the_index} #{song_title}", project.Config["extract"].(map[string]interface{})["0"].(map[string]interface{})["title"])
+
+	project.Config["extract"].(map[string]interface{})["0"].(map[string]interface{})["title"] = "#{unknown}"
+	_, err = (&projectService{}).resolveSongConfig(ps, 4, vars, []byte("X:1\n"))
+	assert.ErrorIs(t, err, templatevars.ErrUnknownVariable)
+}
+
+func TestValidateProjectVariables(t *testing.T) {
+	assert.NoError(t, ValidateProjectVariables(nil))
+	assert.NoError(t, ValidateProjectVariables(map[string]string{"ort": "", "_x1": "y"}))
+
+	err := ValidateProjectVariables(map[string]string{"the_index": "1", "a-b": "c", "ok": "d"})
+	require.Error(t, err)
+	errs := err.(ValidationErrors)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "variables.a-b", errs[0].Field)
+	assert.Equal(t, "variables.the_index", errs[1].Field)
+}
+
+func TestProjectService_SetProjectVariables(t *testing.T) {
+	services, cleanup := setupProjectTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	project, err := services.Project.Create(ctx, CreateProjectRequest{
+		Title:     "Variables Project",
+		ShortName: "VAR",
+		Config:    map[string]interface{}{"title": "#{ort|unbekannt}"},
+	})
+	require.NoError(t, err)
+
+	updated, err := services.Project.SetProjectVariables(ctx, project.ID, map[string]string{"ort": "Monbachtal"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"ort": "Monbachtal"}, updated.Variables)
+
+	// Builds use the stored variables
+	abcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(abcDir, "song.abc"), []byte("X:1\nT:Song\n"), 0644))
+	song, err := services.DB().Song.Create().SetTitle("Song").SetFilename("song.abc").Save(ctx)
+	require.NoError(t, err)
+	priority := 1
+	_, err = services.Project.AddSongToProject(ctx, AddSongToProjectRequest{
+		ProjectID: project.ID,
+		SongID:    song.ID,
+		Priority:  &priority,
+	})
+	require.NoError(t, err)
+
+	resolved, err := services.Project.ResolveSongConfig(ctx, ResolveSongConfigRequest{
+		ProjectID:  project.ID,
+		SongID:     song.ID,
+		AbcFileDir: abcDir,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Monbachtal", resolved.Config["title"])
+	assert.Equal(t, "Monbachtal", resolved.Variables["ort"])
+	assert.Equal(t, "Song", resolved.Variables["song_title"])
+
+	_, err = services.Project.SetProjectVariables(ctx, project.ID, map[string]string{"PREFIX": "x"})
+	assert.IsType(t, ValidationErrors{}, err)
+
+	updated, err = services.Project.SetProjectVariables(ctx, project.ID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, updated.Variables)
+
+	_, err = services.Project.SetProjectVariables(ctx, 999, map[string]string{"ort": "x"})
+	assert.Equal(t, ErrProjectNotFound, err)
+}
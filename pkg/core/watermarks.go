@@ -0,0 +1,165 @@
+package core
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/bwl21/zupfmanager/internal/templatevars"
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	pdfcpuModel "github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/types"
+)
+
+// watermarksConfigKey is the project config key of the watermarks stamped
+// on the print files, e.g.
+//
+//	"watermarks": {
+//	  "entwurf": {"text": "ENTWURF #{sampleId}", "style": "rot:45, op:0.2", "folders": ["klein", "gross"]},
+//	  "teilnehmer": {"text": "Nur für Teilnehmer", "style": "pos:bc, scale:0.4", "onTop": true, "final": true}
+//	}
+//
+// Watermarks are named so that editions and build profiles can change or
+// disable single watermarks with their config overlay.
+const watermarksConfigKey = "watermarks"
+
+// buildWatermark is a text or image watermark of a build
+type buildWatermark struct {
+	Name    string
+	Text    string   // text with the variables of the build expanded
+	Image   string   // path of an image file
+	Style   string   // pdfcpu description, e.g. "rot:45, op:0.3, scale:0.5"
+	OnTop   bool     // stamped over the page content instead of behind it
+	Folders []string // druckdateien folders to stamp, all if empty
+}
+
+// projectWatermarks returns the enabled watermarks of the project config in
+// name order. Final builds only get the watermarks marked final, drafts
+// marks are left out.
+func projectWatermarks(project *ent.Project, vars *templatevars.Variables, final bool) ([]buildWatermark, error) {
+	value, ok := project.Config[watermarksConfigKey]
+	if !ok || value == nil {
+		return nil, nil
+	}
+	entries, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, ValidationErrors{{
+			Field:   "config." + watermarksConfigKey,
+			Message: "watermarks must be an object of watermark names and settings",
+		}}
+	}
+
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errors ValidationErrors
+	var watermarks []buildWatermark
+	for _, name := range names {
+		field := fmt.Sprintf("config.%s.%s", watermarksConfigKey, name)
+		settings, ok := entries[name].(map[string]interface{})
+		if !ok {
+			errors = append(errors, ValidationError{Field: field, Message: "the watermark must be an object"})
+			continue
+		}
+		if enabled, ok := settings["enabled"].(bool); ok && !enabled {
+			continue
+		}
+		if keep, _ := settings["final"].(bool); final && !keep {
+			continue
+		}
+
+		watermark := buildWatermark{Name: name}
+		text, _ := settings["text"].(string)
+		watermark.Image, _ = settings["image"].(string)
+		watermark.Style, _ = settings["style"].(string)
+		watermark.OnTop, _ = settings["onTop"].(bool)
+		if folders, ok := settings["folders"].([]interface{}); ok {
+			for _, folder := range folders {
+				if folder, ok := folder.(string); ok {
+					watermark.Folders = append(watermark.Folders, folder)
+				}
+			}
+		}
+
+		if (text == "") == (watermark.Image == "") {
+			errors = append(errors, ValidationError{Field: field, Message: "a watermark needs either text or image"})
+			continue
+		}
+		if text != "" {
+			expanded, err := vars.Expand(text)
+			if err != nil {
+				errors = append(errors, ValidationError{Field: field + ".text", Message: err.Error()})
+				continue
+			}
+			watermark.Text = strings.TrimSpace(expanded)
+		} else if _, err := os.Stat(watermark.Image); err != nil {
+			errors = append(errors, ValidationError{Field: field + ".image", Message: fmt.Sprintf("image %s not found", watermark.Image)})
+			continue
+		}
+		if _, err := watermark.pdfcpuWatermark(); err != nil {
+			errors = append(errors, ValidationError{Field: field + ".style", Message: err.Error()})
+			continue
+		}
+		watermarks = append(watermarks, watermark)
+	}
+
+	if errors.HasErrors() {
+		return nil, errors
+	}
+	return watermarks, nil
+}
+
+// appliesTo reports whether the watermark is stamped on the files of a folder
+func (w buildWatermark) appliesTo(folder string) bool {
+	return len(w.Folders) == 0 || containsString(w.Folders, folder)
+}
+
+// pdfcpuWatermark returns the pdfcpu watermark to stamp
+func (w buildWatermark) pdfcpuWatermark() (*pdfcpuModel.Watermark, error) {
+	if w.Image != "" {
+		return api.ImageWatermark(w.Image, w.Style, w.OnTop, false, types.POINTS)
+	}
+	return api.TextWatermark(w.Text, w.Style, w.OnTop, false, types.POINTS)
+}
+
+// stampWatermarks stamps the watermarks on every print file of the given
+// folders below druckdateien, before they are merged. The files are copies,
+// the PDFs in the render cache stay unmarked.
+func (s *projectService) stampWatermarks(outputDir string, folders []string, watermarks []buildWatermark) error {
+	for _, folder := range folders {
+		var folderWatermarks []buildWatermark
+		for _, watermark := range watermarks {
+			if watermark.appliesTo(folder) {
+				folderWatermarks = append(folderWatermarks, watermark)
+			}
+		}
+		if len(folderWatermarks) == 0 {
+			continue
+		}
+
+		files, err := filepath.Glob(filepath.Join(outputDir, "druckdateien", folder, "*.pdf"))
+		if err != nil {
+			return fmt.Errorf("failed to list PDFs of folder %s: %w", folder, err)
+		}
+		for _, file := range files {
+			for _, watermark := range folderWatermarks {
+				wm, err := watermark.pdfcpuWatermark()
+				if err != nil {
+					return fmt.Errorf("watermark %s: %w", watermark.Name, err)
+				}
+				if err := api.AddWatermarksFile(file, file, nil, wm, nil); err != nil {
+					return fmt.Errorf("failed to stamp watermark %s on %s: %w", watermark.Name, filepath.Base(file), err)
+				}
+			}
+		}
+		slog.Info("Stamped watermarks", "folder", folder, "files", len(files), "watermarks", len(folderWatermarks))
+	}
+	return nil
+}
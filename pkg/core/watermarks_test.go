@@ -0,0 +1,76 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bwl21/zupfmanager/internal/ent"
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectWatermarks(t *testing.T) {
+	project := &ent.Project{ShortName: "WMK", Config: map[string]any{
+		"watermarks": map[string]any{
+			"entwurf":    map[string]any{"text": "ENTWURF #{sampleId}", "style": "rot:45, op:0.2", "folders": []any{"klein"}},
+			"teilnehmer": map[string]any{"text": "Nur für Teilnehmer", "onTop": true, "final": true},
+			"alt":        map[string]any{"text": "Alt", "enabled": false},
+		},
+	}}
+	vars := projectVariables(project, "B", time.Now())
+
+	watermarks, err := projectWatermarks(project, vars, false)
+	require.NoError(t, err)
+	require.Len(t, watermarks, 2)
+	assert.Equal(t, buildWatermark{Name: "entwurf", Text: "ENTWURF B", Style: "rot:45, op:0.2", Folders: []string{"klein"}}, watermarks[0])
+	assert.True(t, watermarks[0].appliesTo("klein"))
+	assert.False(t, watermarks[0].appliesTo("gross"))
+	assert.True(t, watermarks[1].OnTop)
+	assert.True(t, watermarks[1].appliesTo("gross"))
+
+	// Final builds only keep the watermarks marked final
+	watermarks, err = projectWatermarks(project, vars, true)
+	require.NoError(t, err)
+	require.Len(t, watermarks, 1)
+	assert.Equal(t, "teilnehmer", watermarks[0].Name)
+
+	_, err = projectWatermarks(&ent.Project{Config: map[string]any{
+		"watermarks": map[string]any{
+			"both":    map[string]any{"text": "A", "image": "logo.png"},
+			"image":   map[string]any{"image": filepath.Join(t.TempDir(), "missing.png")},
+			"style":   map[string]any{"text": "A", "style": "unknown:1"},
+			"unknown": map[string]any{"text": "#{unknown}"},
+			"value":   "ENTWURF",
+		},
+	}}, vars, false)
+	require.IsType(t, ValidationErrors{}, err)
+	assert.Len(t, err.(ValidationErrors), 5)
+}
+
+func TestProjectService_StampWatermarks(t *testing.T) {
+	outputDir := t.TempDir()
+	for _, folder := range []string{"klein", "gross"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(outputDir, "druckdateien", folder), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(outputDir, "druckdateien", folder, "01_alpha.pdf"), minimalPDF(2), 0644))
+	}
+
+	s := &projectService{}
+	watermarks := []buildWatermark{
+		{Name: "entwurf", Text: "ENTWURF", Style: "op:0.2", Folders: []string{"klein"}},
+		{Name: "datum", Text: "2025-06-01", Style: "pos:bc, scale:0.2"},
+	}
+	require.NoError(t, s.stampWatermarks(outputDir, []string{"gross", "klein"}, watermarks))
+
+	for _, folder := range []string{"klein", "gross"} {
+		file := filepath.Join(outputDir, "druckdateien", folder, "01_alpha.pdf")
+		stamped, err := api.HasWatermarksFile(file, nil)
+		require.NoError(t, err)
+		assert.True(t, stamped, folder)
+		pages, err := api.PageCountFile(file)
+		require.NoError(t, err)
+		assert.Equal(t, 2, pages)
+	}
+}